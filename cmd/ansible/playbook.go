@@ -3,9 +3,12 @@
 package ansible
 
 import (
+	"io"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cloudposse/atmos/pkg/component"
+	h "github.com/cloudposse/atmos/pkg/hooks"
 )
 
 // playbookCmd represents the `atmos ansible playbook` command.
@@ -57,5 +60,8 @@ func runPlaybook(cmd *cobra.Command, args []string) error {
 	}
 
 	// Execute via component registry.
-	return provider.Execute(ctx)
+	return h.RunWithEvents(h.BeforeAnsiblePlaybook, h.AfterAnsiblePlaybook, &info, func(capture io.Writer) error {
+		ctx.OutputCapture = capture
+		return provider.Execute(ctx)
+	})
 }
@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/agent"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	envpkg "github.com/cloudposse/atmos/pkg/env"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/ui"
+)
+
+const (
+	agentSocketFlagName          = "socket"
+	agentRefreshIntervalFlagName = "refresh-interval"
+	// authAgentSocketViperKey is the viper key bound to ATMOS_AUTH_AGENT_SOCKET.
+	authAgentSocketViperKey = "atmos_auth_agent_socket"
+	// authAgentRequestTimeout bounds how long exec/shell wait for the agent before falling back.
+	authAgentRequestTimeout = 5 * time.Minute
+)
+
+//go:embed markdown/atmos_auth_agent_usage.md
+var authAgentUsageMarkdown string
+
+// authAgentCmd runs the background credential refresher.
+var authAgentCmd = &cobra.Command{
+	Use:   "agent",
+	Short: "Run a background agent that keeps credentials fresh.",
+	Long: `Run an agent that refreshes identity credentials before they expire and serves them over a Unix socket.
+
+Export ATMOS_AUTH_AGENT_SOCKET to point 'atmos auth exec' and 'atmos auth shell' at the agent. AWS SDKs in those processes
+then load credentials through a generated credential_process profile, so long-running commands such as 'terraform apply'
+keep working after the original session expires.`,
+	Example:            authAgentUsageMarkdown,
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: false},
+	RunE:               executeAuthAgentCommand,
+}
+
+// authAgentCredentialProcessCmd prints credentials from the agent in AWS credential_process format.
+var authAgentCredentialProcessCmd = &cobra.Command{
+	Use:   "credential-process",
+	Short: "Print AWS credentials from the auth agent in credential_process format.",
+	Long:  "Fetch fresh AWS credentials for an identity from a running auth agent. Intended to be referenced by `credential_process` in an AWS config file.",
+	Example: `  # ~/.aws/config
+  [profile prod-admin]
+  credential_process = atmos auth agent credential-process --identity=prod-admin`,
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: false},
+	RunE:               executeAuthAgentCredentialProcessCommand,
+}
+
+// executeAuthAgentCommand starts the agent in the foreground until interrupted.
+func executeAuthAgentCommand(cmd *cobra.Command, args []string) error {
+	defer perf.Track(nil, "cmd.executeAuthAgentCommand")()
+
+	handleHelpRequest(cmd, args)
+	checkAtmosConfig(WithStackValidation(false))
+
+	atmosConfig, err := cfg.InitCliConfig(newAuthConfigAndStacksInfo(cmd), false)
+	if err != nil {
+		return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrFailedToInitializeAtmosConfig, err)
+	}
+
+	authManager, err := createAuthManager(&atmosConfig.Auth, atmosConfig.CliConfigPath)
+	if err != nil {
+		return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrFailedToInitializeAuthManager, err)
+	}
+
+	// Authenticate the requested (or default) identity up front so interactive logins happen now,
+	// not on the first request from a child process.
+	// GetIdentityFromFlags handles the NoOptDefVal quirk for `--identity <name>`.
+	identityName := GetIdentityFromFlags(cmd, os.Args)
+	if identityName == cfg.IdentityFlagDisabledValue {
+		identityName = ""
+	} else if identityName == "" || identityName == IdentityFlagSelectValue {
+		defaultIdentity, err := authManager.GetDefaultIdentity(identityName == IdentityFlagSelectValue)
+		if err != nil {
+			log.Debug("No default identity for auth agent; identities will be authenticated on first request", "error", err)
+		}
+		identityName = defaultIdentity
+	}
+	var identities []string
+	if identityName != "" {
+		identities = append(identities, identityName)
+	}
+
+	refreshInterval, _ := cmd.Flags().GetDuration(agentRefreshIntervalFlagName)
+	socketPath := authAgentSocketPath(cmd)
+	a, err := agent.New(authManager, agent.Options{
+		SocketPath:      socketPath,
+		Identities:      identities,
+		RefreshInterval: refreshInterval,
+		OnListen: func() {
+			ui.Infof("Auth agent listening on `%s`", socketPath)
+			ui.Hintf("Run `export %s=%s` to use it from `atmos auth exec` and `atmos auth shell`", agent.SocketEnvVar, socketPath)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := a.Run(ctx); err != nil {
+		return err
+	}
+	ui.Info("Auth agent stopped")
+	return nil
+}
+
+// executeAuthAgentCredentialProcessCommand writes credential_process JSON to stdout.
+// It doesn't load atmos.yaml; the agent owns the auth configuration.
+func executeAuthAgentCredentialProcessCommand(cmd *cobra.Command, _ []string) error {
+	defer perf.Track(nil, "cmd.executeAuthAgentCredentialProcessCommand")()
+
+	identityName := GetIdentityFromFlags(cmd, os.Args)
+	if identityName == "" || identityName == IdentityFlagSelectValue || identityName == cfg.IdentityFlagDisabledValue {
+		return fmt.Errorf("%w: --identity is required", errUtils.ErrInvalidArgumentError)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), authAgentRequestTimeout)
+	defer cancel()
+
+	creds, err := agent.NewClient(authAgentSocketPath(cmd)).Credentials(ctx, identityName)
+	if err != nil {
+		return err
+	}
+
+	// Write directly to stdout: the output is consumed by AWS SDKs and must not be masked.
+	return json.NewEncoder(cmd.OutOrStdout()).Encode(creds)
+}
+
+// authAgentSocketPath resolves the agent socket from --socket, ATMOS_AUTH_AGENT_SOCKET, or the default location.
+func authAgentSocketPath(cmd *cobra.Command) string {
+	if cmd != nil {
+		if socket, _ := cmd.Flags().GetString(agentSocketFlagName); socket != "" {
+			return socket
+		}
+	}
+	if socket := viper.GetString(authAgentSocketViperKey); socket != "" {
+		return socket
+	}
+	socket, err := agent.DefaultSocketPath()
+	if err != nil {
+		log.Debug("Failed to resolve default auth agent socket", "error", err)
+	}
+	return socket
+}
+
+// authAgentEnvironment asks the agent selected by ATMOS_AUTH_AGENT_SOCKET for the environment that
+// points a child process at it. It returns false when no agent is configured or it can't be reached,
+// in which case callers authenticate locally as usual.
+func authAgentEnvironment(ctx context.Context, identityName string) (map[string]string, bool) {
+	socket := viper.GetString(authAgentSocketViperKey)
+	if socket == "" {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, authAgentRequestTimeout)
+	defer cancel()
+
+	env, err := agent.NewClient(socket).Environment(ctx, identityName)
+	if err != nil {
+		if errors.Is(err, errUtils.ErrAuthAgentUnavailable) {
+			ui.Warningf("Auth agent at `%s` is not reachable, authenticating without it", socket)
+		} else {
+			ui.Warningf("Auth agent could not provide credentials for `%s`, authenticating without it", identityName)
+		}
+		log.Debug("Auth agent request failed", "socket", socket, "identity", identityName, "error", err)
+		return nil, false
+	}
+	return env, true
+}
+
+// applyAuthAgentEnvironment overlays the agent's variables on a prepared environment.
+func applyAuthAgentEnvironment(envList []string, agentEnv map[string]string) []string {
+	for key, value := range agentEnv {
+		envList = envpkg.UpdateEnvVar(envList, key, value)
+	}
+	return envList
+}
+
+func init() {
+	// NOTE: --identity flag is inherited from parent authCmd (PersistentFlags in cmd/auth.go).
+	authAgentCmd.PersistentFlags().String(agentSocketFlagName, "", fmt.Sprintf("Path of the agent's Unix socket (defaults to $%s, then the XDG state directory)", agent.SocketEnvVar))
+	authAgentCmd.Flags().Duration(agentRefreshIntervalFlagName, agent.DefaultRefreshInterval, "How often to check whether credentials need refreshing")
+
+	if err := viper.BindEnv(authAgentSocketViperKey, agent.SocketEnvVar); err != nil {
+		log.Trace("Failed to bind auth agent socket environment variable", "error", err)
+	}
+
+	authAgentCmd.AddCommand(authAgentCredentialProcessCmd)
+	authCmd.AddCommand(authAgentCmd)
+}
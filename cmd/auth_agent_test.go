@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/auth/agent"
+	awsCloud "github.com/cloudposse/atmos/pkg/auth/cloud/aws"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+)
+
+// staticAuthenticator returns the same AWS credentials for every identity.
+type staticAuthenticator struct{}
+
+func (staticAuthenticator) Authenticate(_ context.Context, identity string) (*types.WhoamiInfo, error) {
+	return &types.WhoamiInfo{
+		Identity: identity,
+		Credentials: &types.AWSCredentials{
+			AccessKeyID:     "AKIATEST",
+			SecretAccessKey: "secret",
+			Expiration:      time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		},
+	}, nil
+}
+
+// startTestAuthAgent runs an agent on a short socket path for the duration of the test.
+func startTestAuthAgent(t *testing.T) string {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("", "agent")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+
+	a, err := agent.New(staticAuthenticator{}, agent.Options{SocketPath: filepath.Join(dir, "a.sock"), Executable: "atmos"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- a.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	require.Eventually(t, func() bool {
+		_, err := agent.NewClient(a.SocketPath()).Identities(context.Background())
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return a.SocketPath()
+}
+
+func TestAuthAgentCredentialProcess(t *testing.T) {
+	_ = NewTestKit(t)
+
+	socket := startTestAuthAgent(t)
+
+	cmd := &cobra.Command{Use: "credential-process"}
+	cmd.Flags().String(IdentityFlagName, "", "")
+	cmd.Flags().String(agentSocketFlagName, "", "")
+	require.NoError(t, cmd.Flags().Set(IdentityFlagName, "dev"))
+	require.NoError(t, cmd.Flags().Set(agentSocketFlagName, socket))
+
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	require.NoError(t, executeAuthAgentCredentialProcessCommand(cmd, nil))
+
+	var creds awsCloud.ProcessCredentials
+	require.NoError(t, json.Unmarshal(out.Bytes(), &creds))
+	assert.Equal(t, 1, creds.Version)
+	assert.Equal(t, "AKIATEST", creds.AccessKeyID)
+}
+
+func TestAuthAgentCredentialProcess_RequiresIdentity(t *testing.T) {
+	_ = NewTestKit(t)
+
+	cmd := &cobra.Command{Use: "credential-process"}
+	cmd.Flags().String(IdentityFlagName, "", "")
+	cmd.Flags().String(agentSocketFlagName, "", "")
+
+	err := executeAuthAgentCredentialProcessCommand(cmd, nil)
+	assert.ErrorContains(t, err, "--identity is required")
+}
+
+func TestAuthAgentEnvironment(t *testing.T) {
+	_ = NewTestKit(t)
+
+	t.Run("no agent configured", func(t *testing.T) {
+		t.Setenv(agent.SocketEnvVar, "")
+		_, ok := authAgentEnvironment(context.Background(), "dev")
+		assert.False(t, ok)
+	})
+
+	t.Run("agent unreachable", func(t *testing.T) {
+		t.Setenv(agent.SocketEnvVar, filepath.Join(t.TempDir(), "missing.sock"))
+		_, ok := authAgentEnvironment(context.Background(), "dev")
+		assert.False(t, ok)
+	})
+
+	t.Run("agent running", func(t *testing.T) {
+		socket := startTestAuthAgent(t)
+		t.Setenv(agent.SocketEnvVar, socket)
+
+		env, ok := authAgentEnvironment(context.Background(), "dev")
+		require.True(t, ok)
+		assert.Equal(t, "dev", env["AWS_PROFILE"])
+
+		envList := applyAuthAgentEnvironment([]string{"AWS_PROFILE=other", "HOME=/home/me"}, env)
+		assert.Contains(t, envList, "AWS_PROFILE=dev")
+		assert.Contains(t, envList, "HOME=/home/me")
+		assert.Contains(t, envList, agent.SocketEnvVar+"="+socket)
+	})
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	awsCloud "github.com/cloudposse/atmos/pkg/auth/cloud/aws"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// authCredentialsCmd prints identity credentials in AWS credential_process format.
+var authCredentialsCmd = &cobra.Command{
+	Use:   "credentials",
+	Short: "Print identity credentials in AWS credential_process format",
+	Long: `Print temporary AWS credentials for an identity as the JSON document expected by an AWS ` + "`credential_process`" + `.
+Cached credentials are reused while they are valid; otherwise the identity is authenticated first.
+
+Profiles written by 'atmos auth export --format aws-config' call this command, so any tool that reads ~/.aws/config
+can use Atmos identities.`,
+	Example: `  # ~/.aws/config
+  [profile prod-admin]
+  credential_process = atmos auth credentials --identity prod-admin`,
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: false},
+	RunE:               executeAuthCredentialsCommand,
+}
+
+// executeAuthCredentialsCommand writes credential_process JSON for the selected identity to stdout.
+func executeAuthCredentialsCommand(cmd *cobra.Command, _ []string) error {
+	defer perf.Track(nil, "cmd.executeAuthCredentialsCommand")()
+
+	atmosConfig, err := cfg.InitCliConfig(newAuthConfigAndStacksInfo(cmd), false)
+	if err != nil {
+		return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrFailedToInitializeAtmosConfig, err)
+	}
+
+	authManager, err := createAuthManager(&atmosConfig.Auth, atmosConfig.CliConfigPath)
+	if err != nil {
+		return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrFailedToInitializeAuthManager, err)
+	}
+
+	// Use GetIdentityFromFlags which handles Cobra's NoOptDefVal quirk for `--identity <name>`.
+	identityName := GetIdentityFromFlags(cmd, os.Args)
+	forceSelect := identityName == IdentityFlagSelectValue
+	if identityName == "" || forceSelect {
+		identityName, err = authManager.GetDefaultIdentity(forceSelect)
+		if err != nil {
+			return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrNoDefaultIdentity, err)
+		}
+	}
+
+	whoami, err := getOrAuthenticateIdentity(context.Background(), authManager, identityName)
+	if err != nil {
+		return err
+	}
+
+	creds, err := awsCloud.NewProcessCredentials(whoami.Credentials)
+	if err != nil {
+		return fmt.Errorf("%w: identity %q", err, identityName)
+	}
+
+	// Write directly to stdout: the output is consumed by AWS SDKs and must not be masked.
+	return json.NewEncoder(cmd.OutOrStdout()).Encode(creds)
+}
+
+func init() {
+	// NOTE: --identity flag is inherited from parent authCmd (PersistentFlags in cmd/auth.go).
+	AddIdentityCompletion(authCredentialsCmd)
+	authCmd.AddCommand(authCredentialsCmd)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/viper"
 
 	errUtils "github.com/cloudposse/atmos/errors"
+	authTypes "github.com/cloudposse/atmos/pkg/auth/types"
 	cfg "github.com/cloudposse/atmos/pkg/config"
 	envpkg "github.com/cloudposse/atmos/pkg/env"
 	log "github.com/cloudposse/atmos/pkg/logger"
@@ -92,19 +93,11 @@ func executeAuthExecCommandCore(cmd *cobra.Command, args []string) error {
 		identityName = defaultIdentity
 	}
 
-	// Try to use cached credentials first (passive check, no prompts).
-	// Only authenticate if cached credentials are not available or expired.
-	_, err = authManager.GetCachedCredentials(ctx, identityName)
-	if err != nil {
-		log.Debug("No valid cached credentials found, authenticating", "identity", identityName, "error", err)
-		// No valid cached credentials - perform full authentication.
-		_, err = authManager.Authenticate(ctx, identityName)
-		if err != nil {
-			// Check for user cancellation - return clean error without wrapping.
-			if errors.Is(err, errUtils.ErrUserAborted) {
-				return errUtils.ErrUserAborted
-			}
-			return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrAuthenticationFailed, err)
+	// When an auth agent is configured, it authenticates and keeps credentials fresh for us.
+	agentEnv, useAgent := authAgentEnvironment(ctx, identityName)
+	if !useAgent {
+		if _, err := getOrAuthenticateIdentity(ctx, authManager, identityName); err != nil {
+			return err
 		}
 	}
 
@@ -116,6 +109,7 @@ func executeAuthExecCommandCore(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to prepare command environment: %w", err)
 	}
+	envList = applyAuthAgentEnvironment(envList, agentEnv)
 
 	// Execute the command with the sanitized environment directly.
 	// The envList already includes os.Environ() (sanitized) + auth vars,
@@ -130,6 +124,26 @@ func executeAuthExecCommandCore(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// getOrAuthenticateIdentity uses cached credentials when they are still valid (passive check, no prompts)
+// and only runs the full authentication flow when they are missing or expired.
+func getOrAuthenticateIdentity(ctx context.Context, authManager authTypes.AuthManager, identityName string) (*authTypes.WhoamiInfo, error) {
+	whoami, err := authManager.GetCachedCredentials(ctx, identityName)
+	if err == nil {
+		return whoami, nil
+	}
+	log.Debug("No valid cached credentials found, authenticating", "identity", identityName, "error", err)
+
+	whoami, err = authManager.Authenticate(ctx, identityName)
+	if err != nil {
+		// Check for user cancellation - return clean error without wrapping.
+		if errors.Is(err, errUtils.ErrUserAborted) {
+			return nil, errUtils.ErrUserAborted
+		}
+		return nil, fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrAuthenticationFailed, err)
+	}
+	return whoami, nil
+}
+
 // executeCommandWithEnv executes a command with a complete environment.
 // The env parameter should be a fully prepared environment (e.g., from PrepareShellEnvironment).
 // It is used directly as the subprocess environment without re-reading os.Environ().
@@ -0,0 +1,328 @@
+package cmd
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	awsCloud "github.com/cloudposse/atmos/pkg/auth/cloud/aws"
+	azureCloud "github.com/cloudposse/atmos/pkg/auth/cloud/azure"
+	gcpCloud "github.com/cloudposse/atmos/pkg/auth/cloud/gcp"
+	authTypes "github.com/cloudposse/atmos/pkg/auth/types"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/ui"
+)
+
+const (
+	authExportFormatAWSConfig = "aws-config"
+	authExportFormatGcloud    = "gcloud"
+	authExportFormatAzureCLI  = "azure-cli"
+)
+
+// authExportFormats maps each export format to the cloud whose identities it exports.
+var authExportFormats = map[string]string{
+	authExportFormatAWSConfig: "aws",
+	authExportFormatGcloud:    "gcp",
+	authExportFormatAzureCLI:  "azure",
+}
+
+var (
+	authExportFormat string
+	authExportPrefix string
+	authExportOutput string
+	authExportForce  bool
+)
+
+//go:embed markdown/atmos_auth_export_usage.md
+var authExportUsageMarkdown string
+
+// authExportCmd writes Atmos identities into native cloud CLI configuration.
+var authExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export identities as native cloud CLI profiles",
+	Long: `Write Atmos identities into the configuration of native cloud CLIs, so IDE plugins, the AWS CLI, gcloud,
+the Azure CLI and kubectl can use them outside Atmos.
+
+The aws-config format adds a profile per AWS identity to ~/.aws/config whose credential_process runs
+'atmos auth credentials', so credentials are refreshed on demand. The gcloud format adds a named configuration
+per GCP identity that reads an access token written by Atmos; gcloud can't refresh that token, so re-run the export
+when it expires. The azure-cli format signs the Azure CLI in to each
+Azure identity's subscription, like 'az login'.`,
+	Example:            authExportUsageMarkdown,
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: false},
+	RunE:               executeAuthExportCommand,
+}
+
+// executeAuthExportCommand exports the selected identities in the requested format.
+func executeAuthExportCommand(cmd *cobra.Command, _ []string) error {
+	defer perf.Track(nil, "cmd.executeAuthExportCommand")()
+
+	cloud, ok := authExportFormats[authExportFormat]
+	if !ok {
+		return errUtils.Build(errUtils.ErrAuthExportUnsupportedFormat).
+			WithExplanationf("Format `%s` is not supported", authExportFormat).
+			WithHintf("Use one of: %s", strings.Join(authExportFormatNames(), ", ")).
+			WithExitCode(1).
+			Err()
+	}
+
+	atmosConfig, err := cfg.InitCliConfig(newAuthConfigAndStacksInfo(cmd), false)
+	if err != nil {
+		return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrFailedToInitializeAtmosConfig, err)
+	}
+
+	authManager, err := createAuthManager(&atmosConfig.Auth, atmosConfig.CliConfigPath)
+	if err != nil {
+		return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrFailedToInitializeAuthManager, err)
+	}
+
+	identities, err := authExportIdentities(cmd, authManager, cloud)
+	if err != nil {
+		return err
+	}
+
+	switch authExportFormat {
+	case authExportFormatAWSConfig:
+		return exportAWSConfig(authManager, identities, atmosConfig.CliConfigPath)
+	case authExportFormatGcloud:
+		return exportGcloud(cmd.Context(), authManager, identities)
+	default:
+		return exportAzureCLI(cmd.Context(), authManager, identities)
+	}
+}
+
+// authExportIdentities returns the identity from --identity, or every identity for the cloud.
+func authExportIdentities(cmd *cobra.Command, authManager authTypes.AuthManager, cloud string) ([]string, error) {
+	configured := authManager.GetIdentities()
+
+	// Use GetIdentityFromFlags which handles Cobra's NoOptDefVal quirk for `--identity <name>`.
+	identityName := GetIdentityFromFlags(cmd, os.Args)
+	if identityName == IdentityFlagSelectValue {
+		selected, err := authManager.GetDefaultIdentity(true)
+		if err != nil {
+			return nil, fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrNoDefaultIdentity, err)
+		}
+		identityName = selected
+	}
+
+	if identityName != "" {
+		identity, ok := configured[identityName]
+		if !ok {
+			return nil, fmt.Errorf("%w: `%s`", errUtils.ErrIdentityNotFound, identityName)
+		}
+		if !identityKindForCloud(identity.Kind, cloud) {
+			return nil, errUtils.Build(errUtils.ErrAuthExportNoIdentities).
+				WithExplanationf("Identity `%s` has kind `%s`, which can't be exported as `%s`", identityName, identity.Kind, authExportFormat).
+				WithExitCode(1).
+				Err()
+		}
+		return []string{identityName}, nil
+	}
+
+	var names []string
+	for name, identity := range configured {
+		if identityKindForCloud(identity.Kind, cloud) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, errUtils.Build(errUtils.ErrAuthExportNoIdentities).
+			WithExplanationf("No identities in `atmos.yaml` can be exported as `%s`", authExportFormat).
+			WithHint("Run `atmos auth list` to see the configured identities").
+			WithExitCode(1).
+			Err()
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// identityKindForCloud reports whether an identity kind such as `aws/permission-set` belongs to the cloud.
+func identityKindForCloud(kind, cloud string) bool {
+	for _, part := range strings.Split(kind, "/") {
+		if part == cloud {
+			return true
+		}
+	}
+	return false
+}
+
+// exportAWSConfig writes a credential_process profile per identity to the AWS config file.
+// Profiles call `atmos auth credentials`, so nothing is authenticated here.
+func exportAWSConfig(authManager authTypes.AuthManager, identities []string, cliConfigPath string) error {
+	configPath := authExportOutput
+	if configPath == "" {
+		var err error
+		if configPath, err = awsCloud.DefaultSharedConfigPath(); err != nil {
+			return err
+		}
+	}
+
+	profiles := make([]awsCloud.CredentialProcessProfile, 0, len(identities))
+	for _, name := range identities {
+		args := []string{"atmos", "auth", "credentials", "--identity", name}
+		// credential_process runs from an arbitrary directory, so run it from the one holding this atmos.yaml.
+		if dir, _, _ := strings.Cut(cliConfigPath, ";"); dir != "" {
+			args = append(args, "--chdir", dir)
+		}
+		profiles = append(profiles, awsCloud.CredentialProcessProfile{
+			Name:              authExportPrefix + name,
+			CredentialProcess: awsCloud.CredentialProcessCommand(args...),
+			Region:            authExportRegion(authManager, name),
+		})
+	}
+
+	if err := awsCloud.WriteCredentialProcessProfiles(configPath, profiles, authExportForce); err != nil {
+		return err
+	}
+
+	for _, p := range profiles {
+		ui.Successf("Exported AWS profile `%s`", p.Name)
+	}
+	ui.Infof("Profiles written to `%s`", configPath)
+	ui.Hintf("Run `aws sts get-caller-identity --profile %s` to try it", profiles[0].Name)
+	return nil
+}
+
+// authExportRegion returns the identity's region, falling back to its provider's region.
+func authExportRegion(authManager authTypes.AuthManager, identityName string) string {
+	if region, ok := authManager.GetIdentities()[identityName].Principal["region"].(string); ok && region != "" {
+		return region
+	}
+	if provider, ok := authManager.GetProviders()[authManager.GetProviderForIdentity(identityName)]; ok {
+		return provider.Region
+	}
+	return ""
+}
+
+// exportGcloud authenticates each identity and writes a named gcloud configuration for it.
+// gcloud can't call back into Atmos, so the configuration reads the current access token from a file,
+// and goes stale when the token expires. An external_account credential with an executable source doesn't help:
+// gcloud exchanges the executable's output at Google STS as a workload identity federation subject token,
+// so it can't return an access token minted by Atmos.
+func exportGcloud(ctx context.Context, authManager authTypes.AuthManager, identities []string) error {
+	configDir := authExportOutput
+	if configDir == "" {
+		var err error
+		if configDir, err = gcpCloud.GcloudConfigDir(); err != nil {
+			return err
+		}
+	}
+	realm := authManager.GetRealm().Value
+
+	var exported []string
+	for _, name := range identities {
+		whoami, err := getOrAuthenticateIdentity(authExportContext(ctx), authManager, name)
+		if err != nil {
+			return err
+		}
+		creds, ok := whoami.Credentials.(*authTypes.GCPCredentials)
+		if !ok {
+			return unexpectedCredentialsError(name, "GCP", whoami.Credentials)
+		}
+
+		tokenFile, err := gcpCloud.WriteGcloudAccessTokenFile(realm, whoami.Provider, name, creds.AccessToken)
+		if err != nil {
+			return err
+		}
+		configName := gcpCloud.GcloudConfigurationName(authExportPrefix + name)
+		path, err := gcpCloud.WriteGcloudConfiguration(configDir, &gcpCloud.GcloudConfiguration{
+			Name:            configName,
+			Account:         creds.ServiceAccountEmail,
+			Project:         creds.ProjectID,
+			Region:          authExportRegion(authManager, name),
+			AccessTokenFile: tokenFile,
+			ExpiresAt:       creds.TokenExpiry,
+		}, authExportForce)
+		if err != nil {
+			return err
+		}
+		log.Debug("Wrote gcloud configuration", "identity", name, "path", path)
+		ui.Successf("Exported gcloud configuration `%s`", configName)
+		if !creds.TokenExpiry.IsZero() {
+			ui.Warningf("gcloud configuration `%s` stops working at %s; gcloud can't refresh its access token",
+				configName, creds.TokenExpiry.Local().Format(time.Kitchen))
+		}
+		exported = append(exported, configName)
+	}
+
+	ui.Hintf("Run `gcloud config configurations activate %s` to use it", exported[0])
+	ui.Hintf("The exported access tokens are not refreshed; re-run `atmos auth export --format %s` when they expire", authExportFormatGcloud)
+	return nil
+}
+
+// exportAzureCLI authenticates each identity and records its tokens and subscription in the Azure CLI's files.
+func exportAzureCLI(ctx context.Context, authManager authTypes.AuthManager, identities []string) error {
+	for _, name := range identities {
+		whoami, err := getOrAuthenticateIdentity(authExportContext(ctx), authManager, name)
+		if err != nil {
+			return err
+		}
+		creds, ok := whoami.Credentials.(*authTypes.AzureCredentials)
+		if !ok {
+			return unexpectedCredentialsError(name, "Azure", whoami.Credentials)
+		}
+		if err := azureCloud.UpdateAzureCLIFiles(creds, creds.TenantID, creds.SubscriptionID, creds.CloudEnvironment); err != nil {
+			return err
+		}
+		ui.Successf("Exported Azure CLI subscription `%s` for identity `%s`", creds.SubscriptionID, name)
+	}
+
+	ui.Hintf("The last exported subscription is the Azure CLI default; run `az account set --subscription <id>` to switch")
+	return nil
+}
+
+// unexpectedCredentialsError reports an identity whose credentials don't match the cloud of the export format.
+func unexpectedCredentialsError(identityName, cloud string, creds authTypes.ICredentials) error {
+	return errUtils.Build(errUtils.ErrAuthExportUnexpectedCreds).
+		WithExplanationf("Identity `%s` returned `%T` instead of %s credentials", identityName, creds, cloud).
+		WithHintf("Check the `kind` of identity `%s` and its provider in `atmos.yaml`", identityName).
+		WithExitCode(1).
+		Err()
+}
+
+// authExportContext returns ctx, or a background context when the command has none (e.g. in tests).
+func authExportContext(ctx context.Context) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
+
+// authExportFormatNames returns the supported formats in sorted order.
+func authExportFormatNames() []string {
+	names := make([]string, 0, len(authExportFormats))
+	for name := range authExportFormats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	authExportCmd.Flags().StringVarP(&authExportFormat, "format", "f", "", "Export format: aws-config, azure-cli, gcloud")
+	authExportCmd.Flags().StringVar(&authExportPrefix, "prefix", "", "Prefix for exported profile and configuration names")
+	authExportCmd.Flags().StringVarP(&authExportOutput, "output", "o", "", "AWS config file (aws-config) or gcloud configuration directory (gcloud) to write")
+	authExportCmd.Flags().BoolVar(&authExportForce, "force", false, "Overwrite profiles that were not created by atmos auth export")
+	if err := authExportCmd.MarkFlagRequired("format"); err != nil {
+		log.Trace("Failed to mark format flag as required", "error", err)
+	}
+
+	if err := authExportCmd.RegisterFlagCompletionFunc("format", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return authExportFormatNames(), cobra.ShellCompDirectiveNoFileComp
+	}); err != nil {
+		log.Trace("Failed to register format flag completion", "error", err)
+	}
+
+	// NOTE: --identity flag is inherited from parent authCmd (PersistentFlags in cmd/auth.go).
+	AddIdentityCompletion(authExportCmd)
+	authCmd.AddCommand(authExportCmd)
+}
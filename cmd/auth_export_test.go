@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	authTypes "github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestIdentityKindForCloud(t *testing.T) {
+	tests := []struct {
+		kind     string
+		cloud    string
+		expected bool
+	}{
+		{kind: "aws/permission-set", cloud: "aws", expected: true},
+		{kind: "aws/assume-role", cloud: "gcp", expected: false},
+		{kind: "gcp/service-account", cloud: "gcp", expected: true},
+		{kind: "azure/subscription", cloud: "azure", expected: true},
+		{kind: "mock/aws", cloud: "aws", expected: true},
+		{kind: "awsx/role", cloud: "aws", expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind+"_"+tt.cloud, func(t *testing.T) {
+			assert.Equal(t, tt.expected, identityKindForCloud(tt.kind, tt.cloud))
+		})
+	}
+}
+
+func newAuthExportTestCommand() *cobra.Command {
+	cmd := &cobra.Command{Use: "export"}
+	cmd.Flags().String(IdentityFlagName, "", "")
+	return cmd
+}
+
+func TestAuthExportIdentities(t *testing.T) {
+	_ = NewTestKit(t)
+	viper.GetViper().Set(IdentityFlagName, "")
+
+	identities := map[string]schema.Identity{
+		"prod-admin":  {Kind: "aws/permission-set"},
+		"dev-admin":   {Kind: "aws/assume-role"},
+		"gcp-prod":    {Kind: "gcp/service-account"},
+		"azure-admin": {Kind: "azure/subscription"},
+	}
+
+	t.Run("all identities for the cloud", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockManager := authTypes.NewMockAuthManager(ctrl)
+		mockManager.EXPECT().GetIdentities().Return(identities)
+
+		names, err := authExportIdentities(newAuthExportTestCommand(), mockManager, "aws")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"dev-admin", "prod-admin"}, names)
+	})
+
+	t.Run("explicit identity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockManager := authTypes.NewMockAuthManager(ctrl)
+		mockManager.EXPECT().GetIdentities().Return(identities)
+
+		cmd := newAuthExportTestCommand()
+		require.NoError(t, cmd.Flags().Set(IdentityFlagName, "gcp-prod"))
+		names, err := authExportIdentities(cmd, mockManager, "gcp")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"gcp-prod"}, names)
+	})
+
+	t.Run("explicit identity for another cloud", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockManager := authTypes.NewMockAuthManager(ctrl)
+		mockManager.EXPECT().GetIdentities().Return(identities)
+
+		cmd := newAuthExportTestCommand()
+		require.NoError(t, cmd.Flags().Set(IdentityFlagName, "gcp-prod"))
+		_, err := authExportIdentities(cmd, mockManager, "aws")
+		assert.ErrorIs(t, err, errUtils.ErrAuthExportNoIdentities)
+	})
+
+	t.Run("unknown identity", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockManager := authTypes.NewMockAuthManager(ctrl)
+		mockManager.EXPECT().GetIdentities().Return(identities)
+
+		cmd := newAuthExportTestCommand()
+		require.NoError(t, cmd.Flags().Set(IdentityFlagName, "missing"))
+		_, err := authExportIdentities(cmd, mockManager, "aws")
+		assert.ErrorIs(t, err, errUtils.ErrIdentityNotFound)
+	})
+
+	t.Run("no identities for the cloud", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockManager := authTypes.NewMockAuthManager(ctrl)
+		mockManager.EXPECT().GetIdentities().Return(map[string]schema.Identity{"prod-admin": {Kind: "aws/permission-set"}})
+
+		_, err := authExportIdentities(newAuthExportTestCommand(), mockManager, "azure")
+		assert.ErrorIs(t, err, errUtils.ErrAuthExportNoIdentities)
+	})
+}
+
+func TestExportAWSConfig(t *testing.T) {
+	_ = NewTestKit(t)
+
+	configPath := filepath.Join(t.TempDir(), "config")
+	oldOutput, oldPrefix := authExportOutput, authExportPrefix
+	authExportOutput, authExportPrefix = configPath, "atmos-"
+	t.Cleanup(func() { authExportOutput, authExportPrefix = oldOutput, oldPrefix })
+
+	ctrl := gomock.NewController(t)
+	mockManager := authTypes.NewMockAuthManager(ctrl)
+	mockManager.EXPECT().GetIdentities().Return(map[string]schema.Identity{
+		"prod-admin": {Kind: "aws/permission-set", Principal: map[string]interface{}{"region": "eu-west-1"}},
+	}).AnyTimes()
+
+	require.NoError(t, exportAWSConfig(mockManager, []string{"prod-admin"}, "/home/me/infra"))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, `[profile atmos-prod-admin]
+credential_process = atmos auth credentials --identity prod-admin --chdir /home/me/infra
+region = eu-west-1
+`, string(data))
+}
+
+func TestGetOrAuthenticateIdentity(t *testing.T) {
+	_ = NewTestKit(t)
+
+	t.Run("uses cached credentials", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockManager := authTypes.NewMockAuthManager(ctrl)
+		cached := &authTypes.WhoamiInfo{Identity: "dev"}
+		mockManager.EXPECT().GetCachedCredentials(gomock.Any(), "dev").Return(cached, nil)
+
+		whoami, err := getOrAuthenticateIdentity(context.Background(), mockManager, "dev")
+		require.NoError(t, err)
+		assert.Same(t, cached, whoami)
+	})
+
+	t.Run("authenticates when nothing is cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockManager := authTypes.NewMockAuthManager(ctrl)
+		fresh := &authTypes.WhoamiInfo{Identity: "dev"}
+		mockManager.EXPECT().GetCachedCredentials(gomock.Any(), "dev").Return(nil, errors.New("expired"))
+		mockManager.EXPECT().Authenticate(gomock.Any(), "dev").Return(fresh, nil)
+
+		whoami, err := getOrAuthenticateIdentity(context.Background(), mockManager, "dev")
+		require.NoError(t, err)
+		assert.Same(t, fresh, whoami)
+	})
+
+	t.Run("wraps authentication errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		mockManager := authTypes.NewMockAuthManager(ctrl)
+		mockManager.EXPECT().GetCachedCredentials(gomock.Any(), "dev").Return(nil, errors.New("expired"))
+		mockManager.EXPECT().Authenticate(gomock.Any(), "dev").Return(nil, errors.New("denied"))
+
+		_, err := getOrAuthenticateIdentity(context.Background(), mockManager, "dev")
+		assert.ErrorIs(t, err, errUtils.ErrAuthenticationFailed)
+	})
+}
+
+func TestExportAzureCLIUnexpectedCredentials(t *testing.T) {
+	_ = NewTestKit(t)
+
+	ctrl := gomock.NewController(t)
+	mockManager := authTypes.NewMockAuthManager(ctrl)
+	mockManager.EXPECT().GetCachedCredentials(gomock.Any(), "azure-dev").
+		Return(&authTypes.WhoamiInfo{Identity: "azure-dev", Credentials: &authTypes.AWSCredentials{}}, nil)
+
+	err := exportAzureCLI(context.Background(), mockManager, []string{"azure-dev"})
+	assert.ErrorIs(t, err, errUtils.ErrAuthExportUnexpectedCreds)
+	assert.NotErrorIs(t, err, errUtils.ErrAuthExportNoIdentities)
+}
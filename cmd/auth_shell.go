@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	_ "embed"
-	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -97,19 +96,11 @@ func executeAuthShellCommandCore(cmd *cobra.Command, args []string) error {
 		identityName = defaultIdentity
 	}
 
-	// Try to use cached credentials first (passive check, no prompts).
-	// Only authenticate if cached credentials are not available or expired.
-	_, err = authManager.GetCachedCredentials(ctx, identityName)
-	if err != nil {
-		log.Debug("No valid cached credentials found, authenticating", "identity", identityName, "error", err)
-		// No valid cached credentials - perform full authentication.
-		_, err = authManager.Authenticate(ctx, identityName)
-		if err != nil {
-			// Check for user cancellation - return clean error without wrapping.
-			if errors.Is(err, errUtils.ErrUserAborted) {
-				return errUtils.ErrUserAborted
-			}
-			return fmt.Errorf(errUtils.ErrWrapFormat, errUtils.ErrAuthenticationFailed, err)
+	// When an auth agent is configured, it authenticates and keeps credentials fresh for us.
+	agentEnv, useAgent := authAgentEnvironment(ctx, identityName)
+	if !useAgent {
+		if _, err := getOrAuthenticateIdentity(ctx, authManager, identityName); err != nil {
+			return err
 		}
 	}
 
@@ -120,6 +111,7 @@ func executeAuthShellCommandCore(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("failed to prepare shell environment: %w", err)
 	}
+	envList = applyAuthAgentEnvironment(envList, agentEnv)
 
 	// Get shell from extracted flag or viper.
 	shell := shellValue
@@ -1,6 +1,8 @@
 package helmfile
 
 import (
+	"io"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cloudposse/atmos/cmd/helmfile/generate"
@@ -9,6 +11,7 @@ import (
 	e "github.com/cloudposse/atmos/internal/exec"
 	"github.com/cloudposse/atmos/pkg/flags"
 	"github.com/cloudposse/atmos/pkg/flags/compat"
+	h "github.com/cloudposse/atmos/pkg/hooks"
 )
 
 // doubleDashHint is displayed in help output.
@@ -41,6 +44,13 @@ func init() {
 	internal.Register(&HelmfileCommandProvider{})
 }
 
+// helmfileHookEvents maps the helmfile subcommands that fire hooks to their before and after events.
+var helmfileHookEvents = map[string][2]h.HookEvent{
+	"sync":  {h.BeforeHelmfileSync, h.AfterHelmfileSync},
+	"apply": {h.BeforeHelmfileApply, h.AfterHelmfileApply},
+	"diff":  {h.BeforeHelmfileDiff, h.AfterHelmfileDiff},
+}
+
 // helmfileRun is the shared execution function for all helmfile subcommands.
 func helmfileRun(cmd *cobra.Command, commandName string, args []string) error {
 	// Check if help was requested and display it.
@@ -57,7 +67,14 @@ func helmfileRun(cmd *cobra.Command, commandName string, args []string) error {
 		return err
 	}
 	info.CliArgs = []string{"helmfile", commandName}
-	return e.ExecuteHelmfile(info)
+
+	events, ok := helmfileHookEvents[commandName]
+	if !ok {
+		return e.ExecuteHelmfile(info)
+	}
+	return h.RunWithEvents(events[0], events[1], &info, func(capture io.Writer) error {
+		return e.ExecuteHelmfile(info, e.WithOutputCapture(capture))
+	})
 }
 
 // HelmfileCommandProvider implements the CommandProvider interface.
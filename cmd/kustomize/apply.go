@@ -0,0 +1,32 @@
+// Kubectl apply CLI docs: https://kubernetes.io/docs/reference/kubectl/generated/kubectl_apply/.
+
+package kustomize
+
+import (
+	"github.com/spf13/cobra"
+
+	h "github.com/cloudposse/atmos/pkg/hooks"
+)
+
+// applyCmd represents the `atmos kustomize apply` command.
+var applyCmd = &cobra.Command{
+	Use:   "apply",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Apply a Kustomize component to the cluster.",
+	Long: `This command applies the kustomization of the component in the Atmos stack to the cluster.
+
+Example usage:
+  atmos kustomize apply <component> --stack <stack> [options]
+  atmos kustomize apply <component> --stack <stack> -- [kubectl apply flags]
+
+To see all available options, refer to https://kubernetes.io/docs/reference/kubectl/generated/kubectl_apply/
+`,
+	// FParseErrWhitelist allows unknown flags to pass through to kubectl.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	RunE:               runApply,
+}
+
+// runApply executes the kustomize apply command.
+func runApply(cmd *cobra.Command, args []string) error {
+	return runKustomizeCommand(cmd, args, "apply", h.BeforeKustomizeApply, h.AfterKustomizeApply)
+}
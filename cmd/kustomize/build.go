@@ -0,0 +1,33 @@
+// Kubectl kustomize CLI docs: https://kubernetes.io/docs/reference/kubectl/generated/kubectl_kustomize/.
+
+package kustomize
+
+import (
+	"github.com/spf13/cobra"
+
+	h "github.com/cloudposse/atmos/pkg/hooks"
+)
+
+// buildCmd represents the `atmos kustomize build` command.
+var buildCmd = &cobra.Command{
+	Use:   "build",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Render the Kubernetes manifests of a Kustomize component.",
+	Long: `This command renders the kustomization of the component in the Atmos stack, with the component variables
+as a generated ConfigMap, and prints the resulting Kubernetes manifests. It does not connect to a cluster.
+
+Example usage:
+  atmos kustomize build <component> --stack <stack> [options]
+  atmos kustomize build <component> --stack <stack> -- [kubectl kustomize flags]
+
+To see all available options, refer to https://kubernetes.io/docs/reference/kubectl/generated/kubectl_kustomize/
+`,
+	// FParseErrWhitelist allows unknown flags to pass through to kubectl.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	RunE:               runBuild,
+}
+
+// runBuild executes the kustomize build command.
+func runBuild(cmd *cobra.Command, args []string) error {
+	return runKustomizeCommand(cmd, args, "build", h.BeforeKustomizeBuild, h.AfterKustomizeBuild)
+}
@@ -0,0 +1,32 @@
+// Kubectl diff CLI docs: https://kubernetes.io/docs/reference/kubectl/generated/kubectl_diff/.
+
+package kustomize
+
+import (
+	"github.com/spf13/cobra"
+
+	h "github.com/cloudposse/atmos/pkg/hooks"
+)
+
+// diffCmd represents the `atmos kustomize diff` command.
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Show the differences between a Kustomize component and the cluster.",
+	Long: `This command shows the changes "atmos kustomize apply" would make to the cluster for the component in the Atmos stack.
+
+Example usage:
+  atmos kustomize diff <component> --stack <stack> [options]
+  atmos kustomize diff <component> --stack <stack> -- [kubectl diff flags]
+
+To see all available options, refer to https://kubernetes.io/docs/reference/kubectl/generated/kubectl_diff/
+`,
+	// FParseErrWhitelist allows unknown flags to pass through to kubectl.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	RunE:               runDiff,
+}
+
+// runDiff executes the kustomize diff command.
+func runDiff(cmd *cobra.Command, args []string) error {
+	return runKustomizeCommand(cmd, args, "diff", h.BeforeKustomizeDiff, h.AfterKustomizeDiff)
+}
@@ -0,0 +1,21 @@
+package kustomize
+
+import (
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// KustomizeFlags returns a registry with flags for Kustomize commands.
+// Kustomize commands only use the common flags; kubectl's own flags are passed through.
+func KustomizeFlags() *flags.FlagRegistry {
+	defer perf.Track(nil, "kustomize.KustomizeFlags")()
+
+	return flags.CommonFlags()
+}
+
+// WithKustomizeFlags returns a flags.Option that adds all Kustomize flags.
+func WithKustomizeFlags() flags.Option {
+	defer perf.Track(nil, "kustomize.WithKustomizeFlags")()
+
+	return flags.WithFlagRegistry(KustomizeFlags())
+}
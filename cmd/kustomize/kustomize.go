@@ -0,0 +1,201 @@
+package kustomize
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cloudposse/atmos/cmd/internal"
+	"github.com/cloudposse/atmos/pkg/component"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/flags/compat"
+	h "github.com/cloudposse/atmos/pkg/hooks"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// kustomizeParser handles flag parsing for shared kustomize flags.
+// These persistent flags are inherited by all kustomize subcommands.
+var kustomizeParser *flags.StandardParser
+
+// kustomizeCmd represents the base command for all kustomize sub-commands.
+var kustomizeCmd = &cobra.Command{
+	Use:   "kustomize",
+	Short: "Manage Kubernetes resources with Kustomize",
+	Long:  `Build, diff and apply kustomizations and raw Kubernetes manifests with kubectl, with component variables rendered into a generated ConfigMap.`,
+	// FParseErrWhitelist allows unknown flags to pass through to kubectl.
+	// Unlike DisableFlagParsing, this still allows Cobra to parse known Atmos flags.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	// RunE handles the case when kustomize is called without a subcommand.
+	RunE: kustomizeGlobalFlagsHandler,
+}
+
+func init() {
+	// Create parser with shared kustomize flags using functional options.
+	// These flags are inherited by all kustomize subcommands.
+	kustomizeParser = flags.NewStandardParser(
+		WithKustomizeFlags(),
+	)
+
+	// Set stack completion function on the flag registry to avoid import cycle.
+	// This must be done before RegisterPersistentFlags() so the completion
+	// function is registered when the flag is registered.
+	kustomizeParser.Registry().SetCompletionFunc("stack", stackFlagCompletion)
+
+	// Register as persistent flags (inherited by subcommands).
+	kustomizeParser.RegisterPersistentFlags(kustomizeCmd)
+
+	// Bind flags to Viper for environment variable support.
+	if err := kustomizeParser.BindToViper(viper.GetViper()); err != nil {
+		panic(err)
+	}
+
+	// Add subcommands.
+	kustomizeCmd.AddCommand(buildCmd)
+	kustomizeCmd.AddCommand(diffCmd)
+	kustomizeCmd.AddCommand(applyCmd)
+
+	// Register completion functions for component argument.
+	RegisterKustomizeCompletions(kustomizeCmd)
+
+	// Register this command with the registry.
+	internal.Register(&KustomizeCommandProvider{})
+}
+
+// KustomizeCommandProvider implements the CommandProvider interface.
+type KustomizeCommandProvider struct{}
+
+// GetCommand returns the kustomize command.
+func (p *KustomizeCommandProvider) GetCommand() *cobra.Command {
+	return kustomizeCmd
+}
+
+// GetName returns the command name.
+func (p *KustomizeCommandProvider) GetName() string {
+	return "kustomize"
+}
+
+// GetGroup returns the command group for help organization.
+func (p *KustomizeCommandProvider) GetGroup() string {
+	return "Core Stack Commands"
+}
+
+// GetAliases returns command aliases.
+func (p *KustomizeCommandProvider) GetAliases() []internal.CommandAlias {
+	return nil // No aliases for kustomize command.
+}
+
+// GetFlagsBuilder returns the flags builder for this command.
+func (p *KustomizeCommandProvider) GetFlagsBuilder() flags.Builder {
+	return nil // Flags are handled by kustomizeParser.
+}
+
+// GetPositionalArgsBuilder returns the positional args builder for this command.
+func (p *KustomizeCommandProvider) GetPositionalArgsBuilder() *flags.PositionalArgsBuilder {
+	return nil // Kustomize command has subcommands, not positional args.
+}
+
+// GetCompatibilityFlags returns compatibility flags for this command.
+func (p *KustomizeCommandProvider) GetCompatibilityFlags() map[string]compat.CompatibilityFlag {
+	return nil // No compatibility flags for kustomize.
+}
+
+// IsExperimental returns whether this command is experimental.
+func (p *KustomizeCommandProvider) IsExperimental() bool {
+	return false
+}
+
+// kustomizeGlobalFlagsHandler handles the kustomize command when called without a subcommand.
+func kustomizeGlobalFlagsHandler(cmd *cobra.Command, args []string) error {
+	// No global flag found and no subcommand provided - show usage.
+	return cmd.Usage()
+}
+
+// buildConfigAndStacksInfo creates a ConfigAndStacksInfo with global flags populated.
+// This ensures config selection flags (--base-path, --config, --config-path, --profile)
+// are properly honored when initializing CLI config.
+func buildConfigAndStacksInfo(cmd *cobra.Command) schema.ConfigAndStacksInfo {
+	v := viper.GetViper()
+	globalFlags := flags.ParseGlobalFlags(cmd, v)
+
+	info := schema.ConfigAndStacksInfo{
+		AtmosBasePath:           globalFlags.BasePath,
+		AtmosConfigFilesFromArg: globalFlags.Config,
+		AtmosConfigDirsFromArg:  globalFlags.ConfigPath,
+		ProfilesFromArg:         globalFlags.Profile,
+	}
+
+	// Get stack from flag if provided.
+	if stackFlag := cmd.Flag("stack"); stackFlag != nil && stackFlag.Value.String() != "" {
+		info.Stack = stackFlag.Value.String()
+	}
+
+	// Get dry-run from flag if provided.
+	if dryRunFlag := cmd.Flag("dry-run"); dryRunFlag != nil && dryRunFlag.Value.String() == "true" {
+		info.DryRun = true
+	}
+
+	return info
+}
+
+// processArgs processes command arguments to extract component and additional args.
+func processArgs(args []string) (component string, additionalArgs []string) {
+	if len(args) > 0 {
+		component = args[0]
+		if len(args) > 1 {
+			additionalArgs = args[1:]
+		}
+	}
+	return component, additionalArgs
+}
+
+// initConfigAndStacksInfo initializes a ConfigAndStacksInfo for kustomize command execution.
+func initConfigAndStacksInfo(cmd *cobra.Command, subCommand string, args []string) schema.ConfigAndStacksInfo {
+	info := buildConfigAndStacksInfo(cmd)
+
+	// Set component type.
+	info.ComponentType = cfg.KustomizeComponentType
+
+	// Set subcommand.
+	info.SubCommand = subCommand
+	info.CliArgs = []string{"kustomize", subCommand}
+
+	// Process positional arguments.
+	component, additionalArgs := processArgs(args)
+	if component != "" {
+		info.ComponentFromArg = component
+	}
+	info.AdditionalArgsAndFlags = additionalArgs
+
+	return info
+}
+
+// runKustomizeCommand executes a kustomize subcommand for a component via the component registry,
+// firing the before and after hook events around it.
+func runKustomizeCommand(cmd *cobra.Command, args []string, subCommand string, before, after h.HookEvent) error {
+	// Initialize config and stacks info.
+	info := initConfigAndStacksInfo(cmd, subCommand, args)
+
+	// Get the kustomize component provider from the registry.
+	// The provider is guaranteed to be registered via pkg/component/kustomize/kustomize.go's init(),
+	// which is invoked when the package is imported in cmd/root.go.
+	provider := component.MustGetProvider("kustomize")
+
+	// Build execution context for the component provider.
+	ctx := &component.ExecutionContext{
+		ComponentType:       "kustomize",
+		Component:           info.ComponentFromArg,
+		Stack:               info.Stack,
+		Command:             "kustomize",
+		SubCommand:          subCommand,
+		ConfigAndStacksInfo: info,
+		Args:                args,
+	}
+
+	// Execute via component registry.
+	return h.RunWithEvents(before, after, &info, func(capture io.Writer) error {
+		ctx.OutputCapture = capture
+		return provider.Execute(ctx)
+	})
+}
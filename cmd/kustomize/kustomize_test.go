@@ -0,0 +1,125 @@
+package kustomize
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestKustomizeCommandProvider(t *testing.T) {
+	provider := &KustomizeCommandProvider{}
+
+	cmd := provider.GetCommand()
+	require.NotNil(t, cmd)
+	assert.Equal(t, "kustomize", cmd.Use)
+	assert.Equal(t, "kustomize", provider.GetName())
+	assert.Equal(t, "Core Stack Commands", provider.GetGroup())
+	assert.Nil(t, provider.GetAliases())
+	assert.Nil(t, provider.GetFlagsBuilder())
+	assert.Nil(t, provider.GetPositionalArgsBuilder())
+	assert.Nil(t, provider.GetCompatibilityFlags())
+	assert.False(t, provider.IsExperimental())
+}
+
+func TestKustomizeCommandStructure(t *testing.T) {
+	assert.True(t, kustomizeCmd.FParseErrWhitelist.UnknownFlags)
+
+	subcommandNames := make([]string, 0, len(kustomizeCmd.Commands()))
+	for _, cmd := range kustomizeCmd.Commands() {
+		subcommandNames = append(subcommandNames, cmd.Name())
+	}
+	assert.ElementsMatch(t, []string{"build", "diff", "apply"}, subcommandNames)
+
+	// The shared flags are inherited by all subcommands.
+	assert.NotNil(t, kustomizeCmd.PersistentFlags().Lookup("stack"))
+	assert.NotNil(t, kustomizeCmd.PersistentFlags().Lookup("dry-run"))
+}
+
+func TestComponentCommandsArgsValidation(t *testing.T) {
+	for _, cmd := range []*cobra.Command{buildCmd, diffCmd, applyCmd} {
+		t.Run(cmd.Name(), func(t *testing.T) {
+			assert.True(t, cmd.FParseErrWhitelist.UnknownFlags)
+			assert.NotNil(t, cmd.ValidArgsFunction)
+
+			// The component is required; arguments after it are passed to kubectl.
+			assert.Error(t, cmd.Args(cmd, []string{}))
+			assert.NoError(t, cmd.Args(cmd, []string{"app"}))
+			assert.NoError(t, cmd.Args(cmd, []string{"app", "--server-side"}))
+		})
+	}
+}
+
+func TestBuildConfigAndStacksInfo(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	assert.Equal(t, schema.ConfigAndStacksInfo{}, buildConfigAndStacksInfo(cmd))
+
+	cmd.Flags().String("stack", "", "stack name")
+	cmd.Flags().Bool("dry-run", false, "dry run")
+	require.NoError(t, cmd.Flags().Set("stack", "plat-ue2-dev"))
+	require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+
+	info := buildConfigAndStacksInfo(cmd)
+	assert.Equal(t, "plat-ue2-dev", info.Stack)
+	assert.True(t, info.DryRun)
+}
+
+func TestProcessArgs(t *testing.T) {
+	component, additionalArgs := processArgs(nil)
+	assert.Empty(t, component)
+	assert.Nil(t, additionalArgs)
+
+	component, additionalArgs = processArgs([]string{"app", "--server-side", "--prune"})
+	assert.Equal(t, "app", component)
+	assert.Equal(t, []string{"--server-side", "--prune"}, additionalArgs)
+}
+
+func TestInitConfigAndStacksInfo(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().String("stack", "", "stack name")
+	require.NoError(t, cmd.Flags().Set("stack", "plat-ue2-dev"))
+
+	info := initConfigAndStacksInfo(cmd, "apply", []string{"app", "--server-side"})
+
+	assert.Equal(t, "kustomize", info.ComponentType)
+	assert.Equal(t, "apply", info.SubCommand)
+	assert.Equal(t, []string{"kustomize", "apply"}, info.CliArgs)
+	assert.Equal(t, "app", info.ComponentFromArg)
+	assert.Equal(t, []string{"--server-side"}, info.AdditionalArgsAndFlags)
+	assert.Equal(t, "plat-ue2-dev", info.Stack)
+}
+
+func TestKustomizeGlobalFlagsHandler(t *testing.T) {
+	// kustomizeGlobalFlagsHandler calls cmd.Usage() which returns nil.
+	assert.NoError(t, kustomizeGlobalFlagsHandler(kustomizeCmd, []string{}))
+}
+
+func TestRegisterKustomizeCompletions(t *testing.T) {
+	testCmd := &cobra.Command{Use: "kustomize"}
+	applySubCmd := &cobra.Command{Use: "apply"}
+	otherSubCmd := &cobra.Command{Use: "other"}
+	testCmd.AddCommand(applySubCmd, otherSubCmd)
+
+	RegisterKustomizeCompletions(testCmd)
+
+	assert.NotNil(t, applySubCmd.ValidArgsFunction)
+	assert.Nil(t, otherSubCmd.ValidArgsFunction)
+}
+
+func TestComponentArgCompletion(t *testing.T) {
+	completions, directive := componentArgCompletion(&cobra.Command{Use: "apply"}, []string{"app"}, "")
+
+	assert.Nil(t, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func TestKustomizeFlags(t *testing.T) {
+	registry := KustomizeFlags()
+	require.NotNil(t, registry)
+	assert.NotNil(t, registry.Get("stack"))
+	assert.NotNil(t, registry.Get("dry-run"))
+	assert.NotNil(t, WithKustomizeFlags())
+}
@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"io"
+
 	"github.com/spf13/cobra"
 
 	packersource "github.com/cloudposse/atmos/cmd/packer/source"
 	e "github.com/cloudposse/atmos/internal/exec"
+	h "github.com/cloudposse/atmos/pkg/hooks"
 	u "github.com/cloudposse/atmos/pkg/utils"
 )
 
@@ -70,5 +73,11 @@ func packerRun(cmd *cobra.Command, commandName string, args []string) error {
 		return err
 	}
 
+	if commandName == "build" {
+		return h.RunWithEvents(h.BeforePackerBuild, h.AfterPackerBuild, &info, func(capture io.Writer) error {
+			return e.ExecutePacker(&info, &packerFlags, e.WithOutputCapture(capture))
+		})
+	}
+
 	return e.ExecutePacker(&info, &packerFlags)
 }
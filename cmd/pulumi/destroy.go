@@ -0,0 +1,32 @@
+// Pulumi destroy CLI docs: https://www.pulumi.com/docs/iac/cli/commands/pulumi_destroy/.
+
+package pulumi
+
+import (
+	"github.com/spf13/cobra"
+
+	h "github.com/cloudposse/atmos/pkg/hooks"
+)
+
+// destroyCmd represents the `atmos pulumi destroy` command.
+var destroyCmd = &cobra.Command{
+	Use:   "destroy",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Destroy the resources of a Pulumi stack.",
+	Long: `This command deletes all resources in the Pulumi stack of the component in the Atmos stack.
+
+Example usage:
+  atmos pulumi destroy <component> --stack <stack> [options]
+  atmos pulumi destroy <component> --stack <stack> -- [pulumi flags]
+
+To see all available options, refer to https://www.pulumi.com/docs/iac/cli/commands/pulumi_destroy/
+`,
+	// FParseErrWhitelist allows unknown flags to pass through to pulumi.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	RunE:               runDestroy,
+}
+
+// runDestroy executes the pulumi destroy command.
+func runDestroy(cmd *cobra.Command, args []string) error {
+	return runPulumiCommand(cmd, args, "destroy", h.BeforePulumiDestroy, h.AfterPulumiDestroy)
+}
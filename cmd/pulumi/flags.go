@@ -0,0 +1,21 @@
+package pulumi
+
+import (
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// PulumiFlags returns a registry with flags for Pulumi commands.
+// Pulumi commands only use the common flags; Pulumi's own flags are passed through.
+func PulumiFlags() *flags.FlagRegistry {
+	defer perf.Track(nil, "pulumi.PulumiFlags")()
+
+	return flags.CommonFlags()
+}
+
+// WithPulumiFlags returns a flags.Option that adds all Pulumi flags.
+func WithPulumiFlags() flags.Option {
+	defer perf.Track(nil, "pulumi.WithPulumiFlags")()
+
+	return flags.WithFlagRegistry(PulumiFlags())
+}
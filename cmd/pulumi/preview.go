@@ -0,0 +1,32 @@
+// Pulumi preview CLI docs: https://www.pulumi.com/docs/iac/cli/commands/pulumi_preview/.
+
+package pulumi
+
+import (
+	"github.com/spf13/cobra"
+
+	h "github.com/cloudposse/atmos/pkg/hooks"
+)
+
+// previewCmd represents the `atmos pulumi preview` command.
+var previewCmd = &cobra.Command{
+	Use:   "preview",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Preview the changes of a Pulumi stack.",
+	Long: `This command shows the changes "pulumi up" would make to the Pulumi stack of the component in the Atmos stack.
+
+Example usage:
+  atmos pulumi preview <component> --stack <stack> [options]
+  atmos pulumi preview <component> --stack <stack> -- [pulumi flags]
+
+To see all available options, refer to https://www.pulumi.com/docs/iac/cli/commands/pulumi_preview/
+`,
+	// FParseErrWhitelist allows unknown flags to pass through to pulumi.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	RunE:               runPreview,
+}
+
+// runPreview executes the pulumi preview command.
+func runPreview(cmd *cobra.Command, args []string) error {
+	return runPulumiCommand(cmd, args, "preview", h.BeforePulumiPreview, h.AfterPulumiPreview)
+}
@@ -0,0 +1,204 @@
+package pulumi
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cloudposse/atmos/cmd/internal"
+	"github.com/cloudposse/atmos/pkg/component"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/flags/compat"
+	h "github.com/cloudposse/atmos/pkg/hooks"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// pulumiParser handles flag parsing for shared pulumi flags.
+// These persistent flags are inherited by all pulumi subcommands.
+var pulumiParser *flags.StandardParser
+
+// pulumiCmd represents the base command for all pulumi sub-commands.
+var pulumiCmd = &cobra.Command{
+	Use:     "pulumi",
+	Aliases: []string{"pu"},
+	Short:   "Manage infrastructure with Pulumi",
+	Long:    `Run Pulumi commands against Pulumi stacks derived from Atmos stacks, with component variables mapped to Pulumi stack config.`,
+	// FParseErrWhitelist allows unknown flags to pass through to Pulumi.
+	// Unlike DisableFlagParsing, this still allows Cobra to parse known Atmos flags.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	// RunE handles the case when pulumi is called without a subcommand.
+	RunE: pulumiGlobalFlagsHandler,
+}
+
+func init() {
+	// Create parser with shared pulumi flags using functional options.
+	// These flags are inherited by all pulumi subcommands.
+	pulumiParser = flags.NewStandardParser(
+		WithPulumiFlags(),
+	)
+
+	// Set stack completion function on the flag registry to avoid import cycle.
+	// This must be done before RegisterPersistentFlags() so the completion
+	// function is registered when the flag is registered.
+	pulumiParser.Registry().SetCompletionFunc("stack", stackFlagCompletion)
+
+	// Register as persistent flags (inherited by subcommands).
+	pulumiParser.RegisterPersistentFlags(pulumiCmd)
+
+	// Bind flags to Viper for environment variable support.
+	if err := pulumiParser.BindToViper(viper.GetViper()); err != nil {
+		panic(err)
+	}
+
+	// Add subcommands.
+	pulumiCmd.AddCommand(previewCmd)
+	pulumiCmd.AddCommand(upCmd)
+	pulumiCmd.AddCommand(destroyCmd)
+	pulumiCmd.AddCommand(refreshCmd)
+	pulumiCmd.AddCommand(versionCmd)
+
+	// Register completion functions for component argument.
+	RegisterPulumiCompletions(pulumiCmd)
+
+	// Register this command with the registry.
+	internal.Register(&PulumiCommandProvider{})
+}
+
+// PulumiCommandProvider implements the CommandProvider interface.
+type PulumiCommandProvider struct{}
+
+// GetCommand returns the pulumi command.
+func (p *PulumiCommandProvider) GetCommand() *cobra.Command {
+	return pulumiCmd
+}
+
+// GetName returns the command name.
+func (p *PulumiCommandProvider) GetName() string {
+	return "pulumi"
+}
+
+// GetGroup returns the command group for help organization.
+func (p *PulumiCommandProvider) GetGroup() string {
+	return "Core Stack Commands"
+}
+
+// GetAliases returns command aliases.
+func (p *PulumiCommandProvider) GetAliases() []internal.CommandAlias {
+	return nil // No aliases for pulumi command.
+}
+
+// GetFlagsBuilder returns the flags builder for this command.
+func (p *PulumiCommandProvider) GetFlagsBuilder() flags.Builder {
+	return nil // Flags are handled by pulumiParser.
+}
+
+// GetPositionalArgsBuilder returns the positional args builder for this command.
+func (p *PulumiCommandProvider) GetPositionalArgsBuilder() *flags.PositionalArgsBuilder {
+	return nil // Pulumi command has subcommands, not positional args.
+}
+
+// GetCompatibilityFlags returns compatibility flags for this command.
+func (p *PulumiCommandProvider) GetCompatibilityFlags() map[string]compat.CompatibilityFlag {
+	return nil // No compatibility flags for pulumi.
+}
+
+// IsExperimental returns whether this command is experimental.
+func (p *PulumiCommandProvider) IsExperimental() bool {
+	return false
+}
+
+// pulumiGlobalFlagsHandler handles the pulumi command when called without a subcommand.
+func pulumiGlobalFlagsHandler(cmd *cobra.Command, args []string) error {
+	// No global flag found and no subcommand provided - show usage.
+	return cmd.Usage()
+}
+
+// buildConfigAndStacksInfo creates a ConfigAndStacksInfo with global flags populated.
+// This ensures config selection flags (--base-path, --config, --config-path, --profile)
+// are properly honored when initializing CLI config.
+func buildConfigAndStacksInfo(cmd *cobra.Command) schema.ConfigAndStacksInfo {
+	v := viper.GetViper()
+	globalFlags := flags.ParseGlobalFlags(cmd, v)
+
+	info := schema.ConfigAndStacksInfo{
+		AtmosBasePath:           globalFlags.BasePath,
+		AtmosConfigFilesFromArg: globalFlags.Config,
+		AtmosConfigDirsFromArg:  globalFlags.ConfigPath,
+		ProfilesFromArg:         globalFlags.Profile,
+	}
+
+	// Get stack from flag if provided.
+	if stackFlag := cmd.Flag("stack"); stackFlag != nil && stackFlag.Value.String() != "" {
+		info.Stack = stackFlag.Value.String()
+	}
+
+	// Get dry-run from flag if provided.
+	if dryRunFlag := cmd.Flag("dry-run"); dryRunFlag != nil && dryRunFlag.Value.String() == "true" {
+		info.DryRun = true
+	}
+
+	return info
+}
+
+// processArgs processes command arguments to extract component and additional args.
+func processArgs(args []string) (component string, additionalArgs []string) {
+	if len(args) > 0 {
+		component = args[0]
+		if len(args) > 1 {
+			additionalArgs = args[1:]
+		}
+	}
+	return component, additionalArgs
+}
+
+// initConfigAndStacksInfo initializes a ConfigAndStacksInfo for pulumi command execution.
+func initConfigAndStacksInfo(cmd *cobra.Command, subCommand string, args []string) schema.ConfigAndStacksInfo {
+	info := buildConfigAndStacksInfo(cmd)
+
+	// Set component type.
+	info.ComponentType = cfg.PulumiComponentType
+
+	// Set subcommand.
+	info.SubCommand = subCommand
+	info.CliArgs = []string{"pulumi", subCommand}
+
+	// Process positional arguments.
+	component, additionalArgs := processArgs(args)
+	if component != "" {
+		info.ComponentFromArg = component
+	}
+	info.AdditionalArgsAndFlags = additionalArgs
+
+	return info
+}
+
+// runPulumiCommand executes a pulumi subcommand for a component via the component registry,
+// firing the before and after hook events around it.
+func runPulumiCommand(cmd *cobra.Command, args []string, subCommand string, before, after h.HookEvent) error {
+	// Initialize config and stacks info.
+	info := initConfigAndStacksInfo(cmd, subCommand, args)
+
+	// Get the pulumi component provider from the registry.
+	// The provider is guaranteed to be registered via pkg/component/pulumi/pulumi.go's init(),
+	// which is invoked when the package is imported in cmd/root.go.
+	provider := component.MustGetProvider("pulumi")
+
+	// Build execution context for the component provider.
+	ctx := &component.ExecutionContext{
+		ComponentType:       "pulumi",
+		Component:           info.ComponentFromArg,
+		Stack:               info.Stack,
+		Command:             "pulumi",
+		SubCommand:          subCommand,
+		ConfigAndStacksInfo: info,
+		Args:                args,
+	}
+
+	// Execute via component registry.
+	return h.RunWithEvents(before, after, &info, func(capture io.Writer) error {
+		ctx.OutputCapture = capture
+		return provider.Execute(ctx)
+	})
+}
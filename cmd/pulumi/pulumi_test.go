@@ -0,0 +1,126 @@
+package pulumi
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestPulumiCommandProvider(t *testing.T) {
+	provider := &PulumiCommandProvider{}
+
+	cmd := provider.GetCommand()
+	require.NotNil(t, cmd)
+	assert.Equal(t, "pulumi", cmd.Use)
+	assert.Equal(t, []string{"pu"}, cmd.Aliases)
+	assert.Equal(t, "pulumi", provider.GetName())
+	assert.Equal(t, "Core Stack Commands", provider.GetGroup())
+	assert.Nil(t, provider.GetAliases())
+	assert.Nil(t, provider.GetFlagsBuilder())
+	assert.Nil(t, provider.GetPositionalArgsBuilder())
+	assert.Nil(t, provider.GetCompatibilityFlags())
+	assert.False(t, provider.IsExperimental())
+}
+
+func TestPulumiCommandStructure(t *testing.T) {
+	assert.True(t, pulumiCmd.FParseErrWhitelist.UnknownFlags)
+
+	subcommandNames := make([]string, 0, len(pulumiCmd.Commands()))
+	for _, cmd := range pulumiCmd.Commands() {
+		subcommandNames = append(subcommandNames, cmd.Name())
+	}
+	assert.ElementsMatch(t, []string{"preview", "up", "destroy", "refresh", "version"}, subcommandNames)
+
+	// The shared flags are inherited by all subcommands.
+	assert.NotNil(t, pulumiCmd.PersistentFlags().Lookup("stack"))
+	assert.NotNil(t, pulumiCmd.PersistentFlags().Lookup("dry-run"))
+}
+
+func TestComponentCommandsArgsValidation(t *testing.T) {
+	for _, cmd := range []*cobra.Command{previewCmd, upCmd, destroyCmd, refreshCmd} {
+		t.Run(cmd.Name(), func(t *testing.T) {
+			assert.True(t, cmd.FParseErrWhitelist.UnknownFlags)
+			assert.NotNil(t, cmd.ValidArgsFunction)
+
+			// The component is required; arguments after it are passed to pulumi.
+			assert.Error(t, cmd.Args(cmd, []string{}))
+			assert.NoError(t, cmd.Args(cmd, []string{"website"}))
+			assert.NoError(t, cmd.Args(cmd, []string{"website", "--yes"}))
+		})
+	}
+}
+
+func TestBuildConfigAndStacksInfo(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	assert.Equal(t, schema.ConfigAndStacksInfo{}, buildConfigAndStacksInfo(cmd))
+
+	cmd.Flags().String("stack", "", "stack name")
+	cmd.Flags().Bool("dry-run", false, "dry run")
+	require.NoError(t, cmd.Flags().Set("stack", "plat-ue2-dev"))
+	require.NoError(t, cmd.Flags().Set("dry-run", "true"))
+
+	info := buildConfigAndStacksInfo(cmd)
+	assert.Equal(t, "plat-ue2-dev", info.Stack)
+	assert.True(t, info.DryRun)
+}
+
+func TestProcessArgs(t *testing.T) {
+	component, additionalArgs := processArgs(nil)
+	assert.Empty(t, component)
+	assert.Nil(t, additionalArgs)
+
+	component, additionalArgs = processArgs([]string{"website", "--yes", "--diff"})
+	assert.Equal(t, "website", component)
+	assert.Equal(t, []string{"--yes", "--diff"}, additionalArgs)
+}
+
+func TestInitConfigAndStacksInfo(t *testing.T) {
+	cmd := &cobra.Command{Use: "test"}
+	cmd.Flags().String("stack", "", "stack name")
+	require.NoError(t, cmd.Flags().Set("stack", "plat-ue2-dev"))
+
+	info := initConfigAndStacksInfo(cmd, "up", []string{"website", "--yes"})
+
+	assert.Equal(t, "pulumi", info.ComponentType)
+	assert.Equal(t, "up", info.SubCommand)
+	assert.Equal(t, []string{"pulumi", "up"}, info.CliArgs)
+	assert.Equal(t, "website", info.ComponentFromArg)
+	assert.Equal(t, []string{"--yes"}, info.AdditionalArgsAndFlags)
+	assert.Equal(t, "plat-ue2-dev", info.Stack)
+}
+
+func TestPulumiGlobalFlagsHandler(t *testing.T) {
+	// pulumiGlobalFlagsHandler calls cmd.Usage() which returns nil.
+	assert.NoError(t, pulumiGlobalFlagsHandler(pulumiCmd, []string{}))
+}
+
+func TestRegisterPulumiCompletions(t *testing.T) {
+	testCmd := &cobra.Command{Use: "pulumi"}
+	upSubCmd := &cobra.Command{Use: "up"}
+	versionSubCmd := &cobra.Command{Use: "version"}
+	testCmd.AddCommand(upSubCmd, versionSubCmd)
+
+	RegisterPulumiCompletions(testCmd)
+
+	assert.NotNil(t, upSubCmd.ValidArgsFunction)
+	assert.Nil(t, versionSubCmd.ValidArgsFunction)
+}
+
+func TestComponentArgCompletion(t *testing.T) {
+	completions, directive := componentArgCompletion(&cobra.Command{Use: "up"}, []string{"website"}, "")
+
+	assert.Nil(t, completions)
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+}
+
+func TestPulumiFlags(t *testing.T) {
+	registry := PulumiFlags()
+	require.NotNil(t, registry)
+	assert.NotNil(t, registry.Get("stack"))
+	assert.NotNil(t, registry.Get("dry-run"))
+	assert.NotNil(t, WithPulumiFlags())
+}
@@ -0,0 +1,32 @@
+// Pulumi refresh CLI docs: https://www.pulumi.com/docs/iac/cli/commands/pulumi_refresh/.
+
+package pulumi
+
+import (
+	"github.com/spf13/cobra"
+
+	h "github.com/cloudposse/atmos/pkg/hooks"
+)
+
+// refreshCmd represents the `atmos pulumi refresh` command.
+var refreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Refresh the state of a Pulumi stack.",
+	Long: `This command refreshes the state of the Pulumi stack of the component in the Atmos stack from the actual resources.
+
+Example usage:
+  atmos pulumi refresh <component> --stack <stack> [options]
+  atmos pulumi refresh <component> --stack <stack> -- [pulumi flags]
+
+To see all available options, refer to https://www.pulumi.com/docs/iac/cli/commands/pulumi_refresh/
+`,
+	// FParseErrWhitelist allows unknown flags to pass through to pulumi.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	RunE:               runRefresh,
+}
+
+// runRefresh executes the pulumi refresh command.
+func runRefresh(cmd *cobra.Command, args []string) error {
+	return runPulumiCommand(cmd, args, "refresh", h.BeforePulumiRefresh, h.AfterPulumiRefresh)
+}
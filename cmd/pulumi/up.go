@@ -0,0 +1,32 @@
+// Pulumi up CLI docs: https://www.pulumi.com/docs/iac/cli/commands/pulumi_up/.
+
+package pulumi
+
+import (
+	"github.com/spf13/cobra"
+
+	h "github.com/cloudposse/atmos/pkg/hooks"
+)
+
+// upCmd represents the `atmos pulumi up` command.
+var upCmd = &cobra.Command{
+	Use:   "up",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Create or update the resources of a Pulumi stack.",
+	Long: `This command creates or updates the resources in the Pulumi stack of the component in the Atmos stack.
+
+Example usage:
+  atmos pulumi up <component> --stack <stack> [options]
+  atmos pulumi up <component> --stack <stack> -- [pulumi flags]
+
+To see all available options, refer to https://www.pulumi.com/docs/iac/cli/commands/pulumi_up/
+`,
+	// FParseErrWhitelist allows unknown flags to pass through to pulumi.
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: true},
+	RunE:               runUp,
+}
+
+// runUp executes the pulumi up command.
+func runUp(cmd *cobra.Command, args []string) error {
+	return runPulumiCommand(cmd, args, "up", h.BeforePulumiUp, h.AfterPulumiUp)
+}
@@ -0,0 +1,58 @@
+// Pulumi version CLI docs: https://www.pulumi.com/docs/iac/cli/commands/pulumi_version/.
+
+package pulumi
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cloudposse/atmos/pkg/component"
+	pulumiComp "github.com/cloudposse/atmos/pkg/component/pulumi"
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// versionCmd represents the `atmos pulumi version` command.
+var versionCmd = &cobra.Command{
+	Use:   "version",
+	Short: "Show Pulumi version information.",
+	Long: `This command shows the version of the Pulumi CLI.
+
+Example usage:
+  atmos pulumi version
+
+To see all available options, refer to https://www.pulumi.com/docs/iac/cli/commands/pulumi_version/
+`,
+	RunE: runVersion,
+}
+
+// runVersion executes the pulumi version command.
+func runVersion(cmd *cobra.Command, _ []string) error {
+	// Parse global flags to honor config selection flags.
+	v := viper.GetViper()
+	globalFlags := flags.ParseGlobalFlags(cmd, v)
+	configAndStacksInfo := schema.ConfigAndStacksInfo{
+		AtmosBasePath:           globalFlags.BasePath,
+		AtmosConfigFilesFromArg: globalFlags.Config,
+		AtmosConfigDirsFromArg:  globalFlags.ConfigPath,
+		ProfilesFromArg:         globalFlags.Profile,
+	}
+
+	// Get the pulumi component provider from the registry.
+	provider, ok := component.GetProvider("pulumi")
+	if !ok {
+		// Fallback to direct execution if provider not found.
+		return pulumiComp.ExecuteVersion(&configAndStacksInfo)
+	}
+
+	// Build execution context for the component provider.
+	ctx := &component.ExecutionContext{
+		ComponentType:       "pulumi",
+		Command:             "pulumi",
+		SubCommand:          "version",
+		ConfigAndStacksInfo: configAndStacksInfo,
+	}
+
+	// Execute via component registry.
+	return provider.Execute(ctx)
+}
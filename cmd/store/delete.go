@@ -0,0 +1,57 @@
+package store
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/store"
+)
+
+var deleteParser *flags.StandardParser
+
+// deleteCmd removes a value from a store.
+var deleteCmd = &cobra.Command{
+	Use:               "delete <store> <key>",
+	Short:             "Delete a value from a store",
+	Long:              `Delete the value of a key for a component in a stack from a store.`,
+	Example:           `  atmos store delete prod/ssm vpc_id -s plat-ue2-prod -c vpc`,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: storeNameCompletion,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer perf.Track(nil, "store.delete.RunE")()
+
+		v := viper.GetViper()
+		if err := deleteParser.BindFlagsToViper(cmd, v); err != nil {
+			return err
+		}
+
+		s, err := loadStore(cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		return deleteValue(s, args[0], v.GetString("stack"), v.GetString("component"), args[1])
+	},
+}
+
+func init() {
+	deleteParser = flags.NewStandardParser(
+		flags.WithStringFlag("stack", "s", "", "Stack the value belongs to"),
+		flags.WithStringFlag("component", "c", "", "Component the value belongs to"),
+	)
+	deleteParser.RegisterFlags(deleteCmd)
+	if err := deleteParser.BindToViper(viper.GetViper()); err != nil {
+		panic(err)
+	}
+}
+
+// deleteValue removes a key from the store.
+func deleteValue(s store.Store, name string, stack string, component string, key string) error {
+	deletable, ok := s.(store.DeletableStore)
+	if !ok {
+		return unsupportedOperationError(name, "delete")
+	}
+	return deletable.Delete(stack, component, key)
+}
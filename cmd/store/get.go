@@ -0,0 +1,97 @@
+package store
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cloudposse/atmos/pkg/data"
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/store"
+)
+
+var getParser *flags.StandardParser
+
+// getOptions holds the options of the store get command.
+type getOptions struct {
+	Stack     string
+	Component string
+	Key       string
+	Version   string
+}
+
+// getCmd reads a value from a store.
+var getCmd = &cobra.Command{
+	Use:   "get <store> <key>",
+	Short: "Read a value from a store",
+	Long: `Read a value from a store.
+
+With --stack and --component the key is read for that component in that stack.
+Without them the key is read as-is, like the !store.get YAML function.
+Strings are printed as-is, other values as JSON.`,
+	Example: `  atmos store get prod/ssm vpc_id -s plat-ue2-prod -c vpc
+  atmos store get prod/ssm vpc_id -s plat-ue2-prod -c vpc --version 3
+  atmos store get prod/ssm /shared/config`,
+	Args:              cobra.ExactArgs(2),
+	ValidArgsFunction: storeNameCompletion,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer perf.Track(nil, "store.get.RunE")()
+
+		v := viper.GetViper()
+		if err := getParser.BindFlagsToViper(cmd, v); err != nil {
+			return err
+		}
+
+		s, err := loadStore(cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		value, err := getValue(s, args[0], getOptions{
+			Stack:     v.GetString("stack"),
+			Component: v.GetString("component"),
+			Key:       args[1],
+			Version:   v.GetString("version"),
+		})
+		if err != nil {
+			return err
+		}
+		return printValue(value)
+	},
+}
+
+func init() {
+	getParser = flags.NewStandardParser(
+		flags.WithStringFlag("stack", "s", "", "Stack the value belongs to"),
+		flags.WithStringFlag("component", "c", "", "Component the value belongs to"),
+		flags.WithStringFlag("version", "", "", "Version of the value to read (stores with versioning only)"),
+	)
+	getParser.RegisterFlags(getCmd)
+	if err := getParser.BindToViper(viper.GetViper()); err != nil {
+		panic(err)
+	}
+}
+
+// getValue reads a value from the store, by stack and component when either is set.
+func getValue(s store.Store, name string, opts getOptions) (any, error) {
+	if opts.Version != "" {
+		versioned, ok := s.(store.VersionedStore)
+		if !ok {
+			return nil, unsupportedOperationError(name, "get --version")
+		}
+		return versioned.GetVersion(opts.Stack, opts.Component, opts.Key, opts.Version)
+	}
+
+	if opts.Stack == "" && opts.Component == "" {
+		return s.GetKey(opts.Key)
+	}
+	return s.Get(opts.Stack, opts.Component, opts.Key)
+}
+
+// printValue prints strings as-is and other values as JSON.
+func printValue(value any) error {
+	if str, ok := value.(string); ok {
+		return data.Writeln(str)
+	}
+	return data.WriteJSON(value)
+}
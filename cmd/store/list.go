@@ -0,0 +1,88 @@
+package store
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/data"
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/store"
+)
+
+var listParser *flags.StandardParser
+
+// listCmd lists the keys of a component in a store.
+var listCmd = &cobra.Command{
+	Use:   "list <store>",
+	Short: "List the keys of a component in a store",
+	Long:  `List the keys stored for a component in a stack, one per line or as a JSON array.`,
+	Example: `  atmos store list prod/ssm -s plat-ue2-prod -c vpc
+  atmos store list prod/ssm -s plat-ue2-prod -c vpc --format json`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: storeNameCompletion,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer perf.Track(nil, "store.list.RunE")()
+
+		v := viper.GetViper()
+		if err := listParser.BindFlagsToViper(cmd, v); err != nil {
+			return err
+		}
+
+		format := v.GetString("format")
+		if format != "text" && format != "json" {
+			return errUtils.Build(errUtils.ErrInvalidFormat).
+				WithExplanationf("Invalid --format value %q", format).
+				WithHint("Supported formats: text, json").
+				WithExitCode(1).
+				Err()
+		}
+
+		s, err := loadStore(cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		keys, err := listKeys(s, args[0], v.GetString("stack"), v.GetString("component"))
+		if err != nil {
+			return err
+		}
+		return printKeys(keys, format)
+	},
+}
+
+func init() {
+	listParser = flags.NewStandardParser(
+		flags.WithStringFlag("stack", "s", "", "Stack the keys belong to"),
+		flags.WithStringFlag("component", "c", "", "Component the keys belong to"),
+		flags.WithStringFlag("format", "f", "text", "Output format: text, json"),
+		flags.WithValidValues("format", "text", "json"),
+	)
+	listParser.RegisterFlags(listCmd)
+	if err := listParser.BindToViper(viper.GetViper()); err != nil {
+		panic(err)
+	}
+}
+
+// listKeys returns the keys of the component in the stack.
+func listKeys(s store.Store, name string, stack string, component string) ([]string, error) {
+	listable, ok := s.(store.ListableStore)
+	if !ok {
+		return nil, unsupportedOperationError(name, "list")
+	}
+	return listable.List(stack, component)
+}
+
+// printKeys prints the keys one per line or as a JSON array.
+func printKeys(keys []string, format string) error {
+	if format == "json" {
+		return data.WriteJSON(keys)
+	}
+	for _, key := range keys {
+		if err := data.Writeln(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/store"
+)
+
+var setParser *flags.StandardParser
+
+// setOptions holds the options of the store set command.
+type setOptions struct {
+	Stack     string
+	Component string
+	Key       string
+	Value     string
+}
+
+// setCmd writes a value to a store.
+var setCmd = &cobra.Command{
+	Use:   "set <store> <key> <value>",
+	Short: "Write a value to a store",
+	Long: `Write a value for a component in a stack to a store.
+
+Values that are valid JSON are decoded before they are stored, so numbers, lists and
+maps keep their type.`,
+	Example: `  atmos store set prod/ssm vpc_id vpc-0a1b2c -s plat-ue2-prod -c vpc
+  atmos store set cache subnets '["subnet-a","subnet-b"]' -s plat-ue2-dev -c vpc`,
+	Args:              cobra.ExactArgs(3),
+	ValidArgsFunction: storeNameCompletion,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer perf.Track(nil, "store.set.RunE")()
+
+		v := viper.GetViper()
+		if err := setParser.BindFlagsToViper(cmd, v); err != nil {
+			return err
+		}
+
+		s, err := loadStore(cmd, args[0])
+		if err != nil {
+			return err
+		}
+
+		return setValue(s, setOptions{
+			Stack:     v.GetString("stack"),
+			Component: v.GetString("component"),
+			Key:       args[1],
+			Value:     args[2],
+		})
+	},
+}
+
+func init() {
+	setParser = flags.NewStandardParser(
+		flags.WithStringFlag("stack", "s", "", "Stack the value belongs to"),
+		flags.WithStringFlag("component", "c", "", "Component the value belongs to"),
+	)
+	setParser.RegisterFlags(setCmd)
+	if err := setParser.BindToViper(viper.GetViper()); err != nil {
+		panic(err)
+	}
+}
+
+// setValue writes a value to the store.
+func setValue(s store.Store, opts setOptions) error {
+	return s.Set(opts.Stack, opts.Component, opts.Key, parseValue(opts.Value))
+}
@@ -0,0 +1,184 @@
+// Package store provides commands to read and write values in the stores configured in atmos.yaml.
+package store
+
+import (
+	"encoding/json"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cloudposse/atmos/cmd/internal"
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/flags/compat"
+	"github.com/cloudposse/atmos/pkg/flags/global"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/store"
+	"github.com/cloudposse/atmos/pkg/store/authbridge"
+)
+
+// storeCmd represents the store command group.
+var storeCmd = &cobra.Command{
+	Use:   "store",
+	Short: "Read and write values in the configured stores",
+	Long: `Read, write, delete and list values in the stores configured in the 'stores' section of atmos.yaml.
+
+Values are addressed by stack, component and key, the same way store hooks write them
+and the !store YAML function reads them.`,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	// Add subcommands.
+	storeCmd.AddCommand(getCmd)
+	storeCmd.AddCommand(setCmd)
+	storeCmd.AddCommand(deleteCmd)
+	storeCmd.AddCommand(listCmd)
+
+	// Register this command with the registry.
+	// This happens during package initialization via blank import in cmd/root.go.
+	internal.Register(&StoreCommandProvider{})
+}
+
+// StoreCommandProvider implements the CommandProvider interface.
+type StoreCommandProvider struct{}
+
+// GetCommand returns the store command.
+func (s *StoreCommandProvider) GetCommand() *cobra.Command {
+	return storeCmd
+}
+
+// GetName returns the command name.
+func (s *StoreCommandProvider) GetName() string {
+	return "store"
+}
+
+// GetGroup returns the command group for help organization.
+func (s *StoreCommandProvider) GetGroup() string {
+	return "Configuration Management"
+}
+
+// GetFlagsBuilder returns the flags builder for this command.
+// Store command has no flags at the parent level.
+func (s *StoreCommandProvider) GetFlagsBuilder() flags.Builder {
+	return nil
+}
+
+// GetPositionalArgsBuilder returns the positional args builder for this command.
+// Store command has no positional arguments.
+func (s *StoreCommandProvider) GetPositionalArgsBuilder() *flags.PositionalArgsBuilder {
+	return nil
+}
+
+// GetCompatibilityFlags returns compatibility flags for this command.
+// Store command has no compatibility flags.
+func (s *StoreCommandProvider) GetCompatibilityFlags() map[string]compat.CompatibilityFlag {
+	return nil
+}
+
+// GetAliases returns command aliases.
+// Store command has no aliases.
+func (s *StoreCommandProvider) GetAliases() []internal.CommandAlias {
+	return nil
+}
+
+// IsExperimental returns whether the command is experimental.
+func (s *StoreCommandProvider) IsExperimental() bool {
+	return false
+}
+
+// buildConfigAndStacksInfo creates ConfigAndStacksInfo from global flags.
+func buildConfigAndStacksInfo(globalFlags *global.Flags) schema.ConfigAndStacksInfo {
+	if globalFlags == nil {
+		return schema.ConfigAndStacksInfo{}
+	}
+	return schema.ConfigAndStacksInfo{
+		AtmosBasePath:           globalFlags.BasePath,
+		AtmosConfigFilesFromArg: globalFlags.Config,
+		AtmosConfigDirsFromArg:  globalFlags.ConfigPath,
+		ProfilesFromArg:         globalFlags.Profile,
+	}
+}
+
+// loadStore loads the Atmos configuration and returns the named store.
+// Stores with an identity are authenticated before they are returned.
+func loadStore(cmd *cobra.Command, name string) (store.Store, error) {
+	globalFlags := flags.ParseGlobalFlags(cmd, viper.GetViper())
+	atmosConfig, err := cfg.InitCliConfig(buildConfigAndStacksInfo(&globalFlags), false)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := lookupStore(&atmosConfig, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if identity := atmosConfig.StoresConfig[name].Identity; identity != "" {
+		authManager, err := auth.CreateAndAuthenticateManagerWithAtmosConfig(identity, &atmosConfig.Auth, cfg.IdentityFlagSelectValue, &atmosConfig)
+		if err != nil {
+			return nil, err
+		}
+		if authManager != nil {
+			atmosConfig.Stores.SetAuthContextResolver(authbridge.NewResolver(authManager, authManager.GetStackInfo()))
+		}
+	}
+
+	return s, nil
+}
+
+// lookupStore returns the named store from the store registry.
+func lookupStore(atmosConfig *schema.AtmosConfiguration, name string) (store.Store, error) {
+	s, ok := atmosConfig.Stores[name]
+	if !ok || s == nil {
+		return nil, errUtils.Build(errUtils.ErrStoreNotFound).
+			WithExplanationf("Store `%s` is not configured", name).
+			WithHint("Configure the store in the `stores` section of `atmos.yaml`").
+			WithContext("store", name).
+			WithExitCode(1).
+			Err()
+	}
+	return s, nil
+}
+
+// unsupportedOperationError reports that a store type does not implement an optional operation.
+func unsupportedOperationError(name string, operation string) error {
+	return errUtils.Build(errUtils.ErrStoreUnsupportedOp).
+		WithExplanationf("Store `%s` does not support `%s`", name, operation).
+		WithHint("See https://atmos.tools/cli/configuration/stores for the operations each store type supports").
+		WithContext("store", name).
+		WithContext("operation", operation).
+		WithExitCode(1).
+		Err()
+}
+
+// parseValue decodes a value given on the command line.
+// Valid JSON is decoded so numbers, lists and maps keep their type; anything else is stored as a string.
+func parseValue(raw string) any {
+	var value any
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return raw
+	}
+	return value
+}
+
+// storeNameCompletion provides shell completion for the configured store names.
+func storeNameCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	globalFlags := flags.ParseGlobalFlags(cmd, viper.GetViper())
+	atmosConfig, err := cfg.InitCliConfig(buildConfigAndStacksInfo(&globalFlags), false)
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	names := make([]string, 0, len(atmosConfig.StoresConfig))
+	for name := range atmosConfig.StoresConfig {
+		names = append(names, name)
+	}
+	return names, cobra.ShellCompDirectiveNoFileComp
+}
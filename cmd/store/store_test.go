@@ -0,0 +1,89 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/data"
+	iolib "github.com/cloudposse/atmos/pkg/io"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/store"
+)
+
+// initTestIO initializes the I/O context for tests that use the data package.
+func initTestIO(t *testing.T) {
+	t.Helper()
+	ioCtx, err := iolib.NewContext()
+	require.NoError(t, err)
+	data.InitWriter(ioCtx)
+}
+
+func newTestFileStore(t *testing.T) store.Store {
+	t.Helper()
+	path := t.TempDir()
+	s, err := store.NewFileStore(store.FileStoreOptions{Path: &path})
+	require.NoError(t, err)
+	return s
+}
+
+func TestParseValue(t *testing.T) {
+	assert.Equal(t, "vpc-123", parseValue("vpc-123"))
+	assert.Equal(t, float64(3), parseValue("3"))
+	assert.Equal(t, true, parseValue("true"))
+	assert.Equal(t, []any{"a", "b"}, parseValue(`["a","b"]`))
+	assert.Equal(t, map[string]any{"id": "vpc-123"}, parseValue(`{"id":"vpc-123"}`))
+}
+
+func TestLookupStore(t *testing.T) {
+	s := newTestFileStore(t)
+	atmosConfig := &schema.AtmosConfiguration{Stores: store.StoreRegistry{"local": s}}
+
+	found, err := lookupStore(atmosConfig, "local")
+	require.NoError(t, err)
+	assert.Equal(t, s, found)
+
+	_, err = lookupStore(atmosConfig, "missing")
+	assert.ErrorIs(t, err, errUtils.ErrStoreNotFound)
+}
+
+func TestStoreOperations(t *testing.T) {
+	initTestIO(t)
+	s := newTestFileStore(t)
+
+	require.NoError(t, setValue(s, setOptions{Stack: "dev", Component: "vpc", Key: "vpc_id", Value: "vpc-123"}))
+	require.NoError(t, setValue(s, setOptions{Stack: "dev", Component: "vpc", Key: "subnets", Value: `["a","b"]`}))
+
+	value, err := getValue(s, "local", getOptions{Stack: "dev", Component: "vpc", Key: "subnets"})
+	require.NoError(t, err)
+	assert.Equal(t, []any{"a", "b"}, value)
+	require.NoError(t, printValue(value))
+
+	value, err = getValue(s, "local", getOptions{Key: "dev/vpc/vpc_id"})
+	require.NoError(t, err)
+	assert.Equal(t, "vpc-123", value)
+	require.NoError(t, printValue(value))
+
+	keys, err := listKeys(s, "local", "dev", "vpc")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"subnets", "vpc_id"}, keys)
+	require.NoError(t, printKeys(keys, "text"))
+	require.NoError(t, printKeys(keys, "json"))
+
+	require.NoError(t, deleteValue(s, "local", "dev", "vpc", "vpc_id"))
+	_, err = getValue(s, "local", getOptions{Stack: "dev", Component: "vpc", Key: "vpc_id"})
+	assert.ErrorIs(t, err, store.ErrResourceNotFound)
+}
+
+func TestStoreOperations_Unsupported(t *testing.T) {
+	s, err := store.NewEnvStore(store.EnvStoreOptions{})
+	require.NoError(t, err)
+
+	_, err = getValue(s, "env", getOptions{Stack: "dev", Component: "vpc", Key: "vpc_id", Version: "1"})
+	assert.ErrorIs(t, err, errUtils.ErrStoreUnsupportedOp)
+
+	err = deleteValue(s, "env", "dev", "vpc", "vpc_id")
+	assert.ErrorIs(t, err, errUtils.ErrStoreUnsupportedOp)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cloudposse/atmos/cmd/internal"
+	h "github.com/cloudposse/atmos/pkg/hooks"
 )
 
 // destroyCmd represents the terraform destroy command.
@@ -15,9 +16,22 @@ var destroyCmd = &cobra.Command{
 For complete Terraform/OpenTofu documentation, see:
   https://developer.hashicorp.com/terraform/cli/commands/destroy
   https://opentofu.org/docs/cli/commands/destroy`,
-	RunE: func(cmd *cobra.Command, args []string) error {
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return runHooks(h.BeforeTerraformDestroy, cmd, args)
+	},
+	RunE: func(cmd *cobra.Command, args []string) (runErr error) {
+		// Cobra skips PostRunE on error, so failure hooks run here.
+		defer func() {
+			if runErr != nil {
+				runHooksOnError(h.AfterTerraformDestroy, cmd, args, runErr)
+			}
+		}()
+
 		return terraformRun(terraformCmd, cmd, args)
 	},
+	PostRunE: func(cmd *cobra.Command, args []string) error {
+		return runHooks(h.AfterTerraformDestroy, cmd, args)
+	},
 }
 
 func init() {
@@ -55,6 +55,20 @@ func registerExecutionFlags(registry *flags.FlagRegistry) {
 		Description: "Pass the generated varfile to terraform init using --var-file flag (OpenTofu feature)",
 		EnvVars:     []string{"ATMOS_INIT_PASS_VARS"},
 	})
+	registry.Register(&flags.IntFlag{
+		Name:        "parallelism",
+		Shorthand:   "",
+		Default:     1,
+		Description: "Maximum number of components to run concurrently in multi-component mode",
+		EnvVars:     []string{"ATMOS_PARALLELISM"},
+	})
+	registry.Register(&flags.BoolFlag{
+		Name:        "continue-on-error",
+		Shorthand:   "",
+		Default:     false,
+		Description: "Keep running independent components after a failure (with --parallelism)",
+		EnvVars:     []string{"ATMOS_CONTINUE_ON_ERROR"},
+	})
 	registry.Register(&flags.StringFlag{
 		Name:        "append-user-agent",
 		Shorthand:   "",
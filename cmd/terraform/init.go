@@ -6,6 +6,7 @@ import (
 
 	"github.com/cloudposse/atmos/cmd/internal"
 	"github.com/cloudposse/atmos/pkg/flags"
+	h "github.com/cloudposse/atmos/pkg/hooks"
 )
 
 // initParser handles flag parsing for init command.
@@ -23,7 +24,14 @@ Note: Atmos will automatically call init for you when running plan and apply com
 For complete Terraform/OpenTofu documentation, see:
   https://developer.hashicorp.com/terraform/cli/commands/init
   https://opentofu.org/docs/cli/commands/init`,
-	RunE: func(cmd *cobra.Command, args []string) error {
+	RunE: func(cmd *cobra.Command, args []string) (runErr error) {
+		// Cobra skips PostRunE on error, so failure hooks run here.
+		defer func() {
+			if runErr != nil {
+				runHooksOnError(h.AfterTerraformInit, cmd, args, runErr)
+			}
+		}()
+
 		v := viper.GetViper()
 
 		// Bind both parent and subcommand parsers.
@@ -39,6 +47,9 @@ For complete Terraform/OpenTofu documentation, see:
 
 		return terraformRunWithOptions(terraformCmd, cmd, args, opts)
 	},
+	PostRunE: func(cmd *cobra.Command, args []string) error {
+		return runHooks(h.AfterTerraformInit, cmd, args)
+	},
 }
 
 func init() {
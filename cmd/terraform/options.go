@@ -35,6 +35,10 @@ type TerraformRunOptions struct {
 	All        bool
 	Affected   bool
 
+	// Parallel execution flags.
+	Parallelism     int
+	ContinueOnError bool
+
 	// Status upload flag.
 	UploadStatus bool
 }
@@ -60,6 +64,8 @@ func ParseTerraformRunOptions(v *viper.Viper) *TerraformRunOptions {
 		Components:              v.GetStringSlice("components"),
 		All:                     v.GetBool("all"),
 		Affected:                v.GetBool("affected"),
+		Parallelism:             v.GetInt("parallelism"),
+		ContinueOnError:         v.GetBool("continue-on-error"),
 		UploadStatus:            v.GetBool("upload-status"),
 	}
 }
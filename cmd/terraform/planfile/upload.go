@@ -14,6 +14,8 @@ import (
 	errUtils "github.com/cloudposse/atmos/errors"
 	"github.com/cloudposse/atmos/internal/exec"
 	"github.com/cloudposse/atmos/pkg/ci/artifact"
+	_ "github.com/cloudposse/atmos/pkg/ci/artifact/azure"  // Register azure artifact store.
+	_ "github.com/cloudposse/atmos/pkg/ci/artifact/gcs"    // Register gcs artifact store.
 	_ "github.com/cloudposse/atmos/pkg/ci/artifact/github" // Register github artifact store.
 	_ "github.com/cloudposse/atmos/pkg/ci/artifact/local"  // Register local artifact store.
 	_ "github.com/cloudposse/atmos/pkg/ci/artifact/s3"     // Register s3 artifact store.
@@ -47,7 +49,7 @@ var uploadCmd = &cobra.Command{
 
 The component is specified as a positional argument and the stack via -s/--stack.
 The storage backend is configured in atmos.yaml under terraform.planfiles.
-Supported backends: local/dir, aws/s3, github/artifacts.
+Supported backends: local/dir, aws/s3, azure/blob, google/gcs, github/artifacts.
 
 When --planfile is omitted, the planfile path is derived from component and stack.`,
 	Args: cobra.ExactArgs(1),
@@ -196,6 +198,9 @@ func createStore(atmosConfig *schema.AtmosConfiguration, storeName string) (plan
 	if err != nil {
 		return nil, err
 	}
+	if err := planfile.ResolveAuthContext(&artOpts); err != nil {
+		return nil, err
+	}
 	backend, err := artifact.NewStore(artOpts)
 	if err != nil {
 		return nil, err
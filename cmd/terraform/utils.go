@@ -31,14 +31,14 @@ func runHooks(event h.HookEvent, cmd_ *cobra.Command, args []string) error {
 	return runHooksWithOutput(event, cmd_, args, "")
 }
 
-// runHooksOnError runs CI hooks with command error context.
+// runHooksOnError runs the failure hooks and CI hooks with command error context.
 // Used to update check runs to failure status when RunE fails
 // (Cobra skips PostRunE on error, so this must be called explicitly).
 func runHooksOnError(event h.HookEvent, cmd_ *cobra.Command, args []string, cmdErr error) {
 	runHooksOnErrorWithOutput(event, cmd_, args, cmdErr, "")
 }
 
-// runHooksOnErrorWithOutput runs CI hooks with command error context and captured output.
+// runHooksOnErrorWithOutput runs the failure hooks and CI hooks with command error context and captured output.
 func runHooksOnErrorWithOutput(event h.HookEvent, cmd_ *cobra.Command, args []string, cmdErr error, output string) {
 	finalArgs := append([]string{cmd_.Name()}, args...)
 
@@ -52,6 +52,8 @@ func runHooksOnErrorWithOutput(event h.HookEvent, cmd_ *cobra.Command, args []st
 		return
 	}
 
+	runFailureHooks(&atmosConfig, &info, cmd_, args, cmdErr)
+
 	forceCIMode, _ := cmd_.Flags().GetBool("ci")
 	if !forceCIMode {
 		forceCIMode = viper.GetBool("ci")
@@ -62,6 +64,31 @@ func runHooksOnErrorWithOutput(event h.HookEvent, cmd_ *cobra.Command, args []st
 	}
 }
 
+// runFailureHooks runs the user-defined `on.terraform.failure` hooks.
+// Errors of the hooks are logged and don't replace the error of the failed command.
+func runFailureHooks(atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStacksInfo, cmd_ *cobra.Command, args []string, cmdErr error) {
+	if info.NeedsPathResolution && info.ComponentFromArg != "" {
+		if err := resolveComponentPath(info, cfg.TerraformComponentType); err != nil {
+			log.Warn("Failure hooks skipped", "error", err)
+			return
+		}
+	}
+
+	hooks, err := h.GetHooks(atmosConfig, info)
+	if err != nil {
+		log.Warn("Failure hooks skipped", "error", err)
+		return
+	}
+	if !hooks.HasHooks() {
+		return
+	}
+
+	log.Info("Running hooks", "event", h.OnTerraformFailure)
+	if err := hooks.RunAllWithError(h.OnTerraformFailure, atmosConfig, info, cmd_, args, cmdErr); err != nil {
+		log.Warn("Failure hook execution failed", "error", err)
+	}
+}
+
 func runHooksWithOutput(event h.HookEvent, cmd_ *cobra.Command, args []string, output string) error {
 	// Build args for ProcessCommandLineArgs.
 	// Note: Double-dash processing is handled by AtmosFlagParser in terraformRun (RunE).
@@ -232,6 +259,11 @@ func executeAffectedCommand(parentCmd *cobra.Command, args []string, info *schem
 	a.Upload = false
 	a.OutputFile = ""
 
+	if info.Parallelism > 1 {
+		log.Debug("Routing to ExecuteTerraformAffectedWithGraph (parallel)", "parallelism", info.Parallelism)
+		return e.ExecuteTerraformAffectedWithGraph(&a, info)
+	}
+
 	return e.ExecuteTerraformAffected(&a, info)
 }
 
@@ -297,6 +329,8 @@ func applyOptionsToInfo(info *schema.ConfigAndStacksInfo, opts *TerraformRunOpti
 	info.UploadStatus = opts.UploadStatus
 	info.All = opts.All
 	info.Affected = opts.Affected
+	info.Parallelism = opts.Parallelism
+	info.ContinueOnError = opts.ContinueOnError
 	info.Query = opts.Query
 
 	// Backend execution flags (only apply if set via CLI).
@@ -383,6 +417,10 @@ func terraformRunWithOptions(parentCmd, actualCmd *cobra.Command, args []string,
 		return executeAffectedCommand(parentCmd, args, &info)
 	}
 	if isMultiComponentExecution(&info) {
+		if info.Parallelism > 1 {
+			log.Debug("Routing to ExecuteTerraformGraph (parallel multi-component)", "parallelism", info.Parallelism)
+			return e.ExecuteTerraformGraph(&info)
+		}
 		log.Debug("Routing to ExecuteTerraformQuery (multi-component)")
 		return e.ExecuteTerraformQuery(&info)
 	}
@@ -438,6 +476,11 @@ func checkTerraformFlags(info *schema.ConfigAndStacksInfo) error {
 		return errUtils.ErrInvalidTerraformSingleComponentAndMultiComponentFlags
 	}
 
+	// `--parallelism` can't be negative (0 and 1 both mean sequential execution).
+	if info.Parallelism < 0 {
+		return fmt.Errorf("%w: got %d", errUtils.ErrInvalidParallelism, info.Parallelism)
+	}
+
 	return nil
 }
 
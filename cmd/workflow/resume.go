@@ -0,0 +1,54 @@
+package workflow
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	e "github.com/cloudposse/atmos/internal/exec"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// resumeCmd resumes a recorded workflow run.
+var resumeCmd = &cobra.Command{
+	Use:   "resume <run-id>",
+	Short: "Resume a failed workflow run",
+	Long: `Resume a recorded workflow run from where it stopped.
+
+Steps that succeeded or were skipped in the run are not executed again, and their
+outputs are restored for the remaining steps. The workflow is loaded from the
+manifest recorded in the run, with the same stack and identity.
+
+Use 'atmos workflow runs list' to find the ID of a run.`,
+	Example:            `  atmos workflow resume 20261018-141502-3f9a1c`,
+	Args:               cobra.ExactArgs(1),
+	FParseErrWhitelist: struct{ UnknownFlags bool }{UnknownFlags: false},
+	ValidArgsFunction:  runIDCompletion,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer perf.Track(nil, "workflow.resume.RunE")()
+
+		atmosConfig, err := cfg.InitCliConfig(buildConfigAndStacksInfo(cmd), false)
+		if err != nil {
+			return err
+		}
+
+		return e.ExecuteWorkflowResume(atmosConfig, args[0])
+	},
+}
+
+// buildConfigAndStacksInfo creates a ConfigAndStacksInfo from the global flags (--base-path, --config, etc.).
+func buildConfigAndStacksInfo(cmd *cobra.Command) schema.ConfigAndStacksInfo {
+	globalFlags := flags.ParseGlobalFlags(cmd, viper.GetViper())
+	return schema.ConfigAndStacksInfo{
+		AtmosBasePath:           globalFlags.BasePath,
+		AtmosConfigFilesFromArg: globalFlags.Config,
+		AtmosConfigDirsFromArg:  globalFlags.ConfigPath,
+		ProfilesFromArg:         globalFlags.Profile,
+	}
+}
+
+func init() {
+	workflowCmd.AddCommand(resumeCmd)
+}
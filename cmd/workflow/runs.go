@@ -0,0 +1,343 @@
+package workflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/data"
+	"github.com/cloudposse/atmos/pkg/flags"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/ui"
+	"github.com/cloudposse/atmos/pkg/ui/theme"
+	"github.com/cloudposse/atmos/pkg/workflow"
+)
+
+const (
+	runTimeFormat = "2006-01-02 15:04:05"
+	noValue       = "-"
+)
+
+var (
+	runsListParser *flags.StandardParser
+	runsShowParser *flags.StandardParser
+)
+
+// runsCmd groups the commands that inspect recorded workflow runs.
+var runsCmd = &cobra.Command{
+	Use:   "runs",
+	Short: "Inspect recorded workflow runs",
+	Long: `Inspect recorded workflow runs.
+
+Every workflow run (except dry runs) is recorded in the Atmos state directory
+($XDG_STATE_HOME/atmos/workflow/runs) with the status, timing, exit code and
+output tail of each step. Failed runs can be resumed with 'atmos workflow resume'.
+The 100 most recent runs are kept.`,
+	Args: cobra.NoArgs,
+}
+
+// runsListCmd lists recorded workflow runs.
+var runsListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List recorded workflow runs",
+	Long:    `List recorded workflow runs, most recent first.`,
+	Example: `  atmos workflow runs list`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer perf.Track(nil, "workflow.runs.list.RunE")()
+
+		v := viper.GetViper()
+		if err := runsListParser.BindFlagsToViper(cmd, v); err != nil {
+			return err
+		}
+
+		store, err := workflow.DefaultRunStore()
+		if err != nil {
+			return err
+		}
+		records, err := store.List()
+		if err != nil {
+			return err
+		}
+
+		switch format := v.GetString("format"); format {
+		case "json":
+			return printRunsJSON(records)
+		case "yaml":
+			return printRunsYAML(records)
+		case "table":
+			printRunsTable(records)
+			return nil
+		default:
+			return invalidRunsFormatError(format)
+		}
+	},
+}
+
+// runsShowCmd shows the details of a recorded workflow run.
+var runsShowCmd = &cobra.Command{
+	Use:               "show <run-id>",
+	Short:             "Show the details of a workflow run",
+	Long:              `Show the status, timing, exit code and output tail of each step of a recorded workflow run.`,
+	Example:           `  atmos workflow runs show 20261018-141502-3f9a1c`,
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: runIDCompletion,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		defer perf.Track(nil, "workflow.runs.show.RunE")()
+
+		v := viper.GetViper()
+		if err := runsShowParser.BindFlagsToViper(cmd, v); err != nil {
+			return err
+		}
+
+		store, err := workflow.DefaultRunStore()
+		if err != nil {
+			return err
+		}
+		record, err := store.Load(args[0])
+		if err != nil {
+			return errUtils.Build(err).
+				WithHint("Use `atmos workflow runs list` to see the recorded workflow runs").
+				WithContext("run", args[0]).
+				WithExitCode(1).
+				Err()
+		}
+
+		switch format := v.GetString("format"); format {
+		case "json":
+			return printRunsJSON(record)
+		case "yaml":
+			return printRunsYAML(record)
+		case "table":
+			printRunDetails(record)
+			return nil
+		default:
+			return invalidRunsFormatError(format)
+		}
+	},
+}
+
+// runIDCompletion provides shell completion for workflow run IDs.
+func runIDCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	store, err := workflow.DefaultRunStore()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	records, err := store.List()
+	if err != nil {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	ids := make([]string, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.ID)
+	}
+	return ids, cobra.ShellCompDirectiveNoFileComp
+}
+
+func printRunsJSON(value any) error {
+	jsonData, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return errUtils.Build(errUtils.ErrOutputFormat).
+			WithCause(err).
+			WithExplanation("Failed to marshal workflow runs to JSON").
+			Err()
+	}
+	_ = data.Writeln(string(jsonData))
+	return nil
+}
+
+func printRunsYAML(value any) error {
+	yamlData, err := yaml.Marshal(value)
+	if err != nil {
+		return errUtils.Build(errUtils.ErrOutputFormat).
+			WithCause(err).
+			WithExplanation("Failed to marshal workflow runs to YAML").
+			Err()
+	}
+	_ = data.Write(string(yamlData))
+	return nil
+}
+
+func invalidRunsFormatError(format string) error {
+	return errUtils.Build(errUtils.ErrInvalidFormat).
+		WithExplanationf("The format `%s` is not supported for this command", format).
+		WithHint("Use `--format table`, `--format json`, or `--format yaml`").
+		WithContext("format", format).
+		WithExitCode(2).
+		Err()
+}
+
+func printRunsTable(records []*workflow.RunRecord) {
+	if len(records) == 0 {
+		ui.Writeln("No workflow runs found")
+		return
+	}
+
+	rows := make([][]string, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, []string{
+			record.ID,
+			record.Workflow,
+			valueOrDash(record.Stack),
+			record.Status,
+			formatStepProgress(record),
+			record.StartedAt.Local().Format(runTimeFormat),
+			record.Duration().String(),
+		})
+	}
+
+	headers := []string{"RUN", "WORKFLOW", "STACK", "STATUS", "STEPS", "STARTED", "DURATION"}
+	ui.Writeln(newRunsTable(headers, rows).String())
+}
+
+func printRunDetails(record *workflow.RunRecord) {
+	defer perf.Track(nil, "workflow.printRunDetails")()
+
+	rows := [][]string{
+		{"Run", record.ID},
+		{"Workflow", record.Workflow},
+		{"File", record.WorkflowPath},
+		{"Stack", valueOrDash(record.Stack)},
+		{"Identity", valueOrDash(record.Identity)},
+		{"Status", record.Status},
+		{"Started", record.StartedAt.Local().Format(runTimeFormat)},
+		{"Finished", formatOptionalTime(record.FinishedAt)},
+		{"Duration", record.Duration().String()},
+	}
+	if record.Resumes > 0 {
+		rows = append(rows, []string{"Resumes", strconv.Itoa(record.Resumes)})
+	}
+
+	details := table.New().
+		Rows(rows...).
+		BorderTop(false).
+		BorderBottom(false).
+		BorderLeft(false).
+		BorderRight(false).
+		BorderRow(false).
+		BorderColumn(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if col == 0 {
+				return lipgloss.NewStyle().
+					Foreground(lipgloss.Color(theme.ColorCyan)).
+					Padding(0, 1, 0, 2)
+			}
+			return lipgloss.NewStyle().Padding(0, 1)
+		})
+	ui.Writef("%s\n\n", details)
+
+	stepRows := make([][]string, 0, len(record.Steps))
+	for i := range record.Steps {
+		step := &record.Steps[i]
+		exitCode := noValue
+		if step.Status == workflow.StepStatusSuccess || step.Status == workflow.StepStatusFailed {
+			exitCode = strconv.Itoa(step.ExitCode)
+		}
+		stepRows = append(stepRows, []string{
+			step.Name,
+			step.Status,
+			exitCode,
+			formatStepDuration(step),
+			step.Command,
+		})
+	}
+	ui.Writeln(newRunsTable([]string{"STEP", "STATUS", "EXIT", "DURATION", "COMMAND"}, stepRows).String())
+
+	// Show the output tail of the steps that failed or were interrupted.
+	for i := range record.Steps {
+		step := &record.Steps[i]
+		if step.Status != workflow.StepStatusFailed && step.Status != workflow.StepStatusRunning {
+			continue
+		}
+		ui.Writef("\nOutput of step `%s`:\n", step.Name)
+		if step.Error != "" {
+			ui.Writef("%s\n", step.Error)
+		}
+		if tail := strings.TrimRight(step.OutputTail, "\n"); tail != "" {
+			ui.Writeln(tail)
+		}
+	}
+}
+
+func newRunsTable(headers []string, rows [][]string) *table.Table {
+	return table.New().
+		Headers(headers...).
+		Rows(rows...).
+		BorderTop(false).
+		BorderBottom(false).
+		BorderLeft(false).
+		BorderRight(false).
+		BorderRow(false).
+		BorderColumn(false).
+		StyleFunc(func(row, col int) lipgloss.Style {
+			if row == table.HeaderRow {
+				return lipgloss.NewStyle().
+					Foreground(lipgloss.Color(theme.ColorCyan)).
+					Bold(true).
+					Padding(0, 2, 0, 0)
+			}
+			return lipgloss.NewStyle().Padding(0, 2, 0, 0)
+		})
+}
+
+// formatStepProgress returns the number of completed steps out of all steps, e.g. `3/5`.
+func formatStepProgress(record *workflow.RunRecord) string {
+	return fmt.Sprintf("%d/%d", len(record.CompletedSteps()), len(record.Steps))
+}
+
+func formatStepDuration(step *workflow.RunStepRecord) string {
+	if step.StartedAt == nil || step.FinishedAt == nil {
+		return noValue
+	}
+	return step.FinishedAt.Sub(*step.StartedAt).Round(time.Millisecond).String()
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return noValue
+	}
+	return t.Local().Format(runTimeFormat)
+}
+
+func valueOrDash(value string) string {
+	if value == "" {
+		return noValue
+	}
+	return value
+}
+
+func init() {
+	runsListParser = flags.NewStandardParser(
+		flags.WithStringFlag("format", "", "table", "Output format: table, json, yaml"),
+	)
+	runsListParser.RegisterFlags(runsListCmd)
+	if err := runsListParser.BindToViper(viper.GetViper()); err != nil {
+		panic(err)
+	}
+
+	runsShowParser = flags.NewStandardParser(
+		flags.WithStringFlag("format", "", "table", "Output format: table, json, yaml"),
+	)
+	runsShowParser.RegisterFlags(runsShowCmd)
+	if err := runsShowParser.BindToViper(viper.GetViper()); err != nil {
+		panic(err)
+	}
+
+	runsCmd.AddCommand(runsListCmd)
+	runsCmd.AddCommand(runsShowCmd)
+	workflowCmd.AddCommand(runsCmd)
+}
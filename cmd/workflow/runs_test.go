@@ -0,0 +1,53 @@
+package workflow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/workflow"
+)
+
+func TestRunIDCompletion(t *testing.T) {
+	t.Setenv("ATMOS_XDG_STATE_HOME", t.TempDir())
+
+	store, err := workflow.DefaultRunStore()
+	require.NoError(t, err)
+	now := time.Now()
+	require.NoError(t, store.Save(&workflow.RunRecord{ID: "older", StartedAt: now.Add(-time.Minute)}))
+	require.NoError(t, store.Save(&workflow.RunRecord{ID: "newer", StartedAt: now}))
+
+	ids, directive := runIDCompletion(&cobra.Command{}, nil, "")
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, directive)
+	assert.Equal(t, []string{"newer", "older"}, ids)
+
+	ids, _ = runIDCompletion(&cobra.Command{}, []string{"newer"}, "")
+	assert.Empty(t, ids)
+}
+
+func TestFormatRunValues(t *testing.T) {
+	started := time.Now()
+	finished := started.Add(1500 * time.Millisecond)
+
+	record := &workflow.RunRecord{Steps: []workflow.RunStepRecord{
+		{Name: "a", Status: workflow.StepStatusSuccess, StartedAt: &started, FinishedAt: &finished},
+		{Name: "b", Status: workflow.StepStatusSkipped},
+		{Name: "c", Status: workflow.StepStatusFailed},
+	}}
+
+	assert.Equal(t, "2/3", formatStepProgress(record))
+	assert.Equal(t, "1.5s", formatStepDuration(&record.Steps[0]))
+	assert.Equal(t, noValue, formatStepDuration(&record.Steps[1]))
+	assert.Equal(t, noValue, formatOptionalTime(nil))
+	assert.Equal(t, noValue, valueOrDash(""))
+	assert.Equal(t, "dev", valueOrDash("dev"))
+}
+
+func TestInvalidRunsFormatError(t *testing.T) {
+	err := invalidRunsFormatError("xml")
+	assert.ErrorIs(t, err, errUtils.ErrInvalidFormat)
+}
@@ -162,6 +162,22 @@ var (
 	ErrStorageAccountRequired = errors.New("storage_account_name is required for azurerm backend")
 	ErrAzurePermissionDenied  = errors.New("permission denied accessing Azure blob")
 
+	// HTTP, pg, consul, kubernetes and remote backend errors.
+	ErrHTTPBackendAddressRequired      = errors.New("address is required for http backend")
+	ErrReadHTTPBackendState            = errors.New("failed to read state from http backend")
+	ErrPgConnStrRequired               = errors.New("conn_str is required for pg backend")
+	ErrReadPgBackendState              = errors.New("failed to read state from pg backend")
+	ErrConsulPathRequired              = errors.New("path is required for consul backend")
+	ErrReadConsulBackendState          = errors.New("failed to read state from consul backend")
+	ErrKubernetesSecretSuffixRequired  = errors.New("secret_suffix is required for kubernetes backend")
+	ErrCreateKubernetesClient          = errors.New("failed to create kubernetes client")
+	ErrReadKubernetesBackendState      = errors.New("failed to read state from kubernetes backend")
+	ErrRemoteBackendOrganizationNeeded = errors.New("organization is required for remote and cloud backends")
+	ErrRemoteBackendWorkspaceNeeded    = errors.New("workspace name is required for remote and cloud backends")
+	ErrRemoteBackendTokenNeeded        = errors.New("API token is required for remote and cloud backends")
+	ErrReadRemoteBackendState          = errors.New("failed to read state from remote backend")
+	ErrDecompressTerraformState        = errors.New("failed to decompress terraform state")
+
 	// Azure authentication errors.
 	ErrAzureOIDClaimNotFound       = errors.New("oid claim not found in token")
 	ErrAzureUsernameClaimNotFound  = errors.New("no username claim found in token (tried upn, unique_name, email)")
@@ -464,6 +480,7 @@ var (
 	// Helmfile-specific subsection errors.
 	ErrInvalidHelmfileCommand      = errors.New("invalid helmfile command")
 	ErrInvalidHelmfileVars         = errors.New("invalid helmfile vars section")
+	ErrInvalidHelmfileHooks        = errors.New("invalid helmfile hooks section")
 	ErrInvalidHelmfileSettings     = errors.New("invalid helmfile settings section")
 	ErrInvalidHelmfileEnv          = errors.New("invalid helmfile env section")
 	ErrInvalidHelmfileAuth         = errors.New("invalid helmfile auth section")
@@ -483,6 +500,7 @@ var (
 	// Packer-specific subsection errors.
 	ErrInvalidPackerCommand      = errors.New("invalid packer command")
 	ErrInvalidPackerVars         = errors.New("invalid packer vars section")
+	ErrInvalidPackerHooks        = errors.New("invalid packer hooks section")
 	ErrInvalidPackerSettings     = errors.New("invalid packer settings section")
 	ErrInvalidPackerEnv          = errors.New("invalid packer env section")
 	ErrInvalidPackerAuth         = errors.New("invalid packer auth section")
@@ -495,6 +513,7 @@ var (
 	ErrInvalidAnsibleSection      = errors.New("invalid ansible section")
 	ErrInvalidAnsibleCommand      = errors.New("invalid ansible command")
 	ErrInvalidAnsibleVars         = errors.New("invalid ansible vars section")
+	ErrInvalidAnsibleHooks        = errors.New("invalid ansible hooks section")
 	ErrInvalidAnsibleSettings     = errors.New("invalid ansible settings section")
 	ErrInvalidAnsibleEnv          = errors.New("invalid ansible env section")
 	ErrInvalidAnsibleAuth         = errors.New("invalid ansible auth section")
@@ -503,11 +522,50 @@ var (
 	// Ansible execution errors.
 	ErrAnsiblePlaybookMissing = errors.New("ansible playbook is required")
 
+	// Pulumi configuration errors.
+	ErrMissingPulumiBasePath = errors.New("pulumi base path is required")
+
+	// Pulumi-specific subsection errors.
+	ErrInvalidPulumiSection      = errors.New("invalid pulumi section")
+	ErrInvalidPulumiCommand      = errors.New("invalid pulumi command")
+	ErrInvalidPulumiVars         = errors.New("invalid pulumi vars section")
+	ErrInvalidPulumiHooks        = errors.New("invalid pulumi hooks section")
+	ErrInvalidPulumiSettings     = errors.New("invalid pulumi settings section")
+	ErrInvalidPulumiEnv          = errors.New("invalid pulumi env section")
+	ErrInvalidPulumiAuth         = errors.New("invalid pulumi auth section")
+	ErrInvalidPulumiDependencies = errors.New("invalid pulumi dependencies section")
+	ErrInvalidPulumiBackend      = errors.New("invalid pulumi backend section")
+
+	// Pulumi execution errors.
+	ErrPulumiProjectMissing = errors.New("pulumi project name is required")
+	ErrPulumiStackConfig    = errors.New("failed to write pulumi stack config")
+
 	// Component type-specific section errors.
 	ErrInvalidComponentsTerraform = errors.New("invalid components.terraform section")
 	ErrInvalidComponentsHelmfile  = errors.New("invalid components.helmfile section")
 	ErrInvalidComponentsPacker    = errors.New("invalid components.packer section")
 	ErrInvalidComponentsAnsible   = errors.New("invalid components.ansible section")
+	ErrInvalidComponentsPulumi    = errors.New("invalid components.pulumi section")
+
+	// Kustomize configuration errors.
+	ErrMissingKustomizeBasePath = errors.New("kustomize base path is required")
+
+	// Kustomize-specific subsection errors.
+	ErrInvalidKustomizeSection      = errors.New("invalid kustomize section")
+	ErrInvalidKustomizeCommand      = errors.New("invalid kustomize command")
+	ErrInvalidKustomizeVars         = errors.New("invalid kustomize vars section")
+	ErrInvalidKustomizeHooks        = errors.New("invalid kustomize hooks section")
+	ErrInvalidKustomizeSettings     = errors.New("invalid kustomize settings section")
+	ErrInvalidKustomizeEnv          = errors.New("invalid kustomize env section")
+	ErrInvalidKustomizeAuth         = errors.New("invalid kustomize auth section")
+	ErrInvalidKustomizeDependencies = errors.New("invalid kustomize dependencies section")
+
+	// Kustomize execution errors.
+	ErrKustomizeManifestsMissing = errors.New("kustomize component has no kustomization or manifests")
+	ErrKustomizeGenerate         = errors.New("failed to generate kustomization")
+	ErrKustomizeKubectlRequired  = errors.New("kubectl is required to diff and apply kustomize components")
+
+	ErrInvalidComponentsKustomize = errors.New("invalid components.kustomize section")
 
 	// Specific component configuration errors.
 	ErrInvalidSpecificTerraformComponent = errors.New("invalid terraform component configuration")
@@ -577,6 +635,12 @@ var (
 	ErrWorkflowNoWorkflow            = errors.New("no workflow found")
 	ErrWorkflowFileNotFound          = errors.New("workflow file not found")
 	ErrInvalidWorkflowManifest       = errors.New("invalid workflow manifest")
+	ErrInvalidWorkflowStepNeeds      = errors.New("invalid workflow step dependencies")
+	ErrInvalidWorkflowStepCondition  = errors.New("invalid workflow step condition")
+	ErrWorkflowStepOutputs           = errors.New("failed to evaluate workflow step outputs")
+	ErrWorkflowRunNotFound           = errors.New("workflow run not found")
+	ErrWorkflowRunState              = errors.New("failed to access workflow run state")
+	ErrWorkflowRunCompleted          = errors.New("workflow run has already completed")
 	ErrWorkingDirNotFound            = errors.New("working directory does not exist")
 	ErrWorkingDirNotDirectory        = errors.New("working directory path is not a directory")
 	ErrWorkingDirAccessFailed        = errors.New("failed to access working directory")
@@ -605,6 +669,7 @@ var (
 	// Terraform --all flag errors.
 	ErrStackRequiredWithAllFlag     = errors.New("stack is required when using --all flag")
 	ErrComponentWithAllFlagConflict = errors.New("component argument can't be used with --all flag")
+	ErrInvalidParallelism           = errors.New("--parallelism must not be negative")
 
 	// Terraform execution errors.
 	ErrTerraformExecFailed            = errors.New("terraform execution failed")
@@ -652,6 +717,8 @@ var (
 	// Initialization and configuration errors.
 	ErrInitializeCLIConfig = errors.New("error initializing CLI config")
 	ErrGetHooks            = errors.New("error getting hooks")
+	ErrInvalidHook         = errors.New("invalid hook configuration")
+	ErrHookFailed          = errors.New("hook failed")
 	ErrSetFlag             = errors.New("failed to set flag")
 	ErrVersionMismatch     = errors.New("version mismatch")
 
@@ -698,6 +765,7 @@ var (
 	ErrWriteADCFile                 = errors.New("failed to write ADC file")
 	ErrWritePropertiesFile          = errors.New("failed to write properties file")
 	ErrWriteAccessTokenFile         = errors.New("failed to write access token file")
+	ErrWriteGcloudConfiguration     = errors.New("failed to write gcloud configuration")
 	ErrPostAuthenticationHookFailed = errors.New("post authentication hook failed")
 	ErrAuthManager                  = errors.New("auth manager error")
 	ErrDefaultIdentity              = errors.New("default identity error")
@@ -737,6 +805,30 @@ var (
 	ErrWebflowStateMismatch       = errors.New("state mismatch: possible CSRF attack")
 	ErrWebflowEmptyCachedToken    = errors.New("cached refresh token is empty")
 
+	// Generic OIDC provider errors.
+	ErrOIDCDiscoveryFailed       = errors.New("failed to discover OIDC issuer configuration")
+	ErrOIDCDeviceFlowUnsupported = errors.New("OIDC issuer does not support the device authorization flow")
+	ErrOIDCTokenMissing          = errors.New("OIDC token response does not contain the requested token")
+	ErrOIDCInteractiveRequired   = errors.New("OIDC browser authentication requires an interactive terminal")
+	ErrOIDCWebIdentityRequired   = errors.New("identity requires OIDC credentials from its provider")
+
+	// CI token provider errors.
+	ErrNotInGitLabCI    = errors.New("not running in GitLab CI")
+	ErrCITokenNotFound  = errors.New("CI job token not found")
+	ErrCITokenMalformed = errors.New("CI job token is not a JWT")
+
+	// Auth agent errors.
+	ErrAuthAgentUnavailable    = errors.New("auth agent is not reachable")
+	ErrAuthAgentRequestFailed  = errors.New("auth agent request failed")
+	ErrAuthAgentAlreadyRunning = errors.New("auth agent is already running")
+
+	// Auth export errors.
+	ErrCredentialProcessUnsupported = errors.New("credential_process output requires AWS credentials")
+	ErrAuthExportUnsupportedFormat  = errors.New("unsupported auth export format")
+	ErrAuthExportNoIdentities       = errors.New("no identities to export")
+	ErrAuthExportProfileConflict    = errors.New("profile already exists")
+	ErrAuthExportUnexpectedCreds    = errors.New("identity returned unexpected credential type")
+
 	// Credential errors.
 	ErrCredentialsInvalid = errors.New("credentials are invalid or have been revoked")
 	ErrInvalidDuration    = errors.New("invalid duration format")
@@ -796,6 +888,8 @@ var (
 	// Store and hook errors.
 	ErrNilTerraformOutput = errors.New("terraform output returned nil")
 	ErrNilStoreValue      = errors.New("cannot store nil value")
+	ErrStoreNotFound      = errors.New("store not found")
+	ErrStoreUnsupportedOp = errors.New("store does not support the operation")
 
 	// Devcontainer errors.
 	ErrDevcontainerNotFound      = errors.New("devcontainer not found")
@@ -846,6 +940,15 @@ var (
 	ErrDeleteObjects             = errors.New("failed to delete objects from bucket")
 	ErrDeleteBucket              = errors.New("failed to delete bucket")
 	ErrListObjects               = errors.New("failed to list bucket objects")
+	ErrGCPProjectRequired        = errors.New("GCP project is required to create a GCS bucket")
+	ErrSubscriptionIDRequired    = errors.New("subscription ID is required for azurerm backend")
+	ErrResourceGroupRequired     = errors.New("resource_group_name is required for azurerm backend")
+	ErrAzureLocationRequired     = errors.New("location is required to create an Azure storage account")
+	ErrCreateResourceGroup       = errors.New("failed to create resource group")
+	ErrCreateStorageAccount      = errors.New("failed to create storage account")
+	ErrCreateContainer           = errors.New("failed to create blob container")
+	ErrDeleteContainer           = errors.New("failed to delete blob container")
+	ErrEnableSoftDelete          = errors.New("failed to enable soft delete")
 
 	// Component path resolution errors.
 	ErrPathNotInComponentDir  = errors.New("path is not within Atmos component directories")
@@ -876,6 +979,21 @@ var (
 	ErrSourceCopyFailed      = errors.New("failed to copy source files")
 	ErrSourceMissing         = errors.New("source not configured for component")
 
+	// Vendor lock file, diff and update errors.
+	ErrReadVendorLockFile       = errors.New("failed to read vendor lock file")
+	ErrWriteVendorLockFile      = errors.New("failed to write vendor lock file")
+	ErrVendorLockFileNotFound   = errors.New("vendor lock file not found")
+	ErrVendorLockEntryMissing   = errors.New("vendor source is not in the lock file")
+	ErrVendorLockDrift          = errors.New("vendored source does not match the lock file")
+	ErrVendorLockedRequiresFile = errors.New("--locked requires a vendor config file")
+	ErrVendorVerify             = errors.New("failed to verify vendored files")
+	ErrVendorVerifyFailed       = errors.New("vendored files do not match the lock file")
+	ErrListGitTags              = errors.New("failed to list Git tags")
+	ErrListOCITags              = errors.New("failed to list OCI image tags")
+	ErrVendorDiff               = errors.New("failed to diff vendored component")
+	ErrVendorUpdate             = errors.New("failed to update vendor config")
+	ErrVendorComponentRequired  = errors.New("the '--component' (shorthand '-c') flag is required")
+
 	// Workdir provisioner errors.
 	ErrSourceDownload   = errors.New("failed to download component source")
 	ErrSourceCacheRead  = errors.New("failed to read source cache")
@@ -918,16 +1036,24 @@ var (
 	ErrIdentityCredentialsNone = errors.New("credentials not available for identity")
 
 	// CI-related errors.
-	ErrCIDisabled              = errors.New("CI server is disabled")
-	ErrCIProviderNotDetected   = errors.New("CI provider not detected")
-	ErrCIProviderNotFound      = errors.New("CI provider not found")
-	ErrCIOperationNotSupported = errors.New("operation not supported by CI provider")
-	ErrCICheckRunCreateFailed  = errors.New("failed to create check run")
-	ErrCICheckRunUpdateFailed  = errors.New("failed to update check run")
-	ErrCIStatusFetchFailed     = errors.New("failed to fetch CI status")
-	ErrCIOutputWriteFailed     = errors.New("failed to write CI output")
-	ErrCISummaryWriteFailed    = errors.New("failed to write CI summary")
-	ErrGitHubTokenNotFound     = errors.New("GitHub token not found")
+	ErrCIDisabled                  = errors.New("CI server is disabled")
+	ErrCIProviderNotDetected       = errors.New("CI provider not detected")
+	ErrCIProviderNotFound          = errors.New("CI provider not found")
+	ErrCIOperationNotSupported     = errors.New("operation not supported by CI provider")
+	ErrCICheckRunCreateFailed      = errors.New("failed to create check run")
+	ErrCICheckRunUpdateFailed      = errors.New("failed to update check run")
+	ErrCIStatusFetchFailed         = errors.New("failed to fetch CI status")
+	ErrCIOutputWriteFailed         = errors.New("failed to write CI output")
+	ErrCISummaryWriteFailed        = errors.New("failed to write CI summary")
+	ErrCICommentFailed             = errors.New("failed to publish pull request comment")
+	ErrCICommentNotFound           = errors.New("pull request comment not found")
+	ErrGitHubTokenNotFound         = errors.New("GitHub token not found")
+	ErrGitLabTokenNotFound         = errors.New("GitLab token not found")
+	ErrGitLabAPIRequestFailed      = errors.New("GitLab API request failed")
+	ErrAzureDevOpsTokenNotFound    = errors.New("Azure DevOps token not found")
+	ErrAzureDevOpsAPIRequestFailed = errors.New("Azure DevOps API request failed")
+	ErrBitbucketTokenNotFound      = errors.New("Bitbucket token not found")
+	ErrBitbucketAPIRequestFailed   = errors.New("Bitbucket API request failed")
 
 	// Planfile storage errors.
 	ErrPlanfileNotFound           = errors.New("planfile not found")
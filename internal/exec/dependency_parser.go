@@ -26,6 +26,9 @@ const (
 type DependencyParser struct {
 	builder *dependency.GraphBuilder
 	nodeMap map[string]string
+	// skipMissingTargets ignores dependencies on components that are not in the graph,
+	// for graphs that only hold the components selected for execution.
+	skipMissingTargets bool
 }
 
 // NewDependencyParser creates a new dependency parser.
@@ -161,6 +164,10 @@ func (p *DependencyParser) parseDependencyMapAnyEntry(fromID, defaultStack strin
 // addDependencyIfExists adds a dependency only if the target node exists.
 func (p *DependencyParser) addDependencyIfExists(fromID, toID string) error {
 	if _, exists := p.nodeMap[toID]; !exists {
+		if p.skipMissingTargets {
+			log.Debug("Dependency target not selected", logFieldFrom, fromID, logFieldTo, toID)
+			return nil
+		}
 		log.Warn("Dependency target not found", logFieldFrom, fromID, logFieldTo, toID)
 		return fmt.Errorf("%w: %s", errUtils.ErrDependencyTargetNotFound, toID)
 	}
@@ -83,7 +83,7 @@ func newChangedFilesIndex(atmosConfig *schema.AtmosConfiguration, changedFiles [
 // Only includes non-empty component base paths to avoid indexing files under the root basePath.
 func buildNormalizedBasePaths(atmosConfig *schema.AtmosConfiguration) []string {
 	// Collect base paths, skipping empty ones to prevent root basePath collisions.
-	basePaths := make([]string, 0, 4)
+	basePaths := make([]string, 0, 6)
 
 	// Add terraform base path if configured.
 	if atmosConfig.Components.Terraform.BasePath != "" {
@@ -100,6 +100,16 @@ func buildNormalizedBasePaths(atmosConfig *schema.AtmosConfiguration) []string {
 		basePaths = append(basePaths, filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Packer.BasePath))
 	}
 
+	// Add pulumi base path if configured.
+	if atmosConfig.Components.Pulumi.BasePath != "" {
+		basePaths = append(basePaths, filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Pulumi.BasePath))
+	}
+
+	// Add kustomize base path if configured.
+	if atmosConfig.Components.Kustomize.BasePath != "" {
+		basePaths = append(basePaths, filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Kustomize.BasePath))
+	}
+
 	// Add stacks base path if configured.
 	if atmosConfig.Stacks.BasePath != "" {
 		basePaths = append(basePaths, filepath.Join(atmosConfig.BasePath, atmosConfig.Stacks.BasePath))
@@ -176,6 +186,10 @@ func (idx *changedFilesIndex) getRelevantFiles(componentType string, atmosConfig
 		basePath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Helmfile.BasePath)
 	case cfg.PackerComponentType:
 		basePath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Packer.BasePath)
+	case cfg.PulumiComponentType:
+		basePath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Pulumi.BasePath)
+	case cfg.KustomizeComponentType:
+		basePath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Kustomize.BasePath)
 	default:
 		// Unknown component type - return all files as fallback.
 		return idx.allFiles
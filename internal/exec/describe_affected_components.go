@@ -21,6 +21,7 @@ const (
 	affectedReasonStackSettings   = "stack.settings"
 	affectedReasonStackSource     = "stack.source"
 	affectedReasonStackProvision  = "stack.provision"
+	affectedReasonStackBackend    = "stack.backend"
 	affectedReasonDeleted         = "deleted"
 	affectedReasonDeletedStack    = "deleted.stack"
 )
@@ -38,6 +39,7 @@ const (
 	sectionNameEnv       = "env"
 	sectionNameSource    = "source"
 	sectionNameProvision = "provision"
+	sectionNameBackend   = "backend"
 )
 
 // shouldSkipComponent determines if a component should be skipped based on metadata.
@@ -444,6 +446,193 @@ func processPackerComponentsIndexed(
 	return affected, nil
 }
 
+// processPulumiComponentsIndexed processes Pulumi components using the files index.
+//
+//nolint:dupl,funlen // Similar structure to processPackerComponentsIndexed but for different component type
+func processPulumiComponentsIndexed(
+	stackName string,
+	pulumiSection map[string]any,
+	remoteStacks *map[string]any,
+	currentStacks *map[string]any,
+	atmosConfig *schema.AtmosConfiguration,
+	filesIndex *changedFilesIndex,
+	patternCache *componentPathPatternCache,
+	includeSpaceliftAdminStacks bool,
+	includeSettings bool,
+	excludeLocked bool,
+) ([]schema.Affected, error) {
+	var affected []schema.Affected
+
+	for componentName, compSection := range pulumiSection {
+		componentSection, ok := compSection.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		metadataSection, hasMetadata := componentSection[sectionNameMetadata].(map[string]any)
+		if hasMetadata {
+			if shouldSkipComponent(metadataSection, componentName, excludeLocked) {
+				continue
+			}
+
+			if !isEqual(remoteStacks, stackName, cfg.PulumiComponentType, componentName, metadataSection, sectionNameMetadata) {
+				err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.PulumiComponentType,
+					&componentSection, affectedReasonStackMetadata, false, nil, includeSettings)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// Resolve the component folder for path matching.
+		component := GetComponentFolder(&componentSection, componentName)
+
+		changed, err := isComponentFolderChangedIndexed(component, cfg.PulumiComponentType, atmosConfig, filesIndex, patternCache)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.PulumiComponentType,
+				&componentSection, affectedReasonComponent, false, nil, includeSettings)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if varSection, ok := componentSection[sectionNameVars].(map[string]any); ok {
+			if !isEqual(remoteStacks, stackName, cfg.PulumiComponentType, componentName, varSection, sectionNameVars) {
+				err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.PulumiComponentType,
+					&componentSection, affectedReasonStackVars, false, nil, includeSettings)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if envSection, ok := componentSection[sectionNameEnv].(map[string]any); ok {
+			if !isEqual(remoteStacks, stackName, cfg.PulumiComponentType, componentName, envSection, sectionNameEnv) {
+				err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.PulumiComponentType,
+					&componentSection, affectedReasonStackEnv, false, nil, includeSettings)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// Check backend section for changes (the Pulumi state backend).
+		if backendSection, ok := componentSection[sectionNameBackend].(map[string]any); ok {
+			if !isEqual(remoteStacks, stackName, cfg.PulumiComponentType, componentName, backendSection, sectionNameBackend) {
+				err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.PulumiComponentType,
+					&componentSection, affectedReasonStackBackend, false, nil, includeSettings)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if settingsSection, ok := componentSection[cfg.SettingsSectionName].(map[string]any); ok {
+			err := checkSettingsAndDependenciesIndexed(
+				&affected, atmosConfig, componentName, stackName, cfg.PulumiComponentType,
+				&componentSection, settingsSection, remoteStacks, currentStacks, filesIndex,
+				includeSpaceliftAdminStacks, includeSettings,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return affected, nil
+}
+
+// processKustomizeComponentsIndexed processes Kustomize components using the files index.
+//
+//nolint:dupl,funlen // Similar structure to processPackerComponentsIndexed but for different component type
+func processKustomizeComponentsIndexed(
+	stackName string,
+	kustomizeSection map[string]any,
+	remoteStacks *map[string]any,
+	currentStacks *map[string]any,
+	atmosConfig *schema.AtmosConfiguration,
+	filesIndex *changedFilesIndex,
+	patternCache *componentPathPatternCache,
+	includeSpaceliftAdminStacks bool,
+	includeSettings bool,
+	excludeLocked bool,
+) ([]schema.Affected, error) {
+	var affected []schema.Affected
+
+	for componentName, compSection := range kustomizeSection {
+		componentSection, ok := compSection.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		metadataSection, hasMetadata := componentSection[sectionNameMetadata].(map[string]any)
+		if hasMetadata {
+			if shouldSkipComponent(metadataSection, componentName, excludeLocked) {
+				continue
+			}
+
+			if !isEqual(remoteStacks, stackName, cfg.KustomizeComponentType, componentName, metadataSection, sectionNameMetadata) {
+				err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.KustomizeComponentType,
+					&componentSection, affectedReasonStackMetadata, false, nil, includeSettings)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		// Resolve the component folder for path matching.
+		component := GetComponentFolder(&componentSection, componentName)
+
+		changed, err := isComponentFolderChangedIndexed(component, cfg.KustomizeComponentType, atmosConfig, filesIndex, patternCache)
+		if err != nil {
+			return nil, err
+		}
+		if changed {
+			err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.KustomizeComponentType,
+				&componentSection, affectedReasonComponent, false, nil, includeSettings)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if varSection, ok := componentSection[sectionNameVars].(map[string]any); ok {
+			if !isEqual(remoteStacks, stackName, cfg.KustomizeComponentType, componentName, varSection, sectionNameVars) {
+				err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.KustomizeComponentType,
+					&componentSection, affectedReasonStackVars, false, nil, includeSettings)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if envSection, ok := componentSection[sectionNameEnv].(map[string]any); ok {
+			if !isEqual(remoteStacks, stackName, cfg.KustomizeComponentType, componentName, envSection, sectionNameEnv) {
+				err := addAffectedComponent(&affected, atmosConfig, componentName, stackName, cfg.KustomizeComponentType,
+					&componentSection, affectedReasonStackEnv, false, nil, includeSettings)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if settingsSection, ok := componentSection[cfg.SettingsSectionName].(map[string]any); ok {
+			err := checkSettingsAndDependenciesIndexed(
+				&affected, atmosConfig, componentName, stackName, cfg.KustomizeComponentType,
+				&componentSection, settingsSection, remoteStacks, currentStacks, filesIndex,
+				includeSpaceliftAdminStacks, includeSettings,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return affected, nil
+}
+
 // checkSettingsAndDependenciesIndexed checks settings using indexed files.
 func checkSettingsAndDependenciesIndexed(
 	affected *[]schema.Affected,
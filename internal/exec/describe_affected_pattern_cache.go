@@ -58,6 +58,10 @@ func (c *componentPathPatternCache) getComponentPathPattern(
 		componentPath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Helmfile.BasePath, component)
 	case cfg.PackerComponentType:
 		componentPath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Packer.BasePath, component)
+	case cfg.PulumiComponentType:
+		componentPath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Pulumi.BasePath, component)
+	case cfg.KustomizeComponentType:
+		componentPath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Kustomize.BasePath, component)
 	default:
 		// Unknown component type - return pattern without caching.
 		return "", fmt.Errorf("%w: %s", errUtils.ErrUnsupportedComponentType, componentType)
@@ -274,6 +274,10 @@ func isComponentFolderChanged(
 		componentPath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Helmfile.BasePath, component)
 	case cfg.PackerComponentType:
 		componentPath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Packer.BasePath, component)
+	case cfg.PulumiComponentType:
+		componentPath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Pulumi.BasePath, component)
+	case cfg.KustomizeComponentType:
+		componentPath = filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Kustomize.BasePath, component)
 	default:
 		return false, fmt.Errorf("%w: %s", errUtils.ErrUnsupportedComponentType, componentType)
 	}
@@ -197,5 +197,45 @@ func processStackAffected(
 		affected = append(affected, packerAffected...)
 	}
 
+	// Process Pulumi components.
+	if pulumiSection, ok := componentsSection[cfg.PulumiComponentType].(map[string]any); ok {
+		pulumiAffected, err := processPulumiComponentsIndexed(
+			stackName,
+			pulumiSection,
+			remoteStacks,
+			currentStacks,
+			atmosConfig,
+			filesIndex,
+			patternCache,
+			includeSpaceliftAdminStacks,
+			includeSettings,
+			excludeLocked,
+		)
+		if err != nil {
+			return nil, err
+		}
+		affected = append(affected, pulumiAffected...)
+	}
+
+	// Process Kustomize components.
+	if kustomizeSection, ok := componentsSection[cfg.KustomizeComponentType].(map[string]any); ok {
+		kustomizeAffected, err := processKustomizeComponentsIndexed(
+			stackName,
+			kustomizeSection,
+			remoteStacks,
+			currentStacks,
+			atmosConfig,
+			filesIndex,
+			patternCache,
+			includeSpaceliftAdminStacks,
+			includeSettings,
+			excludeLocked,
+		)
+		if err != nil {
+			return nil, err
+		}
+		affected = append(affected, kustomizeAffected...)
+	}
+
 	return affected, nil
 }
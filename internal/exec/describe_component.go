@@ -237,6 +237,33 @@ func ExecuteDescribeComponent(params *ExecuteDescribeComponentParams) (map[strin
 	return result.ComponentSection, nil
 }
 
+// DescribeComponentEnv returns the component `env` section with templates and YAML functions evaluated.
+// It authenticates with the component's identity the same way component commands do, so functions
+// like `!store` and `!terraform.state` resolve to the values the component runs with.
+func DescribeComponentEnv(atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStacksInfo) (map[string]any, error) {
+	defer perf.Track(atmosConfig, "exec.DescribeComponentEnv")()
+
+	authManager, err := createAndAuthenticateAuthManager(atmosConfig, info)
+	if err != nil {
+		return nil, err
+	}
+
+	sections, err := ExecuteDescribeComponent(&ExecuteDescribeComponentParams{
+		AtmosConfig:          atmosConfig,
+		Component:            info.ComponentFromArg,
+		Stack:                info.Stack,
+		ProcessTemplates:     true,
+		ProcessYamlFunctions: true,
+		AuthManager:          authManager,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	env, _ := sections["env"].(map[string]any)
+	return env, nil
+}
+
 // writeOutputToFile writes output to a file if specified.
 func writeOutputToFile(file string, output string) error {
 	const filePermissions = 0o600
@@ -409,7 +436,7 @@ func tryProcessWithComponentType(params *componentTypeProcessParams) (schema.Con
 	return result, err
 }
 
-// detectComponentType tries to detect component type (Terraform, Helmfile, Packer, or Ansible).
+// detectComponentType tries to detect component type (Terraform, Helmfile, Packer, Ansible, Pulumi, or Kustomize).
 func detectComponentType(
 	atmosConfig *schema.AtmosConfiguration,
 	configAndStacksInfo *schema.ConfigAndStacksInfo,
@@ -461,8 +488,30 @@ func detectComponentType(
 				baseParams.componentType = cfg.AnsibleComponentType
 				result, err = tryProcessWithComponentType(&baseParams)
 				if err != nil {
-					result.ComponentSection[cfg.ComponentTypeSectionName] = ""
-					return result, err
+					// Same check for Ansible errors.
+					if !errors.Is(err, errUtils.ErrInvalidComponent) {
+						return result, err
+					}
+
+					// Try Pulumi.
+					baseParams.configAndStacksInfo = result
+					baseParams.componentType = cfg.PulumiComponentType
+					result, err = tryProcessWithComponentType(&baseParams)
+					if err != nil {
+						// Same check for Pulumi errors.
+						if !errors.Is(err, errUtils.ErrInvalidComponent) {
+							return result, err
+						}
+
+						// Try Kustomize.
+						baseParams.configAndStacksInfo = result
+						baseParams.componentType = cfg.KustomizeComponentType
+						result, err = tryProcessWithComponentType(&baseParams)
+						if err != nil {
+							result.ComponentSection[cfg.ComponentTypeSectionName] = ""
+							return result, err
+						}
+					}
 				}
 			}
 		}
@@ -593,5 +593,18 @@ func findComponentSectionInCachedStacks(stacks map[string]any, stackName, compon
 			return comp
 		}
 	}
+	// Check pulumi components.
+	if pulumiSection, ok := componentsSection["pulumi"].(map[string]any); ok {
+		if comp, ok := pulumiSection[componentName].(map[string]any); ok {
+			return comp
+		}
+	}
+
+	// Check kustomize components.
+	if kustomizeSection, ok := componentsSection["kustomize"].(map[string]any); ok {
+		if comp, ok := kustomizeSection[componentName].(map[string]any); ok {
+			return comp
+		}
+	}
 	return nil
 }
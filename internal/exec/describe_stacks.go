@@ -178,6 +178,10 @@ func getComponentBasePath(atmosConfig *schema.AtmosConfiguration, componentKind
 		return atmosConfig.Components.Packer.BasePath
 	case cfg.AnsibleSectionName:
 		return atmosConfig.Components.Ansible.BasePath
+	case cfg.PulumiSectionName:
+		return atmosConfig.Components.Pulumi.BasePath
+	case cfg.KustomizeSectionName:
+		return atmosConfig.Components.Kustomize.BasePath
 	default:
 		return ""
 	}
@@ -139,6 +139,8 @@ func (p *describeStacksProcessor) processStackFile(stackFileName string, stackMa
 		{cfg.HelmfileSectionName, processComponentTypeOpts{}},
 		{cfg.PackerSectionName, processComponentTypeOpts{}},
 		{cfg.AnsibleSectionName, processComponentTypeOpts{}},
+		{cfg.PulumiSectionName, processComponentTypeOpts{}},
+		{cfg.KustomizeSectionName, processComponentTypeOpts{}},
 	}
 
 	for _, te := range typeEntries {
@@ -718,8 +720,8 @@ func applyTerraformMetadataInheritance(
 }
 
 // hasStackExplicitComponents reports whether a stack section contains any component
-// entries under components.terraform, components.helmfile, components.packer, or
-// components.ansible.
+// entries under components.terraform, components.helmfile, components.packer,
+// components.ansible, components.pulumi, or components.kustomize.
 func hasStackExplicitComponents(stackSection map[string]any) bool {
 	componentsSection, ok := stackSection[cfg.ComponentsSectionName]
 	if !ok || componentsSection == nil {
@@ -734,6 +736,8 @@ func hasStackExplicitComponents(stackSection map[string]any) bool {
 		cfg.HelmfileSectionName,
 		cfg.PackerSectionName,
 		cfg.AnsibleSectionName,
+		cfg.PulumiSectionName,
+		cfg.KustomizeSectionName,
 	} {
 		if typeMap, ok := comps[typeName].(map[string]any); ok && len(typeMap) > 0 {
 			return true
@@ -44,7 +44,8 @@ func ExecuteHelmfileCmd(cmd *cobra.Command, args []string, additionalArgsAndFlag
 }
 
 // ExecuteHelmfile executes helmfile commands.
-func ExecuteHelmfile(info schema.ConfigAndStacksInfo) error {
+// Optional shell command options are applied to the helmfile process (e.g., to capture its output for CI).
+func ExecuteHelmfile(info schema.ConfigAndStacksInfo, opts ...ShellCommandOption) error {
 	defer perf.Track(nil, "exec.ExecuteHelmfile")()
 
 	atmosConfig, err := cfg.InitCliConfig(info, true)
@@ -403,7 +404,7 @@ func ExecuteHelmfile(info schema.ConfigAndStacksInfo) error {
 		envVars,
 		info.DryRun,
 		info.RedirectStdErr,
-		WithEnvironment(info.SanitizedEnv),
+		append([]ShellCommandOption{WithEnvironment(info.SanitizedEnv)}, opts...)...,
 	)
 	if err != nil {
 		return err
@@ -34,11 +34,22 @@ func processOciImage(atmosConfig *schema.AtmosConfiguration, imageName string, d
 	return processOciImageWithFS(atmosConfig, imageName, destDir, defaultOCIFileSystem)
 }
 
+// processOciImageWithDigest processes an OCI image like processOciImage and returns the digest of the pulled manifest.
+func processOciImageWithDigest(atmosConfig *schema.AtmosConfiguration, imageName string, destDir string) (string, error) {
+	return extractOciImage(atmosConfig, imageName, destDir, defaultOCIFileSystem)
+}
+
 // processOciImageWithFS processes an OCI image using a FileSystem implementation.
 func processOciImageWithFS(atmosConfig *schema.AtmosConfiguration, imageName string, destDir string, fs filesystem.FileSystem) error {
+	_, err := extractOciImage(atmosConfig, imageName, destDir, fs)
+	return err
+}
+
+// extractOciImage pulls an OCI image, extracts its layers to destDir, and returns the manifest digest.
+func extractOciImage(atmosConfig *schema.AtmosConfiguration, imageName string, destDir string, fs filesystem.FileSystem) (string, error) {
 	tempDir, err := fs.MkdirTemp("", uuid.New().String())
 	if err != nil {
-		return errors.Join(errUtils.ErrCreateTempDirectory, err)
+		return "", errors.Join(errUtils.ErrCreateTempDirectory, err)
 	}
 	defer func() {
 		if err := fs.RemoveAll(tempDir); err != nil {
@@ -49,18 +60,18 @@ func processOciImageWithFS(atmosConfig *schema.AtmosConfiguration, imageName str
 	ref, err := name.ParseReference(imageName)
 	if err != nil {
 		log.Error("Failed to parse OCI image reference", "image", imageName, "error", err)
-		return errors.Join(errUtils.ErrInvalidImageReference, err)
+		return "", errors.Join(errUtils.ErrInvalidImageReference, err)
 	}
 
 	descriptor, err := pullImage(atmosConfig, ref)
 	if err != nil {
-		return errors.Join(errUtils.ErrPullImage, err)
+		return "", errors.Join(errUtils.ErrPullImage, err)
 	}
 
 	img, err := descriptor.Image()
 	if err != nil {
 		log.Error("Failed to get image descriptor", "image", imageName, "error", err)
-		return fmt.Errorf("%w '%s': %s", errUtils.ErrGetImageDescriptor, imageName, err)
+		return "", fmt.Errorf("%w '%s': %s", errUtils.ErrGetImageDescriptor, imageName, err)
 	}
 
 	checkArtifactType(descriptor, imageName)
@@ -68,21 +79,21 @@ func processOciImageWithFS(atmosConfig *schema.AtmosConfiguration, imageName str
 	layers, err := img.Layers()
 	if err != nil {
 		log.Error("Failed to retrieve layers from OCI image", "image", imageName, "error", err)
-		return errors.Join(errUtils.ErrGetImageLayers, err)
+		return "", errors.Join(errUtils.ErrGetImageLayers, err)
 	}
 
 	if len(layers) == 0 {
 		log.Warn("OCI image has no layers", "image", imageName)
-		return ErrNoLayers
+		return "", ErrNoLayers
 	}
 
 	for i, layer := range layers {
 		if err := processLayer(layer, i, destDir); err != nil {
-			return fmt.Errorf("%w %d: %s", errUtils.ErrProcessLayer, i, err)
+			return "", fmt.Errorf("%w %d: %s", errUtils.ErrProcessLayer, i, err)
 		}
 	}
 
-	return nil
+	return descriptor.Digest.String(), nil
 }
 
 // pullImage pulls an OCI image from the specified reference and returns its descriptor.
@@ -91,6 +102,35 @@ func processOciImageWithFS(atmosConfig *schema.AtmosConfiguration, imageName str
 // 2. ATMOS_GITHUB_TOKEN or GITHUB_TOKEN environment variables (for ghcr.io only)
 // 3. Anonymous authentication - fallback.
 func pullImage(atmosConfig *schema.AtmosConfiguration, ref name.Reference) (*remote.Descriptor, error) {
+	registry := ref.Context().Registry.Name()
+	authMethod, authSource := resolveOCIAuth(atmosConfig, ref)
+
+	descriptor, err := remote.Get(ref, remote.WithAuth(authMethod))
+	if err != nil {
+		log.Error("Failed to pull OCI image", "image", ref.Name(), "registry", registry, "auth", authSource, "error", err)
+		return nil, fmt.Errorf("failed to pull image '%s': %w", ref.Name(), err)
+	}
+
+	return descriptor, nil
+}
+
+// listOciTags lists the tags of the repository of an OCI image reference, using the same authentication as pullImage.
+func listOciTags(atmosConfig *schema.AtmosConfiguration, imageName string) ([]string, error) {
+	ref, err := name.ParseReference(imageName)
+	if err != nil {
+		return nil, errors.Join(errUtils.ErrInvalidImageReference, err)
+	}
+
+	authMethod, _ := resolveOCIAuth(atmosConfig, ref)
+	tags, err := remote.List(ref.Context(), remote.WithAuth(authMethod))
+	if err != nil {
+		return nil, fmt.Errorf("%w '%s': %w", errUtils.ErrListOCITags, ref.Context().Name(), err)
+	}
+	return tags, nil
+}
+
+// resolveOCIAuth returns the authenticator for a registry and a description of its source, see pullImage.
+func resolveOCIAuth(atmosConfig *schema.AtmosConfiguration, ref name.Reference) (authn.Authenticator, string) {
 	var authMethod authn.Authenticator
 	var authSource string
 
@@ -119,14 +159,7 @@ func pullImage(atmosConfig *schema.AtmosConfiguration, ref name.Reference) (*rem
 	}
 
 	log.Debug("Authenticating to OCI registry", "registry", registry, "method", authSource)
-
-	descriptor, err := remote.Get(ref, remote.WithAuth(authMethod))
-	if err != nil {
-		log.Error("Failed to pull OCI image", "image", ref.Name(), "registry", registry, "auth", authSource, "error", err)
-		return nil, fmt.Errorf("failed to pull image '%s': %w", ref.Name(), err)
-	}
-
-	return descriptor, nil
+	return authMethod, authSource
 }
 
 // getGHCRAuth returns authentication credentials for GitHub Container Registry (ghcr.io).
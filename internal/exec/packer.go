@@ -37,9 +37,11 @@ type PackerFlags struct {
 }
 
 // ExecutePacker executes Packer commands.
+// Optional shell command options are applied to the packer process (e.g., to capture its output for CI).
 func ExecutePacker(
 	info *schema.ConfigAndStacksInfo,
 	packerFlags *PackerFlags,
+	opts ...ShellCommandOption,
 ) error {
 	defer perf.Track(nil, "exec.ExecutePacker")()
 
@@ -289,6 +291,6 @@ func ExecutePacker(
 		envVars,
 		info.DryRun,
 		info.RedirectStdErr,
-		WithEnvironment(info.SanitizedEnv),
+		append([]ShellCommandOption{WithEnvironment(info.SanitizedEnv)}, opts...)...,
 	)
 }
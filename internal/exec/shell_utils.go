@@ -11,6 +11,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/charmbracelet/lipgloss"
@@ -41,6 +42,7 @@ type shellCommandConfig struct {
 	stdoutCapture  io.Writer
 	stderrCapture  io.Writer
 	stdoutOverride io.Writer
+	stderrOverride io.Writer
 	// processEnv replaces os.Environ() as the process environment.
 	// When set, ExecuteShellCommand uses this instead of re-reading os.Environ().
 	// This is used when auth has already sanitized the environment (e.g., removed IRSA vars).
@@ -72,6 +74,44 @@ func WithStdoutOverride(w io.Writer) ShellCommandOption {
 	}
 }
 
+// WithStderrOverride returns a ShellCommandOption that replaces the default stderr
+// (os.Stderr) with a different writer. Used to prefix the output of components that
+// run concurrently so their lines stay attributable.
+func WithStderrOverride(w io.Writer) ShellCommandOption {
+	return func(c *shellCommandConfig) {
+		c.stderrOverride = w
+	}
+}
+
+// WithOutputCapture returns a ShellCommandOption that tees both stdout and stderr to the provided writer.
+// Writes from the two streams are serialized, so a plain buffer can be used.
+// A nil writer disables capturing, which lets callers pass an optional writer through unconditionally.
+func WithOutputCapture(w io.Writer) ShellCommandOption {
+	defer perf.Track(nil, "exec.WithOutputCapture")()
+
+	return func(c *shellCommandConfig) {
+		if w == nil {
+			return
+		}
+		locked := &lockedWriter{w: w}
+		c.stdoutCapture = locked
+		c.stderrCapture = locked
+	}
+}
+
+// lockedWriter serializes writes to an underlying writer shared by several streams.
+type lockedWriter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// Write writes p to the underlying writer while holding the lock.
+func (l *lockedWriter) Write(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.w.Write(p)
+}
+
 // WithEnvironment provides a pre-sanitized process environment for subprocess execution.
 // When provided, ExecuteShellCommand uses this instead of re-reading os.Environ().
 // Pass nil to fall back to the default os.Environ() behavior.
@@ -150,8 +190,13 @@ func ExecuteShellCommand(
 		redirectStdError = "NUL"
 	}
 
+	var stderrTarget io.Writer = os.Stderr
+	if cfg.stderrOverride != nil {
+		stderrTarget = cfg.stderrOverride
+	}
+
 	if redirectStdError == "/dev/stderr" {
-		maskedStderr := ioLayer.MaskWriter(os.Stderr)
+		maskedStderr := ioLayer.MaskWriter(stderrTarget)
 		if cfg.stderrCapture != nil {
 			cmd.Stderr = io.MultiWriter(maskedStderr, cfg.stderrCapture)
 		} else {
@@ -165,7 +210,7 @@ func ExecuteShellCommand(
 			cmd.Stderr = maskedStderr
 		}
 	} else if redirectStdError == "" {
-		maskedStderr := ioLayer.MaskWriter(os.Stderr)
+		maskedStderr := ioLayer.MaskWriter(stderrTarget)
 		if cfg.stderrCapture != nil {
 			cmd.Stderr = io.MultiWriter(maskedStderr, cfg.stderrCapture)
 		} else {
@@ -213,12 +258,14 @@ func ExecuteShellCommand(
 }
 
 // ExecuteShell runs a shell script.
+// Only the WithStdoutCapture, WithStderrCapture and WithStdoutOverride options are supported.
 func ExecuteShell(
 	command string,
 	name string,
 	dir string,
 	envVars []string,
 	dryRun bool,
+	opts ...ShellCommandOption,
 ) error {
 	defer perf.Track(nil, "exec.ExecuteShell")()
 
@@ -245,7 +292,29 @@ func ExecuteShell(
 		return nil
 	}
 
-	return u.ShellRunner(command, name, dir, mergedEnv, ioLayer.MaskWriter(os.Stdout))
+	cfg := &shellCommandConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	var stdoutTarget io.Writer = os.Stdout
+	if cfg.stdoutOverride != nil {
+		stdoutTarget = cfg.stdoutOverride
+	}
+	var out io.Writer = ioLayer.MaskWriter(stdoutTarget)
+	if cfg.stdoutCapture != nil {
+		out = io.MultiWriter(out, cfg.stdoutCapture)
+	}
+
+	var errOut io.Writer = os.Stderr
+	if cfg.stderrOverride != nil {
+		errOut = cfg.stderrOverride
+	}
+	if cfg.stderrCapture != nil {
+		errOut = io.MultiWriter(errOut, cfg.stderrCapture)
+	}
+
+	return u.ShellRunnerWithStderr(command, name, dir, mergedEnv, out, errOut)
 }
 
 // parseEnvVarKey extracts the key from an environment variable string (KEY=value).
@@ -10,6 +10,9 @@ import (
 	"github.com/cloudposse/atmos/pkg/schema"
 )
 
+// kustomizeDefaultCommand is the executable for Kustomize components when no command is configured.
+const kustomizeDefaultCommand = "kubectl"
+
 // mergeComponentConfigurations merges component configurations (vars, settings, env, etc.).
 //
 //nolint:gocognit,nestif,revive,cyclop,funlen // Complex configuration merging logic with multiple component types.
@@ -110,26 +113,22 @@ func mergeComponentConfigurations(atmosConfig *schema.AtmosConfiguration, opts *
 		}
 	}
 
-	// Terraform-specific: merge hooks using deferred merge.
-	var finalComponentHooks map[string]any
-	if opts.ComponentType == cfg.TerraformComponentType {
-		var hooksCtx *m.DeferredMergeContext
-		finalComponentHooks, hooksCtx, err = m.MergeWithDeferred(
-			atmosConfig,
-			[]map[string]any{
-				opts.GlobalAndTerraformHooks,
-				result.BaseComponentHooks,
-				result.ComponentHooks,
-				result.ComponentOverridesHooks,
-			})
-		if err != nil {
-			return nil, err
-		}
+	// Merge hooks using deferred merge.
+	finalComponentHooks, hooksCtx, err := m.MergeWithDeferred(
+		atmosConfig,
+		[]map[string]any{
+			opts.GlobalHooks,
+			result.BaseComponentHooks,
+			result.ComponentHooks,
+			result.ComponentOverridesHooks,
+		})
+	if err != nil {
+		return nil, err
+	}
 
-		// Apply deferred merges for hooks (without YAML processing - already done earlier).
-		if err := m.ApplyDeferredMerges(hooksCtx, finalComponentHooks, atmosConfig, nil); err != nil {
-			return nil, err
-		}
+	// Apply deferred merges for hooks (without YAML processing - already done earlier).
+	if err := m.ApplyDeferredMerges(hooksCtx, finalComponentHooks, atmosConfig, nil); err != nil {
+		return nil, err
 	}
 
 	// Terraform-specific: merge generate section using deferred merge.
@@ -173,6 +172,16 @@ func mergeComponentConfigurations(atmosConfig *schema.AtmosConfiguration, opts *
 	if opts.ComponentType == cfg.HelmfileComponentType && opts.AtmosConfig.Components.Helmfile.Command != "" {
 		finalComponentCommand = opts.AtmosConfig.Components.Helmfile.Command
 	}
+	if opts.ComponentType == cfg.PulumiComponentType && opts.AtmosConfig.Components.Pulumi.Command != "" {
+		finalComponentCommand = opts.AtmosConfig.Components.Pulumi.Command
+	}
+	if opts.ComponentType == cfg.KustomizeComponentType {
+		// Kustomize components are built, diffed and applied with kubectl.
+		finalComponentCommand = kustomizeDefaultCommand
+		if opts.AtmosConfig.Components.Kustomize.Command != "" {
+			finalComponentCommand = opts.AtmosConfig.Components.Kustomize.Command
+		}
+	}
 	if opts.GlobalCommand != "" {
 		finalComponentCommand = opts.GlobalCommand
 	}
@@ -264,6 +273,26 @@ func mergeComponentConfigurations(atmosConfig *schema.AtmosConfiguration, opts *
 		comp[cfg.LocalsSectionName] = finalComponentLocals
 	}
 
+	// Add hooks if present (Terraform components always include the hooks section below).
+	if len(finalComponentHooks) > 0 {
+		comp[cfg.HooksSectionName] = finalComponentHooks
+	}
+
+	// Pulumi: merge the backend from global, base component, and component levels.
+	if opts.ComponentType == cfg.PulumiComponentType {
+		finalComponentBackend, err := m.Merge(
+			atmosConfig,
+			[]map[string]any{
+				opts.GlobalBackendSection,
+				result.BaseComponentBackendSection,
+				result.ComponentBackendSection,
+			})
+		if err != nil {
+			return nil, err
+		}
+		comp[cfg.BackendSectionName] = finalComponentBackend
+	}
+
 	// Terraform-specific: process backends and add Terraform-specific fields.
 	if opts.ComponentType == cfg.TerraformComponentType {
 		// Process backend configuration.
@@ -51,7 +51,7 @@ func TestMergeComponentConfigurations(t *testing.T) {
 						"region": "us-east-1",
 					},
 				},
-				GlobalAndTerraformHooks: map[string]any{
+				GlobalHooks: map[string]any{
 					"before": []string{"global hook"},
 				},
 				GlobalBackendType: "s3",
@@ -139,6 +139,9 @@ func TestMergeComponentConfigurations(t *testing.T) {
 				},
 				GlobalEnv:  map[string]any{},
 				GlobalAuth: map[string]any{},
+				GlobalHooks: map[string]any{
+					"notify": map[string]any{"command": "http", "url": "https://example.com"},
+				},
 				AtmosConfig: &schema.AtmosConfiguration{
 					Components: schema.Components{
 						Helmfile: schema.Helmfile{
@@ -163,10 +166,16 @@ func TestMergeComponentConfigurations(t *testing.T) {
 				BaseComponentSettings:      map[string]any{},
 				BaseComponentEnv:           map[string]any{},
 				BaseComponentAuth:          map[string]any{},
+				ComponentHooks: map[string]any{
+					"notify": map[string]any{"events": []any{"after-helmfile-sync"}},
+				},
 			},
 			expectedVars: map[string]any{
 				"namespace": "default",
 			},
+			expectedHooks: map[string]any{
+				"notify": map[string]any{"command": "http", "url": "https://example.com", "events": []any{"after-helmfile-sync"}},
+			},
 			expectedCommand: "helmfile",
 		},
 		{
@@ -259,9 +268,9 @@ func TestMergeComponentConfigurations(t *testing.T) {
 						"bucket": "test",
 					},
 				},
-				TerraformProviders:      map[string]any{},
-				GlobalAndTerraformHooks: map[string]any{},
-				AtmosConfig:             &schema.AtmosConfiguration{},
+				TerraformProviders: map[string]any{},
+				GlobalHooks:        map[string]any{},
+				AtmosConfig:        &schema.AtmosConfiguration{},
 			},
 			result: &ComponentProcessorResult{
 				ComponentVars: map[string]any{},
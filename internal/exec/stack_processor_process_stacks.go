@@ -67,6 +67,8 @@ func ProcessStackConfig(
 	globalHelmfileSection := map[string]any{}
 	globalPackerSection := map[string]any{}
 	globalAnsibleSection := map[string]any{}
+	globalPulumiSection := map[string]any{}
+	globalKustomizeSection := map[string]any{}
 	globalComponentsSection := map[string]any{}
 	globalAuthSection := map[string]any{}
 
@@ -101,10 +103,27 @@ func ProcessStackConfig(
 	ansibleAuth := map[string]any{}
 	ansibleDependencies := map[string]any{}
 
+	pulumiVars := map[string]any{}
+	pulumiSettings := map[string]any{}
+	pulumiEnv := map[string]any{}
+	pulumiCommand := ""
+	pulumiAuth := map[string]any{}
+	pulumiDependencies := map[string]any{}
+	pulumiBackend := map[string]any{}
+
+	kustomizeVars := map[string]any{}
+	kustomizeSettings := map[string]any{}
+	kustomizeEnv := map[string]any{}
+	kustomizeCommand := ""
+	kustomizeAuth := map[string]any{}
+	kustomizeDependencies := map[string]any{}
+
 	terraformComponents := map[string]any{}
 	helmfileComponents := map[string]any{}
 	packerComponents := map[string]any{}
 	ansibleComponents := map[string]any{}
+	pulumiComponents := map[string]any{}
+	kustomizeComponents := map[string]any{}
 	allComponents := map[string]any{}
 
 	// Global sections.
@@ -171,6 +190,20 @@ func ProcessStackConfig(
 		}
 	}
 
+	if i, ok := config[cfg.PulumiSectionName]; ok {
+		globalPulumiSection, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiSection, stackName)
+		}
+	}
+
+	if i, ok := config[cfg.KustomizeSectionName]; ok {
+		globalKustomizeSection, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidKustomizeSection, stackName)
+		}
+	}
+
 	if i, ok := config[cfg.ComponentsSectionName]; ok {
 		globalComponentsSection, ok = i.(map[string]any)
 		if !ok {
@@ -373,6 +406,19 @@ func ProcessStackConfig(
 		return nil, err
 	}
 
+	helmfileHooks := map[string]any{}
+	if i, ok := globalHelmfileSection[cfg.HooksSectionName]; ok {
+		helmfileHooks, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidHelmfileHooks, stackName)
+		}
+	}
+
+	globalAndHelmfileHooks, err := m.Merge(atmosConfig, []map[string]any{globalHooksSection, helmfileHooks})
+	if err != nil {
+		return nil, err
+	}
+
 	if i, ok := globalHelmfileSection[cfg.SettingsSectionName]; ok {
 		helmfileSettings, ok = i.(map[string]any)
 		if !ok {
@@ -443,6 +489,19 @@ func ProcessStackConfig(
 		return nil, err
 	}
 
+	packerHooks := map[string]any{}
+	if i, ok := globalPackerSection[cfg.HooksSectionName]; ok {
+		packerHooks, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPackerHooks, stackName)
+		}
+	}
+
+	globalAndPackerHooks, err := m.Merge(atmosConfig, []map[string]any{globalHooksSection, packerHooks})
+	if err != nil {
+		return nil, err
+	}
+
 	if i, ok := globalPackerSection[cfg.SettingsSectionName]; ok {
 		packerSettings, ok = i.(map[string]any)
 		if !ok {
@@ -513,6 +572,19 @@ func ProcessStackConfig(
 		return nil, err
 	}
 
+	ansibleHooks := map[string]any{}
+	if i, ok := globalAnsibleSection[cfg.HooksSectionName]; ok {
+		ansibleHooks, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidAnsibleHooks, stackName)
+		}
+	}
+
+	globalAndAnsibleHooks, err := m.Merge(atmosConfig, []map[string]any{globalHooksSection, ansibleHooks})
+	if err != nil {
+		return nil, err
+	}
+
 	if i, ok := globalAnsibleSection[cfg.SettingsSectionName]; ok {
 		ansibleSettings, ok = i.(map[string]any)
 		if !ok {
@@ -563,6 +635,178 @@ func ProcessStackConfig(
 		return nil, err
 	}
 
+	// Pulumi section.
+	if i, ok := globalPulumiSection[cfg.CommandSectionName]; ok {
+		pulumiCommand, ok = i.(string)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiCommand, stackName)
+		}
+	}
+
+	if i, ok := globalPulumiSection[cfg.VarsSectionName]; ok {
+		pulumiVars, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiVars, stackName)
+		}
+	}
+
+	globalAndPulumiVars, err := m.Merge(atmosConfig, []map[string]any{globalVarsSection, pulumiVars})
+	if err != nil {
+		return nil, err
+	}
+
+	pulumiHooks := map[string]any{}
+	if i, ok := globalPulumiSection[cfg.HooksSectionName]; ok {
+		pulumiHooks, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiHooks, stackName)
+		}
+	}
+
+	globalAndPulumiHooks, err := m.Merge(atmosConfig, []map[string]any{globalHooksSection, pulumiHooks})
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := globalPulumiSection[cfg.SettingsSectionName]; ok {
+		pulumiSettings, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiSettings, stackName)
+		}
+	}
+
+	globalAndPulumiSettings, err := m.Merge(atmosConfig, []map[string]any{globalSettingsSection, pulumiSettings})
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := globalPulumiSection[cfg.EnvSectionName]; ok {
+		pulumiEnv, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiEnv, stackName)
+		}
+	}
+
+	// Include atmos.yaml global env as lowest priority in the merge chain.
+	globalAndPulumiEnv, err := m.Merge(atmosConfig, []map[string]any{atmosConfigEnv, globalEnvSection, pulumiEnv})
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := globalPulumiSection[cfg.AuthSectionName]; ok {
+		pulumiAuth, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiAuth, stackName)
+		}
+	}
+
+	globalAndPulumiAuth, err := m.Merge(atmosConfig, []map[string]any{globalAuthSection, pulumiAuth})
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := globalPulumiSection[cfg.DependenciesSectionName]; ok {
+		pulumiDependencies, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiDependencies, stackName)
+		}
+	}
+
+	globalAndPulumiDependencies, err := m.Merge(atmosConfig, []map[string]any{globalDependenciesSection, pulumiDependencies})
+	if err != nil {
+		return nil, err
+	}
+
+	// Pulumi backend section (the state backend URL).
+	if i, ok := globalPulumiSection[cfg.BackendSectionName]; ok {
+		pulumiBackend, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidPulumiBackend, stackName)
+		}
+	}
+
+	// Kustomize section.
+	if i, ok := globalKustomizeSection[cfg.CommandSectionName]; ok {
+		kustomizeCommand, ok = i.(string)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidKustomizeCommand, stackName)
+		}
+	}
+
+	if i, ok := globalKustomizeSection[cfg.VarsSectionName]; ok {
+		kustomizeVars, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidKustomizeVars, stackName)
+		}
+	}
+
+	globalAndKustomizeVars, err := m.Merge(atmosConfig, []map[string]any{globalVarsSection, kustomizeVars})
+	if err != nil {
+		return nil, err
+	}
+
+	kustomizeHooks := map[string]any{}
+	if i, ok := globalKustomizeSection[cfg.HooksSectionName]; ok {
+		kustomizeHooks, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidKustomizeHooks, stackName)
+		}
+	}
+
+	globalAndKustomizeHooks, err := m.Merge(atmosConfig, []map[string]any{globalHooksSection, kustomizeHooks})
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := globalKustomizeSection[cfg.SettingsSectionName]; ok {
+		kustomizeSettings, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidKustomizeSettings, stackName)
+		}
+	}
+
+	globalAndKustomizeSettings, err := m.Merge(atmosConfig, []map[string]any{globalSettingsSection, kustomizeSettings})
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := globalKustomizeSection[cfg.EnvSectionName]; ok {
+		kustomizeEnv, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidKustomizeEnv, stackName)
+		}
+	}
+
+	// Include atmos.yaml global env as lowest priority in the merge chain.
+	globalAndKustomizeEnv, err := m.Merge(atmosConfig, []map[string]any{atmosConfigEnv, globalEnvSection, kustomizeEnv})
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := globalKustomizeSection[cfg.AuthSectionName]; ok {
+		kustomizeAuth, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidKustomizeAuth, stackName)
+		}
+	}
+
+	globalAndKustomizeAuth, err := m.Merge(atmosConfig, []map[string]any{globalAuthSection, kustomizeAuth})
+	if err != nil {
+		return nil, err
+	}
+
+	if i, ok := globalKustomizeSection[cfg.DependenciesSectionName]; ok {
+		kustomizeDependencies, ok = i.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidKustomizeDependencies, stackName)
+		}
+	}
+
+	globalAndKustomizeDependencies, err := m.Merge(atmosConfig, []map[string]any{globalDependenciesSection, kustomizeDependencies})
+	if err != nil {
+		return nil, err
+	}
+
 	// Convert atmosConfig.Auth struct to map[string]any once before parallel processing.
 	// This prevents race conditions when processAuthConfig is called from multiple goroutines.
 	// Use JSON marshaling for deep conversion of nested structs to maps.
@@ -606,7 +850,7 @@ func ProcessStackConfig(
 					GlobalCommand:                   terraformCommand,
 					AtmosGlobalAuthMap:              atmosAuthConfig,
 					TerraformProviders:              terraformProviders,
-					GlobalAndTerraformHooks:         globalAndTerraformHooks,
+					GlobalHooks:                     globalAndTerraformHooks,
 					GlobalAndTerraformGenerate:      globalAndTerraformGenerate,
 					GlobalBackendType:               globalBackendType,
 					GlobalBackendSection:            globalBackendSection,
@@ -646,6 +890,7 @@ func ProcessStackConfig(
 					ComponentsBasePath:       helmfileComponentsBasePath,
 					CheckBaseComponentExists: checkBaseComponentExists,
 					GlobalVars:               globalAndHelmfileVars,
+					GlobalHooks:              globalAndHelmfileHooks,
 					GlobalSettings:           globalAndHelmfileSettings,
 					GlobalEnv:                globalAndHelmfileEnv,
 					GlobalAuth:               globalAndHelmfileAuth,
@@ -684,6 +929,7 @@ func ProcessStackConfig(
 					ComponentsBasePath:       packerComponentsBasePath,
 					CheckBaseComponentExists: checkBaseComponentExists,
 					GlobalVars:               globalAndPackerVars,
+					GlobalHooks:              globalAndPackerHooks,
 					GlobalSettings:           globalAndPackerSettings,
 					GlobalEnv:                globalAndPackerEnv,
 					GlobalAuth:               globalAndPackerAuth,
@@ -722,6 +968,7 @@ func ProcessStackConfig(
 					ComponentsBasePath:       ansibleComponentsBasePath,
 					CheckBaseComponentExists: checkBaseComponentExists,
 					GlobalVars:               globalAndAnsibleVars,
+					GlobalHooks:              globalAndAnsibleHooks,
 					GlobalSettings:           globalAndAnsibleSettings,
 					GlobalEnv:                globalAndAnsibleEnv,
 					GlobalAuth:               globalAndAnsibleAuth,
@@ -740,10 +987,91 @@ func ProcessStackConfig(
 		}
 	}
 
+	// Process all Pulumi components in parallel.
+	if componentTypeFilter == "" || componentTypeFilter == cfg.PulumiComponentType {
+		if allPulumiComponents, ok := globalComponentsSection[cfg.PulumiComponentType]; ok {
+			allPulumiComponentsMap, ok := allPulumiComponents.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidComponentsPulumi, stackName)
+			}
+
+			// Build options for each Pulumi component.
+			buildPulumiOpts := func(component string, componentMap map[string]any) (*ComponentProcessorOptions, error) {
+				return &ComponentProcessorOptions{
+					ComponentType:            cfg.PulumiComponentType,
+					Component:                component,
+					Stack:                    stack,
+					StackName:                stackName,
+					ComponentMap:             componentMap,
+					AllComponentsMap:         allPulumiComponentsMap,
+					ComponentsBasePath:       atmosConfig.PulumiDirAbsolutePath,
+					CheckBaseComponentExists: checkBaseComponentExists,
+					GlobalVars:               globalAndPulumiVars,
+					GlobalHooks:              globalAndPulumiHooks,
+					GlobalSettings:           globalAndPulumiSettings,
+					GlobalEnv:                globalAndPulumiEnv,
+					GlobalAuth:               globalAndPulumiAuth,
+					GlobalDependencies:       globalAndPulumiDependencies,
+					GlobalCommand:            pulumiCommand,
+					GlobalBackendSection:     pulumiBackend,
+					AtmosGlobalAuthMap:       atmosAuthConfig,
+					AtmosConfig:              atmosConfig,
+				}, nil
+			}
+
+			var err error
+			pulumiComponents, err = processComponentsInParallel(atmosConfig, allPulumiComponentsMap, buildPulumiOpts)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Process all Kustomize components in parallel.
+	if componentTypeFilter == "" || componentTypeFilter == cfg.KustomizeComponentType {
+		if allKustomizeComponents, ok := globalComponentsSection[cfg.KustomizeComponentType]; ok {
+			allKustomizeComponentsMap, ok := allKustomizeComponents.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf(errFormatWithFile, errUtils.ErrInvalidComponentsKustomize, stackName)
+			}
+
+			// Build options for each Kustomize component.
+			buildKustomizeOpts := func(component string, componentMap map[string]any) (*ComponentProcessorOptions, error) {
+				return &ComponentProcessorOptions{
+					ComponentType:            cfg.KustomizeComponentType,
+					Component:                component,
+					Stack:                    stack,
+					StackName:                stackName,
+					ComponentMap:             componentMap,
+					AllComponentsMap:         allKustomizeComponentsMap,
+					ComponentsBasePath:       atmosConfig.KustomizeDirAbsolutePath,
+					CheckBaseComponentExists: checkBaseComponentExists,
+					GlobalVars:               globalAndKustomizeVars,
+					GlobalHooks:              globalAndKustomizeHooks,
+					GlobalSettings:           globalAndKustomizeSettings,
+					GlobalEnv:                globalAndKustomizeEnv,
+					GlobalAuth:               globalAndKustomizeAuth,
+					GlobalDependencies:       globalAndKustomizeDependencies,
+					GlobalCommand:            kustomizeCommand,
+					AtmosGlobalAuthMap:       atmosAuthConfig,
+					AtmosConfig:              atmosConfig,
+				}, nil
+			}
+
+			var err error
+			kustomizeComponents, err = processComponentsInParallel(atmosConfig, allKustomizeComponentsMap, buildKustomizeOpts)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	allComponents[cfg.TerraformComponentType] = terraformComponents
 	allComponents[cfg.HelmfileComponentType] = helmfileComponents
 	allComponents[cfg.PackerComponentType] = packerComponents
 	allComponents[cfg.AnsibleComponentType] = ansibleComponents
+	allComponents[cfg.PulumiComponentType] = pulumiComponents
+	allComponents[cfg.KustomizeComponentType] = kustomizeComponents
 
 	result := map[string]any{
 		cfg.ComponentsSectionName: allComponents,
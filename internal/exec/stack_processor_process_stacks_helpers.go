@@ -30,12 +30,12 @@ type ComponentProcessorOptions struct {
 	GlobalEnv          map[string]any
 	GlobalAuth         map[string]any
 	GlobalDependencies map[string]any
+	GlobalHooks        map[string]any // Stack-level hooks merged with the component type hooks.
 	GlobalCommand      string
 	AtmosGlobalAuthMap map[string]any // Pre-converted atmosConfig.Auth to prevent race conditions
 
 	// Terraform-specific options.
 	TerraformProviders              map[string]any
-	GlobalAndTerraformHooks         map[string]any
 	GlobalAndTerraformGenerate      map[string]any
 	GlobalBackendType               string
 	GlobalBackendSection            map[string]any
@@ -73,17 +73,15 @@ func extractComponentSections(opts *ComponentProcessorOptions, result *Component
 		}
 	}
 
-	// Terraform-specific: extract hooks section.
-	if opts.ComponentType == cfg.TerraformComponentType {
-		if i, ok := opts.ComponentMap[cfg.HooksSectionName]; ok {
-			componentHooks, ok := i.(map[string]any)
-			if !ok {
-				return fmt.Errorf("%w: 'components.%s.%s.hooks' in the file '%s'", errUtils.ErrInvalidComponentHooks, opts.ComponentType, opts.Component, opts.StackName)
-			}
-			result.ComponentHooks = componentHooks
-		} else {
-			result.ComponentHooks = make(map[string]any, componentSmallMapCapacity)
+	// Extract hooks section.
+	if i, ok := opts.ComponentMap[cfg.HooksSectionName]; ok {
+		componentHooks, ok := i.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: 'components.%s.%s.hooks' in the file '%s'", errUtils.ErrInvalidComponentHooks, opts.ComponentType, opts.Component, opts.StackName)
 		}
+		result.ComponentHooks = componentHooks
+	} else {
+		result.ComponentHooks = make(map[string]any, componentSmallMapCapacity)
 	}
 
 	// Extract auth section.
@@ -130,6 +128,19 @@ func extractComponentSections(opts *ComponentProcessorOptions, result *Component
 		result.ComponentDependencies = componentDependencies
 	}
 
+	// Pulumi: extract the backend section (the state backend URL).
+	if opts.ComponentType == cfg.PulumiComponentType {
+		if i, ok := opts.ComponentMap[cfg.BackendSectionName]; ok {
+			componentBackendSection, ok := i.(map[string]any)
+			if !ok {
+				return fmt.Errorf("%w: 'components.%s.%s.backend' in the file '%s'", errUtils.ErrInvalidComponentBackend, opts.ComponentType, opts.Component, opts.StackName)
+			}
+			result.ComponentBackendSection = componentBackendSection
+		} else {
+			result.ComponentBackendSection = make(map[string]any, componentSmallMapCapacity)
+		}
+	}
+
 	// Terraform-specific: extract backend configuration.
 	if opts.ComponentType == cfg.TerraformComponentType {
 		if i, ok := opts.ComponentMap[cfg.BackendTypeSectionName]; ok {
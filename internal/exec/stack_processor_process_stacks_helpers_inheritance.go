@@ -23,9 +23,9 @@ func processComponentInheritance(opts *ComponentProcessorOptions, result *Compon
 	result.BaseComponentMetadata = make(map[string]any, componentSmallMapCapacity)
 	result.BaseComponentDependencies = make(map[string]any, componentSmallMapCapacity)
 	result.BaseComponentLocals = make(map[string]any, componentSmallMapCapacity)
+	result.BaseComponentHooks = make(map[string]any, componentSmallMapCapacity)
 	if opts.ComponentType == cfg.TerraformComponentType {
 		result.BaseComponentProviders = make(map[string]any, componentSmallMapCapacity)
-		result.BaseComponentHooks = make(map[string]any, componentSmallMapCapacity)
 		result.BaseComponentGenerate = make(map[string]any, componentSmallMapCapacity)
 		result.BaseComponentBackendSection = make(map[string]any, componentSmallMapCapacity)
 		result.BaseComponentRemoteStateBackendSection = make(map[string]any, componentSmallMapCapacity)
@@ -203,14 +203,14 @@ func applyBaseComponentConfig(opts *ComponentProcessorOptions, result *Component
 	result.BaseComponentMetadata = baseComponentConfig.BaseComponentMetadata
 	result.BaseComponentDependencies = baseComponentConfig.BaseComponentDependencies
 	result.BaseComponentLocals = baseComponentConfig.BaseComponentLocals
+	result.BaseComponentHooks = baseComponentConfig.BaseComponentHooks
 	result.BaseComponentName = baseComponentConfig.FinalBaseComponentName
 	result.BaseComponentCommand = baseComponentConfig.BaseComponentCommand
 	*componentInheritanceChain = baseComponentConfig.ComponentInheritanceChain
 
-	// Terraform-specific: extract base component providers, hooks, generate, backend, source, and provision.
+	// Terraform-specific: extract base component providers, generate, backend, source, and provision.
 	if opts.ComponentType == cfg.TerraformComponentType {
 		result.BaseComponentProviders = baseComponentConfig.BaseComponentProviders
-		result.BaseComponentHooks = baseComponentConfig.BaseComponentHooks
 		result.BaseComponentGenerate = baseComponentConfig.BaseComponentGenerate
 		result.BaseComponentBackendType = baseComponentConfig.BaseComponentBackendType
 		result.BaseComponentBackendSection = baseComponentConfig.BaseComponentBackendSection
@@ -219,4 +219,9 @@ func applyBaseComponentConfig(opts *ComponentProcessorOptions, result *Component
 		result.BaseComponentSourceSection = baseComponentConfig.BaseComponentSourceSection
 		result.BaseComponentProvisionSection = baseComponentConfig.BaseComponentProvisionSection
 	}
+
+	// Pulumi: extract the base component backend.
+	if opts.ComponentType == cfg.PulumiComponentType {
+		result.BaseComponentBackendSection = baseComponentConfig.BaseComponentBackendSection
+	}
 }
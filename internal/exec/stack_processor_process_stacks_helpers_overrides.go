@@ -19,9 +19,9 @@ func processComponentOverrides(opts *ComponentProcessorOptions, result *Componen
 	result.ComponentOverridesSettings = make(map[string]any, componentOverridesCapacity)
 	result.ComponentOverridesEnv = make(map[string]any, componentOverridesCapacity)
 	result.ComponentOverridesAuth = make(map[string]any, componentOverridesCapacity)
+	result.ComponentOverridesHooks = make(map[string]any, componentOverridesCapacity)
 	if opts.ComponentType == cfg.TerraformComponentType {
 		result.ComponentOverridesProviders = make(map[string]any, componentOverridesCapacity)
-		result.ComponentOverridesHooks = make(map[string]any, componentOverridesCapacity)
 		result.ComponentOverridesGenerate = make(map[string]any, componentOverridesCapacity)
 	}
 
@@ -93,15 +93,13 @@ func processComponentOverrides(opts *ComponentProcessorOptions, result *Componen
 		}
 	}
 
-	// Terraform-specific: extract hooks overrides.
-	if opts.ComponentType == cfg.TerraformComponentType {
-		if i, ok := componentOverrides[cfg.HooksSectionName]; ok {
-			componentOverridesHooks, ok := i.(map[string]any)
-			if !ok {
-				return fmt.Errorf("%w: 'components.%s.%s.overrides.hooks' in the manifest '%s'", errUtils.ErrInvalidComponentOverridesHooks, opts.ComponentType, opts.Component, opts.StackName)
-			}
-			result.ComponentOverridesHooks = componentOverridesHooks
+	// Extract hooks overrides.
+	if i, ok := componentOverrides[cfg.HooksSectionName]; ok {
+		componentOverridesHooks, ok := i.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: 'components.%s.%s.overrides.hooks' in the manifest '%s'", errUtils.ErrInvalidComponentOverridesHooks, opts.ComponentType, opts.Component, opts.StackName)
 		}
+		result.ComponentOverridesHooks = componentOverridesHooks
 	}
 
 	// Terraform-specific: extract generate overrides.
@@ -358,6 +358,56 @@ func TestProcessStackConfig_ErrorPaths(t *testing.T) {
 			},
 			expectedError: errUtils.ErrInvalidAnsibleDependencies,
 		},
+		{
+			name: "invalid pulumi section type",
+			config: map[string]any{
+				cfg.PulumiSectionName: "invalid-not-a-map",
+			},
+			expectedError: errUtils.ErrInvalidPulumiSection,
+		},
+		{
+			name: "invalid pulumi backend type",
+			config: map[string]any{
+				cfg.PulumiSectionName: map[string]any{
+					cfg.BackendSectionName: "invalid",
+				},
+			},
+			expectedError: errUtils.ErrInvalidPulumiBackend,
+		},
+		{
+			name: "invalid components.pulumi type",
+			config: map[string]any{
+				cfg.ComponentsSectionName: map[string]any{
+					cfg.PulumiComponentType: "invalid",
+				},
+			},
+			expectedError: errUtils.ErrInvalidComponentsPulumi,
+		},
+		{
+			name: "invalid kustomize section type",
+			config: map[string]any{
+				cfg.KustomizeSectionName: "invalid-not-a-map",
+			},
+			expectedError: errUtils.ErrInvalidKustomizeSection,
+		},
+		{
+			name: "invalid kustomize vars type",
+			config: map[string]any{
+				cfg.KustomizeSectionName: map[string]any{
+					cfg.VarsSectionName: "invalid",
+				},
+			},
+			expectedError: errUtils.ErrInvalidKustomizeVars,
+		},
+		{
+			name: "invalid components.kustomize type",
+			config: map[string]any{
+				cfg.ComponentsSectionName: map[string]any{
+					cfg.KustomizeComponentType: "invalid",
+				},
+			},
+			expectedError: errUtils.ErrInvalidComponentsKustomize,
+		},
 	}
 
 	for _, tt := range tests {
@@ -383,3 +433,106 @@ func TestProcessStackConfig_ErrorPaths(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessStackConfig_PulumiBackend(t *testing.T) {
+	atmosConfig := &schema.AtmosConfiguration{}
+
+	config := map[string]any{
+		cfg.PulumiSectionName: map[string]any{
+			cfg.BackendSectionName: map[string]any{"url": "s3://acme-pulumi-state"},
+			cfg.VarsSectionName:    map[string]any{"region": "us-east-2"},
+		},
+		cfg.ComponentsSectionName: map[string]any{
+			cfg.PulumiComponentType: map[string]any{
+				"website": map[string]any{
+					cfg.VarsSectionName: map[string]any{"name": "website"},
+				},
+				"website/local": map[string]any{
+					cfg.MetadataSectionName: map[string]any{
+						"component": "website",
+						"inherits":  []any{"website"},
+					},
+					cfg.BackendSectionName: map[string]any{"url": "file://state"},
+				},
+			},
+		},
+	}
+
+	result, err := ProcessStackConfig(
+		atmosConfig,
+		"/test/stacks",
+		"/test/terraform",
+		"/test/helmfile",
+		"/test/packer",
+		"/test/ansible",
+		"test-stack.yaml",
+		config,
+		false,
+		false,
+		"",
+		map[string]map[string][]string{},
+		map[string]map[string]any{},
+		false,
+	)
+	require.NoError(t, err)
+
+	components, ok := result[cfg.ComponentsSectionName].(map[string]any)[cfg.PulumiComponentType].(map[string]any)
+	require.True(t, ok)
+
+	website := components["website"].(map[string]any)
+	assert.Equal(t, map[string]any{"url": "s3://acme-pulumi-state"}, website[cfg.BackendSectionName])
+	assert.Equal(t, map[string]any{"region": "us-east-2", "name": "website"}, website[cfg.VarsSectionName])
+	assert.Equal(t, "pulumi", website[cfg.CommandSectionName])
+
+	local := components["website/local"].(map[string]any)
+	assert.Equal(t, map[string]any{"url": "file://state"}, local[cfg.BackendSectionName])
+	assert.Equal(t, map[string]any{"region": "us-east-2", "name": "website"}, local[cfg.VarsSectionName])
+}
+
+func TestProcessStackConfig_KustomizeCommand(t *testing.T) {
+	config := map[string]any{
+		cfg.KustomizeSectionName: map[string]any{
+			cfg.VarsSectionName: map[string]any{"replicas": 2},
+		},
+		cfg.ComponentsSectionName: map[string]any{
+			cfg.KustomizeComponentType: map[string]any{
+				"app": map[string]any{
+					cfg.VarsSectionName: map[string]any{"image": "nginx:1.27"},
+				},
+				"app/standalone": map[string]any{
+					cfg.MetadataSectionName: map[string]any{"component": "app"},
+					cfg.CommandSectionName:  "kustomize",
+				},
+			},
+		},
+	}
+
+	result, err := ProcessStackConfig(
+		&schema.AtmosConfiguration{},
+		"/test/stacks",
+		"/test/terraform",
+		"/test/helmfile",
+		"/test/packer",
+		"/test/ansible",
+		"test-stack.yaml",
+		config,
+		false,
+		false,
+		"",
+		map[string]map[string][]string{},
+		map[string]map[string]any{},
+		false,
+	)
+	require.NoError(t, err)
+
+	components, ok := result[cfg.ComponentsSectionName].(map[string]any)[cfg.KustomizeComponentType].(map[string]any)
+	require.True(t, ok)
+
+	// Kustomize components run through kubectl unless a command is configured.
+	app := components["app"].(map[string]any)
+	assert.Equal(t, "kubectl", app[cfg.CommandSectionName])
+	assert.Equal(t, map[string]any{"replicas": 2, "image": "nginx:1.27"}, app[cfg.VarsSectionName])
+
+	standalone := components["app/standalone"].(map[string]any)
+	assert.Equal(t, "kustomize", standalone[cfg.CommandSectionName])
+}
@@ -204,6 +204,10 @@ func BuildComponentPath(
 		return filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Helmfile.BasePath, componentFolder)
 	case cfg.PackerComponentType:
 		return filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Packer.BasePath, componentFolder)
+	case cfg.PulumiComponentType:
+		return filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Pulumi.BasePath, componentFolder)
+	case cfg.KustomizeComponentType:
+		return filepath.Join(atmosConfig.BasePath, atmosConfig.Components.Kustomize.BasePath, componentFolder)
 	default:
 		return ""
 	}
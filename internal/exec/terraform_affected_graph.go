@@ -180,12 +180,25 @@ func extractAffectedNodeIDs(affectedList []schema.Affected) []string {
 }
 
 // executeAffectedInOrder executes the affected components in topological order.
+// When info.Parallelism is greater than 1, independent components run concurrently
+// and are reported as affected components, dependents or dependencies like in the sequential order.
 func executeAffectedInOrder(
 	graph *dependency.Graph,
 	affectedList []schema.Affected,
 	args *DescribeAffectedCmdArgs,
 	info *schema.ConfigAndStacksInfo,
 ) error {
+	if info.Parallelism > 1 {
+		return executeGraphInParallelWithReport(graph, info, &parallelReport{
+			order:        "Processing affected components in dependency order",
+			reverseOrder: "Processing affected components in reverse dependency order for destroy",
+			success:      "Successfully processed affected components",
+			logNode: func(node *dependency.Node, index, total int) {
+				logComponentExecution(node, index, total, isDirectlyAffected(node, affectedList), args.IncludeDependents)
+			},
+		})
+	}
+
 	// Get execution order.
 	executionOrder, err := graph.TopologicalSort()
 	if err != nil {
@@ -10,6 +10,8 @@ import (
 	"github.com/cloudposse/atmos/pkg/dependency"
 	"github.com/cloudposse/atmos/pkg/perf"
 	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/store/authbridge"
+	"github.com/cloudposse/atmos/pkg/ui"
 	u "github.com/cloudposse/atmos/pkg/utils"
 )
 
@@ -71,8 +73,119 @@ func ExecuteTerraformAll(info *schema.ConfigAndStacksInfo) error {
 	return executeInDependencyOrder(graph, info)
 }
 
+// ExecuteTerraformGraph executes terraform commands for the components selected by the
+// multi-component flags (--all, --stack, --components, --query) in dependency order.
+// It selects the same components as ExecuteTerraformQuery, so `--parallelism N` only changes
+// how many of them run at a time, not which of them run.
+func ExecuteTerraformGraph(info *schema.ConfigAndStacksInfo) error {
+	defer perf.Track(nil, "exec.ExecuteTerraformGraph")()
+
+	atmosConfig, err := cfg.InitCliConfig(*info, true)
+	if err != nil {
+		return fmt.Errorf(errWrapFmt, errUtils.ErrInitializeCLIConfig, err)
+	}
+
+	log.Debug("Executing terraform command for the selected components in dependency order", "command", info.SubCommand)
+
+	// Create auth manager so YAML functions like !terraform.state can use authenticated credentials.
+	authManager, err := createQueryAuthManager(info, &atmosConfig)
+	if err != nil {
+		return err
+	}
+
+	// Inject auth resolver into identity-aware stores, as ExecuteTerraformQuery does.
+	if authManager != nil {
+		resolver := authbridge.NewResolver(authManager, info)
+		atmosConfig.Stores.SetAuthContextResolver(resolver)
+	}
+
+	stacks, err := ExecuteDescribeStacks(
+		&atmosConfig,
+		info.Stack,
+		info.Components,
+		[]string{cfg.TerraformComponentType},
+		nil,
+		false,
+		info.ProcessTemplates,
+		info.ProcessFunctions,
+		false,
+		info.Skip,
+		authManager,
+	)
+	if err != nil {
+		return fmt.Errorf(errWrapFmt, errUtils.ErrExecuteDescribeStacks, err)
+	}
+
+	graph, err := buildSelectedTerraformGraph(&atmosConfig, stacks, info)
+	if err != nil {
+		return fmt.Errorf(errWrapFmt, errUtils.ErrBuildDepGraph, err)
+	}
+
+	if graph.Size() == 0 {
+		ui.Success("No components matched")
+		return nil
+	}
+
+	// Execute components in dependency order.
+	return executeInDependencyOrder(graph, info)
+}
+
+// buildSelectedTerraformGraph builds a dependency graph of the components selected by the multi-component flags.
+// Dependencies on components that are not selected are ignored, so only the selected components run.
+func buildSelectedTerraformGraph(
+	atmosConfig *schema.AtmosConfiguration,
+	stacks map[string]any,
+	info *schema.ConfigAndStacksInfo,
+) (*dependency.Graph, error) {
+	builder := dependency.NewBuilder()
+	nodeMap := make(map[string]string)
+	var selected []*dependency.Node
+
+	err := walkTerraformComponents(stacks, func(stackName, componentName string, componentSection map[string]any) error {
+		ok, err := isTerraformComponentSelected(atmosConfig, info, stackName, componentName, componentSection, log.Debug)
+		if err != nil || !ok {
+			return err
+		}
+
+		node := &dependency.Node{
+			ID:        fmt.Sprintf(nodeIDFormat, componentName, stackName),
+			Component: componentName,
+			Stack:     stackName,
+			Type:      cfg.TerraformComponentType,
+			Metadata:  componentSection,
+		}
+		nodeMap[node.ID] = node.ID
+		selected = append(selected, node)
+		return builder.AddNode(node)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parser := NewDependencyParser(builder, nodeMap)
+	parser.skipMissingTargets = true
+	for _, node := range selected {
+		if err := parser.ParseComponentDependencies(node.Stack, node.Component, node.Metadata); err != nil {
+			return nil, fmt.Errorf("%w: building dependencies: %w", errUtils.ErrBuildDepGraph, err)
+		}
+	}
+
+	graph, err := builder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("%w: finalizing graph: %w", errUtils.ErrBuildDepGraph, err)
+	}
+
+	log.Debug("Dependency graph built", "nodes", graph.Size(), "roots", len(graph.Roots))
+	return graph, nil
+}
+
 // executeInDependencyOrder executes terraform commands in dependency order.
+// When info.Parallelism is greater than 1, independent components run concurrently.
 func executeInDependencyOrder(graph *dependency.Graph, info *schema.ConfigAndStacksInfo) error {
+	if info.Parallelism > 1 {
+		return executeGraphInParallel(graph, info)
+	}
+
 	// Get execution order.
 	executionOrder, err := graph.TopologicalSort()
 	if err != nil {
@@ -760,3 +760,79 @@ func TestApplyFiltersToGraph_ComponentFilter(t *testing.T) {
 	_, hasVPC := result.GetNode("vpc-dev")
 	assert.True(t, hasVPC)
 }
+
+func TestBuildSelectedTerraformGraph(t *testing.T) {
+	stacks := map[string]any{
+		"dev": map[string]any{
+			"components": map[string]any{
+				"terraform": map[string]any{
+					"vpc": map[string]any{
+						"metadata": map[string]any{"component": "vpc"},
+						"vars":     map[string]any{"tier": "network"},
+					},
+					"database": map[string]any{
+						"metadata": map[string]any{"component": "database"},
+						"vars":     map[string]any{"tier": "data"},
+						"settings": map[string]any{
+							"depends_on": []any{
+								map[string]any{"component": "vpc"},
+							},
+						},
+					},
+					"app": map[string]any{
+						"metadata": map[string]any{"component": "app"},
+						"vars":     map[string]any{"tier": "data"},
+						"settings": map[string]any{
+							"depends_on": []any{
+								map[string]any{"component": "database"},
+							},
+						},
+					},
+					"base": map[string]any{
+						"metadata": map[string]any{"type": "abstract"},
+					},
+					"legacy": map[string]any{
+						"vars": map[string]any{"tier": "network"},
+					},
+				},
+			},
+		},
+	}
+	atmosConfig := &schema.AtmosConfiguration{}
+
+	t.Run("selects the same components as the sequential path", func(t *testing.T) {
+		graph, err := buildSelectedTerraformGraph(atmosConfig, stacks, &schema.ConfigAndStacksInfo{})
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, graph.Size())
+		for _, id := range []string{"vpc-dev", "database-dev", "app-dev"} {
+			_, exists := graph.GetNode(id)
+			assert.True(t, exists, id)
+		}
+
+		app, _ := graph.GetNode("app-dev")
+		assert.Equal(t, []string{"database-dev"}, app.Dependencies)
+	})
+
+	t.Run("does not add unselected dependencies", func(t *testing.T) {
+		info := &schema.ConfigAndStacksInfo{Query: `.vars.tier == "data"`}
+		graph, err := buildSelectedTerraformGraph(atmosConfig, stacks, info)
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, graph.Size())
+		_, exists := graph.GetNode("vpc-dev")
+		assert.False(t, exists)
+
+		database, _ := graph.GetNode("database-dev")
+		assert.Empty(t, database.Dependencies)
+		app, _ := graph.GetNode("app-dev")
+		assert.Equal(t, []string{"database-dev"}, app.Dependencies)
+	})
+
+	t.Run("empty when nothing matches", func(t *testing.T) {
+		info := &schema.ConfigAndStacksInfo{Query: `.vars.tier == "edge"`}
+		graph, err := buildSelectedTerraformGraph(atmosConfig, stacks, info)
+		require.NoError(t, err)
+		assert.Equal(t, 0, graph.Size())
+	})
+}
@@ -16,6 +16,7 @@ import (
 func executeTerraformForNode(
 	node *dependency.Node,
 	info *schema.ConfigAndStacksInfo,
+	opts ...ShellCommandOption,
 ) error {
 	// Validate the node.
 	if shouldSkipNode(node) {
@@ -31,7 +32,7 @@ func executeTerraformForNode(
 	updateInfoFromNode(info, node)
 
 	// Execute the command.
-	return executeNodeCommand(node, info)
+	return executeNodeCommand(node, info, opts...)
 }
 
 // shouldSkipNode checks if a node should be skipped based on its metadata.
@@ -108,7 +109,7 @@ func updateInfoFromNode(info *schema.ConfigAndStacksInfo, node *dependency.Node)
 }
 
 // executeNodeCommand executes the terraform command for a node.
-func executeNodeCommand(node *dependency.Node, info *schema.ConfigAndStacksInfo) error {
+func executeNodeCommand(node *dependency.Node, info *schema.ConfigAndStacksInfo, opts ...ShellCommandOption) error {
 	command := formatNodeCommand(node, info)
 
 	if info.DryRun {
@@ -119,7 +120,7 @@ func executeNodeCommand(node *dependency.Node, info *schema.ConfigAndStacksInfo)
 	log.Debug("Executing", "command", command)
 
 	// Execute the terraform command.
-	if err := ExecuteTerraform(*info); err != nil {
+	if err := ExecuteTerraform(*info, opts...); err != nil {
 		return fmt.Errorf("%w: %w", errUtils.ErrTerraformExecFailed, err)
 	}
 
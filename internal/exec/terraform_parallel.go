@@ -0,0 +1,297 @@
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+
+	log "github.com/charmbracelet/log"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/dependency"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// nodeExecutor executes the terraform command for a single graph node.
+// Package-level variable to allow test injection.
+var nodeExecutor = executeTerraformForNode
+
+// parallelReport holds the messages logged by a parallel execution, so it reports progress like the sequential
+// execution of the same command.
+type parallelReport struct {
+	order        string
+	reverseOrder string
+	success      string
+	// logNode logs a node that starts processing. The index counts the started nodes.
+	logNode func(node *dependency.Node, index, total int)
+}
+
+// defaultParallelReport reports progress like executeInDependencyOrder.
+var defaultParallelReport = parallelReport{
+	order:        "Processing components in dependency order",
+	reverseOrder: "Processing components in reverse dependency order for destroy",
+	success:      "Successfully processed all components",
+	logNode: func(node *dependency.Node, index, total int) {
+		log.Info("Processing component", "index", index, "total", total, "component", node.Component, "stack", node.Stack)
+	},
+}
+
+// parallelRun holds the shared state of a level-by-level parallel execution.
+type parallelRun struct {
+	info     *schema.ConfigAndStacksInfo
+	report   *parallelReport
+	total    int
+	reverse  bool
+	stdoutMu sync.Mutex
+	stderrMu sync.Mutex
+
+	mu        sync.Mutex
+	failed    map[string]bool
+	errs      []error
+	started   int
+	processed int
+	skipped   int
+	// folderLocks serializes the nodes that share a component folder.
+	folderLocks map[string]*sync.Mutex
+}
+
+// executeGraphInParallel executes the graph nodes level by level using dependency.Graph.GetExecutionLevels.
+// Nodes within a level have no dependencies on each other and run concurrently, bounded by info.Parallelism.
+// Nodes that use the same component folder (e.g. the same component in several stacks) never run at the same time,
+// because terraform selects the workspace and initializes the backend in that folder.
+// For the destroy command the levels are reversed so dependents are destroyed before their dependencies.
+// After the first failure no new nodes are scheduled, unless info.ContinueOnError is set, in which case
+// only the nodes that (transitively) depend on a failed node are skipped.
+func executeGraphInParallel(graph *dependency.Graph, info *schema.ConfigAndStacksInfo) error {
+	defer perf.Track(nil, "exec.executeGraphInParallel")()
+
+	return executeGraphInParallelWithReport(graph, info, &defaultParallelReport)
+}
+
+// executeGraphInParallelWithReport executes the graph nodes like executeGraphInParallel and logs progress with report.
+func executeGraphInParallelWithReport(graph *dependency.Graph, info *schema.ConfigAndStacksInfo, report *parallelReport) error {
+	defer perf.Track(nil, "exec.executeGraphInParallelWithReport")()
+
+	levels, err := graph.GetExecutionLevels()
+	if err != nil {
+		return fmt.Errorf(errWrapFmt, errUtils.ErrTopologicalOrder, err)
+	}
+
+	run := &parallelRun{
+		info:        info,
+		report:      report,
+		total:       graph.Size(),
+		reverse:     info.SubCommand == "destroy",
+		failed:      make(map[string]bool),
+		folderLocks: make(map[string]*sync.Mutex),
+	}
+
+	if run.reverse {
+		slices.Reverse(levels)
+		log.Info(report.reverseOrder, "count", run.total, "levels", len(levels), "parallelism", info.Parallelism)
+	} else {
+		log.Info(report.order, "count", run.total, "levels", len(levels), "parallelism", info.Parallelism)
+	}
+
+	for i := range levels {
+		if len(run.errs) > 0 && !info.ContinueOnError {
+			break
+		}
+		log.Debug("Processing execution level", "level", i+1, "total", len(levels), "components", len(levels[i]))
+		run.executeLevel(levels[i])
+	}
+
+	if len(run.errs) > 0 {
+		log.Error("Failed to process components", "failed", len(run.errs), "succeeded", run.processed, "skipped", run.skipped)
+		return errors.Join(run.errs...)
+	}
+
+	log.Info(report.success, "count", run.processed)
+	return nil
+}
+
+// executeLevel runs all nodes of a single execution level with bounded concurrency.
+func (r *parallelRun) executeLevel(level []dependency.Node) {
+	sem := make(chan struct{}, r.info.Parallelism)
+	var wg sync.WaitGroup
+
+	for i := range level {
+		node := &level[i]
+
+		if r.isBlocked(node) {
+			r.markSkipped(node)
+			continue
+		}
+
+		sem <- struct{}{}
+
+		// Stop scheduling new work after the first failure.
+		if r.shouldStop() {
+			<-sem
+			r.markSkipped(node)
+			continue
+		}
+
+		wg.Add(1)
+		go func(node *dependency.Node) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			r.executeNode(node)
+		}(node)
+	}
+
+	wg.Wait()
+}
+
+// executeNode runs the terraform command for a node with its output prefixed by `component@stack`.
+func (r *parallelRun) executeNode(node *dependency.Node) {
+	prefix := fmt.Sprintf("[%s@%s] ", node.Component, node.Stack)
+	stdout := newPrefixWriter(os.Stdout, &r.stdoutMu, prefix)
+	stderr := newPrefixWriter(os.Stderr, &r.stderrMu, prefix)
+
+	// Each node gets its own copy of info because executeTerraformForNode mutates it.
+	nodeInfo := *r.info
+
+	folderLock := r.folderLock(node)
+	folderLock.Lock()
+	r.logStart(node)
+	err := nodeExecutor(node, &nodeInfo, WithStdoutOverride(stdout), WithStderrOverride(stderr))
+	folderLock.Unlock()
+
+	stdout.Flush()
+	stderr.Flush()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err != nil {
+		log.Error("Failed to process component", "component", node.Component, "stack", node.Stack, "error", err)
+		r.failed[node.ID] = true
+		r.errs = append(r.errs, fmt.Errorf("%w: component=%s stack=%s: %w", errUtils.ErrTerraformExecFailed, node.Component, node.Stack, err))
+		return
+	}
+	r.processed++
+}
+
+// logStart logs a node that starts processing, numbering the nodes in the order they start.
+func (r *parallelRun) logStart(node *dependency.Node) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.started++
+	r.report.logNode(node, r.started, r.total)
+}
+
+// folderLock returns the lock of the component folder used by a node.
+func (r *parallelRun) folderLock(node *dependency.Node) *sync.Mutex {
+	folder := GetComponentFolder(&node.Metadata, node.Component)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lock, ok := r.folderLocks[folder]
+	if !ok {
+		lock = &sync.Mutex{}
+		r.folderLocks[folder] = lock
+	}
+	return lock
+}
+
+// isBlocked reports whether a node must be skipped because a node it waits on has failed or was skipped.
+// For destroy, a node waits on its dependents; otherwise it waits on its dependencies.
+func (r *parallelRun) isBlocked(node *dependency.Node) bool {
+	waitsOn := node.Dependencies
+	if r.reverse {
+		waitsOn = node.Dependents
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, id := range waitsOn {
+		if r.failed[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// shouldStop reports whether scheduling must stop because of a failure.
+func (r *parallelRun) shouldStop() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.errs) > 0 && !r.info.ContinueOnError
+}
+
+// markSkipped records a node as not executed, so nodes waiting on it are skipped as well.
+func (r *parallelRun) markSkipped(node *dependency.Node) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	log.Warn("Skipping component", "component", node.Component, "stack", node.Stack)
+	r.failed[node.ID] = true
+	r.skipped++
+}
+
+// prefixWriter writes complete lines to the underlying writer, each line starting with a prefix.
+// Writers that share a mutex never interleave their lines.
+type prefixWriter struct {
+	out    io.Writer
+	mu     *sync.Mutex
+	prefix []byte
+	buf    bytes.Buffer
+}
+
+// newPrefixWriter creates a prefixWriter.
+func newPrefixWriter(out io.Writer, mu *sync.Mutex, prefix string) *prefixWriter {
+	return &prefixWriter{
+		out:    out,
+		mu:     mu,
+		prefix: []byte(prefix),
+	}
+}
+
+// Write buffers p and writes every complete line to the underlying writer.
+func (w *prefixWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+
+	for {
+		idx := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if idx < 0 {
+			break
+		}
+		if err := w.writeLine(w.buf.Next(idx + 1)); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Flush writes any remaining partial line.
+func (w *prefixWriter) Flush() {
+	if w.buf.Len() == 0 {
+		return
+	}
+	line := append(w.buf.Bytes(), '\n')
+	w.buf.Reset()
+	_ = w.writeLine(line)
+}
+
+// writeLine writes a single prefixed line while holding the shared lock.
+func (w *prefixWriter) writeLine(line []byte) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, err := w.out.Write(w.prefix); err != nil {
+		return err
+	}
+	_, err := w.out.Write(line)
+	return err
+}
@@ -0,0 +1,257 @@
+package exec
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/dependency"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+var errNodeFailed = errors.New("node failed")
+
+// buildParallelTestGraph builds vpc -> (eks, rds) -> app, plus an independent dns node.
+func buildParallelTestGraph(t *testing.T) *dependency.Graph {
+	t.Helper()
+
+	builder := dependency.NewBuilder()
+	for _, c := range []string{"vpc", "eks", "rds", "app", "dns"} {
+		require.NoError(t, builder.AddNode(&dependency.Node{ID: c + "-dev", Component: c, Stack: "dev"}))
+	}
+	require.NoError(t, builder.AddDependency("eks-dev", "vpc-dev"))
+	require.NoError(t, builder.AddDependency("rds-dev", "vpc-dev"))
+	require.NoError(t, builder.AddDependency("app-dev", "eks-dev"))
+	require.NoError(t, builder.AddDependency("app-dev", "rds-dev"))
+
+	graph, err := builder.Build()
+	require.NoError(t, err)
+	return graph
+}
+
+// recordingExecutor replaces nodeExecutor and records the order in which nodes finish.
+type recordingExecutor struct {
+	mu       sync.Mutex
+	order    []string
+	fail     map[string]bool
+	running  atomic.Int32
+	maxInUse atomic.Int32
+}
+
+func (r *recordingExecutor) install(t *testing.T) {
+	t.Helper()
+
+	original := nodeExecutor
+	t.Cleanup(func() { nodeExecutor = original })
+
+	nodeExecutor = func(node *dependency.Node, _ *schema.ConfigAndStacksInfo, _ ...ShellCommandOption) error {
+		inUse := r.running.Add(1)
+		defer r.running.Add(-1)
+		for {
+			current := r.maxInUse.Load()
+			if inUse <= current || r.maxInUse.CompareAndSwap(current, inUse) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.order = append(r.order, node.Component)
+		if r.fail[node.Component] {
+			return errNodeFailed
+		}
+		return nil
+	}
+}
+
+func (r *recordingExecutor) index(component string) int {
+	for i, c := range r.order {
+		if c == component {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestExecuteGraphInParallel_RespectsDependencies(t *testing.T) {
+	rec := &recordingExecutor{}
+	rec.install(t)
+
+	err := executeGraphInParallel(buildParallelTestGraph(t), &schema.ConfigAndStacksInfo{SubCommand: "plan", Parallelism: 4})
+	require.NoError(t, err)
+
+	require.Len(t, rec.order, 5)
+	assert.Less(t, rec.index("vpc"), rec.index("eks"))
+	assert.Less(t, rec.index("vpc"), rec.index("rds"))
+	assert.Less(t, rec.index("eks"), rec.index("app"))
+	assert.Less(t, rec.index("rds"), rec.index("app"))
+	assert.Greater(t, rec.maxInUse.Load(), int32(1), "independent components should run concurrently")
+}
+
+func TestExecuteGraphInParallel_BoundsConcurrency(t *testing.T) {
+	rec := &recordingExecutor{}
+	rec.install(t)
+
+	builder := dependency.NewBuilder()
+	for _, c := range []string{"a", "b", "c", "d", "e", "f"} {
+		require.NoError(t, builder.AddNode(&dependency.Node{ID: c + "-dev", Component: c, Stack: "dev"}))
+	}
+	graph, err := builder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, executeGraphInParallel(graph, &schema.ConfigAndStacksInfo{SubCommand: "plan", Parallelism: 2}))
+	assert.Len(t, rec.order, 6)
+	assert.LessOrEqual(t, rec.maxInUse.Load(), int32(2))
+}
+
+func TestExecuteGraphInParallel_ReversesForDestroy(t *testing.T) {
+	rec := &recordingExecutor{}
+	rec.install(t)
+
+	err := executeGraphInParallel(buildParallelTestGraph(t), &schema.ConfigAndStacksInfo{SubCommand: "destroy", Parallelism: 4})
+	require.NoError(t, err)
+
+	require.Len(t, rec.order, 5)
+	assert.Less(t, rec.index("app"), rec.index("eks"))
+	assert.Less(t, rec.index("app"), rec.index("rds"))
+	assert.Less(t, rec.index("eks"), rec.index("vpc"))
+	assert.Less(t, rec.index("rds"), rec.index("vpc"))
+}
+
+func TestExecuteGraphInParallel_StopsOnFirstFailure(t *testing.T) {
+	rec := &recordingExecutor{fail: map[string]bool{"vpc": true}}
+	rec.install(t)
+
+	err := executeGraphInParallel(buildParallelTestGraph(t), &schema.ConfigAndStacksInfo{SubCommand: "plan", Parallelism: 2})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errUtils.ErrTerraformExecFailed)
+	assert.ErrorIs(t, err, errNodeFailed)
+
+	// Level 0 (vpc, dns) is already in flight; nothing after the failing level is scheduled.
+	assert.ElementsMatch(t, []string{"dns", "vpc"}, rec.order)
+}
+
+func TestExecuteGraphInParallel_ContinueOnError(t *testing.T) {
+	rec := &recordingExecutor{fail: map[string]bool{"eks": true}}
+	rec.install(t)
+
+	builder := dependency.NewBuilder()
+	for _, c := range []string{"vpc", "eks", "rds", "app", "dns", "cdn"} {
+		require.NoError(t, builder.AddNode(&dependency.Node{ID: c + "-dev", Component: c, Stack: "dev"}))
+	}
+	require.NoError(t, builder.AddDependency("eks-dev", "vpc-dev"))
+	require.NoError(t, builder.AddDependency("rds-dev", "vpc-dev"))
+	require.NoError(t, builder.AddDependency("app-dev", "eks-dev"))
+	require.NoError(t, builder.AddDependency("cdn-dev", "dns-dev"))
+	graph, err := builder.Build()
+	require.NoError(t, err)
+
+	err = executeGraphInParallel(graph, &schema.ConfigAndStacksInfo{SubCommand: "apply", Parallelism: 4, ContinueOnError: true})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errNodeFailed)
+
+	// app depends on the failed eks and is skipped; everything else still runs.
+	assert.ElementsMatch(t, []string{"vpc", "dns", "eks", "rds", "cdn"}, rec.order)
+}
+
+func TestExecuteGraphInParallel_SerializesSharedComponentFolder(t *testing.T) {
+	var mu sync.Mutex
+	running := map[string]int{}
+	maxInUse := map[string]int{}
+	var total, maxTotal atomic.Int32
+
+	original := nodeExecutor
+	t.Cleanup(func() { nodeExecutor = original })
+	nodeExecutor = func(node *dependency.Node, _ *schema.ConfigAndStacksInfo, _ ...ShellCommandOption) error {
+		folder := GetComponentFolder(&node.Metadata, node.Component)
+
+		mu.Lock()
+		running[folder]++
+		maxInUse[folder] = max(maxInUse[folder], running[folder])
+		mu.Unlock()
+		inUse := total.Add(1)
+		for {
+			current := maxTotal.Load()
+			if inUse <= current || maxTotal.CompareAndSwap(current, inUse) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		total.Add(-1)
+		mu.Lock()
+		running[folder]--
+		mu.Unlock()
+		return nil
+	}
+
+	// `vpc` is deployed to two stacks, and `vpc-east` uses the same component folder.
+	builder := dependency.NewBuilder()
+	nodes := []*dependency.Node{
+		{ID: "vpc-dev", Component: "vpc", Stack: "dev", Metadata: map[string]any{"component": "vpc"}},
+		{ID: "vpc-prod", Component: "vpc", Stack: "prod", Metadata: map[string]any{"component": "vpc"}},
+		{ID: "vpc-east-prod", Component: "vpc-east", Stack: "prod", Metadata: map[string]any{"component": "vpc"}},
+		{ID: "dns-dev", Component: "dns", Stack: "dev"},
+		{ID: "dns-prod", Component: "dns", Stack: "prod"},
+	}
+	for _, node := range nodes {
+		require.NoError(t, builder.AddNode(node))
+	}
+	graph, err := builder.Build()
+	require.NoError(t, err)
+
+	require.NoError(t, executeGraphInParallel(graph, &schema.ConfigAndStacksInfo{SubCommand: "plan", Parallelism: 5}))
+
+	assert.Equal(t, 1, maxInUse["vpc"], "nodes sharing the vpc folder must not run concurrently")
+	assert.Equal(t, 1, maxInUse["dns"], "nodes sharing the dns folder must not run concurrently")
+	assert.Greater(t, maxTotal.Load(), int32(1), "nodes in different folders should run concurrently")
+}
+
+func TestExecuteGraphInParallelWithReport_LogsEveryNode(t *testing.T) {
+	rec := &recordingExecutor{}
+	rec.install(t)
+
+	var mu sync.Mutex
+	var indexes []int
+	logged := map[string]int{}
+	report := &parallelReport{
+		logNode: func(node *dependency.Node, index, total int) {
+			mu.Lock()
+			defer mu.Unlock()
+			indexes = append(indexes, index)
+			logged[node.Component] = total
+		},
+	}
+
+	err := executeGraphInParallelWithReport(buildParallelTestGraph(t), &schema.ConfigAndStacksInfo{SubCommand: "plan", Parallelism: 4}, report)
+	require.NoError(t, err)
+
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, indexes)
+	assert.Equal(t, map[string]int{"vpc": 5, "eks": 5, "rds": 5, "app": 5, "dns": 5}, logged)
+}
+
+func TestPrefixWriter(t *testing.T) {
+	var out bytes.Buffer
+	var mu sync.Mutex
+	w := newPrefixWriter(&out, &mu, "[vpc@dev] ")
+
+	n, err := w.Write([]byte("line one\nline "))
+	require.NoError(t, err)
+	assert.Equal(t, 14, n)
+	assert.Equal(t, "[vpc@dev] line one\n", out.String())
+
+	_, err = w.Write([]byte("two\npartial"))
+	require.NoError(t, err)
+	w.Flush()
+
+	assert.Equal(t, "[vpc@dev] line one\n[vpc@dev] line two\n[vpc@dev] partial\n", out.String())
+}
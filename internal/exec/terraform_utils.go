@@ -280,6 +280,42 @@ func processTerraformComponent(
 	componentSection map[string]any,
 	logFunc func(msg any, keyvals ...any),
 	executeFn func(schema.ConfigAndStacksInfo, ...ShellCommandOption) error,
+) (bool, error) {
+	selected, err := isTerraformComponentSelected(atmosConfig, info, stackName, componentName, componentSection, logFunc)
+	if err != nil || !selected {
+		return false, err
+	}
+
+	command := fmt.Sprintf("atmos terraform %s %s -s %s", info.SubCommand, componentName, stackName)
+	logFunc("Executing", commandStr, command)
+
+	// Show user-facing progress for dry-run mode.
+	if info.DryRun {
+		ui.Successf("Would %s `%s` in `%s` (dry run)", info.SubCommand, componentName, stackName)
+		return true, nil
+	}
+
+	info.Component = componentName
+	info.ComponentFromArg = componentName
+	info.Stack = stackName
+	info.StackFromArg = stackName
+
+	if err := executeFn(*info); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// isTerraformComponentSelected reports whether a Terraform component is selected by a multi-component command.
+// Components without a metadata section, abstract and disabled components, and components that don't satisfy
+// the `--query` expression are not selected.
+func isTerraformComponentSelected(
+	atmosConfig *schema.AtmosConfiguration,
+	info *schema.ConfigAndStacksInfo,
+	stackName, componentName string,
+	componentSection map[string]any,
+	logFunc func(msg any, keyvals ...any),
 ) (bool, error) {
 	metadataSection, ok := componentSection[cfg.MetadataSectionName].(map[string]any)
 	if !ok {
@@ -296,35 +332,19 @@ func processTerraformComponent(
 		return false, nil
 	}
 
-	command := fmt.Sprintf("atmos terraform %s %s -s %s", info.SubCommand, componentName, stackName)
-
-	if info.Query != "" {
-		queryResult, err := u.EvaluateYqExpression(atmosConfig, componentSection, info.Query)
-		if err != nil {
-			return false, err
-		}
-
-		if queryPassed, ok := queryResult.(bool); !ok || !queryPassed {
-			logFunc("Skipping the component because the query criteria not satisfied", commandStr, command, "query", info.Query)
-			return false, nil
-		}
-	}
-
-	logFunc("Executing", commandStr, command)
-
-	// Show user-facing progress for dry-run mode.
-	if info.DryRun {
-		ui.Successf("Would %s `%s` in `%s` (dry run)", info.SubCommand, componentName, stackName)
+	if info.Query == "" {
 		return true, nil
 	}
 
-	info.Component = componentName
-	info.ComponentFromArg = componentName
-	info.Stack = stackName
-	info.StackFromArg = stackName
+	queryResult, err := u.EvaluateYqExpression(atmosConfig, componentSection, info.Query)
+	if err != nil {
+		return false, err
+	}
 
-	if err := executeFn(*info); err != nil {
-		return true, err
+	if queryPassed, ok := queryResult.(bool); !ok || !queryPassed {
+		command := fmt.Sprintf("atmos terraform %s %s -s %s", info.SubCommand, componentName, stackName)
+		logFunc("Skipping the component because the query criteria not satisfied", commandStr, command, "query", info.Query)
+		return false, nil
 	}
 
 	return true, nil
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/dependency"
 	"github.com/cloudposse/atmos/pkg/schema"
 	"github.com/cloudposse/atmos/tests"
 )
@@ -234,6 +235,35 @@ func TestExecuteTerraformQueryNoMatches(t *testing.T) {
 	}
 }
 
+// TestExecuteTerraformGraphNoMatches verifies that the parallel path runs nothing when no components match the query.
+func TestExecuteTerraformGraphNoMatches(t *testing.T) {
+	os.Unsetenv("ATMOS_BASE_PATH")
+	os.Unsetenv("ATMOS_CLI_CONFIG_PATH")
+
+	workDir := "../../tests/fixtures/scenarios/terraform-apply-affected"
+	t.Chdir(workDir)
+
+	original := nodeExecutor
+	t.Cleanup(func() { nodeExecutor = original })
+	var executed []string
+	nodeExecutor = func(node *dependency.Node, _ *schema.ConfigAndStacksInfo, _ ...ShellCommandOption) error {
+		executed = append(executed, node.ID)
+		return nil
+	}
+
+	info := schema.ConfigAndStacksInfo{
+		ComponentType: "terraform",
+		SubCommand:    "plan",
+		All:           true,
+		Parallelism:   4,
+		Query:         ".vars.tags.team == \"nonexistent-team\"",
+	}
+
+	err := ExecuteTerraformGraph(&info)
+	require.NoError(t, err)
+	assert.Empty(t, executed)
+}
+
 // TestWalkTerraformComponents verifies that walkTerraformComponents iterates over all components.
 func TestWalkTerraformComponents(t *testing.T) {
 	t.Run("iterates all components", func(t *testing.T) {
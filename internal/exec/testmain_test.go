@@ -34,5 +34,15 @@ func TestMain(m *testing.M) {
 	if os.Getenv("_ATMOS_TEST_EXIT_ONE") == "1" {
 		os.Exit(1)
 	}
-	os.Exit(m.Run())
+
+	// Record workflow runs in a temporary state directory instead of the user's XDG state dir.
+	stateHome, err := os.MkdirTemp("", "atmos-exec-test-state")
+	if err == nil {
+		_ = os.Setenv("ATMOS_XDG_STATE_HOME", stateHome)
+	}
+	code := m.Run()
+	if stateHome != "" {
+		_ = os.RemoveAll(stateHome)
+	}
+	os.Exit(code)
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	errUtils "github.com/cloudposse/atmos/errors"
 	cfg "github.com/cloudposse/atmos/pkg/config"
 	"github.com/cloudposse/atmos/pkg/perf"
 	"github.com/cloudposse/atmos/pkg/schema"
@@ -15,7 +16,6 @@ import (
 
 var (
 	ErrVendorConfigNotExist       = errors.New("the '--everything' flag is set, but vendor config file does not exist")
-	ErrExecuteVendorDiffCmd       = errors.New("'atmos vendor diff' is not implemented yet")
 	ErrValidateComponentFlag      = errors.New("either '--component' or '--tags' flag can be provided, but not both")
 	ErrValidateComponentStackFlag = errors.New("either '--component' or '--stack' flag can be provided, but not both")
 	ErrValidateEverythingFlag     = errors.New("'--everything' flag cannot be combined with '--component', '--stack', or '--tags' flags")
@@ -30,11 +30,6 @@ func ExecuteVendorPullCmd(cmd *cobra.Command, args []string) error {
 	return ExecuteVendorPullCommand(cmd, args)
 }
 
-// ExecuteVendorDiffCmd executes `vendor diff` commands.
-func ExecuteVendorDiffCmd(cmd *cobra.Command, args []string) error {
-	return ErrExecuteVendorDiffCmd
-}
-
 type VendorFlags struct {
 	DryRun        bool
 	Component     string
@@ -42,6 +37,7 @@ type VendorFlags struct {
 	Tags          []string
 	Everything    bool
 	ComponentType string
+	Locked        bool
 }
 
 // ExecuteVendorPullCommand executes `atmos vendor` commands.
@@ -70,6 +66,10 @@ func ExecuteVendorPullCommand(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if vendorFlags.Locked && vendorFlags.Stack != "" {
+		return lockedRequiresVendorConfigError()
+	}
+
 	if vendorFlags.Stack != "" {
 		return ExecuteStackVendorInternal(vendorFlags.Stack, vendorFlags.DryRun)
 	}
@@ -115,6 +115,13 @@ func parseVendorFlags(flags *pflag.FlagSet) (VendorFlags, error) {
 		}
 	}
 
+	// Handle 'locked' flag only if it exists
+	if flags.Lookup("locked") != nil {
+		if vendorFlags.Locked, err = flags.GetBool("locked"); err != nil {
+			return vendorFlags, err
+		}
+	}
+
 	return vendorFlags, nil
 }
 
@@ -162,9 +169,14 @@ func handleVendorConfig(atmosConfig *schema.AtmosConfiguration, flg *VendorFlags
 			atmosVendorSpec:      vendorConfig.Spec,
 			component:            flg.Component,
 			tags:                 flg.Tags,
+			locked:               flg.Locked,
 		})
 	}
 
+	if flg.Locked {
+		return lockedRequiresVendorConfigError()
+	}
+
 	if flg.Component != "" {
 		return handleComponentVendor(atmosConfig, flg)
 	}
@@ -176,6 +188,14 @@ func handleVendorConfig(atmosConfig *schema.AtmosConfiguration, flg *VendorFlags
 	return ErrMissingComponent
 }
 
+// lockedRequiresVendorConfigError returns the error for `--locked` outside of the vendor config file flow.
+func lockedRequiresVendorConfigError() error {
+	return errUtils.Build(errUtils.ErrVendorLockedRequiresFile).
+		WithExplanationf("The lock file is only written for sources in '%s'", cfg.AtmosVendorConfigFileName).
+		WithHint("Define the sources in the vendor config file and run `atmos vendor pull` to create the lock file").
+		Err()
+}
+
 func handleComponentVendor(atmosConfig *schema.AtmosConfiguration, flg *VendorFlags) error {
 	componentType := flg.ComponentType
 	if componentType == "" {
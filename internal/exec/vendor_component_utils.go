@@ -165,20 +165,47 @@ func ExecuteComponentVendorInternal(
 ) error {
 	defer perf.Track(atmosConfig, "exec.ExecuteComponentVendorInternal")()
 
+	componentPkg, err := newComponentPackage(vendorComponentSpec, component, componentPath)
+	if err != nil {
+		return err
+	}
+
+	var packages []pkgComponentVendor
+	packages = append(packages, componentPkg)
+	// Process mixins
+	if len(vendorComponentSpec.Mixins) > 0 {
+		mixinPkgs, err := processComponentMixins(vendorComponentSpec, componentPath)
+		if err != nil {
+			return err
+		}
+		packages = append(packages, mixinPkgs...)
+	}
+	if len(packages) > 0 {
+		return executeVendorModel(packages, dryRun, atmosConfig)
+	}
+	return nil
+}
+
+// newComponentPackage returns the package for the source of a component vendoring config file.
+func newComponentPackage(
+	vendorComponentSpec *schema.VendorComponentSpec,
+	component string,
+	componentPath string,
+) (pkgComponentVendor, error) {
 	if vendorComponentSpec.Source.Uri == "" {
-		return fmt.Errorf("%w:'%s'", ErrUriMustSpecified, cfg.ComponentVendorConfigFileName)
+		return pkgComponentVendor{}, fmt.Errorf("%w:'%s'", ErrUriMustSpecified, cfg.ComponentVendorConfigFileName)
 	}
 	uri := vendorComponentSpec.Source.Uri
 	// Parse 'uri' template
 	if vendorComponentSpec.Source.Version != "" {
 		t, err := template.New(fmt.Sprintf("source-uri-%s", vendorComponentSpec.Source.Version)).Funcs(getSprigFuncMap()).Funcs(gomplate.CreateFuncs(context.Background(), nil)).Parse(vendorComponentSpec.Source.Uri)
 		if err != nil {
-			return err
+			return pkgComponentVendor{}, err
 		}
 		var tpl bytes.Buffer
 		err = t.Execute(&tpl, vendorComponentSpec.Source)
 		if err != nil {
-			return err
+			return pkgComponentVendor{}, err
 		}
 		uri = tpl.String()
 	}
@@ -194,7 +221,7 @@ func ExecuteComponentVendorInternal(
 		uri, useLocalFileSystem, sourceIsLocalFile = handleLocalFileScheme(componentPath, uri)
 	}
 	pType := determinePackageType(useOciScheme, useLocalFileSystem)
-	componentPkg := pkgComponentVendor{
+	return pkgComponentVendor{
 		uri:                 uri,
 		name:                component,
 		componentPath:       componentPath,
@@ -203,22 +230,7 @@ func ExecuteComponentVendorInternal(
 		version:             vendorComponentSpec.Source.Version,
 		vendorComponentSpec: vendorComponentSpec,
 		IsComponent:         true,
-	}
-
-	var packages []pkgComponentVendor
-	packages = append(packages, componentPkg)
-	// Process mixins
-	if len(vendorComponentSpec.Mixins) > 0 {
-		mixinPkgs, err := processComponentMixins(vendorComponentSpec, componentPath)
-		if err != nil {
-			return err
-		}
-		packages = append(packages, mixinPkgs...)
-	}
-	if len(packages) > 0 {
-		return executeVendorModel(packages, dryRun, atmosConfig)
-	}
-	return nil
+	}, nil
 }
 
 // handleLocalFileScheme processes the URI for local file system paths.
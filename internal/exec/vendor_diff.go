@@ -0,0 +1,264 @@
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/data"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/ui"
+	"github.com/cloudposse/atmos/pkg/vendor"
+)
+
+// VendorDiffOptions holds the options of `atmos vendor diff`.
+type VendorDiffOptions struct {
+	Component     string
+	ComponentType string
+	// Version is the upstream version to compare with. Defaults to the version in the vendor config.
+	Version string
+}
+
+// ExecuteVendorDiffCmd executes `vendor diff` commands.
+func ExecuteVendorDiffCmd(cmd *cobra.Command, args []string) error {
+	defer perf.Track(nil, "exec.ExecuteVendorDiffCmd")()
+
+	info, err := ProcessCommandLineArgs("terraform", cmd, args, nil)
+	if err != nil {
+		return err
+	}
+
+	atmosConfig, err := cfg.InitCliConfig(info, false)
+	if err != nil {
+		return fmt.Errorf("failed to initialize CLI config: %w", err)
+	}
+
+	flags := cmd.Flags()
+	var opts VendorDiffOptions
+	if opts.Component, err = flags.GetString("component"); err != nil {
+		return err
+	}
+	if opts.ComponentType, err = flags.GetString("type"); err != nil {
+		return err
+	}
+	if opts.Version, err = flags.GetString("version"); err != nil {
+		return err
+	}
+
+	diff, err := ExecuteVendorDiff(&atmosConfig, &opts)
+	if err != nil {
+		return err
+	}
+	if diff == "" {
+		ui.Successf("No differences between the vendored and the upstream version of `%s`", opts.Component)
+		return nil
+	}
+	return data.Write(diff)
+}
+
+// ExecuteVendorDiff downloads the upstream version of a vendored component into a temporary directory and
+// returns a unified diff from the vendored copy to it. Components are looked up in `vendor.yaml` first,
+// then in the component's `component.yaml`.
+func ExecuteVendorDiff(atmosConfig *schema.AtmosConfiguration, opts *VendorDiffOptions) (string, error) {
+	defer perf.Track(atmosConfig, "exec.ExecuteVendorDiff")()
+
+	if opts.Component == "" {
+		return "", errUtils.Build(errUtils.ErrVendorComponentRequired).
+			WithHint("Example: atmos vendor diff -c vpc").
+			Err()
+	}
+
+	vendorConfig, vendorConfigExists, foundVendorConfigFile, err := ReadAndProcessVendorConfigFile(
+		atmosConfig,
+		cfg.AtmosVendorConfigFileName,
+		true,
+	)
+	if err != nil {
+		return "", err
+	}
+	if vendorConfigExists {
+		source, err := findVendorSource(atmosConfig, foundVendorConfigFile, &vendorConfig.Spec, opts.Component)
+		if err != nil {
+			return "", err
+		}
+		if source != nil {
+			return diffAtmosVendorSource(atmosConfig, foundVendorConfigFile, source, opts.Version)
+		}
+	}
+
+	componentType := opts.ComponentType
+	if componentType == "" {
+		componentType = cfg.TerraformComponentType
+	}
+	config, componentPath, err := ReadAndProcessComponentVendorConfigFile(atmosConfig, opts.Component, componentType)
+	if err != nil {
+		return "", err
+	}
+	return diffComponentVendor(atmosConfig, &config.Spec, opts.Component, componentPath, opts.Version)
+}
+
+// findVendorSource returns the source of a component from a vendor config file and its imports, or nil.
+func findVendorSource(
+	atmosConfig *schema.AtmosConfiguration,
+	vendorConfigFile string,
+	spec *schema.AtmosVendorSpec,
+	component string,
+) (*schema.AtmosVendorSource, error) {
+	sources, _, err := processVendorImports(atmosConfig, vendorConfigFile, spec.Imports, spec.Sources, []string{vendorConfigFile})
+	if err != nil {
+		return nil, err
+	}
+	for i := range sources {
+		if sources[i].Component == component {
+			return &sources[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// diffAtmosVendorSource diffs the first target of a `vendor.yaml` source against the upstream version.
+func diffAtmosVendorSource(
+	atmosConfig *schema.AtmosConfiguration,
+	vendorConfigFile string,
+	source *schema.AtmosVendorSource,
+	version string,
+) (string, error) {
+	if len(source.Targets) == 0 {
+		return "", fmt.Errorf("%w for component '%s'", ErrTargetsMissing, source.Component)
+	}
+
+	current := *source
+	current.Targets = source.Targets[:1]
+	upstream := current
+	if version != "" {
+		upstream.Version = version
+		upstream.Targets = schema.AtmosVendorTargets{{Path: current.Targets[0].Path}}
+	}
+
+	params := &vendorSourceParams{
+		atmosConfig:          atmosConfig,
+		component:            source.Component,
+		vendorConfigFileName: vendorConfigFile,
+		vendorConfigFilePath: filepath.Dir(vendorConfigFile),
+	}
+	params.sources = []schema.AtmosVendorSource{current}
+	local, err := processAtmosVendorSource(params)
+	if err != nil {
+		return "", err
+	}
+	params.sources = []schema.AtmosVendorSource{upstream}
+	upstreamPkgs, err := processAtmosVendorSource(params)
+	if err != nil {
+		return "", err
+	}
+
+	stagingDir, err := createTempDir()
+	if err != nil {
+		return "", err
+	}
+	defer removeTempDir(stagingDir)
+
+	upstreamPath, err := upstreamPkgs[0].fetchInto(atmosConfig, stagingDir)
+	if err != nil {
+		return "", fmt.Errorf("%w '%s': %w", errUtils.ErrVendorDiff, source.Component, err)
+	}
+
+	// Without a lock entry, files that only exist locally may come from other sources (e.g. mixins) and are not shown.
+	lock, err := vendor.ReadLockFile(vendor.LockFilePath(vendorConfigFile))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return "", err
+	}
+	lockEntry := lock.Find(local[0].lockTarget)
+	skip, err := localOnlySkipFunc(upstreamPath, func(name string) bool {
+		if lockEntry == nil {
+			return false
+		}
+		_, ok := lockEntry.Files[name]
+		return ok
+	})
+	if err != nil {
+		return "", err
+	}
+
+	target := local[0].lockTarget
+	return vendor.DiffPaths(local[0].targetPath, upstreamPath, "a/"+target, "b/"+target, skip)
+}
+
+// diffComponentVendor diffs a component vendored with `component.yaml` against the upstream version.
+// Mixins are not compared.
+func diffComponentVendor(
+	atmosConfig *schema.AtmosConfiguration,
+	vendorComponentSpec *schema.VendorComponentSpec,
+	component string,
+	componentPath string,
+	version string,
+) (string, error) {
+	spec := *vendorComponentSpec
+	if version != "" {
+		spec.Source.Version = version
+	}
+
+	p, err := newComponentPackage(&spec, component, componentPath)
+	if err != nil {
+		return "", err
+	}
+
+	stagingDir, err := createTempDir()
+	if err != nil {
+		return "", err
+	}
+	defer removeTempDir(stagingDir)
+
+	p.componentPath = filepath.Join(stagingDir, filepath.Base(componentPath))
+	if err := installComponent(&p, atmosConfig); err != nil {
+		return "", fmt.Errorf("%w '%s': %w", errUtils.ErrVendorDiff, component, err)
+	}
+
+	// The component directory also holds `component.yaml`, mixins and generated files, so local-only files are not shown.
+	skip, err := localOnlySkipFunc(p.componentPath, func(string) bool { return false })
+	if err != nil {
+		return "", err
+	}
+
+	label := filepath.ToSlash(filepath.Base(componentPath))
+	return vendor.DiffPaths(componentPath, p.componentPath, "a/"+label, "b/"+label, skip)
+}
+
+// localOnlySkipFunc returns a diff skip function for files that are not in the upstream copy,
+// unless keep reports them as vendored.
+func localOnlySkipFunc(upstreamPath string, keep func(name string) bool) (func(string) bool, error) {
+	_, upstreamFiles, err := vendor.HashPath(upstreamPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	return func(name string) bool {
+		_, inUpstream := upstreamFiles[name]
+		return !inUpstream && !keep(name)
+	}, nil
+}
+
+// fetchInto downloads the package and copies it into dir like `vendor pull` copies it to the target,
+// applying `included_paths` and `excluded_paths`. It returns the path of the copy.
+func (p *pkgAtmosVendor) fetchInto(atmosConfig *schema.AtmosConfiguration, dir string) (string, error) {
+	tempDir, err := createTempDir()
+	if err != nil {
+		return "", err
+	}
+	defer removeTempDir(tempDir)
+
+	if err := p.installer(&tempDir, atmosConfig, &vendor.LockEntry{}); err != nil {
+		return "", err
+	}
+
+	staged := filepath.Join(dir, filepath.Base(p.targetPath))
+	if err := copyToTargetWithPatterns(tempDir, staged, &p.atmosVendorSource, p.sourceIsLocalFile); err != nil {
+		return "", fmt.Errorf("failed to copy package: %w", err)
+	}
+	return staged, nil
+}
@@ -0,0 +1,185 @@
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"strings"
+
+	cp "github.com/otiai10/copy"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/vendor"
+)
+
+// maxReportedDrifts limits how many drifted files are listed in a single error.
+const maxReportedDrifts = 10
+
+// lockType returns the lock entry type of the package.
+func (p *pkgAtmosVendor) lockType(commit string) string {
+	switch p.pkgType {
+	case pkgTypeOci:
+		return vendor.LockTypeOCI
+	case pkgTypeLocal:
+		return vendor.LockTypeLocal
+	default:
+		if commit != "" {
+			return vendor.LockTypeGit
+		}
+		return vendor.LockTypeRemote
+	}
+}
+
+// newLockEntry returns the lock entry for the package. The Git commit and OCI digest are recorded by the installer.
+func (p *pkgAtmosVendor) newLockEntry() *vendor.LockEntry {
+	return &vendor.LockEntry{
+		Component: p.atmosVendorSource.Component,
+		Source:    p.uri,
+		Version:   p.version,
+		Target:    p.lockTarget,
+		Type:      p.lockType(""),
+	}
+}
+
+// checkCommit compares the Git commit of the cloned source with the lock file in locked mode.
+func (p *pkgAtmosVendor) checkCommit(commit string) error {
+	if p.lockedEntry == nil || p.lockedEntry.Commit == "" || commit == p.lockedEntry.Commit {
+		return nil
+	}
+	return p.driftError(fmt.Sprintf("source resolves to commit %s, but the lock file has commit %s", commit, p.lockedEntry.Commit))
+}
+
+// checkDigest compares the pulled OCI digest with the lock file in locked mode.
+func (p *pkgAtmosVendor) checkDigest(digest string) error {
+	if p.lockedEntry == nil || p.lockedEntry.Digest == "" || digest == p.lockedEntry.Digest {
+		return nil
+	}
+	return p.driftError(fmt.Sprintf("image resolves to digest %s, but the lock file has digest %s", digest, p.lockedEntry.Digest))
+}
+
+// stageAndInstall copies the downloaded package into a staging directory, records its content in the lock entry,
+// checks it against the lock file in locked mode, and only then copies it to the target.
+func (p *pkgAtmosVendor) stageAndInstall(tempDir string, entry *vendor.LockEntry) error {
+	stagingDir, err := createTempDir()
+	if err != nil {
+		return err
+	}
+	defer removeTempDir(stagingDir)
+
+	stagedTarget := filepath.Join(stagingDir, filepath.Base(p.targetPath))
+	if err := copyToTargetWithPatterns(tempDir, stagedTarget, &p.atmosVendorSource, p.sourceIsLocalFile); err != nil {
+		return fmt.Errorf("failed to copy package: %w", err)
+	}
+
+	entry.Hash, entry.Files, err = vendor.HashPath(stagedTarget)
+	if err != nil {
+		return fmt.Errorf("failed to hash package: %w", err)
+	}
+
+	if p.lockedEntry != nil && entry.Hash != p.lockedEntry.Hash {
+		drifts := vendor.DiffFiles(p.lockedEntry.Files, entry.Files, true)
+		return p.driftError("downloaded files differ: " + formatDrifts(drifts))
+	}
+
+	copyOptions := cp.Options{
+		// Leave an existing .git directory in the target untouched, see copyToTargetWithPatterns.
+		OnDirExists: func(src, dest string) cp.DirExistsAction {
+			if filepath.Base(dest) == gitDirName {
+				return cp.Untouchable
+			}
+			return cp.Merge
+		},
+	}
+	if err := cp.Copy(stagedTarget, p.targetPath, copyOptions); err != nil {
+		return fmt.Errorf("failed to copy package: %w", err)
+	}
+	return nil
+}
+
+// driftError returns the error reported when a package does not match the lock file.
+// The details are part of the message because the vendor model prints per-package errors inline.
+func (p *pkgAtmosVendor) driftError(explanation string) error {
+	return fmt.Errorf("%w (target '%s'): %s", errUtils.ErrVendorLockDrift, p.lockTarget, explanation)
+}
+
+// formatDrifts renders drifted files as a comma-separated list, truncated to maxReportedDrifts.
+func formatDrifts(drifts []vendor.Drift) string {
+	var lines []string
+	for i, drift := range drifts {
+		if i == maxReportedDrifts {
+			lines = append(lines, fmt.Sprintf("and %d more", len(drifts)-maxReportedDrifts))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("%s %s", drift.Kind, drift.Path))
+	}
+	return strings.Join(lines, ", ")
+}
+
+// lockTargetPath returns the target path as recorded in the lock file.
+func lockTargetPath(target string) string {
+	return filepath.ToSlash(filepath.Clean(target))
+}
+
+// applyVendorLock attaches the lock file entries to the packages for `vendor pull --locked`.
+func applyVendorLock(vendorConfigFileName string, packages []pkgAtmosVendor) error {
+	lockPath := vendor.LockFilePath(vendorConfigFileName)
+	lock, err := vendor.ReadLockFile(lockPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return errUtils.Build(errUtils.ErrVendorLockFileNotFound).
+			WithExplanationf("`--locked` requires the lock file '%s'", lockPath).
+			WithHint("Run `atmos vendor pull` to create the lock file and commit it").
+			Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := range packages {
+		p := &packages[i]
+		p.locked = true
+		p.lockedEntry = lock.Find(p.lockTarget)
+	}
+	return nil
+}
+
+// writeVendorLock records the lock entries of a successful `vendor pull`.
+// A full pull replaces the lock file; a pull filtered by component or tags only updates the pulled targets.
+// Entries of packages that failed to vendor are kept as they were.
+func writeVendorLock(params *executeVendorOptions, packages []pkgAtmosVendor, entries []vendor.LockEntry) error {
+	lockPath := vendor.LockFilePath(params.vendorConfigFileName)
+	lock, err := vendor.ReadLockFile(lockPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if params.component == "" && len(params.tags) == 0 {
+		targets := make(map[string]bool, len(packages))
+		for i := range packages {
+			targets[packages[i].lockTarget] = true
+		}
+		kept := lock.Sources[:0]
+		for _, existing := range lock.Sources {
+			if targets[existing.Target] {
+				kept = append(kept, existing)
+			}
+		}
+		lock.Sources = kept
+	}
+
+	for _, entry := range entries {
+		lock.Upsert(entry)
+	}
+
+	if err := vendor.WriteLockFile(lockPath, lock); err != nil {
+		return err
+	}
+	log.Debug("Wrote vendor lock file", "path", lockPath, "sources", len(lock.Sources))
+	return nil
+}
+
+// lockEntryMissingError returns the error reported in locked mode for a target that is not in the lock file.
+func lockEntryMissingError(p *pkgAtmosVendor) error {
+	return fmt.Errorf("%w: target '%s'", errUtils.ErrVendorLockEntryMissing, p.lockTarget)
+}
@@ -0,0 +1,132 @@
+package exec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/vendor"
+)
+
+// newLockTestVendor creates a local source directory and vendor options that vendor it into `components/vpc`.
+func newLockTestVendor(t *testing.T) (string, string, *executeVendorOptions) {
+	t.Helper()
+
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "main.tf"), []byte("# main"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "variables.tf"), []byte("# vars"), 0o644))
+
+	baseDir := t.TempDir()
+	return srcDir, baseDir, &executeVendorOptions{
+		atmosConfig:          &schema.AtmosConfiguration{},
+		vendorConfigFileName: filepath.Join(baseDir, "vendor.yaml"),
+		atmosVendorSpec: schema.AtmosVendorSpec{
+			Sources: []schema.AtmosVendorSource{{
+				Component: "vpc",
+				Source:    srcDir,
+				Targets:   schema.AtmosVendorTargets{{Path: "components/vpc"}},
+			}},
+		},
+	}
+}
+
+func TestExecuteAtmosVendorInternal_WritesLockFile(t *testing.T) {
+	_, baseDir, params := newLockTestVendor(t)
+
+	require.NoError(t, ExecuteAtmosVendorInternal(params))
+
+	lock, err := vendor.ReadLockFile(filepath.Join(baseDir, vendor.LockFileName))
+	require.NoError(t, err)
+	require.Len(t, lock.Sources, 1)
+
+	entry := lock.Sources[0]
+	assert.Equal(t, "vpc", entry.Component)
+	assert.Equal(t, "components/vpc", entry.Target)
+	assert.Equal(t, vendor.LockTypeLocal, entry.Type)
+	assert.Contains(t, entry.Files, "main.tf")
+	assert.Contains(t, entry.Files, "variables.tf")
+
+	hash, _, err := vendor.HashPath(filepath.Join(baseDir, "components", "vpc"))
+	require.NoError(t, err)
+	assert.Equal(t, hash, entry.Hash)
+}
+
+func TestExecuteAtmosVendorInternal_Locked(t *testing.T) {
+	srcDir, baseDir, params := newLockTestVendor(t)
+	params.locked = true
+
+	// Without a lock file.
+	err := ExecuteAtmosVendorInternal(params)
+	assert.ErrorIs(t, err, errUtils.ErrVendorLockFileNotFound)
+
+	params.locked = false
+	require.NoError(t, ExecuteAtmosVendorInternal(params))
+	lockBefore, err := os.ReadFile(filepath.Join(baseDir, vendor.LockFileName))
+	require.NoError(t, err)
+
+	// Unchanged source.
+	params.locked = true
+	require.NoError(t, ExecuteAtmosVendorInternal(params))
+
+	// Changed source: the pull fails and neither the target nor the lock file is touched.
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "main.tf"), []byte("# changed"), 0o644))
+	err = ExecuteAtmosVendorInternal(params)
+	assert.ErrorIs(t, err, ErrVendorComponents)
+
+	content, err := os.ReadFile(filepath.Join(baseDir, "components", "vpc", "main.tf"))
+	require.NoError(t, err)
+	assert.Equal(t, "# main", string(content))
+
+	lockAfter, err := os.ReadFile(filepath.Join(baseDir, vendor.LockFileName))
+	require.NoError(t, err)
+	assert.Equal(t, string(lockBefore), string(lockAfter))
+}
+
+func TestWriteVendorLock_FilteredPullKeepsOtherEntries(t *testing.T) {
+	baseDir := t.TempDir()
+	params := &executeVendorOptions{vendorConfigFileName: filepath.Join(baseDir, "vendor.yaml")}
+	lockPath := filepath.Join(baseDir, vendor.LockFileName)
+
+	packages := []pkgAtmosVendor{{lockTarget: "components/vpc"}, {lockTarget: "components/alb"}}
+	require.NoError(t, writeVendorLock(params, packages, []vendor.LockEntry{
+		{Component: "vpc", Target: "components/vpc", Hash: "h1:vpc"},
+		{Component: "alb", Target: "components/alb", Hash: "h1:alb"},
+	}))
+
+	// A pull filtered by component only updates its own entry.
+	params.component = "vpc"
+	require.NoError(t, writeVendorLock(params, packages[:1], []vendor.LockEntry{
+		{Component: "vpc", Target: "components/vpc", Hash: "h1:vpc2"},
+	}))
+	lock, err := vendor.ReadLockFile(lockPath)
+	require.NoError(t, err)
+	require.Len(t, lock.Sources, 2)
+	assert.Equal(t, "h1:vpc2", lock.Find("components/vpc").Hash)
+
+	// A full pull drops entries for targets that are no longer in the vendor config.
+	params.component = ""
+	require.NoError(t, writeVendorLock(params, packages[:1], []vendor.LockEntry{
+		{Component: "vpc", Target: "components/vpc", Hash: "h1:vpc3"},
+	}))
+	lock, err = vendor.ReadLockFile(lockPath)
+	require.NoError(t, err)
+	require.Len(t, lock.Sources, 1)
+	assert.Nil(t, lock.Find("components/alb"))
+}
+
+func TestPkgAtmosVendor_CheckCommit(t *testing.T) {
+	p := &pkgAtmosVendor{name: "vpc", pkgType: pkgTypeRemote}
+	assert.NoError(t, p.checkCommit("1111111111111111111111111111111111111111"))
+
+	p.lockedEntry = &vendor.LockEntry{Commit: "1111111111111111111111111111111111111111"}
+	assert.NoError(t, p.checkCommit("1111111111111111111111111111111111111111"))
+
+	err := p.checkCommit("2222222222222222222222222222222222222222")
+	assert.ErrorIs(t, err, errUtils.ErrVendorLockDrift)
+	assert.Contains(t, err.Error(), "2222222222222222222222222222222222222222")
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/cloudposse/atmos/pkg/schema"
 	"github.com/cloudposse/atmos/pkg/ui/theme"
 	u "github.com/cloudposse/atmos/pkg/utils"
+	"github.com/cloudposse/atmos/pkg/vendor"
 )
 
 type pkgType int
@@ -48,6 +49,8 @@ var (
 type installedPkgMsg struct {
 	err  error
 	name string
+	// lock is the lock entry of a vendored package (only set for packages from the vendor config file).
+	lock *vendor.LockEntry
 }
 
 func (p pkgType) String() string {
@@ -75,6 +78,11 @@ type pkgAtmosVendor struct {
 	pkgType           pkgType
 	version           string
 	atmosVendorSource schema.AtmosVendorSource
+	// lockTarget is the target path relative to the vendor config directory, as recorded in the lock file.
+	lockTarget string
+	// locked is set by `vendor pull --locked`; lockedEntry is the matching lock file entry (nil if missing).
+	locked      bool
+	lockedEntry *vendor.LockEntry
 }
 type pkgComponentVendor struct {
 	uri                 string
@@ -102,6 +110,7 @@ type modelVendor struct {
 	failedPkgNames []string
 	atmosConfig    *schema.AtmosConfiguration
 	isTTY          bool
+	lockEntries    []vendor.LockEntry
 }
 
 func executeVendorModel[T pkgComponentVendor | pkgAtmosVendor](
@@ -112,10 +121,27 @@ func executeVendorModel[T pkgComponentVendor | pkgAtmosVendor](
 	if len(packages) == 0 {
 		return nil
 	}
+	model, err := runVendorModel(packages, dryRun, atmosConfig)
+	if err != nil {
+		return err
+	}
+
+	if model.failedPkg > 0 {
+		return vendorFailureError(model.failedPkg, len(model.packages), model.failedPkgNames)
+	}
+	return nil
+}
+
+// runVendorModel installs the packages and returns the final model, which holds the failed packages and lock entries.
+func runVendorModel[T pkgComponentVendor | pkgAtmosVendor](
+	packages []T,
+	dryRun bool,
+	atmosConfig *schema.AtmosConfiguration,
+) (*modelVendor, error) {
 	// Initialize model based on package type
 	model, err := newModelVendor(packages, dryRun, atmosConfig)
 	if err != nil {
-		return fmt.Errorf("%w: %v (verify terminal capabilities and permissions)", errUtils.ErrTUIModel, err)
+		return nil, fmt.Errorf("%w: %v (verify terminal capabilities and permissions)", errUtils.ErrTUIModel, err)
 	}
 
 	var opts []tea.ProgramOption
@@ -125,13 +151,9 @@ func executeVendorModel[T pkgComponentVendor | pkgAtmosVendor](
 	}
 
 	if _, err := tea.NewProgram(&model, opts...).Run(); err != nil {
-		return fmt.Errorf("execution failed: %w", err)
-	}
-
-	if model.failedPkg > 0 {
-		return vendorFailureError(model.failedPkg, len(model.packages), model.failedPkgNames)
+		return nil, fmt.Errorf("execution failed: %w", err)
 	}
-	return nil
+	return &model, nil
 }
 
 // vendorFailureError builds a descriptive error listing the names of the
@@ -266,6 +288,8 @@ func (m *modelVendor) handleInstalledPkgMsg(msg *installedPkgMsg) (tea.Model, te
 		mark = xMark
 		m.failedPkg++
 		m.failedPkgNames = append(m.failedPkgNames, pkg.name)
+	} else if msg.lock != nil {
+		m.lockEntries = append(m.lockEntries, *msg.lock)
 	}
 	version := ""
 	if pkg.version != "" {
@@ -363,27 +387,33 @@ func downloadAndInstall(p *pkgAtmosVendor, dryRun bool, atmosConfig *schema.Atmo
 		if dryRun {
 			return handleDryRunInstall(p, atmosConfig)
 		}
+		if p.locked && p.lockedEntry == nil {
+			return newInstallError(lockEntryMissingError(p), p.name)
+		}
 		tempDir, err := createTempDir()
 		if err != nil {
 			return newInstallError(err, p.name)
 		}
 
 		defer removeTempDir(tempDir)
-		if err := p.installer(&tempDir, atmosConfig); err != nil {
+		entry := p.newLockEntry()
+		if err := p.installer(&tempDir, atmosConfig, entry); err != nil {
 			return newInstallError(err, p.name)
 		}
 
-		if err := copyToTargetWithPatterns(tempDir, p.targetPath, &p.atmosVendorSource, p.sourceIsLocalFile); err != nil {
-			return newInstallError(fmt.Errorf("failed to copy package: %w", err), p.name)
+		// Stage the files first so that in locked mode nothing is written to the target on drift.
+		if err := p.stageAndInstall(tempDir, entry); err != nil {
+			return newInstallError(err, p.name)
 		}
 		return installedPkgMsg{
 			err:  nil,
 			name: p.name,
+			lock: entry,
 		}
 	}
 }
 
-func (p *pkgAtmosVendor) installer(tempDir *string, atmosConfig *schema.AtmosConfiguration) error {
+func (p *pkgAtmosVendor) installer(tempDir *string, atmosConfig *schema.AtmosConfiguration, entry *vendor.LockEntry) error {
 	switch p.pkgType {
 	case pkgTypeRemote:
 		// Use go-getter to download remote packages
@@ -391,15 +421,28 @@ func (p *pkgAtmosVendor) installer(tempDir *string, atmosConfig *schema.AtmosCon
 		if p.atmosVendorSource.Retry != nil {
 			opts = append(opts, downloader.WithRetryConfig(p.atmosVendorSource.Retry))
 		}
+		// Record the commit checked out in the clone, so the lock file pins exactly what was downloaded.
+		opts = append(opts, downloader.WithGitCommitHandler(func(commit string) {
+			entry.Commit = commit
+			entry.Type = p.lockType(commit)
+		}))
 		if err := downloader.NewGoGetterDownloader(atmosConfig, opts...).Fetch(p.uri, *tempDir, downloader.ClientModeAny, 10*time.Minute); err != nil {
 			return fmt.Errorf("failed to download package: %w", err)
 		}
+		if err := p.checkCommit(entry.Commit); err != nil {
+			return err
+		}
 
 	case pkgTypeOci:
 		// Process OCI images
-		if err := processOciImage(atmosConfig, p.uri, *tempDir); err != nil {
+		digest, err := processOciImageWithDigest(atmosConfig, p.uri, *tempDir)
+		if err != nil {
 			return fmt.Errorf("failed to process OCI image: %w", err)
 		}
+		entry.Digest = digest
+		if err := p.checkDigest(digest); err != nil {
+			return err
+		}
 
 	case pkgTypeLocal:
 		// Copy from local file system
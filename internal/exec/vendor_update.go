@@ -0,0 +1,318 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.yaml.in/yaml/v3"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/downloader"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/ui"
+	"github.com/cloudposse/atmos/pkg/vendor"
+)
+
+// VendorUpdateOptions holds the options of `atmos vendor update`.
+type VendorUpdateOptions struct {
+	Component     string
+	ComponentType string
+	Tags          []string
+	DryRun        bool
+}
+
+// vendorVersionUpdate is a version change of one component.
+type vendorVersionUpdate struct {
+	component string
+	from      string
+	to        string
+}
+
+// ExecuteVendorUpdateCmd executes `vendor update` commands.
+func ExecuteVendorUpdateCmd(cmd *cobra.Command, args []string) error {
+	defer perf.Track(nil, "exec.ExecuteVendorUpdateCmd")()
+
+	info, err := ProcessCommandLineArgs("terraform", cmd, args, nil)
+	if err != nil {
+		return err
+	}
+
+	atmosConfig, err := cfg.InitCliConfig(info, false)
+	if err != nil {
+		return fmt.Errorf("failed to initialize CLI config: %w", err)
+	}
+
+	flags := cmd.Flags()
+	var opts VendorUpdateOptions
+	if opts.Component, err = flags.GetString("component"); err != nil {
+		return err
+	}
+	if opts.ComponentType, err = flags.GetString("type"); err != nil {
+		return err
+	}
+	tagsCsv, err := flags.GetString("tags")
+	if err != nil {
+		return err
+	}
+	if tagsCsv != "" {
+		opts.Tags = strings.Split(tagsCsv, ",")
+	}
+	if opts.DryRun, err = flags.GetBool("dry-run"); err != nil {
+		return err
+	}
+	if opts.Component != "" && len(opts.Tags) > 0 {
+		return ErrValidateComponentFlag
+	}
+
+	return ExecuteVendorUpdate(&atmosConfig, &opts)
+}
+
+// ExecuteVendorUpdate resolves the newest semver tag of each Git and OCI source and rewrites `version`
+// in `vendor.yaml` (and its imports) or in the component's `component.yaml`, preserving comments.
+func ExecuteVendorUpdate(atmosConfig *schema.AtmosConfiguration, opts *VendorUpdateOptions) error {
+	defer perf.Track(atmosConfig, "exec.ExecuteVendorUpdate")()
+
+	_, vendorConfigExists, foundVendorConfigFile, err := ReadAndProcessVendorConfigFile(
+		atmosConfig,
+		cfg.AtmosVendorConfigFileName,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+
+	var updates []vendorVersionUpdate
+	switch {
+	case vendorConfigExists:
+		found := map[string]bool{}
+		updates, err = updateVendorConfigFile(atmosConfig, foundVendorConfigFile, opts, map[string]bool{}, found)
+		if err != nil {
+			return err
+		}
+		if opts.Component != "" && !found[opts.Component] {
+			return fmt.Errorf("%w component '%s', file '%s'", ErrComponentNotDefined, opts.Component, foundVendorConfigFile)
+		}
+	case opts.Component != "":
+		updates, err = updateComponentVendorConfigFile(atmosConfig, opts)
+		if err != nil {
+			return err
+		}
+	default:
+		return ErrVendoringNotConfigured
+	}
+
+	if len(updates) == 0 {
+		ui.Success("All vendored components are up to date")
+		return nil
+	}
+	for _, update := range updates {
+		if opts.DryRun {
+			ui.Infof("Would update `%s` from `%s` to `%s`", update.component, update.from, update.to)
+		} else {
+			ui.Successf("Updated `%s` from `%s` to `%s`", update.component, update.from, update.to)
+		}
+	}
+	if !opts.DryRun {
+		ui.Hint("Run `atmos vendor pull` to vendor the new versions")
+	}
+	return nil
+}
+
+// updateVendorConfigFile updates the versions of the sources in a vendor config file or directory and,
+// recursively, in its local imports. found collects the components that were seen.
+func updateVendorConfigFile(
+	atmosConfig *schema.AtmosConfiguration,
+	vendorConfigFile string,
+	opts *VendorUpdateOptions,
+	visited map[string]bool,
+	found map[string]bool,
+) ([]vendorVersionUpdate, error) {
+	configFiles, err := getConfigFiles(vendorConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var updates []vendorVersionUpdate
+	for _, configFile := range configFiles {
+		if visited[configFile] {
+			continue
+		}
+		visited[configFile] = true
+
+		fileUpdates, imports, err := updateVendorConfigSources(atmosConfig, configFile, opts, found)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, fileUpdates...)
+
+		for _, imp := range imports {
+			_, exists, importFile, err := ReadAndProcessVendorConfigFile(atmosConfig, imp, false)
+			if err != nil {
+				return nil, err
+			}
+			if !exists {
+				log.Debug("Skipping vendor config import that is not a local file", "import", imp)
+				continue
+			}
+			importUpdates, err := updateVendorConfigFile(atmosConfig, importFile, opts, visited, found)
+			if err != nil {
+				return nil, err
+			}
+			updates = append(updates, importUpdates...)
+		}
+	}
+	return updates, nil
+}
+
+// updateVendorConfigSources updates the source versions in a single vendor config file and returns its imports.
+func updateVendorConfigSources(
+	atmosConfig *schema.AtmosConfiguration,
+	configFile string,
+	opts *VendorUpdateOptions,
+	found map[string]bool,
+) ([]vendorVersionUpdate, []string, error) {
+	content, err := os.ReadFile(configFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	var config schema.AtmosVendorConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, nil, err
+	}
+
+	var updates []vendorVersionUpdate
+	versions := map[string]string{}
+	for i := range config.Spec.Sources {
+		source := &config.Spec.Sources[i]
+		if source.Component == "" || shouldSkipSource(source, opts.Component, opts.Tags) {
+			continue
+		}
+		found[source.Component] = true
+
+		latest, err := latestVendorSourceVersion(atmosConfig, configFile, source)
+		if err != nil {
+			return nil, nil, err
+		}
+		if latest != "" {
+			versions[source.Component] = latest
+			updates = append(updates, vendorVersionUpdate{component: source.Component, from: source.Version, to: latest})
+		}
+	}
+
+	if len(versions) > 0 && !opts.DryRun {
+		updated, _, err := vendor.SetSourceVersions(content, versions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w '%s': %w", errUtils.ErrVendorUpdate, configFile, err)
+		}
+		if err := os.WriteFile(configFile, updated, existingFileMode(configFile)); err != nil {
+			return nil, nil, fmt.Errorf("%w '%s': %w", errUtils.ErrVendorUpdate, configFile, err)
+		}
+	}
+	return updates, config.Spec.Imports, nil
+}
+
+// latestVendorSourceVersion returns the newest version of a `vendor.yaml` source, or "" if it is up to date
+// or cannot be updated (no semver `version`, `version` not used in `source`, or a local source).
+func latestVendorSourceVersion(atmosConfig *schema.AtmosConfiguration, configFile string, source *schema.AtmosVendorSource) (string, error) {
+	if source.Version == "" || !strings.Contains(source.Source, ".Version") || len(source.Targets) == 0 {
+		log.Debug("Skipping source without a templated version", "component", source.Component)
+		return "", nil
+	}
+
+	// Only the source URI is needed, so resolve a single target.
+	single := *source
+	single.Targets = source.Targets[:1]
+	pkgs, err := processAtmosVendorSource(&vendorSourceParams{
+		atmosConfig:          atmosConfig,
+		sources:              []schema.AtmosVendorSource{single},
+		vendorConfigFileName: configFile,
+		vendorConfigFilePath: filepath.Dir(configFile),
+	})
+	if err != nil || len(pkgs) == 0 {
+		return "", err
+	}
+	return latestPackageVersion(atmosConfig, source.Component, pkgs[0].uri, pkgs[0].pkgType, source.Version)
+}
+
+// updateComponentVendorConfigFile updates `spec.source.version` in a component's `component.yaml`.
+func updateComponentVendorConfigFile(atmosConfig *schema.AtmosConfiguration, opts *VendorUpdateOptions) ([]vendorVersionUpdate, error) {
+	componentType := opts.ComponentType
+	if componentType == "" {
+		componentType = cfg.TerraformComponentType
+	}
+	config, componentPath, err := ReadAndProcessComponentVendorConfigFile(atmosConfig, opts.Component, componentType)
+	if err != nil {
+		return nil, err
+	}
+
+	current := config.Spec.Source.Version
+	if current == "" || !strings.Contains(config.Spec.Source.Uri, ".Version") {
+		log.Debug("Skipping component without a templated version", "component", opts.Component)
+		return nil, nil
+	}
+
+	p, err := newComponentPackage(&config.Spec, opts.Component, componentPath)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := latestPackageVersion(atmosConfig, opts.Component, p.uri, p.pkgType, current)
+	if err != nil || latest == "" {
+		return nil, err
+	}
+
+	if !opts.DryRun {
+		configFile, err := findComponentConfigFile(componentPath, strings.TrimSuffix(cfg.ComponentVendorConfigFileName, ".yaml"))
+		if err != nil {
+			return nil, err
+		}
+		content, err := os.ReadFile(configFile)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := vendor.SetComponentVersion(content, latest)
+		if err != nil {
+			return nil, fmt.Errorf("%w '%s': %w", errUtils.ErrVendorUpdate, configFile, err)
+		}
+		if err := os.WriteFile(configFile, updated, existingFileMode(configFile)); err != nil {
+			return nil, fmt.Errorf("%w '%s': %w", errUtils.ErrVendorUpdate, configFile, err)
+		}
+	}
+	return []vendorVersionUpdate{{component: opts.Component, from: current, to: latest}}, nil
+}
+
+// latestPackageVersion lists the tags of a Git or OCI source and returns the newest semver tag after current.
+func latestPackageVersion(atmosConfig *schema.AtmosConfiguration, component, uri string, pType pkgType, current string) (string, error) {
+	var tags []string
+	var err error
+	switch pType {
+	case pkgTypeOci:
+		tags, err = listOciTags(atmosConfig, uri)
+	case pkgTypeRemote:
+		tags, err = downloader.ListGitTags(context.Background(), atmosConfig, uri)
+	default:
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("%w for component '%s': %w", errUtils.ErrVendorUpdate, component, err)
+	}
+
+	latest := vendor.LatestVersion(current, tags)
+	log.Debug("Resolved the latest version", "component", component, "current", current, "latest", latest, "tags", len(tags))
+	return latest, nil
+}
+
+// existingFileMode returns the permissions of an existing file, so rewriting it keeps them.
+func existingFileMode(path string) os.FileMode {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0o644
+	}
+	return info.Mode().Perm()
+}
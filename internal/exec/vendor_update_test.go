@@ -0,0 +1,111 @@
+package exec
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// writeVendorUpdateConfig writes local sources `src/v1.0.0` and `src/v1.1.0`, and a `vendor.yaml`
+// that vendors the source at v1.0.0 into `components/vpc`.
+func writeVendorUpdateConfig(t *testing.T) (*schema.AtmosConfiguration, string) {
+	t.Helper()
+
+	baseDir := t.TempDir()
+	for _, version := range []string{"v1.0.0", "v1.1.0"} {
+		dir := filepath.Join(baseDir, "src", version)
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "main.tf"), []byte("# "+version+"\n"), 0o644))
+	}
+
+	vendorFile := filepath.Join(baseDir, "vendor.yaml")
+	content := `apiVersion: atmos/v1
+kind: AtmosVendorConfig
+spec:
+  sources:
+    # The VPC component.
+    - component: vpc
+      source: "` + filepath.ToSlash(baseDir) + `/src/{{.Version}}"
+      version: "v1.0.0" # pinned
+      targets: ["components/vpc"]
+`
+	require.NoError(t, os.WriteFile(vendorFile, []byte(content), 0o644))
+
+	atmosConfig := &schema.AtmosConfiguration{BasePath: baseDir}
+	atmosConfig.Vendor.BasePath = vendorFile
+	return atmosConfig, vendorFile
+}
+
+func TestExecuteVendorUpdate_LocalSource(t *testing.T) {
+	atmosConfig, vendorFile := writeVendorUpdateConfig(t)
+	before, err := os.ReadFile(vendorFile)
+	require.NoError(t, err)
+
+	// Local sources have no tags, so they are left unchanged.
+	require.NoError(t, ExecuteVendorUpdate(atmosConfig, &VendorUpdateOptions{Component: "vpc"}))
+	after, err := os.ReadFile(vendorFile)
+	require.NoError(t, err)
+	assert.Equal(t, string(before), string(after))
+
+	err = ExecuteVendorUpdate(atmosConfig, &VendorUpdateOptions{Component: "eks"})
+	assert.ErrorIs(t, err, ErrComponentNotDefined)
+}
+
+func TestLatestPackageVersion_Git(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := t.TempDir()
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"commit", "--quiet", "--allow-empty", "-m", "first"},
+		{"tag", "v1.0.0"},
+		{"tag", "v1.1.0"},
+		{"tag", "v2.0.0-rc.1"},
+	} {
+		cmd := exec.Command("git", append([]string{"-C", repo}, args...)...)
+		cmd.Env = append(cmd.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	uri := "git::file://" + filepath.ToSlash(repo) + "?ref=v1.0.0"
+	latest, err := latestPackageVersion(&schema.AtmosConfiguration{}, "vpc", uri, pkgTypeRemote, "v1.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.1.0", latest)
+
+	latest, err = latestPackageVersion(&schema.AtmosConfiguration{}, "vpc", "./src", pkgTypeLocal, "v1.0.0")
+	require.NoError(t, err)
+	assert.Empty(t, latest)
+}
+
+func TestExecuteVendorDiff(t *testing.T) {
+	atmosConfig, vendorFile := writeVendorUpdateConfig(t)
+
+	targetDir := filepath.Join(filepath.Dir(vendorFile), "components", "vpc")
+	require.NoError(t, os.MkdirAll(targetDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(targetDir, "main.tf"), []byte("# v1.0.0\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(targetDir, "backend.tf.json"), []byte("{}\n"), 0o644))
+
+	// The vendored copy matches the configured version, and local-only files are not shown.
+	diff, err := ExecuteVendorDiff(atmosConfig, &VendorDiffOptions{Component: "vpc"})
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	diff, err = ExecuteVendorDiff(atmosConfig, &VendorDiffOptions{Component: "vpc", Version: "v1.1.0"})
+	require.NoError(t, err)
+	assert.Equal(t, "--- a/components/vpc/main.tf\n+++ b/components/vpc/main.tf\n@@ -1 +1 @@\n-# v1.0.0\n+# v1.1.0\n", diff)
+
+	_, err = ExecuteVendorDiff(atmosConfig, &VendorDiffOptions{})
+	assert.Error(t, err)
+}
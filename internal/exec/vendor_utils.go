@@ -62,6 +62,7 @@ type executeVendorOptions struct {
 	component            string
 	tags                 []string
 	dryRun               bool
+	locked               bool
 }
 
 type vendorSourceParams struct {
@@ -241,8 +242,30 @@ func ExecuteAtmosVendorInternal(params *executeVendorOptions) error {
 	if err != nil {
 		return err
 	}
-	if len(packages) > 0 {
-		return executeVendorModel(packages, params.dryRun, params.atmosConfig)
+	if len(packages) == 0 {
+		return nil
+	}
+
+	if params.locked {
+		if err := applyVendorLock(params.vendorConfigFileName, packages); err != nil {
+			return err
+		}
+	}
+
+	model, err := runVendorModel(packages, params.dryRun, params.atmosConfig)
+	if err != nil {
+		return err
+	}
+
+	// `--locked` never rewrites the lock file; it only verifies against it.
+	if !params.dryRun && !params.locked && len(model.lockEntries) > 0 {
+		if err := writeVendorLock(params, packages, model.lockEntries); err != nil {
+			return err
+		}
+	}
+
+	if model.failedPkg > 0 {
+		return vendorFailureError(model.failedPkg, len(model.packages), model.failedPkgNames)
 	}
 	return nil
 }
@@ -448,6 +471,7 @@ func processTargets(params *processTargetsParams) ([]pkgAtmosVendor, error) {
 			pkgType:           pType,
 			version:           effectiveVersion,
 			atmosVendorSource: *params.Source,
+			lockTarget:        lockTargetPath(target),
 		}
 		packages = append(packages, p)
 	}
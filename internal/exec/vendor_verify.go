@@ -0,0 +1,106 @@
+package exec
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/ui"
+	"github.com/cloudposse/atmos/pkg/vendor"
+)
+
+// ExecuteVendorVerifyCmd executes `vendor verify` commands.
+func ExecuteVendorVerifyCmd(cmd *cobra.Command, args []string) error {
+	defer perf.Track(nil, "exec.ExecuteVendorVerifyCmd")()
+
+	info, err := ProcessCommandLineArgs("terraform", cmd, args, nil)
+	if err != nil {
+		return err
+	}
+
+	atmosConfig, err := cfg.InitCliConfig(info, false)
+	if err != nil {
+		return fmt.Errorf("failed to initialize CLI config: %w", err)
+	}
+
+	component, err := cmd.Flags().GetString("component")
+	if err != nil {
+		return err
+	}
+
+	return ExecuteVendorVerify(&atmosConfig, component)
+}
+
+// ExecuteVendorVerify re-hashes the vendored targets recorded in the vendor lock file and reports local modifications.
+func ExecuteVendorVerify(atmosConfig *schema.AtmosConfiguration, component string) error {
+	defer perf.Track(atmosConfig, "exec.ExecuteVendorVerify")()
+
+	_, vendorConfigExists, foundVendorConfigFile, err := ReadAndProcessVendorConfigFile(
+		atmosConfig,
+		cfg.AtmosVendorConfigFileName,
+		true,
+	)
+	if err != nil {
+		return err
+	}
+	if !vendorConfigExists {
+		return ErrVendoringNotConfigured
+	}
+
+	lockPath := vendor.LockFilePath(foundVendorConfigFile)
+	lock, err := vendor.ReadLockFile(lockPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return errUtils.Build(errUtils.ErrVendorLockFileNotFound).
+			WithExplanationf("Lock file '%s' does not exist", lockPath).
+			WithHint("Run `atmos vendor pull` to create the lock file").
+			Err()
+	}
+	if err != nil {
+		return err
+	}
+
+	entries := lock.Sources
+	if component != "" {
+		entries = nil
+		for _, entry := range lock.Sources {
+			if entry.Component == component {
+				entries = append(entries, entry)
+			}
+		}
+		if len(entries) == 0 {
+			return errUtils.Build(errUtils.ErrVendorLockEntryMissing).
+				WithExplanationf("Component '%s' is not in the lock file '%s'", component, lockPath).
+				WithHint("Run `atmos vendor pull` to update the lock file").
+				Err()
+		}
+	}
+
+	drifts, err := vendor.Verify(filepath.Dir(foundVendorConfigFile), entries)
+	if err != nil {
+		return err
+	}
+
+	if len(drifts) == 0 {
+		ui.Successf("Verified %d vendored target(s) against '%s'", len(entries), lockPath)
+		return nil
+	}
+
+	for _, drift := range drifts {
+		path := drift.Target
+		if drift.Path != "." {
+			path = drift.Target + "/" + drift.Path
+		}
+		ui.Errorf("%s: %s", drift.Kind, path)
+	}
+	return errUtils.Build(errUtils.ErrVendorVerifyFailed).
+		WithExplanationf("%d vendored file(s) differ from the lock file '%s'", len(drifts), lockPath).
+		WithHint("Revert the local changes, or run `atmos vendor pull` to re-vendor and update the lock file").
+		Err()
+}
@@ -69,47 +69,10 @@ func ExecuteWorkflowCmd(cmd *cobra.Command, args []string) error {
 		}
 		// If file is not provided, attempt auto-discovery.
 		if workflowFile == "" {
-			matches, err := findWorkflowAcrossFiles(workflowName, &atmosConfig)
+			workflowFile, err = discoverWorkflowFile(&atmosConfig, workflowName, true)
 			if err != nil {
 				return err
 			}
-
-			switch {
-			case len(matches) == 0:
-				return errUtils.Build(errUtils.ErrWorkflowNoWorkflow).
-					WithHintf("No workflow found with name `%s`", workflowName).
-					WithHint("Use 'atmos describe workflows' to see all available workflows").
-					WithExitCode(1).
-					Err()
-			case len(matches) == 1:
-				// Single match - use it automatically.
-				workflowFile = matches[0].File
-			default:
-				// Multiple matches - show interactive selector in TTY, error in CI.
-				if !term.IsTTYSupportForStdin() || telemetry.IsCI() {
-					// Non-interactive environment - list matching files and error.
-					fileList := make([]string, len(matches))
-					for i, match := range matches {
-						fileList[i] = match.File
-					}
-					// Sort for deterministic output (important for tests and snapshots).
-					sort.Strings(fileList)
-					return errUtils.Build(errUtils.ErrWorkflowNoWorkflow).
-						WithHintf("Multiple workflow files contain workflow `%s`", workflowName).
-						WithHintf("Matching files: %s", strings.Join(fileList, ", ")).
-						WithHintf("Use --file flag to specify which one: atmos workflow %s --file <file>", workflowName).
-						WithExitCode(1).
-						Err()
-				}
-				// TTY mode - show interactive selector.
-				workflowFile, err = promptForWorkflowFile(matches)
-				if err != nil {
-					if errors.Is(err, errUtils.ErrUserAborted) {
-						return errUtils.ErrUserAborted
-					}
-					return err
-				}
-			}
 		}
 	}
 
@@ -130,6 +93,94 @@ func ExecuteWorkflowCmd(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	workflowPath := resolveWorkflowPath(&atmosConfig, workflowFile)
+
+	workflowDefinition, err := loadWorkflowDefinition(workflowPath, workflowName)
+	if err != nil {
+		return err
+	}
+
+	err = ExecuteWorkflow(atmosConfig, workflowName, workflowPath, workflowDefinition, dryRun, commandLineStack, fromStep, commandLineIdentity)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ExecuteWorkflowByName runs a workflow non-interactively. When workflowFile is empty, the workflow is
+// auto-discovered across all workflow manifests and must be defined in exactly one of them.
+func ExecuteWorkflowByName(atmosConfig *schema.AtmosConfiguration, workflowName, workflowFile, stack string) error {
+	defer perf.Track(atmosConfig, "exec.ExecuteWorkflowByName")()
+
+	var err error
+	if workflowFile == "" {
+		workflowFile, err = discoverWorkflowFile(atmosConfig, workflowName, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	workflowPath := resolveWorkflowPath(atmosConfig, workflowFile)
+	workflowDefinition, err := loadWorkflowDefinition(workflowPath, workflowName)
+	if err != nil {
+		return err
+	}
+
+	return ExecuteWorkflow(*atmosConfig, workflowName, workflowPath, workflowDefinition, false, stack, "", "")
+}
+
+// discoverWorkflowFile finds the workflow manifest that defines the named workflow.
+// When several manifests define it, the user is prompted to choose one if allowPrompt is set and a TTY is attached.
+func discoverWorkflowFile(atmosConfig *schema.AtmosConfiguration, workflowName string, allowPrompt bool) (string, error) {
+	matches, err := findWorkflowAcrossFiles(workflowName, atmosConfig)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case len(matches) == 0:
+		return "", errUtils.Build(errUtils.ErrWorkflowNoWorkflow).
+			WithHintf("No workflow found with name `%s`", workflowName).
+			WithHint("Use 'atmos describe workflows' to see all available workflows").
+			WithExitCode(1).
+			Err()
+	case len(matches) == 1:
+		// Single match - use it automatically.
+		return matches[0].File, nil
+	}
+
+	// Multiple matches - show interactive selector in TTY, error in CI.
+	if !allowPrompt || !term.IsTTYSupportForStdin() || telemetry.IsCI() {
+		// Non-interactive environment - list matching files and error.
+		fileList := make([]string, len(matches))
+		for i, match := range matches {
+			fileList[i] = match.File
+		}
+		// Sort for deterministic output (important for tests and snapshots).
+		sort.Strings(fileList)
+		return "", errUtils.Build(errUtils.ErrWorkflowNoWorkflow).
+			WithHintf("Multiple workflow files contain workflow `%s`", workflowName).
+			WithHintf("Matching files: %s", strings.Join(fileList, ", ")).
+			WithHintf("Use --file flag to specify which one: atmos workflow %s --file <file>", workflowName).
+			WithExitCode(1).
+			Err()
+	}
+
+	// TTY mode - show interactive selector.
+	workflowFile, err := promptForWorkflowFile(matches)
+	if err != nil {
+		if errors.Is(err, errUtils.ErrUserAborted) {
+			return "", errUtils.ErrUserAborted
+		}
+		return "", err
+	}
+	return workflowFile, nil
+}
+
+// resolveWorkflowPath returns the path of a workflow manifest relative to the workflows base path,
+// adding the default extension when the file is specified without one.
+func resolveWorkflowPath(atmosConfig *schema.AtmosConfiguration, workflowFile string) string {
 	var workflowPath string
 	if u.IsPathAbsolute(workflowFile) {
 		workflowPath = workflowFile
@@ -138,14 +189,18 @@ func ExecuteWorkflowCmd(cmd *cobra.Command, args []string) error {
 	}
 
 	// If the workflow file is specified without an extension, use the default extension
-	ext := filepath.Ext(workflowPath)
-	if ext == "" {
-		ext = u.DefaultStackConfigFileExtension
-		workflowPath = workflowPath + ext
+	if filepath.Ext(workflowPath) == "" {
+		workflowPath += u.DefaultStackConfigFileExtension
 	}
+	return workflowPath
+}
+
+// loadWorkflowDefinition reads the workflow manifest and returns the definition of the named workflow.
+func loadWorkflowDefinition(workflowPath, workflowName string) (*schema.WorkflowDefinition, error) {
+	defer perf.Track(nil, "exec.loadWorkflowDefinition")()
 
 	if !u.FileExists(workflowPath) {
-		return errUtils.Build(errUtils.ErrWorkflowFileNotFound).
+		return nil, errUtils.Build(errUtils.ErrWorkflowFileNotFound).
 			WithHintf("The workflow manifest file `%s` does not exist", filepath.ToSlash(workflowPath)).
 			WithExitCode(1).
 			Err()
@@ -153,29 +208,26 @@ func ExecuteWorkflowCmd(cmd *cobra.Command, args []string) error {
 
 	fileContent, err := os.ReadFile(workflowPath)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	var workflowManifest schema.WorkflowManifest
-	var workflowConfig schema.WorkflowConfig
-	var workflowDefinition schema.WorkflowDefinition
-
-	workflowManifest, err = u.UnmarshalYAML[schema.WorkflowManifest](string(fileContent))
+	workflowManifest, err := u.UnmarshalYAML[schema.WorkflowManifest](string(fileContent))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if workflowManifest.Workflows == nil {
-		return errUtils.Build(errUtils.ErrInvalidWorkflowManifest).
+		return nil, errUtils.Build(errUtils.ErrInvalidWorkflowManifest).
 			WithExplanationf("The workflow manifest `%s` must be a map with the top-level `workflows:` key", filepath.ToSlash(workflowPath)).
 			WithHint("Add a top-level 'workflows:' key to the manifest file").
 			WithExitCode(1).
 			Err()
 	}
 
-	workflowConfig = workflowManifest.Workflows
+	workflowConfig := workflowManifest.Workflows
 
-	if i, ok := workflowConfig[workflowName]; !ok {
+	workflowDefinition, ok := workflowConfig[workflowName]
+	if !ok {
 		validWorkflows := make([]string, 0, len(workflowConfig))
 		for w := range workflowConfig {
 			validWorkflows = append(validWorkflows, w)
@@ -183,19 +235,12 @@ func ExecuteWorkflowCmd(cmd *cobra.Command, args []string) error {
 		// sorting so that the output is deterministic.
 		sort.Strings(validWorkflows)
 
-		return errUtils.Build(errUtils.ErrWorkflowNoWorkflow).
+		return nil, errUtils.Build(errUtils.ErrWorkflowNoWorkflow).
 			WithHintf("No workflow exists with name `%s`", workflowName).
 			WithHintf("Available workflows in %s: %s", filepath.Base(workflowPath), u.FormatList(validWorkflows)).
 			WithExitCode(1).
 			Err()
-	} else {
-		workflowDefinition = i
-	}
-
-	err = ExecuteWorkflow(atmosConfig, workflowName, workflowPath, &workflowDefinition, dryRun, commandLineStack, fromStep, commandLineIdentity)
-	if err != nil {
-		return err
 	}
 
-	return nil
+	return &workflowDefinition, nil
 }
@@ -132,3 +132,53 @@ func (p *WorkflowUIProvider) PrintError(err error, title, explanation string) {
 
 	errUtils.CheckErrorAndPrint(err, title, explanation)
 }
+
+// isWorkflowDAG returns true if the workflow steps use `needs` or `parallel`.
+func isWorkflowDAG(workflowDefinition *schema.WorkflowDefinition) bool {
+	return workflow.IsDAG(workflowDefinition)
+}
+
+// executeWorkflowStepGraph runs the workflow steps as a dependency graph, bounded by the workflow `max_parallel`.
+// With fromStep, only the named step, the steps that depend on it, and the steps listed after it are executed.
+// The completed steps of a resumed run are not executed. skipStep is called for the other steps that are not executed.
+func executeWorkflowStepGraph(
+	workflowDefinition *schema.WorkflowDefinition,
+	fromStep string,
+	completed map[string]bool,
+	skipStep func(stepName string),
+	runStep workflow.StepRunFunc,
+) error {
+	defer perf.Track(nil, "exec.executeWorkflowStepGraph")()
+
+	graph, err := workflow.BuildStepGraph(workflowDefinition)
+	if err != nil {
+		return errUtils.Build(err).
+			WithTitle(WorkflowErrTitle).
+			WithExitCode(1).
+			Err()
+	}
+
+	var selected map[string]bool
+	if fromStep != "" || len(completed) > 0 {
+		selected = make(map[string]bool, len(workflowDefinition.Steps))
+		if fromStep != "" {
+			selected = workflow.SelectStepsFrom(graph, workflowDefinition.Steps, fromStep)
+		} else {
+			for i := range workflowDefinition.Steps {
+				selected[workflowDefinition.Steps[i].Name] = true
+			}
+		}
+
+		for i := range workflowDefinition.Steps {
+			name := workflowDefinition.Steps[i].Name
+			switch {
+			case completed[name]:
+				delete(selected, name)
+			case !selected[name]:
+				skipStep(name)
+			}
+		}
+	}
+
+	return workflow.RunStepGraph(graph, workflowDefinition.Steps, selected, workflowDefinition.MaxParallel, runStep)
+}
@@ -0,0 +1,193 @@
+package exec
+
+import (
+	"io"
+	"path/filepath"
+	"time"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	ioLayer "github.com/cloudposse/atmos/pkg/io"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/ui"
+	"github.com/cloudposse/atmos/pkg/workflow"
+)
+
+// workflowStepRunner runs a workflow step. When output is not nil, the step output is also written to it.
+type workflowStepRunner func(stepIdx int, step *schema.WorkflowStep, output io.Writer) error
+
+// ExecuteWorkflowResume resumes a recorded workflow run.
+// The steps that succeeded or were skipped in the run are not executed again, and their outputs are restored.
+// The workflow is loaded from the manifest recorded in the run.
+func ExecuteWorkflowResume(atmosConfig schema.AtmosConfiguration, runID string) error {
+	defer perf.Track(&atmosConfig, "exec.ExecuteWorkflowResume")()
+
+	store, err := workflow.DefaultRunStore()
+	if err != nil {
+		return err
+	}
+
+	record, err := store.Load(runID)
+	if err != nil {
+		return errUtils.Build(err).
+			WithTitle(WorkflowErrTitle).
+			WithHint("Use `atmos workflow runs list` to see the recorded workflow runs").
+			WithContext("run", runID).
+			WithExitCode(1).
+			Err()
+	}
+
+	if record.Status == workflow.RunStatusSucceeded {
+		return errUtils.Build(errUtils.ErrWorkflowRunCompleted).
+			WithTitle(WorkflowErrTitle).
+			WithExplanationf("Run `%s` of workflow `%s` completed successfully, there is nothing to resume.", record.ID, record.Workflow).
+			WithHintf("Run the workflow again with `atmos workflow %s`", record.Workflow).
+			WithContext("run", record.ID).
+			WithExitCode(1).
+			Err()
+	}
+
+	workflowDefinition, err := loadWorkflowDefinition(record.WorkflowPath, record.Workflow)
+	if err != nil {
+		return err
+	}
+
+	ui.Infof("Resuming run `%s` of workflow `%s`", record.ID, record.Workflow)
+
+	return executeWorkflow(atmosConfig, record.Workflow, record.WorkflowPath, workflowDefinition, false, record.Stack, "", record.Identity, record)
+}
+
+// startWorkflowRun records the start of a workflow run, or of a resumed run.
+// Dry runs are not recorded. If the run state can't be written, the workflow still runs without being recorded.
+func startWorkflowRun(
+	workflowName string,
+	workflowPath string,
+	workflowDefinition *schema.WorkflowDefinition,
+	dryRun bool,
+	stack string,
+	identity string,
+	resumeRun *workflow.RunRecord,
+) *workflow.RunRecorder {
+	defer perf.Track(nil, "exec.startWorkflowRun")()
+
+	if dryRun {
+		return nil
+	}
+
+	store, err := workflow.DefaultRunStore()
+	if err != nil {
+		log.Warn("Workflow run will not be recorded", "error", err)
+		return nil
+	}
+
+	record := resumeRun
+	if record == nil {
+		// Record the absolute path, so the run can be resumed from any directory.
+		if absPath, err := filepath.Abs(workflowPath); err == nil {
+			workflowPath = absPath
+		}
+
+		now := time.Now()
+		record = &workflow.RunRecord{
+			ID:           workflow.NewRunID(now),
+			Workflow:     workflowName,
+			WorkflowPath: workflowPath,
+			Stack:        stack,
+			Identity:     identity,
+			StartedAt:    now,
+		}
+	} else {
+		record.Resumes++
+	}
+	record.SyncSteps(workflowDefinition.Steps)
+
+	recorder := workflow.NewRunRecorder(store, record)
+	log.Debug("Recording workflow run", "run", recorder.ID(), "path", store.Dir())
+
+	// Keep the state directory from growing forever.
+	if removed, err := store.Prune(workflow.MaxRunRecords); err != nil {
+		log.Debug("Failed to prune workflow run records", "error", err)
+	} else if removed > 0 {
+		log.Debug("Pruned workflow run records", "removed", removed)
+	}
+	return recorder
+}
+
+// finishWorkflowRun records the end of a workflow run.
+func finishWorkflowRun(recorder *workflow.RunRecorder, err error) {
+	if recorder == nil {
+		return
+	}
+
+	recorder.Finish(err)
+	if err != nil {
+		log.Debug("Workflow run failed, resume it with `atmos workflow resume`", "run", recorder.ID())
+	}
+}
+
+// restoreWorkflowRunOutcomes restores the outcomes of the steps that completed in a resumed run,
+// and returns the names of those steps.
+func restoreWorkflowRunOutcomes(resumeRun *workflow.RunRecord, templates *workflowStepTemplates) map[string]bool {
+	if resumeRun == nil {
+		return nil
+	}
+
+	completed := resumeRun.CompletedSteps()
+	for i := range resumeRun.Steps {
+		step := &resumeRun.Steps[i]
+		if completed[step.Name] {
+			templates.outcomes.Set(step.Name, workflow.StepOutcome{Status: step.Status, Outputs: step.Outputs})
+		}
+	}
+	return completed
+}
+
+// recordWorkflowStepSkipped records a step that is not executed.
+func recordWorkflowStepSkipped(templates *workflowStepTemplates, recorder *workflow.RunRecorder, stepName string) {
+	templates.recordSkipped(stepName)
+	recorder.StepFinished(stepName, workflow.StepStatusSkipped, 0, nil, "", nil)
+}
+
+// recordWorkflowStep wraps runStep to record the status, timing, exit code and output tail of every step in the run.
+func recordWorkflowStep(recorder *workflow.RunRecorder, templates *workflowStepTemplates, runStep workflowStepRunner) workflow.StepRunFunc {
+	return func(stepIdx int, step *schema.WorkflowStep) error {
+		if recorder == nil {
+			return runStep(stepIdx, step, nil)
+		}
+
+		recorder.StepStarted(step.Name)
+		tail := workflow.NewTailWriter(workflow.OutputTailLimit)
+
+		// The step output is captured before masking, so mask it here to keep secrets out of the run-state file.
+		if err := runStep(stepIdx, step, ioLayer.MaskWriter(tail)); err != nil {
+			recorder.StepFinished(step.Name, workflow.StepStatusFailed, errUtils.GetExitCode(err), err, tail.String(), nil)
+			return err
+		}
+
+		// The step either ran successfully or was skipped by its `if` condition.
+		outcome, _ := templates.outcomes.Get(step.Name)
+		recorder.StepFinished(step.Name, outcome.Status, 0, nil, tail.String(), maskWorkflowOutputs(outcome.Outputs))
+		return nil
+	}
+}
+
+// maskWorkflowOutputs masks the secrets in the step outputs before they are written to the run-state file.
+// The outputs are dropped when masking is unavailable to avoid writing secrets to disk.
+func maskWorkflowOutputs(outputs map[string]string) map[string]string {
+	if len(outputs) == 0 {
+		return outputs
+	}
+
+	ioCtx := ioLayer.GetContext()
+	if ioCtx == nil {
+		log.Debug("I/O context is not initialized, the workflow step outputs are not recorded")
+		return nil
+	}
+
+	masked := make(map[string]string, len(outputs))
+	for name, value := range outputs {
+		masked[name] = ioCtx.Masker().Mask(value)
+	}
+	return masked
+}
@@ -0,0 +1,219 @@
+package exec
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	ioLayer "github.com/cloudposse/atmos/pkg/io"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/workflow"
+)
+
+func TestWorkflowRunRecordAndResume(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+	}{
+		{
+			name: "sequential",
+			manifest: `
+workflows:
+  deploy:
+    steps:
+      - name: first
+        type: shell
+        command: echo x >> count && echo id-123
+        outputs:
+          id: "{{ .stdout }}"
+      - name: second
+        type: shell
+        command: test -f ready || { echo not ready >&2; exit 3; }
+      - name: third
+        type: shell
+        command: echo {{ .steps.first.outputs.id }} > result
+`,
+		},
+		{
+			name: "dependency graph",
+			manifest: `
+workflows:
+  deploy:
+    steps:
+      - name: first
+        type: shell
+        command: echo x >> count && echo id-123
+        outputs:
+          id: "{{ .stdout }}"
+      - name: second
+        type: shell
+        command: test -f ready || { echo not ready >&2; exit 3; }
+        needs: [first]
+      - name: third
+        type: shell
+        command: echo {{ .steps.first.outputs.id }} > result
+        needs: [second]
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			t.Chdir(dir)
+			t.Setenv("ATMOS_XDG_STATE_HOME", filepath.Join(dir, "state"))
+
+			workflowPath := filepath.Join(dir, "workflows.yaml")
+			require.NoError(t, os.WriteFile(workflowPath, []byte(tt.manifest), 0o600))
+			workflowDefinition, err := loadWorkflowDefinition(workflowPath, "deploy")
+			require.NoError(t, err)
+
+			atmosConfig := schema.AtmosConfiguration{}
+			err = ExecuteWorkflow(atmosConfig, "deploy", workflowPath, workflowDefinition, false, "", "", "")
+			require.Error(t, err)
+
+			store, err := workflow.DefaultRunStore()
+			require.NoError(t, err)
+			records, err := store.List()
+			require.NoError(t, err)
+			require.Len(t, records, 1)
+
+			record := records[0]
+			assert.Equal(t, workflow.RunStatusFailed, record.Status)
+			assert.Equal(t, "deploy", record.Workflow)
+			assert.Equal(t, workflowPath, record.WorkflowPath)
+			assert.Equal(t, workflow.StepStatusSuccess, record.Step("first").Status)
+			assert.Equal(t, "id-123\n", record.Step("first").OutputTail)
+			assert.Equal(t, map[string]string{"id": "id-123"}, record.Step("first").Outputs)
+			assert.Equal(t, workflow.StepStatusFailed, record.Step("second").Status)
+			assert.Equal(t, 3, record.Step("second").ExitCode)
+			assert.Equal(t, "not ready\n", record.Step("second").OutputTail)
+			assert.NotNil(t, record.Step("second").FinishedAt)
+			assert.Equal(t, workflow.StepStatusPending, record.Step("third").Status)
+
+			// Fix the failing step and resume: the first step is not executed again and its outputs are restored.
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "ready"), nil, 0o600))
+			require.NoError(t, ExecuteWorkflowResume(atmosConfig, record.ID))
+
+			count, err := os.ReadFile(filepath.Join(dir, "count"))
+			require.NoError(t, err)
+			assert.Equal(t, 1, strings.Count(string(count), "x"))
+
+			result, err := os.ReadFile(filepath.Join(dir, "result"))
+			require.NoError(t, err)
+			assert.Equal(t, "id-123", strings.TrimSpace(string(result)))
+
+			resumed, err := store.Load(record.ID)
+			require.NoError(t, err)
+			assert.Equal(t, workflow.RunStatusSucceeded, resumed.Status)
+			assert.Equal(t, 1, resumed.Resumes)
+			assert.Empty(t, resumed.Error)
+			for i := range resumed.Steps {
+				assert.Equal(t, workflow.StepStatusSuccess, resumed.Steps[i].Status, resumed.Steps[i].Name)
+			}
+
+			// A run that succeeded can't be resumed.
+			err = ExecuteWorkflowResume(atmosConfig, record.ID)
+			assert.ErrorIs(t, err, errUtils.ErrWorkflowRunCompleted)
+		})
+	}
+}
+
+func TestWorkflowRunOutputTailIsMasked(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+	t.Setenv("ATMOS_XDG_STATE_HOME", filepath.Join(dir, "state"))
+
+	const secret = "wf-run-secret-3f9c2a7e"
+	ioLayer.RegisterSecret(secret)
+	t.Setenv("WF_RUN_SECRET", secret)
+
+	workflowPath := filepath.Join(dir, "workflows.yaml")
+	manifest := `
+workflows:
+  deploy:
+    steps:
+      - name: print
+        type: shell
+        command: echo token=$WF_RUN_SECRET && echo error=$WF_RUN_SECRET >&2
+        outputs:
+          token: "{{ .stdout }}"
+`
+	require.NoError(t, os.WriteFile(workflowPath, []byte(manifest), 0o600))
+	workflowDefinition, err := loadWorkflowDefinition(workflowPath, "deploy")
+	require.NoError(t, err)
+
+	require.NoError(t, ExecuteWorkflow(schema.AtmosConfiguration{}, "deploy", workflowPath, workflowDefinition, false, "", "", ""))
+
+	store, err := workflow.DefaultRunStore()
+	require.NoError(t, err)
+	records, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+
+	tail := records[0].Step("print").OutputTail
+	assert.Contains(t, tail, "token=")
+	assert.NotContains(t, tail, secret)
+
+	token := records[0].Step("print").Outputs["token"]
+	assert.Contains(t, token, "token=")
+	assert.NotContains(t, token, secret)
+
+	// The secret doesn't reach the run-state file on disk either.
+	require.NoError(t, filepath.Walk(filepath.Join(dir, "state"), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(content), secret, path)
+		return nil
+	}))
+}
+
+func TestWorkflowRunNotRecorded(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ATMOS_XDG_STATE_HOME", filepath.Join(dir, "state"))
+
+	workflowDefinition := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{{Name: "step1", Type: "shell", Command: "echo hello"}},
+	}
+	require.NoError(t, ExecuteWorkflow(schema.AtmosConfiguration{}, "hello", "workflows.yaml", workflowDefinition, true, "", "", ""))
+
+	store, err := workflow.DefaultRunStore()
+	require.NoError(t, err)
+	records, err := store.List()
+	require.NoError(t, err)
+	assert.Empty(t, records, "dry runs are not recorded")
+
+	err = ExecuteWorkflowResume(schema.AtmosConfiguration{}, "missing")
+	assert.ErrorIs(t, err, errUtils.ErrWorkflowRunNotFound)
+}
+
+func TestWorkflowRunFromStepRecordsSkippedSteps(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ATMOS_XDG_STATE_HOME", filepath.Join(dir, "state"))
+
+	workflowDefinition := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "step1", Type: "shell", Command: "echo one"},
+			{Name: "step2", Type: "shell", Command: "echo two"},
+		},
+	}
+	require.NoError(t, ExecuteWorkflow(schema.AtmosConfiguration{}, "hello", "workflows.yaml", workflowDefinition, false, "", "step2", ""))
+
+	store, err := workflow.DefaultRunStore()
+	require.NoError(t, err)
+	records, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, workflow.RunStatusSucceeded, records[0].Status)
+	assert.Equal(t, workflow.StepStatusSkipped, records[0].Step("step1").Status)
+	assert.Equal(t, workflow.StepStatusSuccess, records[0].Step("step2").Status)
+	assert.Equal(t, "two\n", records[0].Step("step2").OutputTail)
+}
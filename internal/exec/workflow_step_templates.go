@@ -0,0 +1,115 @@
+package exec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/workflow"
+)
+
+// workflowStepTemplates evaluates the `if` conditions, commands and `outputs` of workflow steps
+// against the template context `.workflow`, `.stack`, `.env` and `.steps`.
+type workflowStepTemplates struct {
+	atmosConfig  *schema.AtmosConfiguration
+	workflowName string
+	dryRun       bool
+	// renderCommands is true when the workflow declares step outputs, so commands can reference them.
+	renderCommands bool
+	outcomes       *workflow.StepOutcomes
+}
+
+// newWorkflowStepTemplates creates a workflowStepTemplates with every step of the workflow marked as pending.
+func newWorkflowStepTemplates(atmosConfig *schema.AtmosConfiguration, workflowName string, workflowDefinition *schema.WorkflowDefinition, dryRun bool) *workflowStepTemplates {
+	defer perf.Track(atmosConfig, "exec.newWorkflowStepTemplates")()
+
+	outcomes := workflow.NewStepOutcomes()
+	for i := range workflowDefinition.Steps {
+		outcomes.Set(workflowDefinition.Steps[i].Name, workflow.StepOutcome{Status: workflow.StepStatusPending})
+	}
+
+	return &workflowStepTemplates{
+		atmosConfig:    atmosConfig,
+		workflowName:   workflowName,
+		dryRun:         dryRun,
+		renderCommands: workflow.UsesStepOutputs(workflowDefinition),
+		outcomes:       outcomes,
+	}
+}
+
+// shouldRun evaluates the `if` condition of a step. Steps without a condition always run.
+func (t *workflowStepTemplates) shouldRun(step *schema.WorkflowStep, stack string, env []string) (bool, error) {
+	defer perf.Track(t.atmosConfig, "exec.workflowStepTemplates.shouldRun")()
+
+	if strings.TrimSpace(step.If) == "" {
+		return true, nil
+	}
+
+	data := workflow.StepTemplateData(t.workflowName, stack, env, t.outcomes)
+	rendered, err := ProcessTmpl(t.atmosConfig, step.Name+"-if", workflow.ConditionTemplate(step.If), data, false)
+	if err != nil {
+		return false, errUtils.Build(errUtils.ErrInvalidWorkflowStepCondition).
+			WithTitle(WorkflowErrTitle).
+			WithCause(err).
+			WithExplanationf("Failed to evaluate the `if` expression of step `%s`:\n```\n%s\n```", step.Name, step.If).
+			WithExitCode(1).
+			Err()
+	}
+
+	run, err := workflow.ParseConditionResult(step.Name, rendered)
+	if err != nil {
+		return false, errUtils.Build(err).WithTitle(WorkflowErrTitle).WithExitCode(1).Err()
+	}
+	return run, nil
+}
+
+// renderCommand processes the step command as a Go template when the workflow declares step outputs.
+// During a dry run, missing values are rendered as `<no value>` since no outputs are captured.
+func (t *workflowStepTemplates) renderCommand(step *schema.WorkflowStep, command, stack string, env []string) (string, error) {
+	defer perf.Track(t.atmosConfig, "exec.workflowStepTemplates.renderCommand")()
+
+	if !t.renderCommands || !strings.Contains(command, "{{") {
+		return command, nil
+	}
+
+	data := workflow.StepTemplateData(t.workflowName, stack, env, t.outcomes)
+	return ProcessTmpl(t.atmosConfig, step.Name, command, data, t.dryRun)
+}
+
+// recordSuccess evaluates the `outputs` of a successful step against its captured stdout and records them.
+func (t *workflowStepTemplates) recordSuccess(step *schema.WorkflowStep, stack string, env []string, stdout string) error {
+	defer perf.Track(t.atmosConfig, "exec.workflowStepTemplates.recordSuccess")()
+
+	outputs := make(map[string]string, len(step.Outputs))
+
+	// Nothing is captured during a dry run, so outputs are not evaluated.
+	if len(step.Outputs) > 0 && !t.dryRun {
+		data := workflow.OutputTemplateData(workflow.StepTemplateData(t.workflowName, stack, env, t.outcomes), stdout)
+
+		// Evaluate in a stable order so the first failing output is deterministic.
+		keys := make([]string, 0, len(step.Outputs))
+		for key := range step.Outputs {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			value, err := ProcessTmpl(t.atmosConfig, fmt.Sprintf("%s-outputs-%s", step.Name, key), step.Outputs[key], data, false)
+			if err != nil {
+				return fmt.Errorf("%w: output `%s`: %w", errUtils.ErrWorkflowStepOutputs, key, err)
+			}
+			outputs[key] = strings.TrimSpace(value)
+		}
+	}
+
+	t.outcomes.Set(step.Name, workflow.StepOutcome{Status: workflow.StepStatusSuccess, Outputs: outputs})
+	return nil
+}
+
+// recordSkipped records a step that did not run.
+func (t *workflowStepTemplates) recordSkipped(stepName string) {
+	t.outcomes.Set(stepName, workflow.StepOutcome{Status: workflow.StepStatusSkipped})
+}
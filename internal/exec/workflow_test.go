@@ -1,8 +1,10 @@
 package exec
 
 import (
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -211,6 +213,137 @@ func TestExecuteWorkflow(t *testing.T) {
 			wantErr:          true,
 			wantSentinel:     errUtils.ErrWorkflowStepFailed,
 		},
+		{
+			name:         "parallel steps with needs",
+			workflow:     "dag-workflow",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Steps: []schema.WorkflowStep{
+					{Name: "step1", Type: "shell", Command: "echo 'Step 1'", Parallel: true},
+					{Name: "step2", Type: "shell", Command: "echo 'Step 2'", Parallel: true},
+					{Name: "step3", Type: "shell", Command: "echo 'Step 3'", Needs: []string{"step1", "step2"}},
+				},
+			},
+			fromStep: "step2",
+			wantErr:  false,
+		},
+		{
+			name:         "needs unknown step",
+			workflow:     "dag-workflow-invalid",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Steps: []schema.WorkflowStep{
+					{Name: "step1", Type: "shell", Command: "echo 'Step 1'"},
+					{Name: "step2", Type: "shell", Command: "echo 'Step 2'", Needs: []string{"missing"}},
+				},
+			},
+			wantErr:      true,
+			wantSentinel: errUtils.ErrInvalidWorkflowStepNeeds,
+		},
+		{
+			name:         "failing parallel step",
+			workflow:     "dag-workflow-failing",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Steps: []schema.WorkflowStep{
+					{Name: "step1", Type: "shell", Command: "exit 1", Parallel: true},
+					{Name: "step2", Type: "shell", Command: "echo 'Step 2'", Parallel: true},
+					{Name: "step3", Type: "shell", Command: "echo 'Step 3'"},
+				},
+			},
+			wantErr:      true,
+			wantSentinel: errUtils.ErrWorkflowStepFailed,
+		},
+		{
+			name:         "step outputs passed to later steps",
+			workflow:     "outputs-workflow",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Steps: []schema.WorkflowStep{
+					{
+						Name:    "vpc",
+						Type:    "shell",
+						Command: `echo '{"vpc_id": "vpc-123"}'`,
+						Outputs: map[string]string{"vpc_id": "{{ .json.vpc_id }}", "raw": "{{ .stdout }}"},
+					},
+					{
+						Name:    "check",
+						Type:    "shell",
+						Command: `test "{{ .steps.vpc.outputs.vpc_id }}" = "vpc-123"`,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:         "step skipped when condition is false",
+			workflow:     "conditional-workflow",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Stack: "dev",
+				Steps: []schema.WorkflowStep{
+					{Name: "step1", Type: "shell", Command: "echo 'Step 1'"},
+					{Name: "prod-only", Type: "shell", Command: "exit 1", If: `eq .stack "prod"`},
+					{Name: "after-step1", Type: "shell", Command: "echo 'Step 3'", If: `{{ eq .steps.step1.status "success" }}`},
+					{Name: "after-skip", Type: "shell", Command: "exit 1", If: `ne (index .steps "prod-only" "status") "skipped"`},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:         "step condition referencing a skipped step",
+			workflow:     "conditional-workflow-skipped",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Stack: "dev",
+				Steps: []schema.WorkflowStep{
+					{Name: "prod_only", Type: "shell", Command: "exit 1", If: `eq .stack "prod"`},
+					{Name: "after_skip", Type: "shell", Command: "exit 1", If: `ne .steps.prod_only.status "skipped"`},
+					{Name: "env_check", Type: "shell", Command: "echo ok", If: `eq .env.STAGE "dev"`, Env: map[string]string{"STAGE": "dev"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:         "conditions and outputs in a dependency graph",
+			workflow:     "conditional-graph-workflow",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Stack:       "dev",
+				MaxParallel: 2,
+				Steps: []schema.WorkflowStep{
+					{Name: "vpc", Type: "shell", Command: "echo vpc-123", Outputs: map[string]string{"vpc_id": "{{ .stdout }}"}},
+					{Name: "prod_only", Type: "shell", Command: "exit 1", If: `eq .stack "prod"`, Parallel: true},
+					{Name: "eks", Type: "shell", Command: `test "{{ .steps.vpc.outputs.vpc_id }}" = "vpc-123"`, Needs: []string{"vpc"}, Parallel: true},
+					{Name: "after_skip", Type: "shell", Command: "exit 1", If: `ne .steps.prod_only.status "skipped"`, Needs: []string{"prod_only", "eks"}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:         "condition that is not a boolean",
+			workflow:     "conditional-workflow-invalid",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Steps: []schema.WorkflowStep{
+					{Name: "step1", Type: "shell", Command: "echo 'Step 1'", If: `.workflow`},
+				},
+			},
+			wantErr:      true,
+			wantSentinel: errUtils.ErrInvalidWorkflowStepCondition,
+		},
+		{
+			name:         "output template error",
+			workflow:     "outputs-workflow-invalid",
+			workflowPath: workflowPath,
+			workflowDef: &schema.WorkflowDefinition{
+				Steps: []schema.WorkflowStep{
+					{Name: "step1", Type: "shell", Command: "echo plain", Outputs: map[string]string{"id": "{{ .json.id"}},
+				},
+			},
+			wantErr:      true,
+			wantSentinel: errUtils.ErrWorkflowStepOutputs,
+		},
 	}
 
 	for _, tt := range tests {
@@ -725,3 +858,34 @@ workflows:
 		assert.Contains(t, formattedErr, "Multiple workflow files")
 	})
 }
+
+func TestExecuteWorkflowPrefixesGraphStepOutput(t *testing.T) {
+	t.Setenv("ATMOS_XDG_STATE_HOME", t.TempDir())
+
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	oldStdout := os.Stdout
+	os.Stdout = w
+	t.Cleanup(func() { os.Stdout = oldStdout })
+
+	workflowDefinition := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "vpc", Type: "shell", Command: "echo vpc line 1 && sleep 0.05 && echo vpc line 2", Parallel: true},
+			{Name: "dns", Type: "shell", Command: "echo dns line 1 && sleep 0.05 && echo dns line 2", Parallel: true},
+		},
+	}
+	err = ExecuteWorkflow(schema.AtmosConfiguration{}, "parallel", "workflows.yaml", workflowDefinition, false, "", "", "")
+	os.Stdout = oldStdout
+	require.NoError(t, w.Close())
+	require.NoError(t, err)
+
+	output, err := io.ReadAll(r)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	assert.ElementsMatch(t, []string{
+		"[vpc] vpc line 1",
+		"[vpc] vpc line 2",
+		"[dns] dns line 1",
+		"[dns] dns line 2",
+	}, lines)
+}
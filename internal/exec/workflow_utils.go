@@ -1,14 +1,17 @@
 package exec
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"slices"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/huh"
@@ -32,6 +35,7 @@ import (
 	"github.com/cloudposse/atmos/pkg/telemetry"
 	"github.com/cloudposse/atmos/pkg/ui"
 	u "github.com/cloudposse/atmos/pkg/utils"
+	"github.com/cloudposse/atmos/pkg/workflow"
 )
 
 // Workflow error title for formatted output.
@@ -63,31 +67,16 @@ type workflowStepErrorContext struct {
 	Command          string
 	CommandType      string
 	FinalStack       string
+	// RunID is the ID of the recorded workflow run, empty if the run is not recorded.
+	RunID string
 }
 
 // buildWorkflowStepError builds an error with resume hints when a workflow step fails.
+// When the run is recorded, the hint resumes the run by its ID. Otherwise, it reruns the workflow with `--from-step`.
 func buildWorkflowStepError(err error, ctx *workflowStepErrorContext) error {
 	log.Debug("Workflow failed", "error", err)
 
-	// Remove the workflow base path, stacks/workflows.
-	workflowFileName := strings.TrimPrefix(filepath.ToSlash(ctx.WorkflowPath), filepath.ToSlash(ctx.WorkflowBasePath))
-	// Remove the leading slash.
-	workflowFileName = strings.TrimPrefix(workflowFileName, "/")
-	// Remove the file extension.
-	workflowFileName = strings.TrimSuffix(workflowFileName, filepath.Ext(workflowFileName))
-
-	resumeCommand := fmt.Sprintf(
-		"%s workflow %s -f %s --from-step '%s'",
-		config.AtmosCommand,
-		ctx.Workflow,
-		workflowFileName,
-		ctx.StepName,
-	)
-
-	// Add stack parameter to resume command if a stack was used.
-	if ctx.FinalStack != "" {
-		resumeCommand = fmt.Sprintf("%s -s '%s'", resumeCommand, ctx.FinalStack)
-	}
+	resumeCommand := buildWorkflowResumeCommand(ctx)
 
 	failedCmd := ctx.Command
 	if ctx.CommandType == config.AtmosCommand {
@@ -111,6 +100,9 @@ func buildWorkflowStepError(err error, ctx *workflowStepErrorContext) error {
 		WithTitle("Workflow Error").
 		WithHintf("The following command failed to execute:\n\n```shell\n%s\n```", failedCmd).
 		WithHintf("To resume the workflow from this step, run:\n\n```shell\n%s\n```", resumeCommand)
+	if ctx.RunID != "" {
+		builder = builder.WithContext("run", ctx.RunID)
+	}
 
 	// Extract exit code from the underlying error if available.
 	if exitCode := errUtils.GetExitCode(err); exitCode != 0 {
@@ -120,6 +112,35 @@ func buildWorkflowStepError(err error, ctx *workflowStepErrorContext) error {
 	return builder.Err()
 }
 
+// buildWorkflowResumeCommand returns the command that resumes a failed workflow from the failed step.
+func buildWorkflowResumeCommand(ctx *workflowStepErrorContext) string {
+	if ctx.RunID != "" {
+		return fmt.Sprintf("%s workflow resume %s", config.AtmosCommand, ctx.RunID)
+	}
+
+	// Remove the workflow base path, stacks/workflows.
+	workflowFileName := strings.TrimPrefix(filepath.ToSlash(ctx.WorkflowPath), filepath.ToSlash(ctx.WorkflowBasePath))
+	// Remove the leading slash.
+	workflowFileName = strings.TrimPrefix(workflowFileName, "/")
+	// Remove the file extension.
+	workflowFileName = strings.TrimSuffix(workflowFileName, filepath.Ext(workflowFileName))
+
+	resumeCommand := fmt.Sprintf(
+		"%s workflow %s -f %s --from-step '%s'",
+		config.AtmosCommand,
+		ctx.Workflow,
+		workflowFileName,
+		ctx.StepName,
+	)
+
+	// Add stack parameter to resume command if a stack was used.
+	if ctx.FinalStack != "" {
+		resumeCommand = fmt.Sprintf("%s -s '%s'", resumeCommand, ctx.FinalStack)
+	}
+
+	return resumeCommand
+}
+
 // prepareStepEnvironment prepares environment variables for a workflow step.
 // baseEnv should already contain system env + global env + toolchain PATH.
 // This function merges workflow and step env on top, then handles auth if needed.
@@ -257,6 +278,23 @@ func ExecuteWorkflow(
 ) error {
 	defer perf.Track(&atmosConfig, "exec.ExecuteWorkflow")()
 
+	return executeWorkflow(atmosConfig, workflow, workflowPath, workflowDefinition, dryRun, commandLineStack, fromStep, commandLineIdentity, nil)
+}
+
+// executeWorkflow executes an Atmos workflow and records the run in the workflow run state.
+// When resumeRun is set, the steps that completed in that run are not executed again,
+// and the run record is updated in place.
+func executeWorkflow(
+	atmosConfig schema.AtmosConfiguration,
+	workflow string,
+	workflowPath string,
+	workflowDefinition *schema.WorkflowDefinition,
+	dryRun bool,
+	commandLineStack string,
+	fromStep string,
+	commandLineIdentity string,
+	resumeRun *workflow.RunRecord,
+) error {
 	steps := workflowDefinition.Steps
 
 	if len(steps) == 0 {
@@ -280,13 +318,17 @@ func ExecuteWorkflow(
 		}
 	}
 
-	// If `--from-step` is specified, skip all the previous steps
+	// Steps with `needs` or `parallel` are executed as a dependency graph.
+	isDAG := isWorkflowDAG(workflowDefinition)
+
+	// If `--from-step` is specified, skip all the previous steps.
+	// For a dependency graph, the steps to run are selected from the graph instead.
 	if fromStep != "" {
-		steps = lo.DropWhile[schema.WorkflowStep](steps, func(step schema.WorkflowStep) bool {
+		remainingSteps := lo.DropWhile[schema.WorkflowStep](steps, func(step schema.WorkflowStep) bool {
 			return step.Name != fromStep
 		})
 
-		if len(steps) == 0 {
+		if len(remainingSteps) == 0 {
 			stepNames := lo.Map(workflowDefinition.Steps, func(step schema.WorkflowStep, _ int) string { return step.Name })
 			return errUtils.Build(errUtils.ErrInvalidFromStep).
 				WithTitle(WorkflowErrTitle).
@@ -296,6 +338,10 @@ func ExecuteWorkflow(
 				WithExitCode(1).
 				Err()
 		}
+
+		if !isDAG {
+			steps = remainingSteps
+		}
 	}
 
 	// Ensure toolchain dependencies are installed and build PATH for workflow steps.
@@ -332,7 +378,19 @@ func ExecuteWorkflow(
 	baseEnv := envpkg.MergeGlobalEnv(os.Environ(), atmosConfig.Env)
 	baseEnv = append(baseEnv, tenv.EnvVars()...)
 
-	for stepIdx, step := range steps {
+	// Step conditions, commands and outputs are evaluated against the outcomes of the previous steps.
+	templates := newWorkflowStepTemplates(&atmosConfig, workflow, workflowDefinition, dryRun)
+
+	// The outcomes of the steps that completed in a resumed run are restored, and the steps are not executed again.
+	completed := restoreWorkflowRunOutcomes(resumeRun, templates)
+
+	recorder := startWorkflowRun(workflow, workflowPath, workflowDefinition, dryRun, commandLineStack, commandLineIdentity, resumeRun)
+
+	// Steps of a dependency graph can run at the same time. Their output lines are prefixed with the step name,
+	// and the lines of different steps never interleave.
+	var stdoutMu, stderrMu sync.Mutex
+
+	runStep := func(stepIdx int, step *schema.WorkflowStep, output io.Writer) error {
 		command := strings.TrimSpace(step.Command)
 		commandType := strings.TrimSpace(step.Type)
 		stepIdentity := strings.TrimSpace(step.Identity)
@@ -343,6 +401,7 @@ func ExecuteWorkflow(
 		}
 
 		finalStack := ""
+		stepStack := resolveWorkflowStepStack(workflowDefinition, step, commandLineStack)
 
 		log.Debug("Executing workflow step", "step", stepIdx, "name", step.Name, "command", command)
 
@@ -350,6 +409,21 @@ func ExecuteWorkflow(
 			commandType = "atmos"
 		}
 
+		// Evaluate the step condition before authenticating, so skipped steps don't require credentials.
+		conditionEnv, err := prepareStepEnvironment(baseEnv, "", step.Name, nil, workflowDefinition.Env, step.Env)
+		if err != nil {
+			return err
+		}
+		run, err := templates.shouldRun(step, stepStack, conditionEnv)
+		if err != nil {
+			return err
+		}
+		if !run {
+			ui.Infof("Skipping step `%s`: the `if` condition is false", step.Name)
+			templates.recordSkipped(step.Name)
+			return nil
+		}
+
 		// Prepare environment variables: start with baseEnv (system + global + toolchain).
 		// Then merge workflow-level and step-level env vars.
 		// If identity is specified, also authenticate and add credentials.
@@ -358,11 +432,54 @@ func ExecuteWorkflow(
 			return err
 		}
 
+		stepErrContext := &workflowStepErrorContext{
+			WorkflowPath:     workflowPath,
+			WorkflowBasePath: atmosConfig.Workflows.BasePath,
+			Workflow:         workflow,
+			StepName:         step.Name,
+			Command:          command,
+			CommandType:      commandType,
+			RunID:            recorder.ID(),
+		}
+
+		command, err = templates.renderCommand(step, command, stepStack, stepEnv)
+		if err != nil {
+			return buildWorkflowStepError(err, stepErrContext)
+		}
+		stepErrContext.Command = command
+
+		// Capture stdout when the step declares outputs. The buffer is reset before every retry attempt.
+		// When the run is recorded, the step output is also written to output.
+		var stdout bytes.Buffer
+		var stdoutCapture []io.Writer
+		if len(step.Outputs) > 0 {
+			stdoutCapture = append(stdoutCapture, &stdout)
+		}
+		if output != nil {
+			stdoutCapture = append(stdoutCapture, output)
+		}
+		var shellOpts []ShellCommandOption
+		if len(stdoutCapture) > 0 {
+			shellOpts = append(shellOpts, WithStdoutCapture(io.MultiWriter(stdoutCapture...)))
+		}
+		if output != nil {
+			shellOpts = append(shellOpts, WithStderrCapture(output))
+		}
+		if isDAG {
+			prefix := fmt.Sprintf("[%s] ", step.Name)
+			stepStdout := newPrefixWriter(os.Stdout, &stdoutMu, prefix)
+			stepStderr := newPrefixWriter(os.Stderr, &stderrMu, prefix)
+			defer stepStdout.Flush()
+			defer stepStderr.Flush()
+			shellOpts = append(shellOpts, WithStdoutOverride(stepStdout), WithStderrOverride(stepStderr))
+		}
+
 		switch commandType {
 		case "shell":
 			commandName := fmt.Sprintf("%s-step-%d", workflow, stepIdx)
 			err = retry.Do(context.Background(), step.Retry, func() error {
-				return ExecuteShell(command, commandName, ".", stepEnv, dryRun)
+				stdout.Reset()
+				return ExecuteShell(command, commandName, ".", stepEnv, dryRun, shellOpts...)
 			})
 		case "atmos":
 			// Parse command using shell.Fields for proper quote handling.
@@ -373,22 +490,7 @@ func ExecuteWorkflow(
 				args = strings.Fields(command)
 			}
 
-			workflowStack := strings.TrimSpace(workflowDefinition.Stack)
-			stepStack := strings.TrimSpace(step.Stack)
-
-			// The workflow `stack` attribute overrides the stack in the `command` (if specified)
-			// The step `stack` attribute overrides the stack in the `command` and the workflow `stack` attribute
-			// The stack defined on the command line (`atmos workflow <name> -f <file> -s <stack>`) has the highest priority,
-			// it overrides all other stacks attributes
-			if workflowStack != "" {
-				finalStack = workflowStack
-			}
-			if stepStack != "" {
-				finalStack = stepStack
-			}
-			if commandLineStack != "" {
-				finalStack = commandLineStack
-			}
+			finalStack = stepStack
 
 			if finalStack != "" {
 				if idx := slices.Index(args, "--"); idx != -1 {
@@ -405,7 +507,8 @@ func ExecuteWorkflow(
 
 			ui.Infof("Executing command: `atmos %s`", command)
 			err = retry.Do(context.Background(), step.Retry, func() error {
-				return ExecuteShellCommand(atmosConfig, "atmos", args, ".", stepEnv, dryRun, "")
+				stdout.Reset()
+				return ExecuteShellCommand(atmosConfig, "atmos", args, ".", stepEnv, dryRun, "", shellOpts...)
 			})
 		default:
 			return errUtils.Build(errUtils.ErrInvalidWorkflowStepType).
@@ -416,20 +519,69 @@ func ExecuteWorkflow(
 				Err()
 		}
 
+		stepErrContext.FinalStack = finalStack
 		if err != nil {
-			return buildWorkflowStepError(err, &workflowStepErrorContext{
-				WorkflowPath:     workflowPath,
-				WorkflowBasePath: atmosConfig.Workflows.BasePath,
-				Workflow:         workflow,
-				StepName:         step.Name,
-				Command:          command,
-				CommandType:      commandType,
-				FinalStack:       finalStack,
-			})
+			return buildWorkflowStepError(err, stepErrContext)
+		}
+
+		if err := templates.recordSuccess(step, stepStack, stepEnv, stdout.String()); err != nil {
+			return buildWorkflowStepError(err, stepErrContext)
+		}
+
+		return nil
+	}
+
+	skipStep := func(stepName string) {
+		recordWorkflowStepSkipped(templates, recorder, stepName)
+	}
+	runRecordedStep := recordWorkflowStep(recorder, templates, runStep)
+
+	err = func() error {
+		if isDAG {
+			return executeWorkflowStepGraph(workflowDefinition, fromStep, completed, skipStep, runRecordedStep)
+		}
+
+		// Steps before `--from-step` are not executed.
+		for i := 0; i < len(workflowDefinition.Steps)-len(steps); i++ {
+			skipStep(workflowDefinition.Steps[i].Name)
+		}
+
+		for stepIdx := range steps {
+			if completed[steps[stepIdx].Name] {
+				continue
+			}
+			if err := runRecordedStep(stepIdx, &steps[stepIdx]); err != nil {
+				return err
+			}
 		}
+		return nil
+	}()
+
+	finishWorkflowRun(recorder, err)
+	return err
+}
+
+// resolveWorkflowStepStack returns the stack for a workflow step.
+// The workflow `stack` attribute overrides the stack in the `command` (if specified).
+// The step `stack` attribute overrides the stack in the `command` and the workflow `stack` attribute.
+// The stack defined on the command line (`atmos workflow <name> -f <file> -s <stack>`) has the highest priority,
+// it overrides all other stacks attributes.
+func resolveWorkflowStepStack(workflowDefinition *schema.WorkflowDefinition, step *schema.WorkflowStep, commandLineStack string) string {
+	finalStack := ""
+	workflowStack := strings.TrimSpace(workflowDefinition.Stack)
+	stepStack := strings.TrimSpace(step.Stack)
+
+	if workflowStack != "" {
+		finalStack = workflowStack
+	}
+	if stepStack != "" {
+		finalStack = stepStack
+	}
+	if commandLineStack != "" {
+		finalStack = commandLineStack
 	}
 
-	return nil
+	return finalStack
 }
 
 // ExecuteDescribeWorkflows executes `atmos describe workflows` command.
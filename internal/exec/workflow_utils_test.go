@@ -494,6 +494,7 @@ func TestBuildWorkflowStepError(t *testing.T) {
 		err            error
 		ctx            *workflowStepErrorContext
 		expectContains []string
+		expectAbsent   []string
 		expectSentinel error
 		expectExitCode int
 	}{
@@ -573,6 +574,23 @@ func TestBuildWorkflowStepError(t *testing.T) {
 			expectContains: []string{"deploy", "step1", "team/project/deploy"},
 			expectSentinel: errUtils.ErrWorkflowStepFailed,
 		},
+		{
+			name: "recorded run resumes by run ID",
+			err:  errors.New("run failure"),
+			ctx: &workflowStepErrorContext{
+				WorkflowPath:     "/workflows/deploy.yaml",
+				WorkflowBasePath: "/workflows",
+				Workflow:         "deploy",
+				StepName:         "step2",
+				Command:          "echo test",
+				CommandType:      "shell",
+				FinalStack:       "prod",
+				RunID:            "20260101-120000-abc123",
+			},
+			expectContains: []string{"atmos workflow resume 20260101-120000-abc123"},
+			expectAbsent:   []string{"--from-step"},
+			expectSentinel: errUtils.ErrWorkflowStepFailed,
+		},
 	}
 
 	for _, tt := range tests {
@@ -587,6 +605,9 @@ func TestBuildWorkflowStepError(t *testing.T) {
 			for _, expected := range tt.expectContains {
 				assert.Contains(t, formattedErr, expected)
 			}
+			for _, absent := range tt.expectAbsent {
+				assert.NotContains(t, formattedErr, absent)
+			}
 
 			if tt.expectExitCode > 0 {
 				exitCode := errUtils.GetExitCode(result)
@@ -0,0 +1,141 @@
+package terraform_backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	atmoshttp "github.com/cloudposse/atmos/pkg/http"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// defaultConsulAddress is the address of the local Consul agent.
+const defaultConsulAddress = "127.0.0.1:8500"
+
+// consulChunkedState is the value Terraform stores at the state path when the state is split into chunks.
+// States larger than the Consul value size limit are stored in several keys listed in `chunks`.
+type consulChunkedState struct {
+	Chunks []string `json:"chunks"`
+}
+
+// ReadTerraformBackendConsul reads the Terraform state from the configured Consul backend.
+// If the state does not exist, the function returns `nil`.
+// https://developer.hashicorp.com/terraform/language/backend/consul
+func ReadTerraformBackendConsul(
+	_ *schema.AtmosConfiguration,
+	componentSections *map[string]any,
+	_ *schema.AuthContext,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendConsul")()
+
+	backend := getBackendSection(componentSections, cfg.BackendTypeConsul)
+	client := atmoshttp.NewDefaultClient(atmoshttp.WithTimeout(httpBackendTimeout))
+
+	return ReadTerraformBackendConsulInternal(client, componentSections, &backend)
+}
+
+// ReadTerraformBackendConsulInternal accepts an HTTP client and reads the Terraform state from the Consul KV store.
+func ReadTerraformBackendConsulInternal(
+	client atmoshttp.Client,
+	componentSections *map[string]any,
+	backend *map[string]any,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendConsulInternal")()
+
+	statePath := GetBackendAttribute(backend, "path")
+	if statePath == "" {
+		return nil, errUtils.ErrConsulPathRequired
+	}
+
+	// The state of a non-default workspace is stored at `<path>-env:<workspace>`.
+	if workspace := getTerraformWorkspaceOrDefault(componentSections); workspace != "default" {
+		statePath = statePath + "-env:" + workspace
+	}
+
+	reader := consulKVReader{
+		client:     client,
+		baseURL:    consulBaseURL(backend),
+		token:      getBackendAttributeOrEnv(backend, "access_token", "CONSUL_HTTP_TOKEN"),
+		datacenter: GetBackendAttribute(backend, "datacenter"),
+	}
+
+	data, err := reader.read(statePath)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	var chunked consulChunkedState
+	if json.Unmarshal(data, &chunked) == nil && len(chunked.Chunks) > 0 {
+		var assembled []byte
+		for _, chunk := range chunked.Chunks {
+			chunkData, err := reader.read(chunk)
+			if err != nil {
+				return nil, err
+			}
+			if chunkData == nil {
+				return nil, fmt.Errorf("%w: state chunk `%s` does not exist", errUtils.ErrReadConsulBackendState, chunk)
+			}
+			assembled = append(assembled, chunkData...)
+		}
+		data = assembled
+	}
+
+	return decompressState(data)
+}
+
+// consulBaseURL returns the URL of the Consul HTTP API from the `address` and `scheme` of the backend.
+func consulBaseURL(backend *map[string]any) string {
+	address := getBackendAttributeOrEnv(backend, "address", "CONSUL_HTTP_ADDR")
+	if address == "" {
+		address = defaultConsulAddress
+	}
+	if strings.Contains(address, "://") {
+		return strings.TrimSuffix(address, "/")
+	}
+
+	scheme := GetBackendAttribute(backend, "scheme")
+	if scheme == "" {
+		scheme = "http"
+		//nolint:forbidigo // Terraform backend environment variables, not Atmos configuration.
+		if strings.EqualFold(os.Getenv("CONSUL_HTTP_SSL"), "true") {
+			scheme = "https"
+		}
+	}
+	return scheme + "://" + address
+}
+
+// consulKVReader reads raw values from the Consul KV HTTP API.
+type consulKVReader struct {
+	client     atmoshttp.Client
+	baseURL    string
+	token      string
+	datacenter string
+}
+
+func (r *consulKVReader) read(key string) ([]byte, error) {
+	query := url.Values{"raw": []string{""}}
+	if r.datacenter != "" {
+		query.Set("dc", r.datacenter)
+	}
+	address := fmt.Sprintf("%s/v1/kv/%s?%s", r.baseURL, strings.TrimPrefix(key, "/"), query.Encode())
+
+	ctx, cancel := context.WithTimeout(context.Background(), httpBackendTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrReadConsulBackendState, err)
+	}
+	if r.token != "" {
+		req.Header.Set("X-Consul-Token", r.token)
+	}
+
+	return readStateOverHTTP(r.client, req, errUtils.ErrReadConsulBackendState)
+}
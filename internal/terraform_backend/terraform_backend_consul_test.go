@@ -0,0 +1,102 @@
+package terraform_backend_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	tb "github.com/cloudposse/atmos/internal/terraform_backend"
+)
+
+func gzipData(t *testing.T, data string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	_, err := writer.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return buf.Bytes()
+}
+
+func TestReadTerraformBackendConsulInternal(t *testing.T) {
+	half := len(testState) / 2
+	kv := map[string][]byte{
+		"terraform/vpc":                      []byte(testState),
+		"terraform/vpc-env:plat-ue2-dev":     gzipData(t, testState),
+		"terraform/vpc-env:plat-ue2-prod":    []byte(`{"current-hash":"abc","chunks":["terraform/vpc/tfstate.abc/0","terraform/vpc/tfstate.abc/1"]}`),
+		"terraform/vpc/tfstate.abc/0":        []byte(testState[:half]),
+		"terraform/vpc/tfstate.abc/1":        []byte(testState[half:]),
+		"terraform/vpc-env:plat-ue2-staging": []byte(`{"chunks":["terraform/vpc/tfstate.def/0"]}`),
+	}
+
+	var gotToken, gotDatacenter string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotToken = r.Header.Get("X-Consul-Token")
+		gotDatacenter = r.URL.Query().Get("dc")
+		if _, ok := r.URL.Query()["raw"]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		key := strings.TrimPrefix(r.URL.Path, "/v1/kv/")
+		if strings.HasPrefix(key, "terraform/forbidden") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		value, ok := kv[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(value)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		workspace string
+		path      string
+		expected  string
+		wantErr   error
+	}{
+		{name: "default workspace", workspace: "default", path: "terraform/vpc", expected: testState},
+		{name: "gzip compressed workspace state", workspace: "plat-ue2-dev", path: "terraform/vpc", expected: testState},
+		{name: "chunked state", workspace: "plat-ue2-prod", path: "terraform/vpc", expected: testState},
+		{name: "missing chunk", workspace: "plat-ue2-staging", path: "terraform/vpc", wantErr: errUtils.ErrReadConsulBackendState},
+		{name: "missing state", workspace: "plat-ue2-dev", path: "terraform/eks"},
+		{name: "permission denied", workspace: "plat-ue2-dev", path: "terraform/forbidden", wantErr: errUtils.ErrReadConsulBackendState},
+		{name: "missing path", workspace: "plat-ue2-dev", wantErr: errUtils.ErrConsulPathRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			componentSections := map[string]any{"workspace": tt.workspace}
+			backend := map[string]any{
+				"address":      server.URL,
+				"path":         tt.path,
+				"access_token": "consul-token",
+				"datacenter":   "dc1",
+			}
+
+			content, err := tb.ReadTerraformBackendConsulInternal(server.Client(), &componentSections, &backend)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "consul-token", gotToken)
+			assert.Equal(t, "dc1", gotDatacenter)
+			if tt.expected == "" {
+				assert.Nil(t, content)
+				return
+			}
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package terraform_backend
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	atmoshttp "github.com/cloudposse/atmos/pkg/http"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// httpBackendTimeout is the timeout to read a state from an HTTP API.
+const httpBackendTimeout = 30 * time.Second
+
+// ReadTerraformBackendHTTP reads the Terraform state from the configured HTTP backend (e.g., GitLab-managed state).
+// If the state does not exist, the function returns `nil`.
+// https://developer.hashicorp.com/terraform/language/backend/http
+func ReadTerraformBackendHTTP(
+	_ *schema.AtmosConfiguration,
+	componentSections *map[string]any,
+	_ *schema.AuthContext,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendHTTP")()
+
+	backend := getBackendSection(componentSections, cfg.BackendTypeHTTP)
+
+	opts := []atmoshttp.ClientOption{atmoshttp.WithTimeout(httpBackendTimeout)}
+	if getBackendBoolAttribute(&backend, "skip_cert_verification") {
+		opts = append(opts, atmoshttp.WithTransport(&http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // Opt-in through the backend's `skip_cert_verification`.
+		}))
+	}
+
+	return ReadTerraformBackendHTTPInternal(atmoshttp.NewDefaultClient(opts...), &backend)
+}
+
+// ReadTerraformBackendHTTPInternal accepts an HTTP client and reads the Terraform state from the configured HTTP backend.
+// The HTTP backend doesn't support workspaces, so the state is always read from `address`.
+func ReadTerraformBackendHTTPInternal(client atmoshttp.Client, backend *map[string]any) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendHTTPInternal")()
+
+	address := getBackendAttributeOrEnv(backend, "address", "TF_HTTP_ADDRESS")
+	if address == "" {
+		return nil, errUtils.ErrHTTPBackendAddressRequired
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), httpBackendTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrReadHTTPBackendState, err)
+	}
+
+	username := getBackendAttributeOrEnv(backend, "username", "TF_HTTP_USERNAME")
+	password := getBackendAttributeOrEnv(backend, "password", "TF_HTTP_PASSWORD")
+	if username != "" || password != "" {
+		req.SetBasicAuth(username, password)
+	}
+
+	return readStateOverHTTP(client, req, errUtils.ErrReadHTTPBackendState)
+}
+
+// readStateOverHTTP sends the request and returns the response body.
+// A `404 Not Found` or `204 No Content` response, or an empty body, means the state does not exist
+// (the component in the stack has not been provisioned yet) and returns `nil` and no error.
+func readStateOverHTTP(client atmoshttp.Client, req *http.Request, errRead error) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errRead, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusNoContent {
+		log.Debug("Terraform state doesn't exist; returning 'null'", "url", req.URL.Redacted(), "status", resp.StatusCode)
+		return nil, nil
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("%w: %s %s returned %s", errRead, req.Method, req.URL.Redacted(), resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errRead, err)
+	}
+	if len(body) == 0 {
+		return nil, nil
+	}
+	return body, nil
+}
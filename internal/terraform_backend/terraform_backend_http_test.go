@@ -0,0 +1,111 @@
+package terraform_backend_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	tb "github.com/cloudposse/atmos/internal/terraform_backend"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const testState = `{"version":4,"terraform_version":"1.9.0","outputs":{"vpc_id":{"value":"vpc-123","type":"string"}}}`
+
+func TestReadTerraformBackendHTTPInternal(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "gitlab-ci-token" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/state/vpc":
+			_, _ = w.Write([]byte(testState))
+		case "/state/empty":
+			w.WriteHeader(http.StatusNoContent)
+		case "/state/broken":
+			w.WriteHeader(http.StatusInternalServerError)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		backend  map[string]any
+		expected string
+		wantErr  error
+	}{
+		{
+			name:     "reads the state",
+			backend:  map[string]any{"address": server.URL + "/state/vpc", "username": "gitlab-ci-token", "password": "secret"},
+			expected: testState,
+		},
+		{
+			name:    "missing state",
+			backend: map[string]any{"address": server.URL + "/state/missing", "username": "gitlab-ci-token", "password": "secret"},
+		},
+		{
+			name:    "empty state",
+			backend: map[string]any{"address": server.URL + "/state/empty", "username": "gitlab-ci-token", "password": "secret"},
+		},
+		{
+			name:    "unauthorized",
+			backend: map[string]any{"address": server.URL + "/state/vpc"},
+			wantErr: errUtils.ErrReadHTTPBackendState,
+		},
+		{
+			name:    "server error",
+			backend: map[string]any{"address": server.URL + "/state/broken", "username": "gitlab-ci-token", "password": "secret"},
+			wantErr: errUtils.ErrReadHTTPBackendState,
+		},
+		{
+			name:    "missing address",
+			backend: map[string]any{},
+			wantErr: errUtils.ErrHTTPBackendAddressRequired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TF_HTTP_ADDRESS", "")
+			content, err := tb.ReadTerraformBackendHTTPInternal(server.Client(), &tt.backend)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expected == "" {
+				assert.Nil(t, content)
+				return
+			}
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
+
+func TestGetTerraformBackend_HTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testState))
+	}))
+	defer server.Close()
+
+	// Credentials are read from the environment variables of the HTTP backend.
+	t.Setenv("TF_HTTP_USERNAME", "user")
+	t.Setenv("TF_HTTP_PASSWORD", "pass")
+
+	componentSections := map[string]any{
+		"backend_type": "http",
+		"backend":      map[string]any{"address": server.URL},
+		"workspace":    "plat-ue2-dev",
+	}
+
+	outputs, err := tb.GetTerraformBackend(&schema.AtmosConfiguration{}, &componentSections, nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"vpc_id": "vpc-123"}, outputs)
+}
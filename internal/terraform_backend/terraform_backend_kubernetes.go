@@ -0,0 +1,135 @@
+package terraform_backend
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/config/homedir"
+	atmoshttp "github.com/cloudposse/atmos/pkg/http"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	// defaultKubernetesNamespace is the namespace of the state secret when the backend doesn't set one.
+	defaultKubernetesNamespace = "default"
+	// kubernetesStateKey is the key of the gzip compressed state in the secret data.
+	kubernetesStateKey = "tfstate"
+)
+
+// kubernetesSecret is the part of a Kubernetes secret that holds the state.
+// The values of `data` are base64 encoded in the API response and decoded by `encoding/json`.
+type kubernetesSecret struct {
+	Data map[string][]byte `json:"data"`
+}
+
+// ReadTerraformBackendKubernetes reads the Terraform state from the secret of the configured Kubernetes backend.
+// If the state does not exist, the function returns `nil`.
+// https://developer.hashicorp.com/terraform/language/backend/kubernetes
+func ReadTerraformBackendKubernetes(
+	_ *schema.AtmosConfiguration,
+	componentSections *map[string]any,
+	_ *schema.AuthContext,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendKubernetes")()
+
+	backend := getBackendSection(componentSections, cfg.BackendTypeKubernetes)
+
+	restConfig, err := newKubernetesRESTConfig(&backend)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrCreateKubernetesClient, err)
+	}
+
+	client, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrCreateKubernetesClient, err)
+	}
+
+	return ReadTerraformBackendKubernetesInternal(client, restConfig.Host, componentSections, &backend)
+}
+
+// ReadTerraformBackendKubernetesInternal accepts an authenticated HTTP client and the API server address,
+// and reads the Terraform state from the `tfstate-<workspace>-<secret_suffix>` secret.
+func ReadTerraformBackendKubernetesInternal(
+	client atmoshttp.Client,
+	host string,
+	componentSections *map[string]any,
+	backend *map[string]any,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendKubernetesInternal")()
+
+	secretSuffix := GetBackendAttribute(backend, "secret_suffix")
+	if secretSuffix == "" {
+		return nil, errUtils.ErrKubernetesSecretSuffixRequired
+	}
+
+	namespace := getBackendAttributeOrEnv(backend, "namespace", "KUBE_NAMESPACE")
+	if namespace == "" {
+		namespace = defaultKubernetesNamespace
+	}
+
+	secretName := fmt.Sprintf("tfstate-%s-%s", getTerraformWorkspaceOrDefault(componentSections), secretSuffix)
+	address := fmt.Sprintf("%s/api/v1/namespaces/%s/secrets/%s",
+		strings.TrimSuffix(host, "/"), url.PathEscape(namespace), url.PathEscape(secretName))
+
+	ctx, cancel := context.WithTimeout(context.Background(), httpBackendTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrReadKubernetesBackendState, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	body, err := readStateOverHTTP(client, req, errUtils.ErrReadKubernetesBackendState)
+	if err != nil || body == nil {
+		return nil, err
+	}
+
+	var secret kubernetesSecret
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrReadKubernetesBackendState, err)
+	}
+
+	state := secret.Data[kubernetesStateKey]
+	if len(state) == 0 {
+		return nil, nil
+	}
+	return decompressState(state)
+}
+
+// newKubernetesRESTConfig builds the client configuration from the backend settings,
+// the same way the Kubernetes backend does: in-cluster config, or a kubeconfig with optional overrides.
+func newKubernetesRESTConfig(backend *map[string]any) (*rest.Config, error) {
+	if getBackendBoolAttribute(backend, "in_cluster_config") {
+		return rest.InClusterConfig()
+	}
+
+	loadingRules := clientcmd.NewDefaultClientConfigLoadingRules()
+	if configPath := getBackendAttributeOrEnv(backend, "config_path", "KUBE_CONFIG_PATH"); configPath != "" {
+		expanded, err := homedir.Expand(configPath)
+		if err != nil {
+			return nil, err
+		}
+		loadingRules.ExplicitPath = expanded
+	}
+
+	overrides := &clientcmd.ConfigOverrides{}
+	overrides.CurrentContext = getBackendAttributeOrEnv(backend, "config_context", "KUBE_CTX")
+	overrides.Context.AuthInfo = getBackendAttributeOrEnv(backend, "config_context_auth_info", "KUBE_CTX_AUTH_INFO")
+	overrides.Context.Cluster = getBackendAttributeOrEnv(backend, "config_context_cluster", "KUBE_CTX_CLUSTER")
+	overrides.ClusterInfo.Server = getBackendAttributeOrEnv(backend, "host", "KUBE_HOST")
+	overrides.ClusterInfo.InsecureSkipTLSVerify = getBackendBoolAttribute(backend, "insecure")
+	overrides.AuthInfo.Token = getBackendAttributeOrEnv(backend, "token", "KUBE_TOKEN")
+
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+}
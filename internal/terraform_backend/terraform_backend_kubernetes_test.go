@@ -0,0 +1,70 @@
+package terraform_backend_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	tb "github.com/cloudposse/atmos/internal/terraform_backend"
+)
+
+func TestReadTerraformBackendKubernetesInternal(t *testing.T) {
+	// `encoding/json` base64 encodes `[]byte` values, the same way the Kubernetes API returns secret data.
+	secret, err := json.Marshal(map[string]any{
+		"kind": "Secret",
+		"data": map[string][]byte{"tfstate": gzipData(t, testState)},
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/namespaces/terraform/secrets/tfstate-plat-ue2-dev-vpc":
+			_, _ = w.Write(secret)
+		case "/api/v1/namespaces/default/secrets/tfstate-default-vpc":
+			_, _ = w.Write([]byte(`{"kind":"Secret","data":{}}`))
+		case "/api/v1/namespaces/forbidden/secrets/tfstate-plat-ue2-dev-vpc":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		workspace string
+		backend   map[string]any
+		expected  string
+		wantErr   error
+	}{
+		{name: "reads the state", workspace: "plat-ue2-dev", backend: map[string]any{"secret_suffix": "vpc", "namespace": "terraform"}, expected: testState},
+		{name: "secret without state", backend: map[string]any{"secret_suffix": "vpc"}},
+		{name: "missing secret", workspace: "plat-ue2-prod", backend: map[string]any{"secret_suffix": "vpc", "namespace": "terraform"}},
+		{name: "permission denied", workspace: "plat-ue2-dev", backend: map[string]any{"secret_suffix": "vpc", "namespace": "forbidden"}, wantErr: errUtils.ErrReadKubernetesBackendState},
+		{name: "missing secret suffix", workspace: "plat-ue2-dev", backend: map[string]any{}, wantErr: errUtils.ErrKubernetesSecretSuffixRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("KUBE_NAMESPACE", "")
+			componentSections := map[string]any{"workspace": tt.workspace}
+
+			content, err := tb.ReadTerraformBackendKubernetesInternal(server.Client(), server.URL, &componentSections, &tt.backend)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expected == "" {
+				assert.Nil(t, content)
+				return
+			}
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
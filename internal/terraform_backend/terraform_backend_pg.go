@@ -0,0 +1,115 @@
+package terraform_backend
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	// defaultPgSchemaName is the schema Terraform creates the `states` table in.
+	defaultPgSchemaName = "terraform_remote_state"
+	// pgQueryTimeout is the timeout to read a state from the database.
+	pgQueryTimeout = 30 * time.Second
+	// pgStateQueryFormat selects the state of a workspace from the `states` table of a schema.
+	pgStateQueryFormat = "SELECT data FROM %s.states WHERE name = $1"
+
+	// PostgreSQL error codes returned when the schema or the table has not been created yet.
+	pgErrUndefinedTable    = "42P01"
+	pgErrInvalidSchemaName = "3F000"
+)
+
+// pgDBCache caches the database handles based on a hash of the connection string.
+// It's a map[string]*sql.DB.
+var pgDBCache sync.Map
+
+func getCachedPgDB(connStr string) (*sql.DB, error) {
+	// Hash the connection string to avoid keeping credentials in the cache key.
+	h := sha256.Sum256([]byte(connStr))
+	cacheKey := hex.EncodeToString(h[:8]) // Use first 8 bytes for brevity.
+
+	if cached, ok := pgDBCache.Load(cacheKey); ok {
+		return cached.(*sql.DB), nil
+	}
+
+	db, err := sql.Open("postgres", connStr)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrReadPgBackendState, err)
+	}
+
+	pgDBCache.Store(cacheKey, db)
+	return db, nil
+}
+
+// ReadTerraformBackendPg reads the Terraform state from the configured Postgres backend.
+// If the state does not exist, the function returns `nil`.
+// https://developer.hashicorp.com/terraform/language/backend/pg
+func ReadTerraformBackendPg(
+	_ *schema.AtmosConfiguration,
+	componentSections *map[string]any,
+	_ *schema.AuthContext,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendPg")()
+
+	backend := getBackendSection(componentSections, cfg.BackendTypePg)
+
+	connStr := getBackendAttributeOrEnv(&backend, "conn_str", "PG_CONN_STR")
+	if connStr == "" {
+		return nil, errUtils.ErrPgConnStrRequired
+	}
+
+	db, err := getCachedPgDB(connStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadTerraformBackendPgInternal(db, componentSections, &backend)
+}
+
+// ReadTerraformBackendPgInternal accepts a database handle and reads the Terraform state from the `states` table.
+// Each workspace is a row in the table, named after the workspace.
+func ReadTerraformBackendPgInternal(
+	db *sql.DB,
+	componentSections *map[string]any,
+	backend *map[string]any,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendPgInternal")()
+
+	schemaName := getBackendAttributeOrEnv(backend, "schema_name", "PG_SCHEMA_NAME")
+	if schemaName == "" {
+		schemaName = defaultPgSchemaName
+	}
+	workspace := getTerraformWorkspaceOrDefault(componentSections)
+
+	ctx, cancel := context.WithTimeout(context.Background(), pgQueryTimeout)
+	defer cancel()
+
+	var data []byte
+	err := db.QueryRowContext(ctx, fmt.Sprintf(pgStateQueryFormat, pq.QuoteIdentifier(schemaName)), workspace).Scan(&data)
+	if err == nil {
+		return data, nil
+	}
+
+	// If the state does not exist (the component in the stack has not been provisioned yet), return a `nil` result and no error.
+	var pqErr *pq.Error
+	if errors.Is(err, sql.ErrNoRows) ||
+		(errors.As(err, &pqErr) && (pqErr.Code == pgErrUndefinedTable || pqErr.Code == pgErrInvalidSchemaName)) {
+		log.Debug("Terraform state doesn't exist in the pg backend; returning 'null'", "schema", schemaName, "workspace", workspace)
+		return nil, nil
+	}
+
+	return nil, fmt.Errorf(errWrapFormat, errUtils.ErrReadPgBackendState, err)
+}
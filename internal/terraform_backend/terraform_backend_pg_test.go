@@ -0,0 +1,146 @@
+package terraform_backend_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	tb "github.com/cloudposse/atmos/internal/terraform_backend"
+)
+
+// fakePgDriver is a stand-in for Postgres that serves the `states` tables of the schemas in `states`.
+type fakePgDriver struct {
+	mu     sync.Mutex
+	states map[string]map[string][]byte
+	err    error
+}
+
+var fakePg = &fakePgDriver{}
+
+func init() {
+	sql.Register("fakepg", fakePg)
+}
+
+func (d *fakePgDriver) Open(string) (driver.Conn, error) { return &fakePgConn{driver: d}, nil }
+
+type fakePgConn struct{ driver *fakePgDriver }
+
+func (c *fakePgConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakePgStmt{driver: c.driver, query: query}, nil
+}
+func (c *fakePgConn) Close() error              { return nil }
+func (c *fakePgConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakePgStmt struct {
+	driver *fakePgDriver
+	query  string
+}
+
+func (s *fakePgStmt) Close() error  { return nil }
+func (s *fakePgStmt) NumInput() int { return 1 }
+func (s *fakePgStmt) Exec([]driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *fakePgStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.driver.mu.Lock()
+	defer s.driver.mu.Unlock()
+
+	if s.driver.err != nil {
+		return nil, s.driver.err
+	}
+
+	// The query is `SELECT data FROM "<schema>".states WHERE name = $1`.
+	schemaName := strings.TrimSuffix(strings.TrimPrefix(strings.Fields(s.query)[3], `"`), `".states`)
+	table, ok := s.driver.states[schemaName]
+	if !ok {
+		return nil, &pq.Error{Code: "42P01", Message: "relation does not exist"}
+	}
+
+	rows := &fakePgRows{}
+	if data, ok := table[args[0].(string)]; ok {
+		rows.values = [][]byte{data}
+	}
+	return rows, nil
+}
+
+type fakePgRows struct {
+	values [][]byte
+	next   int
+}
+
+func (r *fakePgRows) Columns() []string { return []string{"data"} }
+func (r *fakePgRows) Close() error      { return nil }
+
+func (r *fakePgRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.next]
+	r.next++
+	return nil
+}
+
+func TestReadTerraformBackendPgInternal(t *testing.T) {
+	fakePg.states = map[string]map[string][]byte{
+		"terraform_remote_state": {"plat-ue2-dev": []byte(testState)},
+		"custom":                 {"default": []byte(testState)},
+	}
+
+	db, err := sql.Open("fakepg", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	tests := []struct {
+		name      string
+		workspace string
+		backend   map[string]any
+		driverErr error
+		expected  string
+		wantErr   error
+	}{
+		{name: "workspace state in default schema", workspace: "plat-ue2-dev", backend: map[string]any{}, expected: testState},
+		{name: "default workspace in custom schema", backend: map[string]any{"schema_name": "custom"}, expected: testState},
+		{name: "missing workspace", workspace: "plat-ue2-prod", backend: map[string]any{}},
+		{name: "missing table", workspace: "plat-ue2-dev", backend: map[string]any{"schema_name": "missing"}},
+		{name: "connection error", workspace: "plat-ue2-dev", backend: map[string]any{}, driverErr: errors.New("connection refused"), wantErr: errUtils.ErrReadPgBackendState},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("PG_SCHEMA_NAME", "")
+			fakePg.err = tt.driverErr
+			defer func() { fakePg.err = nil }()
+
+			componentSections := map[string]any{"workspace": tt.workspace}
+			content, err := tb.ReadTerraformBackendPgInternal(db, &componentSections, &tt.backend)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expected == "" {
+				assert.Nil(t, content)
+				return
+			}
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
+
+func TestReadTerraformBackendPg_MissingConnStr(t *testing.T) {
+	t.Setenv("PG_CONN_STR", "")
+	componentSections := map[string]any{"backend": map[string]any{}}
+
+	_, err := tb.ReadTerraformBackendPg(nil, &componentSections, nil)
+	assert.ErrorIs(t, err, errUtils.ErrPgConnStrRequired)
+}
@@ -26,6 +26,12 @@ func RegisterTerraformBackends() {
 	terraformBackends[cfg.BackendTypeS3] = ReadTerraformBackendS3
 	terraformBackends[cfg.BackendTypeGCS] = ReadTerraformBackendGCS
 	terraformBackends[cfg.BackendTypeAzurerm] = ReadTerraformBackendAzurerm
+	terraformBackends[cfg.BackendTypeHTTP] = ReadTerraformBackendHTTP
+	terraformBackends[cfg.BackendTypePg] = ReadTerraformBackendPg
+	terraformBackends[cfg.BackendTypeConsul] = ReadTerraformBackendConsul
+	terraformBackends[cfg.BackendTypeKubernetes] = ReadTerraformBackendKubernetes
+	terraformBackends[cfg.BackendTypeRemote] = ReadTerraformBackendRemote
+	terraformBackends[cfg.BackendTypeCloud] = ReadTerraformBackendRemote
 }
 
 // GetTerraformBackendReadFunc accepts a backend type and returns a function to read the state file from the backend.
@@ -14,4 +14,10 @@ func TestGetTerraformBackendReadFunc(t *testing.T) {
 
 	assert.NotNil(t, tb.GetTerraformBackendReadFunc(cfg.BackendTypeLocal))
 	assert.NotNil(t, tb.GetTerraformBackendReadFunc(cfg.BackendTypeS3))
+	assert.NotNil(t, tb.GetTerraformBackendReadFunc(cfg.BackendTypeHTTP))
+	assert.NotNil(t, tb.GetTerraformBackendReadFunc(cfg.BackendTypePg))
+	assert.NotNil(t, tb.GetTerraformBackendReadFunc(cfg.BackendTypeConsul))
+	assert.NotNil(t, tb.GetTerraformBackendReadFunc(cfg.BackendTypeKubernetes))
+	assert.NotNil(t, tb.GetTerraformBackendReadFunc(cfg.BackendTypeRemote))
+	assert.NotNil(t, tb.GetTerraformBackendReadFunc(cfg.BackendTypeCloud))
 }
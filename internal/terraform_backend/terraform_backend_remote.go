@@ -0,0 +1,209 @@
+package terraform_backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/config/homedir"
+	atmoshttp "github.com/cloudposse/atmos/pkg/http"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// defaultRemoteBackendHostname is the hostname of HCP Terraform.
+const defaultRemoteBackendHostname = "app.terraform.io"
+
+// tfeResource is the part of an HCP Terraform API resource that's needed to locate the state.
+type tfeResource struct {
+	Data struct {
+		ID         string         `json:"id"`
+		Attributes map[string]any `json:"attributes"`
+	} `json:"data"`
+}
+
+// terraformCredentials is the format of the Terraform CLI credentials file written by `terraform login`.
+type terraformCredentials struct {
+	Credentials map[string]struct {
+		Token string `json:"token"`
+	} `json:"credentials"`
+}
+
+// ReadTerraformBackendRemote reads the Terraform state of the workspace from HCP Terraform or Terraform Enterprise,
+// for both the `remote` backend and the `cloud` block.
+// If the workspace or its state does not exist, the function returns `nil`.
+// https://developer.hashicorp.com/terraform/language/backend/remote
+// https://developer.hashicorp.com/terraform/cli/cloud/settings
+func ReadTerraformBackendRemote(
+	_ *schema.AtmosConfiguration,
+	componentSections *map[string]any,
+	_ *schema.AuthContext,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendRemote")()
+
+	backendType := GetComponentBackendType(componentSections)
+	if backendType == "" {
+		backendType = cfg.BackendTypeRemote
+	}
+	backend := getBackendSection(componentSections, backendType)
+
+	hostname := getBackendAttributeOrEnv(&backend, "hostname", "TF_CLOUD_HOSTNAME")
+	if hostname == "" {
+		hostname = defaultRemoteBackendHostname
+	}
+
+	token := getBackendAttributeOrEnv(&backend, "token", remoteBackendTokenEnvVar(hostname))
+	if token == "" {
+		token = readTerraformCredentialsToken(hostname)
+	}
+	if token == "" {
+		return nil, fmt.Errorf("%w: set `token` in the backend, `%s`, or run `terraform login %s`",
+			errUtils.ErrRemoteBackendTokenNeeded, remoteBackendTokenEnvVar(hostname), hostname)
+	}
+
+	client := atmoshttp.NewDefaultClient(atmoshttp.WithTimeout(httpBackendTimeout))
+	return ReadTerraformBackendRemoteInternal(client, "https://"+hostname, token, componentSections, &backend)
+}
+
+// ReadTerraformBackendRemoteInternal accepts an HTTP client, the API address and token,
+// and downloads the current state version of the workspace.
+func ReadTerraformBackendRemoteInternal(
+	client atmoshttp.Client,
+	baseURL string,
+	token string,
+	componentSections *map[string]any,
+	backend *map[string]any,
+) ([]byte, error) {
+	defer perf.Track(nil, "terraform_backend.ReadTerraformBackendRemoteInternal")()
+
+	organization := getBackendAttributeOrEnv(backend, "organization", "TF_CLOUD_ORGANIZATION")
+	if organization == "" {
+		return nil, errUtils.ErrRemoteBackendOrganizationNeeded
+	}
+
+	workspace, err := remoteBackendWorkspace(componentSections, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	api := tfeClient{client: client, baseURL: strings.TrimSuffix(baseURL, "/"), token: token}
+
+	ws, err := api.get(fmt.Sprintf("/api/v2/organizations/%s/workspaces/%s", url.PathEscape(organization), url.PathEscape(workspace)))
+	if err != nil || ws == nil {
+		return nil, err
+	}
+
+	stateVersion, err := api.get(fmt.Sprintf("/api/v2/workspaces/%s/current-state-version", url.PathEscape(ws.Data.ID)))
+	if err != nil || stateVersion == nil {
+		return nil, err
+	}
+
+	downloadURL, _ := stateVersion.Data.Attributes["hosted-state-download-url"].(string)
+	if downloadURL == "" {
+		log.Debug("Current state version of the workspace has no state to download; returning 'null'", "workspace", workspace)
+		return nil, nil
+	}
+
+	return api.download(downloadURL)
+}
+
+// remoteBackendWorkspace returns the name of the HCP Terraform workspace of the component.
+// A workspace `name` is used as-is (with Atmos context tokens such as `{terraform_workspace}` replaced),
+// a `prefix` (remote backend) is prepended to the Terraform workspace,
+// and workspaces selected by `tags` or `project` (cloud block) are named after the Terraform workspace.
+func remoteBackendWorkspace(componentSections *map[string]any, backend *map[string]any) (string, error) {
+	terraformWorkspace := GetTerraformWorkspace(componentSections)
+	workspaces, _ := (*backend)["workspaces"].(map[string]any)
+
+	if name, ok := workspaces["name"].(string); ok && name != "" {
+		return cfg.ReplaceContextTokens(schema.Context{TerraformWorkspace: terraformWorkspace}, name), nil
+	}
+
+	if terraformWorkspace == "" {
+		return "", errUtils.ErrRemoteBackendWorkspaceNeeded
+	}
+
+	if prefix, ok := workspaces["prefix"].(string); ok {
+		return prefix + terraformWorkspace, nil
+	}
+	return terraformWorkspace, nil
+}
+
+// remoteBackendTokenEnvVar returns the environment variable Terraform reads the API token for the hostname from.
+// Dots in the hostname are replaced by underscores and hyphens by double underscores.
+func remoteBackendTokenEnvVar(hostname string) string {
+	return "TF_TOKEN_" + strings.NewReplacer(".", "_", "-", "__").Replace(hostname)
+}
+
+// readTerraformCredentialsToken returns the API token for the hostname from the Terraform CLI credentials file,
+// or an empty string when the file or the hostname doesn't exist.
+func readTerraformCredentialsToken(hostname string) string {
+	home, err := homedir.Dir()
+	if err != nil {
+		return ""
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, ".terraform.d", "credentials.tfrc.json"))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Failed to read Terraform credentials file", "error", err)
+		}
+		return ""
+	}
+
+	var credentials terraformCredentials
+	if err := json.Unmarshal(data, &credentials); err != nil {
+		log.Debug("Failed to parse Terraform credentials file", "error", err)
+		return ""
+	}
+	return credentials.Credentials[hostname].Token
+}
+
+// tfeClient calls the HCP Terraform API.
+type tfeClient struct {
+	client  atmoshttp.Client
+	baseURL string
+	token   string
+}
+
+// get reads an API resource, or returns `nil` when it doesn't exist.
+func (c *tfeClient) get(path string) (*tfeResource, error) {
+	body, err := c.do(c.baseURL + path)
+	if err != nil || body == nil {
+		return nil, err
+	}
+
+	var resource tfeResource
+	if err := json.Unmarshal(body, &resource); err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrReadRemoteBackendState, err)
+	}
+	return &resource, nil
+}
+
+// download reads the state from the download URL of a state version.
+func (c *tfeClient) download(downloadURL string) ([]byte, error) {
+	return c.do(downloadURL)
+}
+
+func (c *tfeClient) do(address string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), httpBackendTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrReadRemoteBackendState, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/vnd.api+json")
+
+	return readStateOverHTTP(c.client, req, errUtils.ErrReadRemoteBackendState)
+}
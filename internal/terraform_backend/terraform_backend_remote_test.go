@@ -0,0 +1,135 @@
+package terraform_backend_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	tb "github.com/cloudposse/atmos/internal/terraform_backend"
+)
+
+func TestReadTerraformBackendRemoteInternal(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tfe-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v2/organizations/acme/workspaces/vpc-plat-ue2-dev":
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-dev","type":"workspaces"}}`))
+		case "/api/v2/organizations/acme/workspaces/plat-ue2-prod":
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-prod","type":"workspaces"}}`))
+		case "/api/v2/organizations/acme/workspaces/plat-ue2-staging":
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-staging","type":"workspaces"}}`))
+		case "/api/v2/workspaces/ws-dev/current-state-version", "/api/v2/workspaces/ws-prod/current-state-version":
+			_, _ = w.Write([]byte(`{"data":{"id":"sv-1","attributes":{"hosted-state-download-url":"` + server.URL + `/state/sv-1"}}}`))
+		case "/api/v2/workspaces/ws-staging/current-state-version":
+			_, _ = w.Write([]byte(`{"data":{"id":"sv-2","attributes":{}}}`))
+		case "/state/sv-1":
+			_, _ = w.Write([]byte(testState))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		workspace string
+		token     string
+		backend   map[string]any
+		expected  string
+		wantErr   error
+	}{
+		{
+			name:      "workspace name with context tokens",
+			workspace: "plat-ue2-dev",
+			backend:   map[string]any{"organization": "acme", "workspaces": map[string]any{"name": "vpc-{terraform_workspace}"}},
+			expected:  testState,
+		},
+		{
+			name:      "workspace prefix",
+			workspace: "ue2-dev",
+			backend:   map[string]any{"organization": "acme", "workspaces": map[string]any{"prefix": "vpc-plat-"}},
+			expected:  testState,
+		},
+		{
+			name:      "workspace selected by tags",
+			workspace: "plat-ue2-prod",
+			backend:   map[string]any{"organization": "acme", "workspaces": map[string]any{"tags": []any{"vpc"}}},
+			expected:  testState,
+		},
+		{
+			name:      "workspace without state",
+			workspace: "plat-ue2-staging",
+			backend:   map[string]any{"organization": "acme"},
+		},
+		{
+			name:      "missing workspace",
+			workspace: "plat-ue2-qa",
+			backend:   map[string]any{"organization": "acme"},
+		},
+		{
+			name:      "invalid token",
+			workspace: "plat-ue2-prod",
+			token:     "invalid",
+			backend:   map[string]any{"organization": "acme"},
+			wantErr:   errUtils.ErrReadRemoteBackendState,
+		},
+		{
+			name:    "missing workspace name",
+			backend: map[string]any{"organization": "acme"},
+			wantErr: errUtils.ErrRemoteBackendWorkspaceNeeded,
+		},
+		{
+			name:      "missing organization",
+			workspace: "plat-ue2-prod",
+			backend:   map[string]any{},
+			wantErr:   errUtils.ErrRemoteBackendOrganizationNeeded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TF_CLOUD_ORGANIZATION", "")
+			token := tt.token
+			if token == "" {
+				token = "tfe-token"
+			}
+			componentSections := map[string]any{"workspace": tt.workspace}
+
+			content, err := tb.ReadTerraformBackendRemoteInternal(server.Client(), server.URL, token, &componentSections, &tt.backend)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			if tt.expected == "" {
+				assert.Nil(t, content)
+				return
+			}
+			assert.Equal(t, tt.expected, string(content))
+		})
+	}
+}
+
+func TestReadTerraformBackendRemote_MissingToken(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("TF_TOKEN_tfe_example-corp_com", "")
+	t.Setenv("TF_TOKEN_tfe_example__corp_com", "")
+
+	componentSections := map[string]any{
+		"backend_type": "cloud",
+		"backend":      map[string]any{"hostname": "tfe.example-corp.com", "organization": "acme"},
+		"workspace":    "plat-ue2-dev",
+	}
+
+	_, err := tb.ReadTerraformBackendRemote(nil, &componentSections, nil)
+	assert.ErrorIs(t, err, errUtils.ErrRemoteBackendTokenNeeded)
+	assert.ErrorContains(t, err, "TF_TOKEN_tfe_example__corp_com")
+}
@@ -1,8 +1,12 @@
 package terraform_backend
 
 import (
+	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strings"
 
 	errUtils "github.com/cloudposse/atmos/errors"
@@ -62,6 +66,74 @@ func GetBackendAttribute(section *map[string]any, attribute string) string {
 	return ""
 }
 
+// getBackendSection returns the configuration of the backend type.
+// The configuration is either nested under the backend type or already flattened (e.g., from the stack processor).
+func getBackendSection(componentSections *map[string]any, backendType string) map[string]any {
+	backend := GetComponentBackend(componentSections)
+	if section, ok := backend[backendType].(map[string]any); ok {
+		return section
+	}
+	if backend == nil {
+		return map[string]any{}
+	}
+	return backend
+}
+
+// getBackendAttributeOrEnv returns an attribute from the backend config.
+// When the attribute is not set, it falls back to the environment variables Terraform reads for the backend.
+func getBackendAttributeOrEnv(backend *map[string]any, attribute string, envVars ...string) string {
+	if value := GetBackendAttribute(backend, attribute); value != "" {
+		return value
+	}
+	for _, envVar := range envVars {
+		//nolint:forbidigo // Terraform backend environment variables, not Atmos configuration.
+		if value := os.Getenv(envVar); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// getBackendBoolAttribute returns a boolean attribute from the backend config.
+func getBackendBoolAttribute(backend *map[string]any, attribute string) bool {
+	switch value := (*backend)[attribute].(type) {
+	case bool:
+		return value
+	case string:
+		return strings.EqualFold(value, "true")
+	default:
+		return false
+	}
+}
+
+// getTerraformWorkspaceOrDefault returns the Terraform workspace of the component, or `default` when it's not set.
+func getTerraformWorkspaceOrDefault(componentSections *map[string]any) string {
+	if workspace := GetTerraformWorkspace(componentSections); workspace != "" {
+		return workspace
+	}
+	return "default"
+}
+
+// decompressState returns the state as-is, or decompressed when it's gzip compressed.
+// Backends that compress the state (e.g., `consul` with `gzip = true` and `kubernetes`) are detected by the gzip header.
+func decompressState(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0x1f || data[1] != 0x8b {
+		return data, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrDecompressTerraformState, err)
+	}
+	defer reader.Close()
+
+	decompressed, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, errUtils.ErrDecompressTerraformState, err)
+	}
+	return decompressed, nil
+}
+
 // GetTerraformBackendVariable returns the output from the configured backend.
 func GetTerraformBackendVariable(
 	atmosConfig *schema.AtmosConfiguration,
@@ -134,7 +206,7 @@ func GetTerraformBackend(
 
 	readBackendStateFunc := GetTerraformBackendReadFunc(backendType)
 	if readBackendStateFunc == nil {
-		return nil, fmt.Errorf("%w: `%s`\nsupported backends: `local`, `s3`, `gcs`, `azurerm`", errUtils.ErrUnsupportedBackendType, backendType)
+		return nil, fmt.Errorf("%w: `%s`\nsupported backends: `local`, `s3`, `gcs`, `azurerm`, `http`, `pg`, `consul`, `kubernetes`, `remote`, `cloud`", errUtils.ErrUnsupportedBackendType, backendType)
 	}
 
 	content, err := readBackendStateFunc(atmosConfig, componentSections, authContext)
@@ -0,0 +1,375 @@
+// Package agent implements the `atmos auth agent` daemon.
+// The agent keeps credentials for one or more identities fresh by re-running the authentication
+// chain before they expire, and serves them to child processes over a Unix socket. AWS SDKs
+// consume them through a generated `credential_process` profile, so long-running commands such as
+// `terraform apply` pick up refreshed credentials without restarting.
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	awsCloud "github.com/cloudposse/atmos/pkg/auth/cloud/aws"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/xdg"
+)
+
+const (
+	// SocketEnvVar points `atmos auth exec`, `atmos auth shell` and the credential_process helper at a running agent.
+	SocketEnvVar = "ATMOS_AUTH_AGENT_SOCKET"
+
+	// DefaultRefreshInterval is how often the agent checks whether credentials need refreshing.
+	DefaultRefreshInterval = time.Minute
+
+	// RefreshBefore is how long before expiry credentials are refreshed.
+	// It matches the auth manager's validity buffer so a refresh always triggers re-authentication.
+	RefreshBefore = 15 * time.Minute
+
+	socketFileName = "agent.sock"
+	dirPermissions = 0o700
+	requestTimeout = 5 * time.Minute
+)
+
+// Authenticator runs the authentication chain for an identity.
+// It is satisfied by types.AuthManager.
+type Authenticator interface {
+	Authenticate(ctx context.Context, identityName string) (*types.WhoamiInfo, error)
+}
+
+// Options configures an Agent.
+type Options struct {
+	// SocketPath is the Unix socket to listen on. Defaults to DefaultSocketPath().
+	SocketPath string
+	// Identities are authenticated when the agent starts. Other identities are authenticated on first request.
+	Identities []string
+	// RefreshInterval is how often credentials are checked for expiry. Defaults to DefaultRefreshInterval.
+	RefreshInterval time.Duration
+	// Executable is the atmos binary referenced by the generated credential_process. Defaults to os.Executable().
+	Executable string
+	// OnListen is called once the socket accepts connections.
+	OnListen func()
+}
+
+// Agent refreshes identity credentials in the background and serves them over a Unix socket.
+type Agent struct {
+	auth            Authenticator
+	socketPath      string
+	executable      string
+	refreshInterval time.Duration
+	identities      []string
+	onListen        func()
+
+	// mu serializes authentication; the auth manager tracks the current chain and is not safe for concurrent use.
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+// entry holds the most recent credentials for an identity.
+type entry struct {
+	credentials types.ICredentials
+	expiration  *time.Time
+}
+
+// IdentityStatus describes an identity served by the agent.
+type IdentityStatus struct {
+	Name       string     `json:"name"`
+	Expiration *time.Time `json:"expiration,omitempty"`
+}
+
+// DefaultSocketPath returns the default agent socket path in the XDG state directory.
+func DefaultSocketPath() (string, error) {
+	defer perf.Track(nil, "agent.DefaultSocketPath")()
+
+	dir, err := xdg.GetXDGStateDir(filepath.Join("auth", "agent"), dirPermissions)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, socketFileName), nil
+}
+
+// New creates an agent. Call Run to start serving.
+func New(auth Authenticator, opts Options) (*Agent, error) {
+	defer perf.Track(nil, "agent.New")()
+
+	if auth == nil {
+		return nil, fmt.Errorf("%w: authenticator", errUtils.ErrNilParam)
+	}
+
+	a := &Agent{
+		auth:            auth,
+		socketPath:      opts.SocketPath,
+		executable:      opts.Executable,
+		refreshInterval: opts.RefreshInterval,
+		identities:      opts.Identities,
+		onListen:        opts.OnListen,
+		entries:         map[string]*entry{},
+	}
+
+	if a.socketPath == "" {
+		path, err := DefaultSocketPath()
+		if err != nil {
+			return nil, err
+		}
+		a.socketPath = path
+	}
+	if a.executable == "" {
+		exe, err := os.Executable()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve atmos executable: %w", err)
+		}
+		a.executable = exe
+	}
+	if a.refreshInterval <= 0 {
+		a.refreshInterval = DefaultRefreshInterval
+	}
+
+	return a, nil
+}
+
+// SocketPath returns the socket the agent listens on.
+func (a *Agent) SocketPath() string {
+	return a.socketPath
+}
+
+// Run authenticates the configured identities, then serves requests and refreshes credentials
+// until ctx is canceled. The socket is removed on return.
+func (a *Agent) Run(ctx context.Context) error {
+	defer perf.Track(nil, "agent.Agent.Run")()
+
+	for _, identity := range a.identities {
+		if _, err := a.credentials(ctx, identity); err != nil {
+			return err
+		}
+	}
+
+	listener, err := a.listen()
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           a.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	if a.onListen != nil {
+		a.onListen()
+	}
+	go a.refreshLoop(ctx)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- server.Serve(listener) }()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+		a.cleanup()
+		return nil
+	case err := <-errCh:
+		a.cleanup()
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	}
+}
+
+// listen creates the Unix socket, replacing a stale socket left by an agent that didn't exit cleanly.
+func (a *Agent) listen() (net.Listener, error) {
+	if err := os.MkdirAll(filepath.Dir(a.socketPath), dirPermissions); err != nil {
+		return nil, fmt.Errorf("failed to create agent directory: %w", err)
+	}
+
+	if _, err := os.Stat(a.socketPath); err == nil {
+		if conn, dialErr := net.DialTimeout("unix", a.socketPath, time.Second); dialErr == nil {
+			_ = conn.Close()
+			return nil, errUtils.Build(errUtils.ErrAuthAgentAlreadyRunning).
+				WithExplanationf("Another agent is listening on `%s`", a.socketPath).
+				WithHintf("Export `%s=%s` to use it, or pass `--socket` to start a second agent", SocketEnvVar, a.socketPath).
+				WithExitCode(1).
+				Err()
+		}
+		log.Debug("Removing stale auth agent socket", "socket", a.socketPath)
+		if err := os.Remove(a.socketPath); err != nil {
+			return nil, fmt.Errorf("failed to remove stale agent socket: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("unix", a.socketPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", a.socketPath, err)
+	}
+	// Only the owner may talk to the agent.
+	if err := os.Chmod(a.socketPath, 0o600); err != nil {
+		_ = listener.Close()
+		return nil, fmt.Errorf("failed to restrict agent socket permissions: %w", err)
+	}
+	return listener, nil
+}
+
+// cleanup removes the socket and generated AWS files.
+func (a *Agent) cleanup() {
+	_ = os.Remove(a.socketPath)
+	_ = os.RemoveAll(a.awsDir())
+}
+
+// refreshLoop periodically refreshes every identity the agent has served.
+func (a *Agent) refreshLoop(ctx context.Context) {
+	ticker := time.NewTicker(a.refreshInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.RefreshAll(ctx)
+		}
+	}
+}
+
+// RefreshAll refreshes credentials that expire within RefreshBefore.
+// Failures are logged; the previous credentials keep being served until they expire.
+func (a *Agent) RefreshAll(ctx context.Context) {
+	defer perf.Track(nil, "agent.Agent.RefreshAll")()
+
+	for _, status := range a.Identities() {
+		if _, err := a.credentials(ctx, status.Name); err != nil {
+			log.Warn("Failed to refresh credentials", "identity", status.Name, "error", err)
+		}
+	}
+}
+
+// Identities returns the identities the agent is serving.
+func (a *Agent) Identities() []IdentityStatus {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.identitiesLocked()
+}
+
+// identitiesLocked returns the served identities sorted by name. Callers must hold a.mu.
+func (a *Agent) identitiesLocked() []IdentityStatus {
+	statuses := make([]IdentityStatus, 0, len(a.entries))
+	for name, e := range a.entries {
+		statuses = append(statuses, IdentityStatus{Name: name, Expiration: e.expiration})
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// credentials returns fresh credentials for the identity, re-running the authentication chain
+// when the cached ones expire within RefreshBefore.
+func (a *Agent) credentials(ctx context.Context, identity string) (types.ICredentials, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	current, known := a.entries[identity]
+	if known && !expiresWithin(current.expiration, RefreshBefore) {
+		return current.credentials, nil
+	}
+
+	info, err := a.auth.Authenticate(types.WithSuppressAuthErrors(ctx, true), identity)
+	if err == nil && (info == nil || info.Credentials == nil) {
+		err = fmt.Errorf("%w: identity %q returned no credentials", errUtils.ErrNoCredentialsFound, identity)
+	}
+	if err != nil {
+		if known && !expiresWithin(current.expiration, 0) {
+			log.Warn("Credential refresh failed, serving existing credentials until they expire",
+				"identity", identity, "expiration", current.expiration, "error", err)
+			return current.credentials, nil
+		}
+		return nil, err
+	}
+
+	expiration, _ := info.Credentials.GetExpiration()
+	a.entries[identity] = &entry{credentials: info.Credentials, expiration: expiration}
+	log.Debug("Auth agent refreshed credentials", "identity", identity, "expiration", expiration)
+
+	if !known {
+		if err := a.writeAWSFiles(); err != nil {
+			log.Warn("Failed to write AWS config for auth agent", "error", err)
+		}
+	}
+	return info.Credentials, nil
+}
+
+// expiresWithin reports whether exp is set and falls within d from now.
+func expiresWithin(exp *time.Time, d time.Duration) bool {
+	return exp != nil && time.Until(*exp) <= d
+}
+
+// Handler returns the HTTP handler served on the agent socket.
+func (a *Agent) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/identities", a.handleIdentities)
+	mux.HandleFunc("GET /v1/identities/{name}/credentials", a.handleCredentials)
+	mux.HandleFunc("GET /v1/identities/{name}/environment", a.handleEnvironment)
+	return mux
+}
+
+func (a *Agent) handleIdentities(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, http.StatusOK, a.Identities())
+}
+
+func (a *Agent) handleCredentials(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	creds, err := a.credentials(ctx, r.PathValue("name"))
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	processCreds, err := awsCloud.NewProcessCredentials(creds)
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, processCreds)
+}
+
+func (a *Agent) handleEnvironment(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+	defer cancel()
+
+	identity := r.PathValue("name")
+	creds, err := a.credentials(ctx, identity)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, a.environment(identity, creds))
+}
+
+// errorResponse is the body returned for failed requests.
+type errorResponse struct {
+	Error string `json:"error"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Debug("Failed to write auth agent response", "error", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, errorResponse{Error: err.Error()})
+}
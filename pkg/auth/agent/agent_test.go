@@ -0,0 +1,238 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+)
+
+// fakeAuthenticator issues AWS credentials with a configurable lifetime and counts calls.
+type fakeAuthenticator struct {
+	mu       sync.Mutex
+	lifetime time.Duration
+	calls    map[string]int
+	err      error
+	creds    types.ICredentials
+}
+
+func newFakeAuthenticator(lifetime time.Duration) *fakeAuthenticator {
+	return &fakeAuthenticator{lifetime: lifetime, calls: map[string]int{}}
+}
+
+func (f *fakeAuthenticator) Authenticate(_ context.Context, identity string) (*types.WhoamiInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls[identity]++
+	if f.err != nil {
+		return nil, f.err
+	}
+	if f.creds != nil {
+		return &types.WhoamiInfo{Identity: identity, Credentials: f.creds}, nil
+	}
+	return &types.WhoamiInfo{
+		Identity: identity,
+		Credentials: &types.AWSCredentials{
+			AccessKeyID:     "AKIA" + identity,
+			SecretAccessKey: "secret",
+			SessionToken:    "token",
+			Region:          "us-east-2",
+			Expiration:      time.Now().Add(f.lifetime).UTC().Format(time.RFC3339),
+		},
+	}, nil
+}
+
+func (f *fakeAuthenticator) callCount(identity string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls[identity]
+}
+
+// shortSocketPath returns a socket path short enough for the Unix socket path limit.
+func shortSocketPath(t *testing.T) string {
+	t.Helper()
+
+	dir, err := os.MkdirTemp("", "agent")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(dir) })
+	return filepath.Join(dir, "a.sock")
+}
+
+// startAgent runs an agent in the background and waits until it accepts connections.
+func startAgent(t *testing.T, auth Authenticator, identities ...string) (*Agent, *Client) {
+	t.Helper()
+
+	a, err := New(auth, Options{SocketPath: shortSocketPath(t), Identities: identities, Executable: "/usr/local/bin/atmos"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- a.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+		_, err := os.Stat(a.SocketPath())
+		assert.True(t, os.IsNotExist(err), "socket should be removed on shutdown")
+	})
+
+	client := NewClient(a.SocketPath())
+	require.Eventually(t, func() bool {
+		_, err := client.Identities(context.Background())
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	return a, client
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, Options{})
+	assert.ErrorIs(t, err, errUtils.ErrNilParam)
+
+	a, err := New(newFakeAuthenticator(time.Hour), Options{SocketPath: "/tmp/x.sock", Executable: "atmos"})
+	require.NoError(t, err)
+	assert.Equal(t, DefaultRefreshInterval, a.refreshInterval)
+	assert.Equal(t, "/tmp/x.sock", a.SocketPath())
+}
+
+func TestAgent_CredentialsCachedUntilRefreshWindow(t *testing.T) {
+	auth := newFakeAuthenticator(time.Hour)
+	a, err := New(auth, Options{SocketPath: shortSocketPath(t), Executable: "atmos"})
+	require.NoError(t, err)
+
+	_, err = a.credentials(context.Background(), "dev")
+	require.NoError(t, err)
+	_, err = a.credentials(context.Background(), "dev")
+	require.NoError(t, err)
+	assert.Equal(t, 1, auth.callCount("dev"), "fresh credentials should be reused")
+
+	// Credentials expiring inside the refresh window are re-issued.
+	auth.lifetime = RefreshBefore / 2
+	a.entries["dev"].expiration = ptr(time.Now().Add(RefreshBefore / 2))
+	a.RefreshAll(context.Background())
+	assert.Equal(t, 2, auth.callCount("dev"))
+}
+
+func TestAgent_RefreshFailureKeepsServingValidCredentials(t *testing.T) {
+	auth := newFakeAuthenticator(RefreshBefore / 2)
+	a, err := New(auth, Options{SocketPath: shortSocketPath(t), Executable: "atmos"})
+	require.NoError(t, err)
+
+	first, err := a.credentials(context.Background(), "dev")
+	require.NoError(t, err)
+
+	auth.err = errors.New("sso session expired")
+	again, err := a.credentials(context.Background(), "dev")
+	require.NoError(t, err)
+	assert.Same(t, first, again)
+
+	// Once the old credentials have expired the error surfaces.
+	a.entries["dev"].expiration = ptr(time.Now().Add(-time.Minute))
+	_, err = a.credentials(context.Background(), "dev")
+	assert.ErrorContains(t, err, "sso session expired")
+}
+
+func TestAgent_ServesCredentialProcess(t *testing.T) {
+	auth := newFakeAuthenticator(time.Hour)
+	a, client := startAgent(t, auth, "dev")
+
+	creds, err := client.Credentials(context.Background(), "dev")
+	require.NoError(t, err)
+	assert.Equal(t, 1, creds.Version)
+	assert.Equal(t, "AKIAdev", creds.AccessKeyID)
+	assert.Equal(t, "token", creds.SessionToken)
+	assert.NotEmpty(t, creds.Expiration)
+
+	// Identities not listed at startup are authenticated on first request.
+	_, err = client.Credentials(context.Background(), "prod")
+	require.NoError(t, err)
+
+	statuses, err := client.Identities(context.Background())
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	assert.Equal(t, "dev", statuses[0].Name)
+	assert.Equal(t, "prod", statuses[1].Name)
+
+	config, err := os.ReadFile(filepath.Join(a.awsDir(), awsConfigFile))
+	require.NoError(t, err)
+	assert.Contains(t, string(config), "[profile prod]")
+	assert.Contains(t, string(config), "credential_process = /usr/local/bin/atmos auth agent credential-process --socket="+a.SocketPath()+" --identity=prod")
+	assert.Contains(t, string(config), "region = us-east-2")
+}
+
+func TestAgent_Environment(t *testing.T) {
+	a, client := startAgent(t, newFakeAuthenticator(time.Hour))
+
+	env, err := client.Environment(context.Background(), "dev")
+	require.NoError(t, err)
+	assert.Equal(t, a.SocketPath(), env[SocketEnvVar])
+	assert.Equal(t, "dev", env["AWS_PROFILE"])
+	assert.Equal(t, filepath.Join(a.awsDir(), awsConfigFile), env["AWS_CONFIG_FILE"])
+	assert.Equal(t, filepath.Join(a.awsDir(), awsCredentialsFile), env["AWS_SHARED_CREDENTIALS_FILE"])
+}
+
+func TestAgent_NonAWSCredentials(t *testing.T) {
+	auth := newFakeAuthenticator(time.Hour)
+	auth.creds = &types.OIDCCredentials{Token: "jwt"}
+	_, client := startAgent(t, auth)
+
+	_, err := client.Credentials(context.Background(), "ci")
+	assert.ErrorIs(t, err, errUtils.ErrAuthAgentRequestFailed)
+	assert.ErrorContains(t, err, errUtils.ErrCredentialProcessUnsupported.Error())
+
+	// The environment only advertises the socket; no AWS profile is generated.
+	env, err := client.Environment(context.Background(), "ci")
+	require.NoError(t, err)
+	assert.NotContains(t, env, "AWS_PROFILE")
+}
+
+func TestAgent_AuthenticationFailure(t *testing.T) {
+	auth := newFakeAuthenticator(time.Hour)
+	_, client := startAgent(t, auth)
+
+	auth.mu.Lock()
+	auth.err = errors.New("boom")
+	auth.mu.Unlock()
+
+	_, err := client.Credentials(context.Background(), "dev")
+	assert.ErrorIs(t, err, errUtils.ErrAuthAgentRequestFailed)
+	assert.ErrorContains(t, err, "boom")
+}
+
+func TestAgent_AlreadyRunning(t *testing.T) {
+	a, _ := startAgent(t, newFakeAuthenticator(time.Hour))
+
+	second, err := New(newFakeAuthenticator(time.Hour), Options{SocketPath: a.SocketPath(), Executable: "atmos"})
+	require.NoError(t, err)
+	_, err = second.listen()
+	assert.ErrorIs(t, err, errUtils.ErrAuthAgentAlreadyRunning)
+}
+
+func TestAgent_StartupAuthenticationFails(t *testing.T) {
+	auth := newFakeAuthenticator(time.Hour)
+	auth.err = errors.New("no sso session")
+	a, err := New(auth, Options{SocketPath: shortSocketPath(t), Identities: []string{"dev"}, Executable: "atmos"})
+	require.NoError(t, err)
+
+	err = a.Run(context.Background())
+	assert.ErrorContains(t, err, "no sso session")
+}
+
+func TestClient_Unavailable(t *testing.T) {
+	client := NewClient(shortSocketPath(t))
+
+	_, err := client.Credentials(context.Background(), "dev")
+	assert.ErrorIs(t, err, errUtils.ErrAuthAgentUnavailable)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,71 @@
+package agent
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	awsCloud "github.com/cloudposse/atmos/pkg/auth/cloud/aws"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/filesystem"
+)
+
+const (
+	awsDirName         = "aws"
+	awsConfigFile      = "config"
+	awsCredentialsFile = "credentials"
+	awsFilePermissions = 0o600
+)
+
+// awsDir holds the AWS config generated by the agent, next to its socket.
+func (a *Agent) awsDir() string {
+	return filepath.Join(filepath.Dir(a.socketPath), awsDirName)
+}
+
+// environment returns the variables that point child processes at the agent.
+// AWS identities get a profile whose credential_process asks the agent for fresh credentials;
+// other identities keep using the files written by the auth manager.
+func (a *Agent) environment(identity string, creds types.ICredentials) map[string]string {
+	env := map[string]string{SocketEnvVar: a.socketPath}
+	if _, ok := creds.(*types.AWSCredentials); !ok {
+		return env
+	}
+	env["AWS_CONFIG_FILE"] = filepath.Join(a.awsDir(), awsConfigFile)
+	env["AWS_SHARED_CREDENTIALS_FILE"] = filepath.Join(a.awsDir(), awsCredentialsFile)
+	env["AWS_PROFILE"] = identity
+	return env
+}
+
+// writeAWSFiles writes an AWS config with a credential_process profile per AWS identity.
+// The credentials file is left empty so no static credentials shadow the profile.
+// Callers must hold a.mu.
+func (a *Agent) writeAWSFiles() error {
+	if err := os.MkdirAll(a.awsDir(), dirPermissions); err != nil {
+		return fmt.Errorf("failed to create agent AWS directory: %w", err)
+	}
+
+	var b strings.Builder
+	b.WriteString("# Generated by `atmos auth agent`. Do not edit; the file is removed when the agent exits.\n")
+	for _, status := range a.identitiesLocked() {
+		aws, ok := a.entries[status.Name].credentials.(*types.AWSCredentials)
+		if !ok {
+			continue
+		}
+		fmt.Fprintf(&b, "\n[profile %s]\n", status.Name)
+		fmt.Fprintf(&b, "credential_process = %s\n", awsCloud.CredentialProcessCommand(
+			a.executable, "auth", "agent", "credential-process", "--socket="+a.socketPath, "--identity="+status.Name))
+		if aws.Region != "" {
+			fmt.Fprintf(&b, "region = %s\n", aws.Region)
+		}
+	}
+
+	fs := filesystem.NewOSFileSystem()
+	if err := fs.WriteFileAtomic(filepath.Join(a.awsDir(), awsConfigFile), []byte(b.String()), awsFilePermissions); err != nil {
+		return fmt.Errorf("failed to write agent AWS config: %w", err)
+	}
+	if err := fs.WriteFileAtomic(filepath.Join(a.awsDir(), awsCredentialsFile), nil, awsFilePermissions); err != nil {
+		return fmt.Errorf("failed to write agent AWS credentials file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package agent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	awsCloud "github.com/cloudposse/atmos/pkg/auth/cloud/aws"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// clientBaseURL is a placeholder host; requests are always dialed over the Unix socket.
+const clientBaseURL = "http://atmos-auth-agent"
+
+// Client talks to a running agent over its Unix socket.
+type Client struct {
+	socketPath string
+	http       *http.Client
+}
+
+// NewClient creates a client for the agent listening on socketPath.
+func NewClient(socketPath string) *Client {
+	defer perf.Track(nil, "agent.NewClient")()
+
+	dialer := &net.Dialer{}
+	return &Client{
+		socketPath: socketPath,
+		http: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+}
+
+// Identities lists the identities the agent is serving. It doubles as a health check.
+func (c *Client) Identities(ctx context.Context) ([]IdentityStatus, error) {
+	defer perf.Track(nil, "agent.Client.Identities")()
+
+	var statuses []IdentityStatus
+	if err := c.get(ctx, "/v1/identities", &statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// Credentials returns fresh AWS credentials for the identity in credential_process format.
+func (c *Client) Credentials(ctx context.Context, identity string) (*awsCloud.ProcessCredentials, error) {
+	defer perf.Track(nil, "agent.Client.Credentials")()
+
+	var creds awsCloud.ProcessCredentials
+	if err := c.get(ctx, "/v1/identities/"+url.PathEscape(identity)+"/credentials", &creds); err != nil {
+		return nil, err
+	}
+	return &creds, nil
+}
+
+// Environment returns the environment variables that point a child process at the agent.
+func (c *Client) Environment(ctx context.Context, identity string) (map[string]string, error) {
+	defer perf.Track(nil, "agent.Client.Environment")()
+
+	env := map[string]string{}
+	if err := c.get(ctx, "/v1/identities/"+url.PathEscape(identity)+"/environment", &env); err != nil {
+		return nil, err
+	}
+	return env, nil
+}
+
+func (c *Client) get(ctx context.Context, path string, dest any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, clientBaseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("%w: %w", errUtils.ErrAuthAgentRequestFailed, err)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return errUtils.Build(errUtils.ErrAuthAgentUnavailable).
+			WithExplanationf("Could not connect to the auth agent at `%s`", c.socketPath).
+			WithHint("Start the agent with `atmos auth agent`").
+			WithHintf("Unset `%s` to authenticate without the agent", SocketEnvVar).
+			WithCause(err).
+			WithExitCode(1).
+			Err()
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var body errorResponse
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Error == "" {
+			body.Error = resp.Status
+		}
+		return fmt.Errorf("%w: %s", errUtils.ErrAuthAgentRequestFailed, body.Error)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(dest); err != nil {
+		return fmt.Errorf("%w: invalid response: %w", errUtils.ErrAuthAgentRequestFailed, err)
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/config/homedir"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	processCredentialsVersion = 1
+
+	// CredentialProcessMarker identifies profiles written by `atmos auth export`.
+	// Profiles whose credential_process contains it may be overwritten on re-export.
+	CredentialProcessMarker = "auth credentials"
+)
+
+// ProcessCredentials is the JSON document expected from an AWS `credential_process`.
+// See https://docs.aws.amazon.com/sdkref/latest/guide/feature-process-credentials.html.
+type ProcessCredentials struct {
+	Version         int    `json:"Version"`
+	AccessKeyID     string `json:"AccessKeyId"`
+	SecretAccessKey string `json:"SecretAccessKey"`
+	SessionToken    string `json:"SessionToken,omitempty"`
+	Expiration      string `json:"Expiration,omitempty"`
+}
+
+// NewProcessCredentials converts Atmos credentials to the credential_process format.
+// Only AWS credentials can be served this way.
+func NewProcessCredentials(creds types.ICredentials) (*ProcessCredentials, error) {
+	defer perf.Track(nil, "aws.NewProcessCredentials")()
+
+	awsCreds, ok := creds.(*types.AWSCredentials)
+	if !ok {
+		return nil, fmt.Errorf("%w: got %T", errUtils.ErrCredentialProcessUnsupported, creds)
+	}
+	return &ProcessCredentials{
+		Version:         processCredentialsVersion,
+		AccessKeyID:     awsCreds.AccessKeyID,
+		SecretAccessKey: awsCreds.SecretAccessKey,
+		SessionToken:    awsCreds.SessionToken,
+		Expiration:      awsCreds.Expiration,
+	}, nil
+}
+
+// CredentialProcessCommand joins arguments into a credential_process command line.
+// Arguments containing whitespace are double-quoted; AWS SDKs split the command on spaces and honor quotes.
+func CredentialProcessCommand(args ...string) string {
+	defer perf.Track(nil, "aws.CredentialProcessCommand")()
+
+	quoted := make([]string, 0, len(args))
+	for _, arg := range args {
+		if strings.ContainsAny(arg, " \t") {
+			arg = `"` + arg + `"`
+		}
+		quoted = append(quoted, arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// CredentialProcessProfile is an AWS config profile that sources credentials from a command.
+type CredentialProcessProfile struct {
+	Name              string
+	CredentialProcess string
+	Region            string
+}
+
+// DefaultSharedConfigPath returns the path of the user's AWS config file (~/.aws/config).
+func DefaultSharedConfigPath() (string, error) {
+	defer perf.Track(nil, "aws.DefaultSharedConfigPath")()
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrGetHomeDir, err)
+	}
+	return filepath.Join(home, ".aws", "config"), nil
+}
+
+// WriteCredentialProcessProfiles adds or replaces credential_process profiles in an AWS config file.
+// The file is edited in place so unrelated profiles, comments and formatting are preserved.
+// A profile that already exists and wasn't written by `atmos auth export` is only replaced when force is set.
+func WriteCredentialProcessProfiles(configPath string, profiles []CredentialProcessProfile, force bool) error {
+	defer perf.Track(nil, "aws.WriteCredentialProcessProfiles")()
+
+	if err := os.MkdirAll(filepath.Dir(configPath), PermissionRWX); err != nil {
+		return fmt.Errorf("%w: %w", ErrCreateConfigFile, err)
+	}
+
+	lockPath := configPath + ".lock"
+	lock, err := acquireFileLock(lockPath)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrWriteConfigFile, err)
+	}
+	defer func() {
+		if err := lock.Unlock(); err != nil {
+			log.Debug("Failed to release file lock", "lock_file", lockPath, "error", err)
+		}
+		_ = os.Remove(lockPath)
+	}()
+
+	existing, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("%w: %w", ErrLoadConfigFile, err)
+	}
+	mode := os.FileMode(PermissionRW)
+	if info, statErr := os.Stat(configPath); statErr == nil {
+		mode = info.Mode().Perm()
+	}
+
+	updated, err := mergeCredentialProcessProfiles(existing, profiles, force)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(configPath, updated, mode); err != nil {
+		return fmt.Errorf("%w: %w", ErrWriteConfigFile, err)
+	}
+
+	log.Debug("Wrote credential_process profiles", "config_file", configPath, "profiles", len(profiles))
+	return nil
+}
+
+// configSection is a contiguous block of an AWS config file starting at a section header.
+// The block before the first header has an empty name.
+type configSection struct {
+	name  string
+	lines []string
+	added bool
+}
+
+// mergeCredentialProcessProfiles replaces matching profile sections in content and appends new ones.
+func mergeCredentialProcessProfiles(content []byte, profiles []CredentialProcessProfile, force bool) ([]byte, error) {
+	sections := splitConfigSections(content)
+
+	index := make(map[string]int, len(sections))
+	for i, s := range sections {
+		if s.name != "" {
+			index[s.name] = i
+		}
+	}
+
+	for _, p := range profiles {
+		name := profileSectionName(p.Name)
+		block := renderCredentialProcessProfile(name, p)
+
+		i, ok := index[name]
+		if !ok {
+			sections = append(sections, configSection{name: name, lines: block, added: true})
+			index[name] = len(sections) - 1
+			continue
+		}
+		if !force && !isExportedProfile(sections[i]) {
+			return nil, errUtils.Build(errUtils.ErrAuthExportProfileConflict).
+				WithExplanationf("Profile `%s` already exists and was not created by `atmos auth export`", p.Name).
+				WithHint("Use `--prefix` to export under different profile names").
+				WithHint("Use `--force` to overwrite it").
+				WithExitCode(1).
+				Err()
+		}
+		// Keep blank lines that separated the old block from the next one.
+		sections[i].lines = append(block, trailingBlankLines(sections[i].lines)...)
+	}
+
+	var b bytes.Buffer
+	for _, s := range sections {
+		// Separate appended profiles from the preceding block with a blank line.
+		if s.added && b.Len() > 0 && !bytes.HasSuffix(b.Bytes(), []byte("\n\n")) {
+			b.WriteString("\n")
+		}
+		for _, line := range s.lines {
+			b.WriteString(line)
+			b.WriteString("\n")
+		}
+	}
+	return b.Bytes(), nil
+}
+
+// splitConfigSections splits an INI document into sections, keeping every line verbatim.
+func splitConfigSections(content []byte) []configSection {
+	sections := []configSection{{}}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+			name := strings.Join(strings.Fields(trimmed[1:len(trimmed)-1]), " ")
+			sections = append(sections, configSection{name: name})
+		}
+		last := &sections[len(sections)-1]
+		last.lines = append(last.lines, line)
+	}
+	if len(sections[0].lines) == 0 {
+		sections = sections[1:]
+	}
+	return sections
+}
+
+// profileSectionName returns the AWS config section name for a profile.
+func profileSectionName(profile string) string {
+	if profile == "default" {
+		return profile
+	}
+	return "profile " + profile
+}
+
+func renderCredentialProcessProfile(section string, p CredentialProcessProfile) []string {
+	lines := []string{
+		"[" + section + "]",
+		"credential_process = " + p.CredentialProcess,
+	}
+	if p.Region != "" {
+		lines = append(lines, "region = "+p.Region)
+	}
+	return lines
+}
+
+// isExportedProfile reports whether a section was written by `atmos auth export`.
+func isExportedProfile(s configSection) bool {
+	for _, line := range s.lines {
+		key, value, ok := strings.Cut(line, "=")
+		if ok && strings.TrimSpace(key) == "credential_process" && strings.Contains(value, CredentialProcessMarker) {
+			return true
+		}
+	}
+	return false
+}
+
+func trailingBlankLines(lines []string) []string {
+	i := len(lines)
+	for i > 0 && strings.TrimSpace(lines[i-1]) == "" {
+		i--
+	}
+	return lines[i:]
+}
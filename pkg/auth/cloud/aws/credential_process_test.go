@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+)
+
+func TestNewProcessCredentials(t *testing.T) {
+	creds, err := NewProcessCredentials(&types.AWSCredentials{
+		AccessKeyID:     "AKIA",
+		SecretAccessKey: "secret",
+		SessionToken:    "token",
+		Expiration:      "2030-01-01T00:00:00Z",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &ProcessCredentials{
+		Version:         1,
+		AccessKeyID:     "AKIA",
+		SecretAccessKey: "secret",
+		SessionToken:    "token",
+		Expiration:      "2030-01-01T00:00:00Z",
+	}, creds)
+
+	_, err = NewProcessCredentials(&types.AzureCredentials{})
+	assert.ErrorIs(t, err, errUtils.ErrCredentialProcessUnsupported)
+}
+
+func TestCredentialProcessCommand(t *testing.T) {
+	assert.Equal(t, "atmos auth credentials --identity dev", CredentialProcessCommand("atmos", "auth", "credentials", "--identity", "dev"))
+	assert.Equal(t, `"/Applications/My Tools/atmos" --chdir "/Users/me/infra repo"`,
+		CredentialProcessCommand("/Applications/My Tools/atmos", "--chdir", "/Users/me/infra repo"))
+}
+
+func TestMergeCredentialProcessProfiles(t *testing.T) {
+	existing := `# My AWS config
+[default]
+region = us-east-1
+
+[profile dev]
+credential_process = atmos auth credentials --identity old-dev
+region = us-west-2
+
+[profile personal]
+# keep me
+region = eu-west-1
+`
+	profiles := []CredentialProcessProfile{
+		{Name: "dev", CredentialProcess: "atmos auth credentials --identity dev", Region: "us-east-2"},
+		{Name: "prod", CredentialProcess: "atmos auth credentials --identity prod"},
+	}
+
+	out, err := mergeCredentialProcessProfiles([]byte(existing), profiles, false)
+	require.NoError(t, err)
+	assert.Equal(t, `# My AWS config
+[default]
+region = us-east-1
+
+[profile dev]
+credential_process = atmos auth credentials --identity dev
+region = us-east-2
+
+[profile personal]
+# keep me
+region = eu-west-1
+
+[profile prod]
+credential_process = atmos auth credentials --identity prod
+`, string(out))
+
+	// Merging again is idempotent.
+	again, err := mergeCredentialProcessProfiles(out, profiles, false)
+	require.NoError(t, err)
+	assert.Equal(t, string(out), string(again))
+}
+
+func TestMergeCredentialProcessProfiles_Conflict(t *testing.T) {
+	existing := "[default]\nregion = us-east-1\n\n[profile personal]\naws_access_key_id = AKIA\n"
+
+	_, err := mergeCredentialProcessProfiles([]byte(existing), []CredentialProcessProfile{
+		{Name: "personal", CredentialProcess: "atmos auth credentials --identity personal"},
+	}, false)
+	require.ErrorIs(t, err, errUtils.ErrAuthExportProfileConflict)
+
+	// The default profile uses a bare [default] section.
+	_, err = mergeCredentialProcessProfiles([]byte(existing), []CredentialProcessProfile{
+		{Name: "default", CredentialProcess: "atmos auth credentials --identity dev"},
+	}, false)
+	require.ErrorIs(t, err, errUtils.ErrAuthExportProfileConflict)
+
+	out, err := mergeCredentialProcessProfiles([]byte(existing), []CredentialProcessProfile{
+		{Name: "personal", CredentialProcess: "atmos auth credentials --identity personal"},
+	}, true)
+	require.NoError(t, err)
+	assert.Equal(t, "[default]\nregion = us-east-1\n\n[profile personal]\ncredential_process = atmos auth credentials --identity personal\n", string(out))
+}
+
+func TestWriteCredentialProcessProfiles(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".aws", "config")
+	profiles := []CredentialProcessProfile{
+		{Name: "dev", CredentialProcess: "atmos auth credentials --identity dev", Region: "us-east-1"},
+	}
+
+	require.NoError(t, WriteCredentialProcessProfiles(configPath, profiles, false))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "[profile dev]\ncredential_process = atmos auth credentials --identity dev\nregion = us-east-1\n", string(data))
+
+	_, err = os.Stat(configPath + ".lock")
+	assert.True(t, os.IsNotExist(err), "lock file should be removed")
+}
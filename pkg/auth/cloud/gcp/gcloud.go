@@ -0,0 +1,174 @@
+package gcp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/config/homedir"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// GcloudAccessTokenFileName holds a bare access token for gcloud's auth/access_token_file property.
+	// The regular access token file also records the expiry, which gcloud can't parse.
+	GcloudAccessTokenFileName = "gcloud_access_token"
+
+	// gcloudConfigurationPrefix is the filename prefix gcloud uses for named configurations.
+	gcloudConfigurationPrefix = "config_"
+
+	// gcloudExportMarker is written at the top of configurations created by `atmos auth export`.
+	gcloudExportMarker = "# Generated by `atmos auth export`."
+)
+
+// GcloudConfiguration is a named gcloud configuration backed by an Atmos identity.
+type GcloudConfiguration struct {
+	// Name is the configuration name, as used with `gcloud config configurations activate`.
+	Name string
+	// Account is the service account or user the configuration authenticates as.
+	Account string
+	// Project is the default project.
+	Project string
+	// Region is the default compute region.
+	Region string
+	// AccessTokenFile is read by gcloud for every request instead of its own credential store.
+	AccessTokenFile string
+	// ExpiresAt is when the access token in AccessTokenFile expires. gcloud doesn't refresh it.
+	ExpiresAt time.Time
+}
+
+// GcloudConfigDir returns the gcloud configuration directory.
+// gcloud uses %APPDATA%\gcloud on Windows and ~/.config/gcloud elsewhere, regardless of XDG_CONFIG_HOME.
+func GcloudConfigDir() (string, error) {
+	defer perf.Track(nil, "gcp.GcloudConfigDir")()
+
+	if runtime.GOOS == "windows" {
+		//nolint:forbidigo // APPDATA is the OS location gcloud itself uses; it isn't an Atmos setting.
+		if appData := os.Getenv("APPDATA"); appData != "" {
+			return filepath.Join(appData, "gcloud"), nil
+		}
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", errUtils.ErrInvalidAuthConfig, err)
+	}
+	return filepath.Join(home, ".config", "gcloud"), nil
+}
+
+// GcloudConfigurationName converts a name into a valid gcloud configuration name.
+// gcloud requires lowercase letters, digits and hyphens, starting with a letter.
+func GcloudConfigurationName(name string) string {
+	defer perf.Track(nil, "gcp.GcloudConfigurationName")()
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "-"):
+			b.WriteRune('-')
+		}
+	}
+	result := strings.Trim(b.String(), "-")
+	if result == "" || result[0] < 'a' || result[0] > 'z' {
+		result = "atmos-" + result
+	}
+	return strings.TrimSuffix(result, "-")
+}
+
+// WriteGcloudAccessTokenFile writes the access token on its own, as gcloud's auth/access_token_file expects.
+// Realm is required for credential isolation.
+func WriteGcloudAccessTokenFile(realm, providerName, identityName, accessToken string) (string, error) {
+	defer perf.Track(nil, "gcp.WriteGcloudAccessTokenFile")()
+
+	if accessToken == "" {
+		return "", fmt.Errorf("%w: access token cannot be empty", errUtils.ErrWriteAccessTokenFile)
+	}
+	dir, err := GetADCDir(realm, providerName, identityName)
+	if err != nil {
+		return "", fmt.Errorf("%w: resolve access token file path: %w", errUtils.ErrWriteAccessTokenFile, err)
+	}
+	path := filepath.Join(dir, GcloudAccessTokenFileName)
+
+	lockPath := path + ".lock"
+	lock, err := acquireFileLock(lockPath)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", errUtils.ErrWriteAccessTokenFile, err)
+	}
+	defer func() {
+		if unlockErr := lock.Unlock(); unlockErr != nil {
+			log.Debug("Failed to release file lock", "lock_file", lockPath, "error", unlockErr)
+		}
+	}()
+
+	if err := os.WriteFile(path, []byte(accessToken), permFile); err != nil {
+		return "", fmt.Errorf("%w: write access token file: %w", errUtils.ErrWriteAccessTokenFile, err)
+	}
+	return path, nil
+}
+
+// WriteGcloudConfiguration writes a named configuration into the gcloud configuration directory.
+// An existing configuration that wasn't written by `atmos auth export` is only replaced when force is set.
+func WriteGcloudConfiguration(configDir string, cfg *GcloudConfiguration, force bool) (string, error) {
+	defer perf.Track(nil, "gcp.WriteGcloudConfiguration")()
+
+	if cfg == nil || cfg.Name == "" {
+		return "", fmt.Errorf("%w: configuration name is required", errUtils.ErrWriteGcloudConfiguration)
+	}
+	dir := filepath.Join(configDir, ConfigurationsSubdir)
+	if err := os.MkdirAll(dir, permDir); err != nil {
+		return "", fmt.Errorf("%w: %w", errUtils.ErrWriteGcloudConfiguration, err)
+	}
+	path := filepath.Join(dir, gcloudConfigurationPrefix+cfg.Name)
+
+	if existing, err := os.ReadFile(path); err == nil && !force && !strings.HasPrefix(string(existing), gcloudExportMarker) {
+		return "", errUtils.Build(errUtils.ErrAuthExportProfileConflict).
+			WithExplanationf("gcloud configuration `%s` already exists and was not created by `atmos auth export`", cfg.Name).
+			WithHint("Use `--prefix` to export under different configuration names").
+			WithHint("Use `--force` to overwrite it").
+			WithExitCode(1).
+			Err()
+	}
+
+	var b strings.Builder
+	b.WriteString(gcloudExportMarker + "\n")
+	b.WriteString(gcloudStaleTokenComment(cfg.ExpiresAt))
+	writeGcloudSection(&b, "core", [][2]string{{"account", cfg.Account}, {"project", cfg.Project}})
+	writeGcloudSection(&b, "auth", [][2]string{{"access_token_file", cfg.AccessTokenFile}})
+	writeGcloudSection(&b, "compute", [][2]string{{"region", cfg.Region}})
+
+	if err := os.WriteFile(path, []byte(b.String()), permFile); err != nil {
+		return "", fmt.Errorf("%w: %w", errUtils.ErrWriteGcloudConfiguration, err)
+	}
+	return path, nil
+}
+
+// gcloudStaleTokenComment explains that gcloud won't refresh the exported access token.
+func gcloudStaleTokenComment(expiresAt time.Time) string {
+	expiry := "after about an hour"
+	if !expiresAt.IsZero() {
+		expiry = "at " + expiresAt.UTC().Format(time.RFC3339)
+	}
+	return fmt.Sprintf("# gcloud doesn't refresh the access token, which expires %s.\n"+
+		"# Re-run `atmos auth export --format gcloud` to refresh it.\n", expiry)
+}
+
+// writeGcloudSection writes an INI section with the non-empty properties, or nothing if all are empty.
+func writeGcloudSection(b *strings.Builder, name string, properties [][2]string) {
+	var lines []string
+	for _, p := range properties {
+		if p[1] != "" {
+			lines = append(lines, p[0]+" = "+p[1])
+		}
+	}
+	if len(lines) == 0 {
+		return
+	}
+	b.WriteString("\n[" + name + "]\n")
+	b.WriteString(strings.Join(lines, "\n") + "\n")
+}
@@ -0,0 +1,108 @@
+package gcp
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+)
+
+func TestGcloudConfigurationName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "already valid", input: "prod-viewer", expected: "prod-viewer"},
+		{name: "uppercase and separators", input: "Prod/Viewer_Admin", expected: "prod-viewer-admin"},
+		{name: "repeated separators", input: "prod..viewer", expected: "prod-viewer"},
+		{name: "leading digit", input: "123-prod", expected: "atmos-123-prod"},
+		{name: "leading separator", input: "-prod-", expected: "prod"},
+		{name: "nothing valid", input: "___", expected: "atmos"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GcloudConfigurationName(tt.input))
+		})
+	}
+}
+
+func TestWriteGcloudAccessTokenFile(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmp)
+
+	path, err := WriteGcloudAccessTokenFile(testRealm, "gcp-adc", "prod", "ya29.token")
+	require.NoError(t, err)
+	assert.Equal(t, GcloudAccessTokenFileName, filepath.Base(path))
+
+	// gcloud reads the whole file as the token, so nothing else may be written.
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "ya29.token", string(data))
+
+	_, err = WriteGcloudAccessTokenFile(testRealm, "gcp-adc", "prod", "")
+	assert.ErrorIs(t, err, errUtils.ErrWriteAccessTokenFile)
+}
+
+func TestWriteGcloudConfiguration(t *testing.T) {
+	configDir := t.TempDir()
+	cfg := &GcloudConfiguration{
+		Name:            "prod",
+		Account:         "sa@proj.iam.gserviceaccount.com",
+		Project:         "proj",
+		AccessTokenFile: "/tmp/token",
+		ExpiresAt:       time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	path, err := WriteGcloudConfiguration(configDir, cfg, false)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(configDir, ConfigurationsSubdir, "config_prod"), path)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	content := string(data)
+	assert.Contains(t, content, "# gcloud doesn't refresh the access token, which expires at 2025-01-02T03:04:05Z.\n")
+	assert.Contains(t, content, "[core]\naccount = sa@proj.iam.gserviceaccount.com\nproject = proj\n")
+	assert.Contains(t, content, "[auth]\naccess_token_file = /tmp/token\n")
+	assert.NotContains(t, content, "[compute]", "empty sections are omitted")
+
+	// Re-exporting replaces a configuration written by atmos.
+	cfg.Region = "us-central1"
+	_, err = WriteGcloudConfiguration(configDir, cfg, false)
+	require.NoError(t, err)
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "[compute]\nregion = us-central1\n")
+}
+
+func TestWriteGcloudConfiguration_Conflict(t *testing.T) {
+	configDir := t.TempDir()
+	dir := filepath.Join(configDir, ConfigurationsSubdir)
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	path := filepath.Join(dir, "config_prod")
+	require.NoError(t, os.WriteFile(path, []byte("[core]\naccount = me@example.com\n"), 0o600))
+
+	cfg := &GcloudConfiguration{Name: "prod", AccessTokenFile: "/tmp/token"}
+
+	_, err := WriteGcloudConfiguration(configDir, cfg, false)
+	require.ErrorIs(t, err, errUtils.ErrAuthExportProfileConflict)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "[core]\naccount = me@example.com\n", string(data), "user configuration must be left untouched")
+
+	_, err = WriteGcloudConfiguration(configDir, cfg, true)
+	require.NoError(t, err)
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "access_token_file = /tmp/token")
+}
+
+func TestWriteGcloudConfiguration_RequiresName(t *testing.T) {
+	_, err := WriteGcloudConfiguration(t.TempDir(), &GcloudConfiguration{}, false)
+	assert.ErrorIs(t, err, errUtils.ErrWriteGcloudConfiguration)
+}
@@ -0,0 +1,412 @@
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/agessh"
+	"github.com/99designs/keyring"
+	"github.com/gofrs/flock"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/config/homedir"
+	"github.com/cloudposse/atmos/pkg/filesystem"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	// AgeKeyringFileName is the default name of the age-encrypted keyring file.
+	AgeKeyringFileName = "atmos-auth.age"
+	// AgeKeyringFilePermissions is the permission for the keyring file (read/write for owner only).
+	AgeKeyringFilePermissions = 0o600
+
+	// ageSecretKeyPrefix identifies inline age identities (as opposed to identity file paths).
+	ageSecretKeyPrefix = "AGE-SECRET-KEY-"
+
+	ageLockTimeout = 10 * time.Second
+	ageLockRetry   = 50 * time.Millisecond
+)
+
+var (
+	// ErrAgeIdentityRequired indicates the age keyring has no identity to decrypt with.
+	ErrAgeIdentityRequired = errors.New("age keyring identity required")
+	// ErrAgeIdentityPassphrase indicates the SSH identity is protected by a passphrase.
+	ErrAgeIdentityPassphrase = errors.New("passphrase-protected SSH keys are not supported by the age keyring")
+	// ErrInvalidAgeRecipient indicates a recipient could not be parsed.
+	ErrInvalidAgeRecipient = errors.New("invalid age recipient")
+)
+
+// ageKeyringStore is a credential store backed by a single age-encrypted file.
+// It reuses the file keyring's credential envelope handling and only swaps the underlying keyring.
+type ageKeyringStore struct {
+	*fileKeyringStore
+}
+
+// Type returns the type of this credential store.
+func (s *ageKeyringStore) Type() string {
+	return types.CredentialStoreTypeAge
+}
+
+// ageKeyringConfig holds the parsed `auth.keyring.spec` for the age keyring.
+type ageKeyringConfig struct {
+	path           string
+	identity       string
+	recipients     []string
+	recipientsFile string
+}
+
+// parseAgeKeyringConfig extracts the age keyring settings from auth config.
+// Priority for the identity: ATMOS_KEYRING_AGE_IDENTITY env var > config.
+func parseAgeKeyringConfig(authConfig *schema.AuthConfig) ageKeyringConfig {
+	var cfg ageKeyringConfig
+	if authConfig != nil && authConfig.Keyring.Spec != nil {
+		spec := authConfig.Keyring.Spec
+		cfg.path, _ = spec["path"].(string)
+		cfg.identity, _ = spec["identity"].(string)
+		cfg.recipientsFile, _ = spec["recipients_file"].(string)
+		switch r := spec["recipients"].(type) {
+		case []string:
+			cfg.recipients = r
+		case []interface{}:
+			for _, v := range r {
+				if s, ok := v.(string); ok {
+					cfg.recipients = append(cfg.recipients, s)
+				}
+			}
+		case string:
+			cfg.recipients = []string{r}
+		}
+	}
+
+	//nolint:forbidigo // os.Getenv required here to avoid viper singleton caching in tests
+	if envIdentity := os.Getenv("ATMOS_KEYRING_AGE_IDENTITY"); envIdentity != "" {
+		cfg.identity = envIdentity
+	}
+
+	return cfg
+}
+
+// newAgeKeyringStore creates a credential store encrypted with age.
+func newAgeKeyringStore(authConfig *schema.AuthConfig) (*ageKeyringStore, error) {
+	defer perf.Track(nil, "credentials.newAgeKeyringStore")()
+
+	cfg := parseAgeKeyringConfig(authConfig)
+
+	path := cfg.path
+	if path == "" {
+		dir, err := getDefaultKeyringPath()
+		if err != nil {
+			return nil, errors.Join(ErrCredentialStore, err)
+		}
+		path = filepath.Join(dir, AgeKeyringFileName)
+	}
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, errors.Join(ErrCredentialStore, fmt.Errorf("failed to expand keyring path: %w", err))
+	}
+	if err := os.MkdirAll(filepath.Dir(path), KeyringDirPermissions); err != nil {
+		return nil, errors.Join(ErrCredentialStore, fmt.Errorf("failed to create keyring directory: %w", err))
+	}
+
+	identities, selfRecipients, err := loadAgeIdentities(cfg.identity)
+	if err != nil {
+		return nil, errors.Join(ErrCredentialStore, err)
+	}
+
+	recipients, err := parseAgeRecipients(cfg.recipients)
+	if err != nil {
+		return nil, errors.Join(ErrCredentialStore, err)
+	}
+	if cfg.recipientsFile != "" {
+		fileRecipients, err := readAgeRecipientsFile(cfg.recipientsFile)
+		if err != nil {
+			return nil, errors.Join(ErrCredentialStore, err)
+		}
+		recipients = append(recipients, fileRecipients...)
+	}
+	// Always encrypt to our own identity so credentials written here can be read back here.
+	selfRecipients = append(selfRecipients, recipients...)
+
+	ring := &ageKeyring{
+		path:       path,
+		identities: identities,
+		recipients: selfRecipients,
+	}
+
+	return &ageKeyringStore{
+		fileKeyringStore: &fileKeyringStore{
+			ring: ring,
+			path: path,
+		},
+	}, nil
+}
+
+// loadAgeIdentities parses an age identity file, an unencrypted SSH private key, or an inline
+// AGE-SECRET-KEY, and returns the identities along with the recipients derived from them.
+func loadAgeIdentities(identity string) ([]age.Identity, []age.Recipient, error) {
+	if identity == "" {
+		return nil, nil, fmt.Errorf("%w: set auth.keyring.spec.identity or ATMOS_KEYRING_AGE_IDENTITY to an age identity file or SSH private key", ErrAgeIdentityRequired)
+	}
+
+	var data []byte
+	source := "inline identity"
+	if strings.HasPrefix(identity, ageSecretKeyPrefix) {
+		data = []byte(identity)
+	} else {
+		path, err := homedir.Expand(identity)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to expand identity path: %w", err)
+		}
+		data, err = os.ReadFile(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: failed to read identity file %s: %w", ErrAgeIdentityRequired, path, err)
+		}
+		source = path
+	}
+
+	var identities []age.Identity
+	if bytes.Contains(data, []byte("-----BEGIN")) {
+		id, err := agessh.ParseIdentity(data)
+		if err != nil {
+			var missing *ssh.PassphraseMissingError
+			if errors.As(err, &missing) {
+				return nil, nil, fmt.Errorf("%w: %s", ErrAgeIdentityPassphrase, source)
+			}
+			return nil, nil, fmt.Errorf("%w: failed to parse SSH identity %s: %w", ErrAgeIdentityRequired, source, err)
+		}
+		identities = []age.Identity{id}
+	} else {
+		ids, err := age.ParseIdentities(bytes.NewReader(data))
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: failed to parse age identity %s: %w", ErrAgeIdentityRequired, source, err)
+		}
+		identities = ids
+	}
+
+	recipients := make([]age.Recipient, 0, len(identities))
+	for _, id := range identities {
+		switch i := id.(type) {
+		case *age.X25519Identity:
+			recipients = append(recipients, i.Recipient())
+		case *agessh.Ed25519Identity:
+			recipients = append(recipients, i.Recipient())
+		case *agessh.RSAIdentity:
+			recipients = append(recipients, i.Recipient())
+		}
+	}
+
+	return identities, recipients, nil
+}
+
+// parseAgeRecipients parses age (age1...) and SSH (ssh-ed25519/ssh-rsa) public keys.
+func parseAgeRecipients(values []string) ([]age.Recipient, error) {
+	var recipients []age.Recipient
+	for _, v := range values {
+		v = strings.TrimSpace(v)
+		if v == "" || strings.HasPrefix(v, "#") {
+			continue
+		}
+
+		var (
+			r   age.Recipient
+			err error
+		)
+		if strings.HasPrefix(v, "ssh-") {
+			r, err = agessh.ParseRecipient(v)
+		} else {
+			r, err = age.ParseX25519Recipient(v)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", ErrInvalidAgeRecipient, v, err)
+		}
+		recipients = append(recipients, r)
+	}
+	return recipients, nil
+}
+
+// readAgeRecipientsFile reads one recipient per line, in the same format as `age -R`.
+func readAgeRecipientsFile(path string) ([]age.Recipient, error) {
+	expanded, err := homedir.Expand(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to expand recipients file path: %w", err)
+	}
+	data, err := os.ReadFile(expanded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read recipients file %s: %w", ErrInvalidAgeRecipient, expanded, err)
+	}
+	return parseAgeRecipients(strings.Split(string(data), "\n"))
+}
+
+// ageKeyring implements keyring.Keyring on top of a single age-encrypted JSON file.
+// Every write re-encrypts the whole file to all recipients, so any recipient's identity can read it.
+type ageKeyring struct {
+	mu         sync.Mutex
+	path       string
+	identities []age.Identity
+	recipients []age.Recipient
+}
+
+// Get returns the item stored under key.
+func (k *ageKeyring) Get(key string) (keyring.Item, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	items, err := k.load()
+	if err != nil {
+		return keyring.Item{}, err
+	}
+	data, ok := items[key]
+	if !ok {
+		return keyring.Item{}, keyring.ErrKeyNotFound
+	}
+	return keyring.Item{Key: key, Data: data}, nil
+}
+
+// GetMetadata returns the non-secret parts of an item.
+func (k *ageKeyring) GetMetadata(_ string) (keyring.Metadata, error) {
+	return keyring.Metadata{}, nil
+}
+
+// Set stores an item, re-encrypting the keyring file.
+func (k *ageKeyring) Set(item keyring.Item) error {
+	return k.update(func(items map[string][]byte) error {
+		items[item.Key] = item.Data
+		return nil
+	})
+}
+
+// Remove deletes an item, re-encrypting the keyring file.
+func (k *ageKeyring) Remove(key string) error {
+	return k.update(func(items map[string][]byte) error {
+		if _, ok := items[key]; !ok {
+			return keyring.ErrKeyNotFound
+		}
+		delete(items, key)
+		return nil
+	})
+}
+
+// Keys returns all keys stored in the keyring.
+func (k *ageKeyring) Keys() ([]string, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	items, err := k.load()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// update applies fn to the decrypted items under an exclusive file lock and writes the result.
+func (k *ageKeyring) update(fn func(map[string][]byte) error) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	lock, err := acquireAgeKeyringLock(k.path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := lock.Unlock(); err != nil {
+			log.Debug("Failed to release age keyring lock", "path", k.path, "error", err)
+		}
+	}()
+
+	items, err := k.load()
+	if err != nil {
+		return err
+	}
+	if err := fn(items); err != nil {
+		return err
+	}
+	return k.save(items)
+}
+
+// load decrypts the keyring file. A missing file is an empty keyring.
+func (k *ageKeyring) load() (map[string][]byte, error) {
+	items := map[string][]byte{}
+
+	f, err := os.Open(k.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return items, nil
+		}
+		return nil, fmt.Errorf("failed to open age keyring %s: %w", k.path, err)
+	}
+	defer f.Close()
+
+	r, err := age.Decrypt(f, k.identities...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt age keyring %s: %w", k.path, err)
+	}
+	plaintext, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt age keyring %s: %w", k.path, err)
+	}
+	if err := json.Unmarshal(plaintext, &items); err != nil {
+		return nil, fmt.Errorf("failed to parse age keyring %s: %w", k.path, err)
+	}
+	return items, nil
+}
+
+// save encrypts items to all recipients and atomically replaces the keyring file.
+func (k *ageKeyring) save(items map[string][]byte) error {
+	plaintext, err := json.Marshal(items)
+	if err != nil {
+		return fmt.Errorf("failed to marshal age keyring: %w", err)
+	}
+
+	var buf bytes.Buffer
+	w, err := age.Encrypt(&buf, k.recipients...)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt age keyring: %w", err)
+	}
+	if _, err := w.Write(plaintext); err != nil {
+		return fmt.Errorf("failed to encrypt age keyring: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to encrypt age keyring: %w", err)
+	}
+
+	fs := filesystem.NewOSFileSystem()
+	if err := fs.WriteFileAtomic(k.path, buf.Bytes(), AgeKeyringFilePermissions); err != nil {
+		return fmt.Errorf("failed to write age keyring %s: %w", k.path, err)
+	}
+	return nil
+}
+
+// acquireAgeKeyringLock acquires an exclusive lock so concurrent Atmos processes don't lose writes.
+func acquireAgeKeyringLock(lockPath string) (*flock.Flock, error) {
+	lock := flock.New(lockPath)
+	ctx, cancel := context.WithTimeout(context.Background(), ageLockTimeout)
+	defer cancel()
+
+	locked, err := lock.TryLockContext(ctx, ageLockRetry)
+	if err != nil {
+		return nil, fmt.Errorf("failed to acquire age keyring lock %s: %w", lockPath, err)
+	}
+	if !locked {
+		return nil, fmt.Errorf("failed to acquire age keyring lock within timeout: %s", lockPath)
+	}
+	return lock, nil
+}
@@ -0,0 +1,238 @@
+package credentials
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// writeAgeIdentity generates an X25519 identity file and returns its path and recipient.
+func writeAgeIdentity(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+
+	id, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, name)
+	require.NoError(t, os.WriteFile(path, []byte("# created: test\n"+id.String()+"\n"), 0o600))
+	return path, id.Recipient().String()
+}
+
+// writeSSHIdentity generates an ed25519 SSH private key and returns its path and authorized_keys line.
+func writeSSHIdentity(t *testing.T, dir string, passphrase string) (string, string) {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var block *pem.Block
+	if passphrase != "" {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(priv, "", []byte(passphrase))
+	} else {
+		block, err = ssh.MarshalPrivateKey(priv, "")
+	}
+	require.NoError(t, err)
+
+	path := filepath.Join(dir, "id_ed25519")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(block), 0o600))
+
+	sshPub, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	return path, string(ssh.MarshalAuthorizedKey(sshPub))
+}
+
+func newAgeTestConfig(path, identity string) *schema.AuthConfig {
+	return &schema.AuthConfig{
+		Keyring: schema.KeyringConfig{
+			Type: "age",
+			Spec: map[string]interface{}{
+				"path":     path,
+				"identity": identity,
+			},
+		},
+	}
+}
+
+func TestAgeKeyring_Suite(t *testing.T) {
+	factory := func(t *testing.T) types.CredentialStore {
+		dir := t.TempDir()
+		identity, _ := writeAgeIdentity(t, dir, "key.txt")
+		store, err := newAgeKeyringStore(newAgeTestConfig(filepath.Join(dir, "keyring.age"), identity))
+		require.NoError(t, err)
+		return store
+	}
+
+	RunCredentialStoreTests(t, factory)
+}
+
+func TestAgeKeyring_Type(t *testing.T) {
+	dir := t.TempDir()
+	identity, _ := writeAgeIdentity(t, dir, "key.txt")
+
+	store, err := newAgeKeyringStore(newAgeTestConfig(filepath.Join(dir, "keyring.age"), identity))
+	require.NoError(t, err)
+	assert.Equal(t, types.CredentialStoreTypeAge, store.Type())
+}
+
+func TestAgeKeyring_FileIsEncrypted(t *testing.T) {
+	dir := t.TempDir()
+	identity, _ := writeAgeIdentity(t, dir, "key.txt")
+	path := filepath.Join(dir, "keyring.age")
+
+	store, err := newAgeKeyringStore(newAgeTestConfig(path, identity))
+	require.NoError(t, err)
+	require.NoError(t, store.Store("dev", &types.OIDCCredentials{Token: "super-secret-token", Provider: "test"}, "realm"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "age-encryption.org/v1")
+	assert.NotContains(t, string(data), "super-secret-token")
+	assert.NotContains(t, string(data), "atmos_realm_dev")
+}
+
+func TestAgeKeyring_SSHIdentity(t *testing.T) {
+	dir := t.TempDir()
+	identity, _ := writeSSHIdentity(t, dir, "")
+	path := filepath.Join(dir, "keyring.age")
+
+	store, err := newAgeKeyringStore(newAgeTestConfig(path, identity))
+	require.NoError(t, err)
+
+	exp := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	require.NoError(t, store.Store("prod", &types.AWSCredentials{AccessKeyID: "AKIA", SecretAccessKey: "SECRET", Expiration: exp}, ""))
+
+	// A new store instance reads the file back with the same SSH key.
+	reopened, err := newAgeKeyringStore(newAgeTestConfig(path, identity))
+	require.NoError(t, err)
+	retrieved, err := reopened.Retrieve("prod", "")
+	require.NoError(t, err)
+	assert.Equal(t, "AKIA", retrieved.(*types.AWSCredentials).AccessKeyID)
+}
+
+func TestAgeKeyring_PassphraseProtectedSSHKey(t *testing.T) {
+	dir := t.TempDir()
+	identity, _ := writeSSHIdentity(t, dir, "hunter22")
+
+	_, err := newAgeKeyringStore(newAgeTestConfig(filepath.Join(dir, "keyring.age"), identity))
+	assert.ErrorIs(t, err, ErrAgeIdentityPassphrase)
+	assert.ErrorIs(t, err, ErrCredentialStore)
+}
+
+func TestAgeKeyring_IdentityRequired(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := newAgeKeyringStore(newAgeTestConfig(filepath.Join(dir, "keyring.age"), ""))
+	assert.ErrorIs(t, err, ErrAgeIdentityRequired)
+
+	_, err = newAgeKeyringStore(newAgeTestConfig(filepath.Join(dir, "keyring.age"), filepath.Join(dir, "missing.txt")))
+	assert.ErrorIs(t, err, ErrAgeIdentityRequired)
+}
+
+func TestAgeKeyring_IdentityFromEnvironment(t *testing.T) {
+	dir := t.TempDir()
+	id, err := age.GenerateX25519Identity()
+	require.NoError(t, err)
+
+	// Inline secret keys are accepted so CI systems can pass the identity as a masked variable.
+	t.Setenv("ATMOS_KEYRING_AGE_IDENTITY", id.String())
+
+	store, err := newAgeKeyringStore(newAgeTestConfig(filepath.Join(dir, "keyring.age"), ""))
+	require.NoError(t, err)
+	require.NoError(t, store.Store("ci", &types.OIDCCredentials{Token: "tok"}, ""))
+
+	retrieved, err := store.Retrieve("ci", "")
+	require.NoError(t, err)
+	assert.Equal(t, "tok", retrieved.(*types.OIDCCredentials).Token)
+}
+
+func TestAgeKeyring_SharedWithRecipients(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "keyring.age")
+	aliceKey, _ := writeAgeIdentity(t, dir, "alice.txt")
+	_, bobRecipient := writeAgeIdentity(t, dir, "bob.txt")
+	bobKey := filepath.Join(dir, "bob.txt")
+	carolKey, carolRecipient := writeSSHIdentity(t, dir, "")
+
+	recipientsFile := filepath.Join(dir, "recipients.txt")
+	require.NoError(t, os.WriteFile(recipientsFile, []byte("# team\n"+carolRecipient), 0o600))
+
+	cfg := newAgeTestConfig(path, aliceKey)
+	cfg.Keyring.Spec["recipients"] = []interface{}{bobRecipient}
+	cfg.Keyring.Spec["recipients_file"] = recipientsFile
+
+	alice, err := newAgeKeyringStore(cfg)
+	require.NoError(t, err)
+	require.NoError(t, alice.Store("shared", &types.OIDCCredentials{Token: "team-token"}, ""))
+
+	for _, key := range []string{bobKey, carolKey} {
+		reader, err := newAgeKeyringStore(newAgeTestConfig(path, key))
+		require.NoError(t, err)
+		retrieved, err := reader.Retrieve("shared", "")
+		require.NoError(t, err, "recipient %s should decrypt the keyring", key)
+		assert.Equal(t, "team-token", retrieved.(*types.OIDCCredentials).Token)
+	}
+
+	// Someone who isn't a recipient can't read the file.
+	outsiderKey, _ := writeAgeIdentity(t, dir, "outsider.txt")
+	outsider, err := newAgeKeyringStore(newAgeTestConfig(path, outsiderKey))
+	require.NoError(t, err)
+	_, err = outsider.Retrieve("shared", "")
+	require.Error(t, err)
+	assert.ErrorIs(t, err, ErrCredentialStore)
+}
+
+func TestAgeKeyring_InvalidRecipient(t *testing.T) {
+	dir := t.TempDir()
+	identity, _ := writeAgeIdentity(t, dir, "key.txt")
+
+	cfg := newAgeTestConfig(filepath.Join(dir, "keyring.age"), identity)
+	cfg.Keyring.Spec["recipients"] = []interface{}{"age1notarecipient"}
+
+	_, err := newAgeKeyringStore(cfg)
+	assert.ErrorIs(t, err, ErrInvalidAgeRecipient)
+}
+
+func TestAgeKeyring_ListAndDelete(t *testing.T) {
+	dir := t.TempDir()
+	identity, _ := writeAgeIdentity(t, dir, "key.txt")
+
+	store, err := newAgeKeyringStore(newAgeTestConfig(filepath.Join(dir, "keyring.age"), identity))
+	require.NoError(t, err)
+
+	require.NoError(t, store.Store("a", &types.OIDCCredentials{Token: "1"}, "realm"))
+	require.NoError(t, store.Store("b", &types.OIDCCredentials{Token: "2"}, "realm"))
+	require.NoError(t, store.Store("c", &types.OIDCCredentials{Token: "3"}, "other"))
+
+	aliases, err := store.List("realm")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, aliases)
+
+	require.NoError(t, store.Delete("a", "realm"))
+	// Deleting again is a no-op.
+	require.NoError(t, store.Delete("a", "realm"))
+
+	aliases, err = store.List("realm")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, aliases)
+}
+
+func TestNewCredentialStoreWithConfig_Age(t *testing.T) {
+	dir := t.TempDir()
+	identity, _ := writeAgeIdentity(t, dir, "key.txt")
+	t.Setenv("ATMOS_KEYRING_TYPE", "age")
+
+	store := NewCredentialStoreWithConfig(newAgeTestConfig(filepath.Join(dir, "keyring.age"), identity))
+	assert.Equal(t, types.CredentialStoreTypeAge, store.Type())
+}
@@ -0,0 +1,66 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/99designs/keyring"
+
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// PassKeyringDefaultPrefix is the folder inside the password store that holds Atmos credentials.
+const PassKeyringDefaultPrefix = "atmos-auth"
+
+// passKeyringStore is a credential store backed by pass (https://www.passwordstore.org/).
+// Entries are GPG-encrypted to the recipients in the store's .gpg-id, so a password store
+// shared through git can be read by every team member listed there.
+type passKeyringStore struct {
+	*fileKeyringStore
+}
+
+// Type returns the type of this credential store.
+func (s *passKeyringStore) Type() string {
+	return types.CredentialStoreTypePass
+}
+
+// parsePassKeyringConfig extracts the pass settings from auth config.
+func parsePassKeyringConfig(authConfig *schema.AuthConfig) (dir, prefix, command string) {
+	if authConfig != nil && authConfig.Keyring.Spec != nil {
+		dir, _ = authConfig.Keyring.Spec["dir"].(string)
+		prefix, _ = authConfig.Keyring.Spec["prefix"].(string)
+		command, _ = authConfig.Keyring.Spec["command"].(string)
+	}
+	if prefix == "" {
+		prefix = PassKeyringDefaultPrefix
+	}
+	return dir, prefix, command
+}
+
+// newPassKeyringStore creates a credential store backed by the pass command.
+// The pass backend is unavailable on Windows and when the pass binary isn't on PATH.
+func newPassKeyringStore(authConfig *schema.AuthConfig) (*passKeyringStore, error) {
+	defer perf.Track(nil, "credentials.newPassKeyringStore")()
+
+	dir, prefix, command := parsePassKeyringConfig(authConfig)
+
+	ring, err := keyring.Open(keyring.Config{
+		ServiceName:     "atmos-auth",
+		AllowedBackends: []keyring.BackendType{keyring.PassBackend},
+		PassDir:         dir,
+		PassPrefix:      prefix,
+		PassCmd:         command,
+	})
+	if err != nil {
+		return nil, errors.Join(ErrCredentialStore, fmt.Errorf("failed to open pass keyring: %w", err))
+	}
+
+	return &passKeyringStore{
+		fileKeyringStore: &fileKeyringStore{
+			ring: ring,
+			path: dir,
+		},
+	}, nil
+}
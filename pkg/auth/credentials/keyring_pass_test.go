@@ -0,0 +1,119 @@
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// fakePassScript emulates the subset of pass used by the keyring: entries are stored unencrypted
+// as <name>.gpg files so the tests don't depend on GPG.
+const fakePassScript = `#!/bin/sh
+set -e
+cmd="$1"; shift
+case "$cmd" in
+  show) cat "$PASSWORD_STORE_DIR/$1.gpg" ;;
+  insert) name="$3"; mkdir -p "$(dirname "$PASSWORD_STORE_DIR/$name")"; cat > "$PASSWORD_STORE_DIR/$name.gpg" ;;
+  rm) rm "$PASSWORD_STORE_DIR/$2.gpg" ;;
+  *) exit 1 ;;
+esac
+`
+
+// newPassTestConfig installs a fake pass command and returns a config pointing at a temp store.
+func newPassTestConfig(t *testing.T) (*schema.AuthConfig, string) {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skipf("pass keyring is not available on Windows")
+	}
+
+	binDir := t.TempDir()
+	command := filepath.Join(binDir, "pass")
+	require.NoError(t, os.WriteFile(command, []byte(fakePassScript), 0o755))
+
+	storeDir := t.TempDir()
+	return &schema.AuthConfig{
+		Keyring: schema.KeyringConfig{
+			Type: "pass",
+			Spec: map[string]interface{}{
+				"dir":     storeDir,
+				"command": command,
+			},
+		},
+	}, storeDir
+}
+
+func TestPassKeyring_Suite(t *testing.T) {
+	factory := func(t *testing.T) types.CredentialStore {
+		cfg, _ := newPassTestConfig(t)
+		store, err := newPassKeyringStore(cfg)
+		require.NoError(t, err)
+		return store
+	}
+
+	RunCredentialStoreTests(t, factory)
+}
+
+func TestPassKeyring_Type(t *testing.T) {
+	cfg, _ := newPassTestConfig(t)
+
+	store, err := newPassKeyringStore(cfg)
+	require.NoError(t, err)
+	assert.Equal(t, types.CredentialStoreTypePass, store.Type())
+}
+
+func TestPassKeyring_EntriesUnderPrefix(t *testing.T) {
+	cfg, storeDir := newPassTestConfig(t)
+
+	store, err := newPassKeyringStore(cfg)
+	require.NoError(t, err)
+	require.NoError(t, store.Store("dev", &types.OIDCCredentials{Token: "tok"}, "realm"))
+
+	_, err = os.Stat(filepath.Join(storeDir, PassKeyringDefaultPrefix, "atmos_realm_dev.gpg"))
+	require.NoError(t, err)
+
+	aliases, err := store.List("realm")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev"}, aliases)
+}
+
+func TestPassKeyring_CustomPrefix(t *testing.T) {
+	cfg, storeDir := newPassTestConfig(t)
+	cfg.Keyring.Spec["prefix"] = "team/atmos"
+
+	store, err := newPassKeyringStore(cfg)
+	require.NoError(t, err)
+	require.NoError(t, store.Store("dev", &types.OIDCCredentials{Token: "tok"}, ""))
+
+	_, err = os.Stat(filepath.Join(storeDir, "team", "atmos", "atmos_dev.gpg"))
+	require.NoError(t, err)
+}
+
+func TestPassKeyring_DeleteIsIdempotent(t *testing.T) {
+	cfg, _ := newPassTestConfig(t)
+
+	store, err := newPassKeyringStore(cfg)
+	require.NoError(t, err)
+	require.NoError(t, store.Store("dev", &types.OIDCCredentials{Token: "tok"}, ""))
+
+	require.NoError(t, store.Delete("dev", ""))
+	require.NoError(t, store.Delete("dev", ""))
+
+	_, err = store.Retrieve("dev", "")
+	assert.ErrorIs(t, err, ErrCredentialsNotFound)
+}
+
+func TestPassKeyring_CommandNotFound(t *testing.T) {
+	cfg, _ := newPassTestConfig(t)
+	cfg.Keyring.Spec["command"] = filepath.Join(t.TempDir(), "missing-pass")
+
+	_, err := newPassKeyringStore(cfg)
+	assert.ErrorIs(t, err, ErrCredentialStore)
+}
@@ -53,7 +53,7 @@ type credentialEnvelope struct {
 }
 
 // NewCredentialStore creates a new credential store instance based on configuration.
-// It selects the appropriate backend (system, file, or memory) based on:
+// It selects the appropriate backend (system, file, age, pass, or memory) based on:
 // 1. ATMOS_KEYRING_TYPE environment variable (highest priority).
 // 2. AuthConfig.Keyring.Type configuration.
 // 3. Default to "system" for backward compatibility.
@@ -88,6 +88,10 @@ func NewCredentialStoreWithConfig(authConfig *schema.AuthConfig) types.Credentia
 		store = newMemoryKeyringStore()
 	case "file":
 		store, err = newFileKeyringStore(authConfig)
+	case "age":
+		store, err = newAgeKeyringStore(authConfig)
+	case "pass":
+		store, err = newPassKeyringStore(authConfig)
 	case "system":
 		store, err = newSystemKeyringStore()
 	default:
@@ -9,9 +9,12 @@ import (
 	azureIdentities "github.com/cloudposse/atmos/pkg/auth/identities/azure"
 	awsProviders "github.com/cloudposse/atmos/pkg/auth/providers/aws"
 	azureProviders "github.com/cloudposse/atmos/pkg/auth/providers/azure"
+	ciProviders "github.com/cloudposse/atmos/pkg/auth/providers/ci"
 	githubProviders "github.com/cloudposse/atmos/pkg/auth/providers/github"
+	gitlabProviders "github.com/cloudposse/atmos/pkg/auth/providers/gitlab"
 	mockProviders "github.com/cloudposse/atmos/pkg/auth/providers/mock"
 	mockawsProviders "github.com/cloudposse/atmos/pkg/auth/providers/mock/aws"
+	oidcProviders "github.com/cloudposse/atmos/pkg/auth/providers/oidc"
 	"github.com/cloudposse/atmos/pkg/auth/types"
 	"github.com/cloudposse/atmos/pkg/perf"
 	"github.com/cloudposse/atmos/pkg/schema"
@@ -111,6 +114,12 @@ func NewProvider(name string, config *schema.Provider) (types.Provider, error) {
 		return azureProviders.NewOIDCProvider(name, config)
 	case "github/oidc":
 		return githubProviders.NewOIDCProvider(name, config)
+	case "gitlab/oidc":
+		return gitlabProviders.NewOIDCProvider(name, config)
+	case "ci/jwt-file":
+		return ciProviders.NewJWTFileProvider(name, config)
+	case "oidc":
+		return oidcProviders.NewOIDCProvider(name, config)
 	case "mock":
 		return mockProviders.NewProvider(name, config), nil
 	case "mock/aws":
@@ -148,6 +157,8 @@ func NewIdentity(name string, config *schema.Identity) (types.Identity, error) {
 		return awsIdentities.NewPermissionSetIdentity(name, config)
 	case "aws/assume-role":
 		return awsIdentities.NewAssumeRoleIdentity(name, config)
+	case "aws/assume-role-with-web-identity":
+		return awsIdentities.NewAssumeRoleWithWebIdentityIdentity(name, config)
 	case "aws/assume-root":
 		return awsIdentities.NewAssumeRootIdentity(name, config)
 	case "ambient":
@@ -69,6 +69,30 @@ func TestNewProvider_Factory(t *testing.T) {
 			config:       &schema.Provider{Kind: "github/oidc", Region: "us-east-1"},
 			expectError:  false,
 		},
+		{
+			name:         "gitlab-oidc-valid",
+			providerName: "gitlab",
+			config:       &schema.Provider{Kind: "gitlab/oidc"},
+			expectError:  false,
+		},
+		{
+			name:         "ci-jwt-file-valid",
+			providerName: "buildkite",
+			config: &schema.Provider{
+				Kind: "ci/jwt-file",
+				Spec: map[string]interface{}{"token_env_var": "BUILDKITE_OIDC_TOKEN"},
+			},
+			expectError: false,
+		},
+		{
+			name:         "oidc-valid",
+			providerName: "okta",
+			config: &schema.Provider{
+				Kind: "oidc",
+				Spec: map[string]interface{}{"issuer_url": "https://example.okta.com", "client_id": "abc"},
+			},
+			expectError: false,
+		},
 		{
 			name:         "gcp-adc-valid",
 			providerName: "gcp-adc",
@@ -179,6 +203,12 @@ func TestNewIdentity_Factory(t *testing.T) {
 			config:       &schema.Identity{Kind: "aws/assume-role"},
 			expectError:  false,
 		},
+		{
+			name:         "aws-assume-role-with-web-identity-valid",
+			identityName: "okta-admin",
+			config:       &schema.Identity{Kind: "aws/assume-role-with-web-identity"},
+			expectError:  false,
+		},
 		{
 			name:         "aws-assume-root-valid",
 			identityName: "root-access",
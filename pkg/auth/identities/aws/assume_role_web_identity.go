@@ -0,0 +1,65 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// assumeRoleWithWebIdentityIdentity implements the aws/assume-role-with-web-identity identity.
+// It exchanges an OIDC token from its provider (e.g. a generic oidc provider backed by Okta
+// or Keycloak) for AWS credentials via STS AssumeRoleWithWebIdentity. Unlike aws/assume-role,
+// it never falls back to AssumeRole with AWS credentials.
+type assumeRoleWithWebIdentityIdentity struct {
+	*assumeRoleIdentity
+}
+
+// NewAssumeRoleWithWebIdentityIdentity creates a new AWS assume role with web identity identity.
+func NewAssumeRoleWithWebIdentityIdentity(name string, config *schema.Identity) (types.Identity, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%w: identity name is empty", errUtils.ErrInvalidIdentityConfig)
+	}
+	if config == nil {
+		return nil, fmt.Errorf("%w: identity config is nil", errUtils.ErrInvalidIdentityConfig)
+	}
+	if config.Kind != types.IdentityKindAWSAssumeRoleWithWebIdentity {
+		return nil, fmt.Errorf("%w: invalid identity kind for assume role with web identity: %s", errUtils.ErrInvalidIdentityKind, config.Kind)
+	}
+
+	return &assumeRoleWithWebIdentityIdentity{
+		assumeRoleIdentity: &assumeRoleIdentity{
+			name:   name,
+			config: config,
+		},
+	}, nil
+}
+
+// Kind returns the identity kind.
+func (i *assumeRoleWithWebIdentityIdentity) Kind() string {
+	return types.IdentityKindAWSAssumeRoleWithWebIdentity
+}
+
+// Authenticate exchanges the OIDC token of the base credentials for AWS credentials.
+func (i *assumeRoleWithWebIdentityIdentity) Authenticate(ctx context.Context, baseCreds types.ICredentials) (types.ICredentials, error) {
+	// Validate identity configuration, sets roleArn and region.
+	if err := i.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: invalid assume role with web identity identity: %w", errUtils.ErrInvalidIdentityConfig, err)
+	}
+
+	oidcCreds, ok := baseCreds.(*types.OIDCCredentials)
+	if !ok || oidcCreds.Token == "" {
+		return nil, errUtils.Build(errUtils.ErrOIDCWebIdentityRequired).
+			WithExplanationf("Identity '%s' received %T credentials instead of an OIDC token", i.name, baseCreds).
+			WithHint("Set `via.provider` to an `oidc`, `github/oidc`, `gitlab/oidc` or `ci/jwt-file` provider").
+			WithHint("Use `aws/assume-role` to assume a role with AWS credentials").
+			WithContext("identity", i.name).
+			WithContext("role_arn", i.roleArn).
+			WithExitCode(2).
+			Err()
+	}
+
+	return i.assumeRoleWithWebIdentity(ctx, oidcCreds)
+}
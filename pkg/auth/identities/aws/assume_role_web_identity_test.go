@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestNewAssumeRoleWithWebIdentityIdentity(t *testing.T) {
+	_, err := NewAssumeRoleWithWebIdentityIdentity("", &schema.Identity{Kind: "aws/assume-role-with-web-identity"})
+	assert.ErrorIs(t, err, errUtils.ErrInvalidIdentityConfig)
+
+	_, err = NewAssumeRoleWithWebIdentityIdentity("okta-admin", nil)
+	assert.ErrorIs(t, err, errUtils.ErrInvalidIdentityConfig)
+
+	_, err = NewAssumeRoleWithWebIdentityIdentity("okta-admin", &schema.Identity{Kind: "aws/assume-role"})
+	assert.ErrorIs(t, err, errUtils.ErrInvalidIdentityKind)
+
+	id, err := NewAssumeRoleWithWebIdentityIdentity("okta-admin", &schema.Identity{
+		Kind: "aws/assume-role-with-web-identity",
+		Via:  &schema.IdentityVia{Provider: "okta"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "aws/assume-role-with-web-identity", id.Kind())
+
+	providerName, err := id.GetProviderName()
+	require.NoError(t, err)
+	assert.Equal(t, "okta", providerName)
+}
+
+func TestAssumeRoleWithWebIdentityIdentity_Validate(t *testing.T) {
+	id, err := NewAssumeRoleWithWebIdentityIdentity("okta-admin", &schema.Identity{Kind: "aws/assume-role-with-web-identity"})
+	require.NoError(t, err)
+	assert.ErrorIs(t, id.Validate(), errUtils.ErrMissingPrincipal)
+
+	id, err = NewAssumeRoleWithWebIdentityIdentity("okta-admin", &schema.Identity{
+		Kind:      "aws/assume-role-with-web-identity",
+		Principal: map[string]any{"region": "us-east-2"},
+	})
+	require.NoError(t, err)
+	assert.ErrorIs(t, id.Validate(), errUtils.ErrMissingAssumeRole)
+}
+
+func TestAssumeRoleWithWebIdentityIdentity_RequiresOIDCCredentials(t *testing.T) {
+	id, err := NewAssumeRoleWithWebIdentityIdentity("okta-admin", &schema.Identity{
+		Kind:      "aws/assume-role-with-web-identity",
+		Principal: map[string]any{"assume_role": "arn:aws:iam::123456789012:role/okta-admin"},
+	})
+	require.NoError(t, err)
+
+	// AWS credentials are rejected instead of falling back to AssumeRole.
+	_, err = id.Authenticate(context.Background(), &types.AWSCredentials{AccessKeyID: "AKIA", SecretAccessKey: "secret"})
+	assert.ErrorIs(t, err, errUtils.ErrOIDCWebIdentityRequired)
+
+	_, err = id.Authenticate(context.Background(), &types.OIDCCredentials{})
+	assert.ErrorIs(t, err, errUtils.ErrOIDCWebIdentityRequired)
+}
@@ -6,21 +6,30 @@ import (
 
 	errUtils "github.com/cloudposse/atmos/errors"
 	azureCloud "github.com/cloudposse/atmos/pkg/auth/cloud/azure"
+	azureProviders "github.com/cloudposse/atmos/pkg/auth/providers/azure"
 	authTypes "github.com/cloudposse/atmos/pkg/auth/types"
 	log "github.com/cloudposse/atmos/pkg/logger"
 	"github.com/cloudposse/atmos/pkg/perf"
 	"github.com/cloudposse/atmos/pkg/schema"
 )
 
+// exchangeFederatedToken exchanges an OIDC token for Azure credentials. Overridable in tests.
+var exchangeFederatedToken = azureProviders.ExchangeFederatedToken
+
 // subscriptionIdentity implements Azure subscription-based identity.
 // This identity uses Azure credentials to access a specific subscription and resource scope.
+// When its provider returns an OIDC token (e.g. gitlab/oidc or ci/jwt-file), the token is
+// exchanged for Azure credentials using the tenant_id and client_id from the principal.
 type subscriptionIdentity struct {
-	name           string
-	config         *schema.Identity
-	subscriptionID string
-	resourceGroup  string
-	location       string
-	realm          string // Credential isolation realm set by auth manager.
+	name             string
+	config           *schema.Identity
+	subscriptionID   string
+	resourceGroup    string
+	location         string
+	tenantID         string
+	clientID         string
+	cloudEnvironment string
+	realm            string // Credential isolation realm set by auth manager.
 }
 
 // NewSubscriptionIdentity creates a new Azure subscription identity.
@@ -36,6 +45,9 @@ func NewSubscriptionIdentity(name string, config *schema.Identity) (*subscriptio
 	subscriptionID := ""
 	resourceGroup := ""
 	location := ""
+	tenantID := ""
+	clientID := ""
+	cloudEnvironment := ""
 
 	if config.Principal != nil {
 		if sid, ok := config.Principal["subscription_id"].(string); ok {
@@ -47,6 +59,16 @@ func NewSubscriptionIdentity(name string, config *schema.Identity) (*subscriptio
 		if loc, ok := config.Principal["location"].(string); ok {
 			location = loc
 		}
+		// Federation settings, used only with OIDC credentials from the provider.
+		if tid, ok := config.Principal["tenant_id"].(string); ok {
+			tenantID = tid
+		}
+		if cid, ok := config.Principal["client_id"].(string); ok {
+			clientID = cid
+		}
+		if ce, ok := config.Principal["cloud_environment"].(string); ok {
+			cloudEnvironment = ce
+		}
 	}
 
 	// Subscription ID is required.
@@ -55,11 +77,14 @@ func NewSubscriptionIdentity(name string, config *schema.Identity) (*subscriptio
 	}
 
 	return &subscriptionIdentity{
-		name:           name,
-		config:         config,
-		subscriptionID: subscriptionID,
-		resourceGroup:  resourceGroup,
-		location:       location,
+		name:             name,
+		config:           config,
+		subscriptionID:   subscriptionID,
+		resourceGroup:    resourceGroup,
+		location:         location,
+		tenantID:         tenantID,
+		clientID:         clientID,
+		cloudEnvironment: cloudEnvironment,
 	}, nil
 }
 
@@ -91,6 +116,15 @@ func (i *subscriptionIdentity) Authenticate(ctx context.Context, baseCreds authT
 		azureCloud.LogFieldSubscription, i.subscriptionID,
 	)
 
+	// OIDC tokens from CI providers are exchanged for Azure credentials first.
+	if oidcCreds, ok := baseCreds.(*authTypes.OIDCCredentials); ok {
+		exchanged, err := i.exchangeOIDCToken(ctx, oidcCreds)
+		if err != nil {
+			return nil, err
+		}
+		baseCreds = exchanged
+	}
+
 	// Verify base credentials are Azure credentials.
 	azureCreds, ok := baseCreds.(*authTypes.AzureCredentials)
 	if !ok {
@@ -130,6 +164,32 @@ func (i *subscriptionIdentity) Authenticate(ctx context.Context, baseCreds authT
 	return creds, nil
 }
 
+// exchangeOIDCToken exchanges an OIDC token from the provider for Azure credentials
+// via workload identity federation.
+func (i *subscriptionIdentity) exchangeOIDCToken(ctx context.Context, oidcCreds *authTypes.OIDCCredentials) (*authTypes.AzureCredentials, error) {
+	if i.tenantID == "" || i.clientID == "" {
+		return nil, errUtils.Build(errUtils.ErrInvalidIdentityConfig).
+			WithExplanationf("Identity '%s' received an OIDC token from its provider, which requires federation settings", i.name).
+			WithHint("Set `principal.tenant_id` and `principal.client_id` to the app registration with the federated credential").
+			WithContext("identity", i.name).
+			WithExitCode(2).
+			Err()
+	}
+
+	spec := map[string]any{
+		"tenant_id":         i.tenantID,
+		"client_id":         i.clientID,
+		"subscription_id":   i.subscriptionID,
+		"location":          i.location,
+		"cloud_environment": i.cloudEnvironment,
+	}
+	creds, err := exchangeFederatedToken(ctx, i.name, spec, oidcCreds.Token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: identity %s: %w", errUtils.ErrAuthenticationFailed, i.name, err)
+	}
+	return creds, nil
+}
+
 // Validate validates the identity configuration.
 func (i *subscriptionIdentity) Validate() error {
 	if i.subscriptionID == "" {
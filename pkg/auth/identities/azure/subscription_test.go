@@ -380,6 +380,60 @@ func TestSubscriptionIdentity_Authenticate(t *testing.T) {
 	}
 }
 
+func TestSubscriptionIdentity_Authenticate_OIDCCredentials(t *testing.T) {
+	config := &schema.Identity{
+		Kind: "azure/subscription",
+		Via:  &schema.IdentityVia{Provider: "gitlab"},
+		Principal: map[string]any{
+			"subscription_id": "sub-123",
+			"location":        "westeurope",
+		},
+	}
+	identity, err := NewSubscriptionIdentity("gitlab-azure", config)
+	require.NoError(t, err)
+
+	// Without tenant_id and client_id the token can't be exchanged.
+	_, err = identity.Authenticate(context.Background(), &types.OIDCCredentials{Token: "ci-token"})
+	assert.ErrorIs(t, err, errUtils.ErrInvalidIdentityConfig)
+
+	config.Principal["tenant_id"] = "tenant-123"
+	config.Principal["client_id"] = "client-123"
+	identity, err = NewSubscriptionIdentity("gitlab-azure", config)
+	require.NoError(t, err)
+
+	original := exchangeFederatedToken
+	t.Cleanup(func() { exchangeFederatedToken = original })
+	exchangeFederatedToken = func(_ context.Context, name string, spec map[string]any, federatedToken string) (*types.AzureCredentials, error) {
+		assert.Equal(t, "gitlab-azure", name)
+		assert.Equal(t, "ci-token", federatedToken)
+		assert.Equal(t, "tenant-123", spec["tenant_id"])
+		assert.Equal(t, "client-123", spec["client_id"])
+		return &types.AzureCredentials{
+			AccessToken:        "azure-token",
+			TenantID:           "tenant-123",
+			ClientID:           "client-123",
+			IsServicePrincipal: true,
+			FederatedToken:     federatedToken,
+		}, nil
+	}
+
+	creds, err := identity.Authenticate(context.Background(), &types.OIDCCredentials{Token: "ci-token"})
+	require.NoError(t, err)
+	azureCreds, ok := creds.(*types.AzureCredentials)
+	require.True(t, ok)
+	assert.Equal(t, "azure-token", azureCreds.AccessToken)
+	assert.Equal(t, "sub-123", azureCreds.SubscriptionID)
+	assert.Equal(t, "westeurope", azureCreds.Location)
+	assert.Equal(t, "ci-token", azureCreds.FederatedToken)
+	assert.True(t, azureCreds.IsServicePrincipal)
+
+	exchangeFederatedToken = func(context.Context, string, map[string]any, string) (*types.AzureCredentials, error) {
+		return nil, errors.New("AADSTS70021")
+	}
+	_, err = identity.Authenticate(context.Background(), &types.OIDCCredentials{Token: "ci-token"})
+	assert.ErrorIs(t, err, errUtils.ErrAuthenticationFailed)
+}
+
 func TestSubscriptionIdentity_Validate(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -9,6 +9,7 @@ import (
 
 	errUtils "github.com/cloudposse/atmos/errors"
 	"github.com/cloudposse/atmos/pkg/auth/cloud/gcp"
+	"github.com/cloudposse/atmos/pkg/auth/providers/gcp_wif"
 	"github.com/cloudposse/atmos/pkg/auth/types"
 	"github.com/cloudposse/atmos/pkg/perf"
 	"github.com/cloudposse/atmos/pkg/schema"
@@ -25,6 +26,20 @@ const (
 	DefaultLifetime = "3600s" // 1 hour.
 )
 
+// exchangeWIFToken exchanges an OIDC token for a federated access token via Google STS.
+// Overridable in tests.
+var exchangeWIFToken = func(ctx context.Context, spec *types.GCPWorkloadIdentityFederationProviderSpec, oidcToken string) (string, error) {
+	provider, err := gcp_wif.New(spec)
+	if err != nil {
+		return "", err
+	}
+	token, err := provider.ExchangeToken(ctx, oidcToken)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
 // Identity implements the gcp/service-account identity.
 type Identity struct {
 	name              string
@@ -137,13 +152,23 @@ func (i *Identity) Authenticate(ctx context.Context, baseCreds types.ICredential
 		return nil, fmt.Errorf("%w: no credentials from provider for identity", errUtils.ErrAuthenticationFailed)
 	}
 
-	gcpCreds, ok := baseCreds.(*types.GCPCredentials)
-	if !ok {
+	var upstreamToken string
+	switch creds := baseCreds.(type) {
+	case *types.GCPCredentials:
+		upstreamToken = creds.AccessToken
+	case *types.OIDCCredentials:
+		// OIDC tokens from CI providers are exchanged via workload identity federation first.
+		federatedToken, err := i.exchangeOIDCToken(ctx, creds)
+		if err != nil {
+			return nil, err
+		}
+		upstreamToken = federatedToken
+	default:
 		return nil, fmt.Errorf("%w: provider did not return GCP credentials", errUtils.ErrAuthenticationFailed)
 	}
 
 	// Impersonate the target service account.
-	accessToken, expiry, err := i.impersonateServiceAccount(ctx, gcpCreds.AccessToken)
+	accessToken, expiry, err := i.impersonateServiceAccount(ctx, upstreamToken)
 	if err != nil {
 		return nil, fmt.Errorf("%w: impersonation failed: %w", errUtils.ErrAuthenticationFailed, err)
 	}
@@ -163,6 +188,30 @@ func (i *Identity) Authenticate(ctx context.Context, baseCreds types.ICredential
 	}, nil
 }
 
+// exchangeOIDCToken exchanges an OIDC token from the provider for a federated access token
+// using the workload identity federation settings of the principal.
+func (i *Identity) exchangeOIDCToken(ctx context.Context, oidcCreds *types.OIDCCredentials) (string, error) {
+	if i.principal.ProjectNumber == "" || i.principal.WorkloadIdentityPoolID == "" || i.principal.WorkloadIdentityProviderID == "" {
+		return "", errUtils.Build(errUtils.ErrInvalidIdentityConfig).
+			WithExplanationf("Identity '%s' received an OIDC token from its provider, which requires workload identity federation settings", i.name).
+			WithHint("Set `principal.project_number`, `principal.workload_identity_pool_id` and `principal.workload_identity_provider_id`").
+			WithContext("identity", i.name).
+			WithExitCode(2).
+			Err()
+	}
+
+	spec := &types.GCPWorkloadIdentityFederationProviderSpec{
+		ProjectNumber:              i.principal.ProjectNumber,
+		WorkloadIdentityPoolID:     i.principal.WorkloadIdentityPoolID,
+		WorkloadIdentityProviderID: i.principal.WorkloadIdentityProviderID,
+	}
+	federatedToken, err := exchangeWIFToken(ctx, spec, oidcCreds.Token)
+	if err != nil {
+		return "", fmt.Errorf("%w: workload identity federation: %w", errUtils.ErrAuthenticationFailed, err)
+	}
+	return federatedToken, nil
+}
+
 // impersonateServiceAccount uses IAM Credentials API to generate an access token.
 func (i *Identity) impersonateServiceAccount(ctx context.Context, upstreamToken string) (string, time.Time, error) {
 	defer perf.Track(nil, "gcp_service_account.impersonateServiceAccount")()
@@ -158,6 +158,52 @@ func TestAuthenticate_WrongCredentialType(t *testing.T) {
 	assert.Contains(t, err.Error(), "did not return GCP credentials")
 }
 
+func TestAuthenticate_OIDCCredentials(t *testing.T) {
+	id, err := New(&types.GCPServiceAccountIdentityPrincipal{
+		ServiceAccountEmail: "sa@proj.iam.gserviceaccount.com",
+	})
+	require.NoError(t, err)
+	id.SetName("gitlab-gcp")
+
+	// Without workload identity federation settings the token can't be exchanged.
+	_, err = id.Authenticate(context.Background(), &types.OIDCCredentials{Token: "ci-token"})
+	assert.ErrorIs(t, err, errUtils.ErrInvalidIdentityConfig)
+
+	id.principal.ProjectNumber = "123456"
+	id.principal.WorkloadIdentityPoolID = "ci-pool"
+	id.principal.WorkloadIdentityProviderID = "gitlab"
+
+	original := exchangeWIFToken
+	t.Cleanup(func() { exchangeWIFToken = original })
+	exchangeWIFToken = func(_ context.Context, spec *types.GCPWorkloadIdentityFederationProviderSpec, oidcToken string) (string, error) {
+		assert.Equal(t, "ci-token", oidcToken)
+		assert.Equal(t, "123456", spec.ProjectNumber)
+		assert.Equal(t, "ci-pool", spec.WorkloadIdentityPoolID)
+		assert.Equal(t, "gitlab", spec.WorkloadIdentityProviderID)
+		return "federated-token", nil
+	}
+	id.iamServiceFactory = func(_ context.Context, accessToken string) (gcpCloud.IAMCredentialsService, error) {
+		assert.Equal(t, "federated-token", accessToken)
+		return &mockIAMService{resp: &iamcredentials.GenerateAccessTokenResponse{
+			AccessToken: "sa-token",
+			ExpireTime:  time.Now().Add(time.Hour).UTC().Format(time.RFC3339),
+		}}, nil
+	}
+
+	creds, err := id.Authenticate(context.Background(), &types.OIDCCredentials{Token: "ci-token"})
+	require.NoError(t, err)
+	gcpCreds, ok := creds.(*types.GCPCredentials)
+	require.True(t, ok)
+	assert.Equal(t, "sa-token", gcpCreds.AccessToken)
+	assert.Equal(t, "sa@proj.iam.gserviceaccount.com", gcpCreds.ServiceAccountEmail)
+
+	exchangeWIFToken = func(context.Context, *types.GCPWorkloadIdentityFederationProviderSpec, string) (string, error) {
+		return "", errors.New("invalid_grant")
+	}
+	_, err = id.Authenticate(context.Background(), &types.OIDCCredentials{Token: "ci-token"})
+	assert.ErrorIs(t, err, errUtils.ErrAuthenticationFailed)
+}
+
 type mockNonGCPCreds struct{}
 
 func (m *mockNonGCPCreds) IsExpired() bool { return false }
@@ -206,6 +206,28 @@ func (p *oidcProvider) Authenticate(ctx context.Context) (authTypes.ICredentials
 		return nil, err
 	}
 
+	creds, err := p.authenticateWithToken(ctx, federatedToken)
+	if err != nil {
+		return nil, err
+	}
+	return creds, nil
+}
+
+// ExchangeFederatedToken exchanges a federated token obtained by another provider
+// (e.g. gitlab/oidc or ci/jwt-file) for Azure credentials. The spec accepts the same
+// keys as the azure/oidc provider spec; tenant_id and client_id are required.
+func ExchangeFederatedToken(ctx context.Context, name string, spec map[string]any, federatedToken string) (*authTypes.AzureCredentials, error) {
+	defer perf.Track(nil, "azure.ExchangeFederatedToken")()
+
+	p, err := NewOIDCProvider(name, &schema.Provider{Kind: authTypes.ProviderKindAzureOIDC, Spec: spec})
+	if err != nil {
+		return nil, err
+	}
+	return p.authenticateWithToken(ctx, federatedToken)
+}
+
+// authenticateWithToken exchanges the federated token for Azure credentials.
+func (p *oidcProvider) authenticateWithToken(ctx context.Context, federatedToken string) (*authTypes.AzureCredentials, error) {
 	// Exchange the federated token for the primary Azure Management API token.
 	tokenResp, err := p.exchangeToken(ctx, federatedToken, p.cloudEnv.ManagementScope)
 	if err != nil {
@@ -944,6 +944,42 @@ func TestOIDCProvider_Authenticate(t *testing.T) {
 	}
 }
 
+func TestExchangeFederatedToken_InvalidSpec(t *testing.T) {
+	_, err := ExchangeFederatedToken(context.Background(), "gitlab-azure", map[string]any{"client_id": "client-456"}, "ci-token")
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+
+	_, err = ExchangeFederatedToken(context.Background(), "gitlab-azure", map[string]any{
+		"tenant_id":         "tenant-123",
+		"client_id":         "client-456",
+		"cloud_environment": "mars",
+	}, "ci-token")
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+}
+
+func TestOIDCProvider_AuthenticateWithToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "ci-token", r.PostForm.Get("client_assertion"))
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(tokenResponse{AccessToken: "azure-access-token", TokenType: "Bearer", ExpiresIn: 3600})
+	}))
+	defer server.Close()
+
+	provider := &oidcProvider{
+		name:          "gitlab-azure",
+		tenantID:      "tenant-123",
+		clientID:      "client-456",
+		tokenEndpoint: server.URL,
+		cloudEnv:      azureCloud.GetCloudEnvironment(""),
+	}
+
+	creds, err := provider.authenticateWithToken(context.Background(), "ci-token")
+	require.NoError(t, err)
+	assert.Equal(t, "azure-access-token", creds.AccessToken)
+	assert.Equal(t, "ci-token", creds.FederatedToken)
+	assert.True(t, creds.IsServicePrincipal)
+}
+
 func TestOIDCProvider_GetHTTPClient(t *testing.T) {
 	t.Run("returns default client when none injected", func(t *testing.T) {
 		provider := &oidcProvider{
@@ -0,0 +1,181 @@
+package ci
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// jwtFileProvider implements the generic ci/jwt-file provider.
+// It reads an OIDC JWT that the CI system has already written to a file (e.g. a Kubernetes
+// projected service account token) or exported to an environment variable (e.g. CircleCI's
+// CIRCLE_OIDC_TOKEN_V2 or a token requested with `buildkite-agent oidc request-token`).
+type jwtFileProvider struct {
+	name        string
+	config      *schema.Provider
+	tokenFile   string
+	tokenEnvVar string
+	audience    string
+	realm       string // Credential isolation realm set by auth manager.
+}
+
+// NewJWTFileProvider creates a new ci/jwt-file provider.
+func NewJWTFileProvider(name string, config *schema.Provider) (types.Provider, error) {
+	defer perf.Track(nil, "ci.NewJWTFileProvider")()
+
+	if config == nil {
+		return nil, fmt.Errorf("%w: provider config is required", errUtils.ErrInvalidProviderConfig)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%w: provider name is required", errUtils.ErrInvalidProviderConfig)
+	}
+
+	p := &jwtFileProvider{
+		name:   name,
+		config: config,
+	}
+	if config.Spec != nil {
+		p.tokenFile, _ = config.Spec["token_file"].(string)
+		p.tokenEnvVar, _ = config.Spec["token_env_var"].(string)
+		p.audience, _ = config.Spec["audience"].(string)
+	}
+	return p, nil
+}
+
+// Name returns the provider name.
+func (p *jwtFileProvider) Name() string {
+	return p.name
+}
+
+// Kind returns the provider kind.
+func (p *jwtFileProvider) Kind() string {
+	return types.ProviderKindCIJWTFile
+}
+
+// SetRealm sets the credential isolation realm for this provider.
+func (p *jwtFileProvider) SetRealm(realm string) {
+	p.realm = realm
+}
+
+// PreAuthenticate is a no-op for ci/jwt-file provider.
+func (p *jwtFileProvider) PreAuthenticate(_ types.AuthManager) error {
+	return nil
+}
+
+// Authenticate reads the JWT from its configured source.
+// The file is re-read on every call so rotated tokens are picked up.
+func (p *jwtFileProvider) Authenticate(_ context.Context) (types.ICredentials, error) {
+	defer perf.Track(nil, "ci.jwtFileProvider.Authenticate")()
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	token, source, err := p.readToken()
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := types.NewOIDCCredentialsFromJWT(token, "ci")
+	if err != nil {
+		return nil, fmt.Errorf("%w: provider %s: token from %s: %w", errUtils.ErrAuthenticationFailed, p.name, source, err)
+	}
+	if p.audience != "" && creds.Audience != p.audience {
+		return nil, fmt.Errorf("%w: token from %s has audience %q, expected %q", errUtils.ErrAuthenticationFailed, source, creds.Audience, p.audience)
+	}
+
+	log.Debug("Read CI job token", "provider", p.name, "source", source, "issuer", creds.Issuer)
+	return creds, nil
+}
+
+// readToken returns the raw token and a description of where it was read from.
+func (p *jwtFileProvider) readToken() (string, string, error) {
+	if p.tokenFile != "" {
+		data, err := os.ReadFile(p.tokenFile)
+		if err != nil {
+			return "", p.tokenFile, errUtils.Build(errUtils.ErrCITokenNotFound).
+				WithExplanationf("Failed to read token file `%s`", p.tokenFile).
+				WithHint("Check that the CI system mounts the token at this path before Atmos runs").
+				WithCause(err).
+				WithContext("provider", p.name).
+				WithContext("token_file", p.tokenFile).
+				WithExitCode(2).
+				Err()
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", p.tokenFile, fmt.Errorf("%w: token file %s is empty", errUtils.ErrCITokenNotFound, p.tokenFile)
+		}
+		return token, p.tokenFile, nil
+	}
+
+	source := "$" + p.tokenEnvVar
+	key := "ci.jwt.env." + strings.ToLower(p.tokenEnvVar)
+	if err := viper.BindEnv(key, p.tokenEnvVar); err != nil {
+		log.Trace("Failed to bind environment variable", "name", p.tokenEnvVar, "error", err)
+	}
+	token := strings.TrimSpace(viper.GetString(key))
+	if token == "" {
+		return "", source, errUtils.Build(errUtils.ErrCITokenNotFound).
+			WithExplanationf("Environment variable `%s` is empty", p.tokenEnvVar).
+			WithHint("Export the CI job's OIDC token before running Atmos").
+			WithContext("provider", p.name).
+			WithContext("token_env_var", p.tokenEnvVar).
+			WithExitCode(2).
+			Err()
+	}
+	return token, source, nil
+}
+
+// Validate validates the provider configuration.
+func (p *jwtFileProvider) Validate() error {
+	switch {
+	case p.tokenFile == "" && p.tokenEnvVar == "":
+		return fmt.Errorf("%w: one of token_file or token_env_var is required", errUtils.ErrInvalidProviderConfig)
+	case p.tokenFile != "" && p.tokenEnvVar != "":
+		return fmt.Errorf("%w: token_file and token_env_var are mutually exclusive", errUtils.ErrInvalidProviderConfig)
+	}
+	return nil
+}
+
+// Environment returns environment variables for this provider.
+func (p *jwtFileProvider) Environment() (map[string]string, error) {
+	// The token is passed to downstream identities via credentials.
+	return map[string]string{}, nil
+}
+
+// Paths returns credential files/directories used by this provider.
+func (p *jwtFileProvider) Paths() ([]types.Path, error) {
+	// The token file is owned by the CI system, not by Atmos.
+	return []types.Path{}, nil
+}
+
+// PrepareEnvironment prepares environment variables for external processes.
+// The token is only used to obtain cloud credentials, so the environment is unchanged.
+func (p *jwtFileProvider) PrepareEnvironment(_ context.Context, environ map[string]string) (map[string]string, error) {
+	defer perf.Track(nil, "ci.jwtFileProvider.PrepareEnvironment")()
+
+	return environ, nil
+}
+
+// Logout removes provider-specific credential storage.
+func (p *jwtFileProvider) Logout(_ context.Context) error {
+	// Tokens are owned by the CI system; there is nothing to clean up.
+	log.Debug("Logout not supported for ci/jwt-file provider (no files to clean up)", "provider", p.name)
+	return errUtils.ErrLogoutNotSupported
+}
+
+// GetFilesDisplayPath returns the display path for credential files.
+// The ci/jwt-file provider doesn't manage credential files.
+func (p *jwtFileProvider) GetFilesDisplayPath() string {
+	return ""
+}
@@ -0,0 +1,131 @@
+package ci
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// serviceAccountToken returns an unsigned Kubernetes-style projected service account token.
+func serviceAccountToken(subject string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, _ := json.Marshal(map[string]any{
+		"iss": "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE",
+		"aud": []string{"sts.amazonaws.com"},
+		"sub": subject,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	return header + "." + base64.RawURLEncoding.EncodeToString(claims) + ".signature"
+}
+
+func newProvider(t *testing.T, spec map[string]any) types.Provider {
+	t.Helper()
+
+	provider, err := NewJWTFileProvider("ci", &schema.Provider{Kind: "ci/jwt-file", Spec: spec})
+	require.NoError(t, err)
+	return provider
+}
+
+func TestNewJWTFileProvider(t *testing.T) {
+	_, err := NewJWTFileProvider("ci", nil)
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+
+	_, err = NewJWTFileProvider("", &schema.Provider{Kind: "ci/jwt-file"})
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+
+	provider := newProvider(t, map[string]any{"token_env_var": "CIRCLE_OIDC_TOKEN_V2"})
+	assert.Equal(t, "ci/jwt-file", provider.Kind())
+	assert.Equal(t, "ci", provider.Name())
+	assert.NoError(t, provider.PreAuthenticate(nil))
+	assert.Empty(t, provider.GetFilesDisplayPath())
+	assert.ErrorIs(t, provider.Logout(context.Background()), errUtils.ErrLogoutNotSupported)
+}
+
+func TestJWTFileProvider_Validate(t *testing.T) {
+	assert.ErrorIs(t, newProvider(t, nil).Validate(), errUtils.ErrInvalidProviderConfig)
+	assert.ErrorIs(t, newProvider(t, map[string]any{
+		"token_file":    "/var/run/secrets/token",
+		"token_env_var": "CIRCLE_OIDC_TOKEN_V2",
+	}).Validate(), errUtils.ErrInvalidProviderConfig)
+	assert.NoError(t, newProvider(t, map[string]any{"token_file": "/var/run/secrets/token"}).Validate())
+	assert.NoError(t, newProvider(t, map[string]any{"token_env_var": "CIRCLE_OIDC_TOKEN_V2"}).Validate())
+}
+
+func TestJWTFileProvider_Authenticate_File(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(serviceAccountToken("system:serviceaccount:ci:runner")+"\n"), 0o600))
+
+	provider := newProvider(t, map[string]any{"token_file": tokenFile, "audience": "sts.amazonaws.com"})
+	creds, err := provider.Authenticate(context.Background())
+	require.NoError(t, err)
+	oidcCreds, ok := creds.(*types.OIDCCredentials)
+	require.True(t, ok)
+	assert.Equal(t, "ci", oidcCreds.Provider)
+	assert.Equal(t, "sts.amazonaws.com", oidcCreds.Audience)
+	assert.Equal(t, "https://oidc.eks.us-east-1.amazonaws.com/id/EXAMPLE", oidcCreds.Issuer)
+
+	// The file is re-read on every call so rotated tokens are picked up.
+	rotated := serviceAccountToken("system:serviceaccount:ci:rotated")
+	require.NoError(t, os.WriteFile(tokenFile, []byte(rotated), 0o600))
+	creds, err = provider.Authenticate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, rotated, creds.(*types.OIDCCredentials).Token)
+
+	// Empty and missing files.
+	require.NoError(t, os.WriteFile(tokenFile, []byte("  \n"), 0o600))
+	_, err = provider.Authenticate(context.Background())
+	assert.ErrorIs(t, err, errUtils.ErrCITokenNotFound)
+
+	_, err = newProvider(t, map[string]any{"token_file": filepath.Join(t.TempDir(), "missing")}).Authenticate(context.Background())
+	assert.ErrorIs(t, err, errUtils.ErrCITokenNotFound)
+}
+
+func TestJWTFileProvider_Authenticate_EnvVar(t *testing.T) {
+	provider := newProvider(t, map[string]any{"token_env_var": "CIRCLE_OIDC_TOKEN_V2"})
+
+	t.Setenv("CIRCLE_OIDC_TOKEN_V2", "")
+	_, err := provider.Authenticate(context.Background())
+	assert.ErrorIs(t, err, errUtils.ErrCITokenNotFound)
+
+	t.Setenv("CIRCLE_OIDC_TOKEN_V2", "not-a-jwt")
+	_, err = provider.Authenticate(context.Background())
+	assert.ErrorIs(t, err, errUtils.ErrCITokenMalformed)
+
+	token := serviceAccountToken("org/acme/project/infra")
+	t.Setenv("CIRCLE_OIDC_TOKEN_V2", token)
+	creds, err := provider.Authenticate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, token, creds.(*types.OIDCCredentials).Token)
+
+	// Audience mismatch.
+	_, err = newProvider(t, map[string]any{"token_env_var": "CIRCLE_OIDC_TOKEN_V2", "audience": "atmos"}).Authenticate(context.Background())
+	assert.ErrorIs(t, err, errUtils.ErrAuthenticationFailed)
+}
+
+func TestJWTFileProvider_Environment(t *testing.T) {
+	provider := newProvider(t, map[string]any{"token_env_var": "CIRCLE_OIDC_TOKEN_V2"})
+
+	env, err := provider.Environment()
+	require.NoError(t, err)
+	assert.Empty(t, env)
+
+	environ := map[string]string{"PATH": "/usr/bin"}
+	prepared, err := provider.PrepareEnvironment(context.Background(), environ)
+	require.NoError(t, err)
+	assert.Equal(t, environ, prepared)
+
+	paths, err := provider.Paths()
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+}
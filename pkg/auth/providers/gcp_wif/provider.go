@@ -372,6 +372,17 @@ func isGitHubActionsHost(host string) bool {
 	return host == "token.actions.githubusercontent.com" || strings.HasSuffix(host, githubActionsSuffix)
 }
 
+// ExchangeToken exchanges an OIDC token obtained by another provider (e.g. gitlab/oidc or
+// ci/jwt-file) for a Google federated access token via STS.
+func (p *Provider) ExchangeToken(ctx context.Context, oidcToken string) (*oauth2.Token, error) {
+	defer perf.Track(nil, "gcp_wif.ExchangeToken")()
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p.exchangeToken(ctx, oidcToken)
+}
+
 // exchangeToken exchanges an OIDC token for a Google federated token via STS.
 func (p *Provider) exchangeToken(ctx context.Context, oidcToken string) (*oauth2.Token, error) {
 	defer perf.Track(nil, "gcp_wif.exchangeToken")()
@@ -1177,3 +1177,32 @@ func TestGetStsURL_Custom(t *testing.T) {
 	p := &Provider{stsURL: "https://custom-sts.example.com/v1/token"}
 	assert.Equal(t, "https://custom-sts.example.com/v1/token", p.getStsURL())
 }
+
+func TestExchangeToken_Exported(t *testing.T) {
+	// Invalid config is rejected before any request is made.
+	p, err := New(&types.GCPWorkloadIdentityFederationProviderSpec{ProjectNumber: "123456"})
+	require.NoError(t, err)
+	_, err = p.ExchangeToken(context.Background(), "oidc-token")
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "oidc-token", r.PostForm.Get("subject_token"))
+		assert.Equal(t, "//iam.googleapis.com/projects/123456/locations/global/workloadIdentityPools/pool/providers/gitlab", r.PostForm.Get("audience"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token": "federated-access-token", "expires_in": 3600, "token_type": "Bearer"}`))
+	}))
+	defer server.Close()
+
+	p, err = New(&types.GCPWorkloadIdentityFederationProviderSpec{
+		ProjectNumber:              "123456",
+		WorkloadIdentityPoolID:     "pool",
+		WorkloadIdentityProviderID: "gitlab",
+	})
+	require.NoError(t, err)
+	p.stsURL = server.URL
+
+	token, err := p.ExchangeToken(context.Background(), "oidc-token")
+	require.NoError(t, err)
+	assert.Equal(t, "federated-access-token", token.AccessToken)
+}
@@ -0,0 +1,193 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/viper"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// DefaultTokenEnvVar is the environment variable read when the provider spec doesn't set token_env_var.
+// It must match the name of the `id_tokens` entry in .gitlab-ci.yml.
+const DefaultTokenEnvVar = "GITLAB_OIDC_TOKEN"
+
+// oidcProvider implements GitLab CI OIDC authentication.
+// GitLab injects ID tokens declared under `id_tokens` in .gitlab-ci.yml as environment variables,
+// so unlike GitHub Actions no token request is needed.
+type oidcProvider struct {
+	name   string
+	config *schema.Provider
+	realm  string // Credential isolation realm set by auth manager.
+}
+
+// NewOIDCProvider creates a new GitLab CI OIDC provider.
+func NewOIDCProvider(name string, config *schema.Provider) (types.Provider, error) {
+	defer perf.Track(nil, "gitlab.NewOIDCProvider")()
+
+	if config == nil {
+		return nil, fmt.Errorf("%w: provider config is required", errUtils.ErrInvalidProviderConfig)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%w: provider name is required", errUtils.ErrInvalidProviderConfig)
+	}
+
+	return &oidcProvider{
+		name:   name,
+		config: config,
+	}, nil
+}
+
+// Name returns the provider name.
+func (p *oidcProvider) Name() string {
+	return p.name
+}
+
+// Kind returns the provider kind.
+func (p *oidcProvider) Kind() string {
+	return types.ProviderKindGitLabOIDC
+}
+
+// SetRealm sets the credential isolation realm for this provider.
+func (p *oidcProvider) SetRealm(realm string) {
+	p.realm = realm
+}
+
+// PreAuthenticate is a no-op for GitLab OIDC provider.
+func (p *oidcProvider) PreAuthenticate(_ types.AuthManager) error {
+	return nil
+}
+
+// Authenticate reads the GitLab ID token from the job environment.
+func (p *oidcProvider) Authenticate(_ context.Context) (types.ICredentials, error) {
+	defer perf.Track(nil, "gitlab.oidcProvider.Authenticate")()
+
+	log.Debug("Starting GitLab OIDC authentication", "provider", p.name)
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	if !isGitLabCI() {
+		return nil, errUtils.Build(errUtils.ErrNotInGitLabCI).
+			WithExplanationf("Provider '%s' can only read ID tokens inside a GitLab CI job", p.name).
+			WithHint("Use the `ci/jwt-file` provider to read a token from a file or environment variable outside GitLab CI").
+			WithContext("provider", p.name).
+			WithExitCode(2).
+			Err()
+	}
+
+	envVar := p.tokenEnvVar()
+	token := readEnv(envVar)
+	if token == "" {
+		return nil, errUtils.Build(errUtils.ErrCITokenNotFound).
+			WithExplanationf("Environment variable `%s` is empty", envVar).
+			WithHint("Declare the token under `id_tokens` in .gitlab-ci.yml").
+			WithHint("Set `spec.token_env_var` if the token uses a different name").
+			WithExample(fmt.Sprintf("# .gitlab-ci.yml\nid_tokens:\n  %s:\n    aud: sts.amazonaws.com", envVar)).
+			WithContext("provider", p.name).
+			WithContext("token_env_var", envVar).
+			WithExitCode(2).
+			Err()
+	}
+
+	creds, err := types.NewOIDCCredentialsFromJWT(token, "gitlab")
+	if err != nil {
+		return nil, fmt.Errorf("%w: provider %s: %w", errUtils.ErrAuthenticationFailed, p.name, err)
+	}
+	if aud := p.audience(); aud != "" && creds.Audience != aud {
+		return nil, fmt.Errorf("%w: ID token in %s has audience %q, expected %q", errUtils.ErrAuthenticationFailed, envVar, creds.Audience, aud)
+	}
+
+	log.Debug("GitLab OIDC authentication successful", "provider", p.name, "issuer", creds.Issuer)
+	return creds, nil
+}
+
+// tokenEnvVar returns the environment variable holding the ID token.
+func (p *oidcProvider) tokenEnvVar() string {
+	if p.config.Spec != nil {
+		if v, ok := p.config.Spec["token_env_var"].(string); ok && v != "" {
+			return v
+		}
+	}
+	return DefaultTokenEnvVar
+}
+
+// audience returns the optional expected audience from provider config.
+func (p *oidcProvider) audience() string {
+	if p.config.Spec != nil {
+		if v, ok := p.config.Spec["audience"].(string); ok {
+			return v
+		}
+	}
+	return ""
+}
+
+// isGitLabCI checks if we're running in a GitLab CI job.
+func isGitLabCI() bool {
+	if err := viper.BindEnv("gitlab.ci", "GITLAB_CI"); err != nil {
+		log.Trace("Failed to bind gitlab.ci environment variable", "error", err)
+	}
+	return viper.GetString("gitlab.ci") == "true"
+}
+
+// readEnv reads a user-configured environment variable through viper.
+func readEnv(name string) string {
+	key := "gitlab.oidc.env." + strings.ToLower(name)
+	if err := viper.BindEnv(key, name); err != nil {
+		log.Trace("Failed to bind environment variable", "name", name, "error", err)
+	}
+	return strings.TrimSpace(viper.GetString(key))
+}
+
+// Validate validates the provider configuration.
+func (p *oidcProvider) Validate() error {
+	if p.config.Spec == nil {
+		return nil
+	}
+	if v, ok := p.config.Spec["token_env_var"]; ok {
+		if s, isString := v.(string); !isString || s == "" {
+			return fmt.Errorf("%w: token_env_var must be a non-empty string", errUtils.ErrInvalidProviderConfig)
+		}
+	}
+	return nil
+}
+
+// Environment returns environment variables for this provider.
+func (p *oidcProvider) Environment() (map[string]string, error) {
+	// The ID token is passed to downstream identities via credentials.
+	return map[string]string{}, nil
+}
+
+// Paths returns credential files/directories used by this provider.
+func (p *oidcProvider) Paths() ([]types.Path, error) {
+	// GitLab OIDC provider doesn't use filesystem credentials.
+	return []types.Path{}, nil
+}
+
+// PrepareEnvironment prepares environment variables for external processes.
+// The ID token is only used to obtain cloud credentials, so the environment is unchanged.
+func (p *oidcProvider) PrepareEnvironment(_ context.Context, environ map[string]string) (map[string]string, error) {
+	defer perf.Track(nil, "gitlab.oidcProvider.PrepareEnvironment")()
+
+	return environ, nil
+}
+
+// Logout removes provider-specific credential storage.
+func (p *oidcProvider) Logout(_ context.Context) error {
+	// ID tokens come from the GitLab job environment; there is nothing to clean up.
+	log.Debug("Logout not supported for GitLab OIDC provider (no files to clean up)", "provider", p.name)
+	return errUtils.ErrLogoutNotSupported
+}
+
+// GetFilesDisplayPath returns the display path for credential files.
+// GitLab OIDC provider doesn't use file-based credentials.
+func (p *oidcProvider) GetFilesDisplayPath() string {
+	return ""
+}
@@ -0,0 +1,137 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// idToken returns an unsigned GitLab-style ID token for the given audience.
+func idToken(audience string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","typ":"JWT"}`))
+	claims, _ := json.Marshal(map[string]any{
+		"iss": "https://gitlab.com",
+		"aud": audience,
+		"sub": "project_path:acme/infra:ref_type:branch:ref:main",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	return header + "." + base64.RawURLEncoding.EncodeToString(claims) + ".signature"
+}
+
+func TestNewOIDCProvider(t *testing.T) {
+	_, err := NewOIDCProvider("gitlab", nil)
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+
+	_, err = NewOIDCProvider("", &schema.Provider{Kind: "gitlab/oidc"})
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+
+	provider, err := NewOIDCProvider("gitlab", &schema.Provider{Kind: "gitlab/oidc"})
+	require.NoError(t, err)
+	assert.Equal(t, "gitlab/oidc", provider.Kind())
+	assert.Equal(t, "gitlab", provider.Name())
+	assert.NoError(t, provider.PreAuthenticate(nil))
+	assert.Empty(t, provider.GetFilesDisplayPath())
+	assert.ErrorIs(t, provider.Logout(context.Background()), errUtils.ErrLogoutNotSupported)
+}
+
+func TestOIDCProvider_Authenticate(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        map[string]any
+		env         map[string]string
+		expectedErr error
+		audience    string
+	}{
+		{
+			name:        "not in GitLab CI",
+			env:         map[string]string{"GITLAB_CI": "", DefaultTokenEnvVar: idToken("sts.amazonaws.com")},
+			expectedErr: errUtils.ErrNotInGitLabCI,
+		},
+		{
+			name:        "missing token",
+			env:         map[string]string{"GITLAB_CI": "true", DefaultTokenEnvVar: ""},
+			expectedErr: errUtils.ErrCITokenNotFound,
+		},
+		{
+			name:     "default token variable",
+			env:      map[string]string{"GITLAB_CI": "true", DefaultTokenEnvVar: idToken("sts.amazonaws.com")},
+			audience: "sts.amazonaws.com",
+		},
+		{
+			name:     "custom token variable",
+			spec:     map[string]any{"token_env_var": "AZURE_ID_TOKEN", "audience": "api://AzureADTokenExchange"},
+			env:      map[string]string{"GITLAB_CI": "true", "AZURE_ID_TOKEN": idToken("api://AzureADTokenExchange")},
+			audience: "api://AzureADTokenExchange",
+		},
+		{
+			name:        "audience mismatch",
+			spec:        map[string]any{"audience": "api://AzureADTokenExchange"},
+			env:         map[string]string{"GITLAB_CI": "true", DefaultTokenEnvVar: idToken("sts.amazonaws.com")},
+			expectedErr: errUtils.ErrAuthenticationFailed,
+		},
+		{
+			name:        "malformed token",
+			env:         map[string]string{"GITLAB_CI": "true", DefaultTokenEnvVar: "not-a-jwt"},
+			expectedErr: errUtils.ErrCITokenMalformed,
+		},
+		{
+			name:        "invalid token_env_var",
+			spec:        map[string]any{"token_env_var": 42},
+			env:         map[string]string{"GITLAB_CI": "true"},
+			expectedErr: errUtils.ErrInvalidProviderConfig,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			provider, err := NewOIDCProvider("gitlab", &schema.Provider{Kind: "gitlab/oidc", Spec: tt.spec})
+			require.NoError(t, err)
+
+			creds, err := provider.Authenticate(context.Background())
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, creds)
+				return
+			}
+
+			require.NoError(t, err)
+			oidcCreds, ok := creds.(*types.OIDCCredentials)
+			require.True(t, ok)
+			assert.Equal(t, "gitlab", oidcCreds.Provider)
+			assert.Equal(t, "https://gitlab.com", oidcCreds.Issuer)
+			assert.Equal(t, tt.audience, oidcCreds.Audience)
+			assert.False(t, oidcCreds.IsExpired())
+		})
+	}
+}
+
+func TestOIDCProvider_Environment(t *testing.T) {
+	provider, err := NewOIDCProvider("gitlab", &schema.Provider{Kind: "gitlab/oidc"})
+	require.NoError(t, err)
+
+	env, err := provider.Environment()
+	require.NoError(t, err)
+	assert.Empty(t, env)
+
+	environ := map[string]string{"PATH": "/usr/bin"}
+	prepared, err := provider.PrepareEnvironment(context.Background(), environ)
+	require.NoError(t, err)
+	assert.Equal(t, environ, prepared)
+
+	paths, err := provider.Paths()
+	require.NoError(t, err)
+	assert.Empty(t, paths)
+}
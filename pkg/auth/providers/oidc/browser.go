@@ -0,0 +1,162 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/utils"
+)
+
+const (
+	callbackPath = "/oauth/callback"
+	// Byte length for generated state strings (CSRF protection).
+	stateBytes = 16
+	// Read-header timeout for the local callback HTTP server.
+	callbackReadHeaderTimeout = 10 * time.Second
+	// Shutdown timeout for the local callback HTTP server.
+	callbackShutdownTimeout = 5 * time.Second
+)
+
+// callbackResult holds the authorization code received by the local callback server.
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// browserFlow runs the authorization code flow with PKCE (RFC 7636), receiving the
+// authorization code on a loopback redirect URI (RFC 8252).
+func (p *oidcProvider) browserFlow(ctx context.Context, oauthConfig *oauth2.Config) (*oauth2.Token, error) {
+	state, err := generateState()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrWebflowAuthFailed, err)
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	listener, resultCh, err := startCallbackServer(ctx, p.spec.CallbackPort, state)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrWebflowCallbackServer, err)
+	}
+	port := listener.Addr().(*net.TCPAddr).Port
+
+	// Copy the config so the redirect URI of this session doesn't leak into refreshes.
+	sessionConfig := *oauthConfig
+	sessionConfig.RedirectURL = fmt.Sprintf("http://127.0.0.1:%d%s", port, callbackPath)
+
+	options := append([]oauth2.AuthCodeOption{oauth2.S256ChallengeOption(verifier)}, p.authCodeOptions()...)
+	authURL := sessionConfig.AuthCodeURL(state, options...)
+
+	utils.PrintfMessageToTUI("🔐 **OIDC Browser Authentication**\n")
+	utils.PrintfMessageToTUI("Opening browser for authentication. If the browser doesn't open, visit:\n")
+	utils.PrintfMessageToTUI("%s\n", authURL)
+	if err := openURLFunc(authURL); err != nil {
+		log.Debug("Failed to open browser", "error", err)
+	}
+
+	var result callbackResult
+	select {
+	case result = <-resultCh:
+	case <-ctx.Done():
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrWebflowTimeout, ctx.Err())
+	}
+	if result.err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrWebflowAuthFailed, result.err)
+	}
+
+	token, err := sessionConfig.Exchange(ctx, result.code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return nil, tokenExchangeError(FlowBrowser, err)
+	}
+	return token, nil
+}
+
+// generateState generates a random OAuth2 state nonce.
+func generateState() (string, error) {
+	buf := make([]byte, stateBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate random bytes: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// startCallbackServer starts an HTTP server on the loopback interface to receive the OAuth2 callback.
+// A port of 0 binds an ephemeral port. The server shuts down when the context is done.
+func startCallbackServer(ctx context.Context, port int, expectedState string) (net.Listener, <-chan callbackResult, error) {
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to bind to loopback address: %w", err)
+	}
+
+	resultCh := make(chan callbackResult, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(callbackPath, makeCallbackHandler(expectedState, resultCh))
+
+	server := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: callbackReadHeaderTimeout,
+	}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Debug("Callback server error", "error", err)
+		}
+	}()
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), callbackShutdownTimeout)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	return listener, resultCh, nil
+}
+
+// makeCallbackHandler returns an http.HandlerFunc that validates the OAuth2 callback
+// parameters and delivers the first result to resultCh.
+func makeCallbackHandler(expectedState string, resultCh chan<- callbackResult) http.HandlerFunc {
+	deliver := func(result callbackResult) {
+		select {
+		case resultCh <- result:
+		default:
+		}
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		if errParam := query.Get("error"); errParam != "" {
+			deliver(callbackResult{err: fmt.Errorf("%w: %s: %s", errUtils.ErrWebflowAuthorizationError, errParam, query.Get("error_description"))})
+			http.Error(w, "Authorization failed. You can close this tab.", http.StatusBadRequest)
+			return
+		}
+
+		if query.Get("state") != expectedState {
+			deliver(callbackResult{err: errUtils.ErrWebflowStateMismatch})
+			http.Error(w, "State mismatch. You can close this tab.", http.StatusBadRequest)
+			return
+		}
+
+		code := query.Get("code")
+		if code == "" {
+			deliver(callbackResult{err: errUtils.ErrWebflowMissingCallbackCode})
+			http.Error(w, "Missing authorization code. You can close this tab.", http.StatusBadRequest)
+			return
+		}
+
+		deliver(callbackResult{code: code})
+
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<!DOCTYPE html><html><body><h2>Authentication successful!</h2><p>You can close this tab and return to your terminal.</p><script>window.close()</script></body></html>`)
+	}
+}
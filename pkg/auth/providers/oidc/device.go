@@ -0,0 +1,41 @@
+package oidc
+
+import (
+	"context"
+
+	"golang.org/x/oauth2"
+
+	"github.com/cloudposse/atmos/pkg/utils"
+)
+
+// displayDeviceCodeFunc shows the device code prompt. Overridable for testing.
+var displayDeviceCodeFunc = displayDeviceCode
+
+// deviceFlow runs the OAuth 2.0 device authorization grant (RFC 8628).
+func (p *oidcProvider) deviceFlow(ctx context.Context, oauthConfig *oauth2.Config) (*oauth2.Token, error) {
+	deviceAuth, err := oauthConfig.DeviceAuth(ctx, p.authCodeOptions()...)
+	if err != nil {
+		return nil, tokenExchangeError(FlowDevice, err)
+	}
+
+	displayDeviceCodeFunc(deviceAuth)
+
+	// Polls the token endpoint at the interval requested by the issuer until the user approves.
+	token, err := oauthConfig.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		return nil, tokenExchangeError(FlowDevice, err)
+	}
+	return token, nil
+}
+
+// displayDeviceCode prints the verification URL and user code.
+func displayDeviceCode(deviceAuth *oauth2.DeviceAuthResponse) {
+	verificationURL := deviceAuth.VerificationURI
+	if deviceAuth.VerificationURIComplete != "" {
+		verificationURL = deviceAuth.VerificationURIComplete
+	}
+	utils.PrintfMessageToTUI("🔐 **OIDC Device Authentication**\n")
+	utils.PrintfMessageToTUI("Visit this URL on a device with a browser:\n")
+	utils.PrintfMessageToTUI("%s\n", verificationURL)
+	utils.PrintfMessageToTUI("and confirm the code **%s**\n", deviceAuth.UserCode)
+}
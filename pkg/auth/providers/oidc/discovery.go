@@ -0,0 +1,70 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	log "github.com/cloudposse/atmos/pkg/logger"
+)
+
+const (
+	// Path of the OpenID Provider Configuration document, relative to the issuer URL.
+	discoveryPath = "/.well-known/openid-configuration"
+	// Maximum size of responses read from the issuer.
+	maxResponseBytes = 1 << 20
+)
+
+// discoveryDocument is the subset of the OpenID Provider Metadata used by the provider.
+type discoveryDocument struct {
+	Issuer                      string `json:"issuer"`
+	AuthorizationEndpoint       string `json:"authorization_endpoint"`
+	TokenEndpoint               string `json:"token_endpoint"`
+	DeviceAuthorizationEndpoint string `json:"device_authorization_endpoint"`
+}
+
+// discover fetches the OpenID Provider Configuration of the issuer.
+func discover(ctx context.Context, client *http.Client, issuerURL string) (*discoveryDocument, error) {
+	endpoint := issuerURL + discoveryPath
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrOIDCDiscoveryFailed, err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	log.Debug("Fetching OIDC discovery document", "endpoint", endpoint)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrOIDCDiscoveryFailed).
+			WithCause(err).
+			WithExplanationf("Failed to contact the OIDC issuer at %s", issuerURL).
+			WithHint("Check your network connectivity").
+			WithHint("Verify `issuer_url` in the provider spec").
+			Err()
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s returned status %s", errUtils.ErrOIDCDiscoveryFailed, endpoint, resp.Status)
+	}
+
+	var doc discoveryDocument
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxResponseBytes)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: decode %s: %w", errUtils.ErrOIDCDiscoveryFailed, endpoint, err)
+	}
+
+	// The issuer in the document must match the configured issuer (OpenID Connect Discovery 1.0, section 4.3).
+	if strings.TrimSuffix(doc.Issuer, "/") != issuerURL {
+		return nil, fmt.Errorf("%w: issuer %q in discovery document does not match issuer_url %q", errUtils.ErrOIDCDiscoveryFailed, doc.Issuer, issuerURL)
+	}
+	if doc.TokenEndpoint == "" {
+		return nil, fmt.Errorf("%w: discovery document has no token_endpoint", errUtils.ErrOIDCDiscoveryFailed)
+	}
+
+	return &doc, nil
+}
@@ -0,0 +1,166 @@
+package oidc
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	mockClientID     = "atmos-cli"
+	mockDeviceCode   = "mock-device-code"
+	mockUserCode     = "ABCD-EFGH"
+	mockAuthCode     = "mock-auth-code"
+	mockRefreshToken = "mock-refresh-token"
+)
+
+// mockIssuer is a minimal local OIDC issuer supporting discovery, the authorization code
+// flow with PKCE, the device authorization flow and refresh tokens.
+type mockIssuer struct {
+	*httptest.Server
+
+	mu              sync.Mutex
+	codeChallenge   string
+	redirectURI     string
+	refreshToken    string
+	issuedTokens    int
+	deviceRequests  int
+	refreshRequests int
+	withoutDevice   bool
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+
+	issuer := &mockIssuer{refreshToken: mockRefreshToken}
+	mux := http.NewServeMux()
+	mux.HandleFunc(discoveryPath, issuer.handleDiscovery)
+	mux.HandleFunc("/authorize", issuer.handleAuthorize)
+	mux.HandleFunc("/device", issuer.handleDevice)
+	mux.HandleFunc("/token", issuer.handleToken)
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	return issuer
+}
+
+// idToken returns an unsigned JWT with the given subject that expires in an hour.
+func (m *mockIssuer) idToken(subject string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	claims, _ := json.Marshal(map[string]any{
+		"iss": m.URL,
+		"aud": mockClientID,
+		"sub": subject,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+	return header + "." + base64.RawURLEncoding.EncodeToString(claims) + ".signature"
+}
+
+func (m *mockIssuer) handleDiscovery(w http.ResponseWriter, _ *http.Request) {
+	doc := discoveryDocument{
+		Issuer:                m.URL,
+		AuthorizationEndpoint: m.URL + "/authorize",
+		TokenEndpoint:         m.URL + "/token",
+	}
+	if !m.withoutDevice {
+		doc.DeviceAuthorizationEndpoint = m.URL + "/device"
+	}
+	writeJSON(w, http.StatusOK, doc)
+}
+
+// handleAuthorize immediately approves the request and redirects back to the client.
+func (m *mockIssuer) handleAuthorize(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	if query.Get("client_id") != mockClientID || query.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid request", http.StatusBadRequest)
+		return
+	}
+
+	m.mu.Lock()
+	m.codeChallenge = query.Get("code_challenge")
+	m.redirectURI = query.Get("redirect_uri")
+	m.mu.Unlock()
+
+	redirect, _ := url.Parse(query.Get("redirect_uri"))
+	params := url.Values{"code": {mockAuthCode}, "state": {query.Get("state")}}
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (m *mockIssuer) handleDevice(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.PostForm.Get("client_id") != mockClientID {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	m.mu.Lock()
+	m.deviceRequests++
+	m.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"device_code":      mockDeviceCode,
+		"user_code":        mockUserCode,
+		"verification_uri": m.URL + "/activate",
+		"expires_in":       300,
+		"interval":         1,
+	})
+}
+
+func (m *mockIssuer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_request"})
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		valid := r.PostForm.Get("code") == mockAuthCode &&
+			r.PostForm.Get("redirect_uri") == m.redirectURI &&
+			oauth2.S256ChallengeFromVerifier(r.PostForm.Get("code_verifier")) == m.codeChallenge
+		if !valid {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+	case "urn:ietf:params:oauth:grant-type:device_code":
+		if r.PostForm.Get("device_code") != mockDeviceCode {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+			return
+		}
+	case "refresh_token":
+		m.refreshRequests++
+		if r.PostForm.Get("refresh_token") != m.refreshToken {
+			writeJSON(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant", "error_description": "refresh token revoked"})
+			return
+		}
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	// Rotate the refresh token on every issuance.
+	m.issuedTokens++
+	m.refreshToken = fmt.Sprintf("%s-%d", mockRefreshToken, m.issuedTokens)
+	writeJSON(w, http.StatusOK, map[string]any{
+		"access_token":  fmt.Sprintf("access-token-%d", m.issuedTokens),
+		"token_type":    "Bearer",
+		"expires_in":    3600,
+		"refresh_token": m.refreshToken,
+		"id_token":      m.idToken(fmt.Sprintf("user-%d", m.issuedTokens)),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,314 @@
+package oidc
+
+// Generic OpenID Connect provider. It authenticates users against any OIDC
+// issuer (Okta, Keycloak, Microsoft Entra ID, ...) and returns the ID token as
+// OIDC credentials, which downstream identities such as
+// aws/assume-role-with-web-identity exchange for cloud credentials.
+// Implementation details are split across:
+//
+//   discovery.go  — issuer discovery (.well-known/openid-configuration)
+//   browser.go    — authorization code flow with PKCE and a local callback server
+//   device.go     — device authorization flow (RFC 8628)
+//   token.go      — token conversion and refresh of cached tokens
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
+	"golang.org/x/oauth2"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/internal/tui/templates/term"
+	atmosCredentials "github.com/cloudposse/atmos/pkg/auth/credentials"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/browser"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	// ProviderKind is the kind identifier for this provider.
+	ProviderKind = types.ProviderKindOIDC
+
+	// Supported authentication flows.
+	FlowAuto    = "auto"
+	FlowBrowser = "browser"
+	FlowDevice  = "device"
+
+	// Supported token types passed to downstream identities.
+	TokenTypeIDToken     = "id_token"
+	TokenTypeAccessToken = "access_token"
+
+	// HTTP timeout for requests to the issuer.
+	requestTimeout = 30 * time.Second
+	// Maximum time to wait for the user to complete authentication.
+	authTimeout = 5 * time.Minute
+)
+
+// defaultScopes are requested when the provider spec doesn't configure scopes.
+// The offline_access scope asks the issuer for a refresh token.
+var defaultScopes = []string{"openid", "offline_access"}
+
+// isInteractive checks if the terminal supports interactive prompts. Overridable for testing.
+var isInteractive = func() bool {
+	if viper.GetBool("force-tty") {
+		return true
+	}
+	return term.IsTTYSupportForStderr()
+}
+
+// openURLFunc opens a URL in the default browser. Overridable for testing.
+var openURLFunc = func(url string) error {
+	return browser.New().Open(url)
+}
+
+// providerSpec is the `spec` section of an oidc provider.
+type providerSpec struct {
+	IssuerURL    string   `mapstructure:"issuer_url"`
+	ClientID     string   `mapstructure:"client_id"`
+	ClientSecret string   `mapstructure:"client_secret"`
+	Scopes       []string `mapstructure:"scopes"`
+	Audience     string   `mapstructure:"audience"`
+	Flow         string   `mapstructure:"flow"`
+	TokenType    string   `mapstructure:"token_type"`
+	CallbackPort int      `mapstructure:"callback_port"`
+}
+
+// oidcProvider implements generic OIDC authentication.
+type oidcProvider struct {
+	name            string
+	config          *schema.Provider
+	spec            providerSpec
+	realm           string                // Credential isolation realm set by auth manager.
+	httpClient      *http.Client          // HTTP client used for all requests to the issuer.
+	credentialStore types.CredentialStore // Optional; if nil, the configured credential store is used.
+}
+
+// NewOIDCProvider creates a new generic OIDC provider.
+func NewOIDCProvider(name string, config *schema.Provider) (types.Provider, error) {
+	defer perf.Track(nil, "oidc.NewOIDCProvider")()
+
+	if config == nil {
+		return nil, fmt.Errorf("%w: provider config is required", errUtils.ErrInvalidProviderConfig)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("%w: provider name is required", errUtils.ErrInvalidProviderConfig)
+	}
+
+	var spec providerSpec
+	if config.Spec != nil {
+		if err := mapstructure.Decode(config.Spec, &spec); err != nil {
+			return nil, fmt.Errorf("%w: invalid oidc provider spec: %w", errUtils.ErrInvalidProviderConfig, err)
+		}
+	}
+	spec.IssuerURL = strings.TrimSuffix(spec.IssuerURL, "/")
+	if len(spec.Scopes) == 0 {
+		spec.Scopes = defaultScopes
+	}
+	if spec.Flow == "" {
+		spec.Flow = FlowAuto
+	}
+	if spec.TokenType == "" {
+		spec.TokenType = TokenTypeIDToken
+	}
+
+	return &oidcProvider{
+		name:       name,
+		config:     config,
+		spec:       spec,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// Name returns the provider name.
+func (p *oidcProvider) Name() string {
+	return p.name
+}
+
+// Kind returns the provider kind.
+func (p *oidcProvider) Kind() string {
+	return ProviderKind
+}
+
+// SetRealm sets the credential isolation realm for this provider.
+func (p *oidcProvider) SetRealm(realm string) {
+	p.realm = realm
+}
+
+// PreAuthenticate is a no-op for the OIDC provider.
+func (p *oidcProvider) PreAuthenticate(_ types.AuthManager) error {
+	return nil
+}
+
+// Validate validates the provider configuration.
+func (p *oidcProvider) Validate() error {
+	defer perf.Track(nil, "oidc.oidcProvider.Validate")()
+
+	if p.spec.IssuerURL == "" {
+		return fmt.Errorf("%w: issuer_url is required in provider spec", errUtils.ErrInvalidProviderConfig)
+	}
+	if !strings.HasPrefix(p.spec.IssuerURL, "https://") && !isLoopbackURL(p.spec.IssuerURL) {
+		return fmt.Errorf("%w: issuer_url must use https: %s", errUtils.ErrInvalidProviderConfig, p.spec.IssuerURL)
+	}
+	if p.spec.ClientID == "" {
+		return fmt.Errorf("%w: client_id is required in provider spec", errUtils.ErrInvalidProviderConfig)
+	}
+	switch p.spec.Flow {
+	case FlowAuto, FlowBrowser, FlowDevice:
+	default:
+		return fmt.Errorf("%w: unsupported flow %q (expected %s, %s or %s)", errUtils.ErrInvalidProviderConfig, p.spec.Flow, FlowAuto, FlowBrowser, FlowDevice)
+	}
+	switch p.spec.TokenType {
+	case TokenTypeIDToken, TokenTypeAccessToken:
+	default:
+		return fmt.Errorf("%w: unsupported token_type %q (expected %s or %s)", errUtils.ErrInvalidProviderConfig, p.spec.TokenType, TokenTypeIDToken, TokenTypeAccessToken)
+	}
+	if p.spec.CallbackPort < 0 || p.spec.CallbackPort > 65535 {
+		return fmt.Errorf("%w: invalid callback_port %d", errUtils.ErrInvalidProviderConfig, p.spec.CallbackPort)
+	}
+	return nil
+}
+
+// isLoopbackURL reports whether the URL points to the local machine (used by local test issuers).
+func isLoopbackURL(rawURL string) bool {
+	return strings.HasPrefix(rawURL, "http://127.0.0.1") || strings.HasPrefix(rawURL, "http://localhost")
+}
+
+// Authenticate refreshes cached tokens when possible and otherwise runs the configured flow.
+func (p *oidcProvider) Authenticate(ctx context.Context) (types.ICredentials, error) {
+	defer perf.Track(nil, "oidc.oidcProvider.Authenticate")()
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+
+	discovery, err := discover(ctx, p.httpClient, p.spec.IssuerURL)
+	if err != nil {
+		return nil, err
+	}
+	oauthConfig := p.oauthConfig(discovery)
+
+	if creds := p.refreshCachedToken(ctx, oauthConfig); creds != nil {
+		log.Debug("Refreshed OIDC token", "provider", p.name)
+		return creds, nil
+	}
+
+	log.Info("Starting OIDC authentication", "provider", p.name, "issuer", p.spec.IssuerURL)
+
+	ctx, cancel := context.WithTimeout(ctx, authTimeout)
+	defer cancel()
+
+	var token *oauth2.Token
+	switch p.resolveFlow(discovery) {
+	case FlowDevice:
+		if discovery.DeviceAuthorizationEndpoint == "" {
+			return nil, fmt.Errorf("%w: %s", errUtils.ErrOIDCDeviceFlowUnsupported, p.spec.IssuerURL)
+		}
+		token, err = p.deviceFlow(ctx, oauthConfig)
+	default:
+		if !isInteractive() {
+			return nil, fmt.Errorf("%w: set `flow: device` for provider %q to authenticate without a browser", errUtils.ErrOIDCInteractiveRequired, p.name)
+		}
+		token, err = p.browserFlow(ctx, oauthConfig)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := p.toCredentials(token, "")
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("OIDC authentication successful", "provider", p.name)
+	return creds, nil
+}
+
+// resolveFlow picks the flow to run. In auto mode the browser flow is used in interactive
+// terminals and the device flow everywhere else (SSH sessions, containers).
+func (p *oidcProvider) resolveFlow(discovery *discoveryDocument) string {
+	if p.spec.Flow != FlowAuto {
+		return p.spec.Flow
+	}
+	if isInteractive() || discovery.DeviceAuthorizationEndpoint == "" {
+		return FlowBrowser
+	}
+	return FlowDevice
+}
+
+// oauthConfig builds the OAuth2 client configuration for the discovered issuer endpoints.
+func (p *oidcProvider) oauthConfig(discovery *discoveryDocument) *oauth2.Config {
+	// Public clients (no secret) identify themselves with client_id in the request body.
+	authStyle := oauth2.AuthStyleAutoDetect
+	if p.spec.ClientSecret == "" {
+		authStyle = oauth2.AuthStyleInParams
+	}
+	return &oauth2.Config{
+		ClientID:     p.spec.ClientID,
+		ClientSecret: p.spec.ClientSecret,
+		Scopes:       p.spec.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:       discovery.AuthorizationEndpoint,
+			TokenURL:      discovery.TokenEndpoint,
+			DeviceAuthURL: discovery.DeviceAuthorizationEndpoint,
+			AuthStyle:     authStyle,
+		},
+	}
+}
+
+// authCodeOptions returns the extra request parameters sent with authorization requests.
+func (p *oidcProvider) authCodeOptions() []oauth2.AuthCodeOption {
+	if p.spec.Audience == "" {
+		return nil
+	}
+	return []oauth2.AuthCodeOption{oauth2.SetAuthURLParam("audience", p.spec.Audience)}
+}
+
+// store returns the credential store used to look up cached refresh tokens.
+func (p *oidcProvider) store() types.CredentialStore {
+	if p.credentialStore == nil {
+		p.credentialStore = atmosCredentials.NewCredentialStore()
+	}
+	return p.credentialStore
+}
+
+// Environment returns environment variables for this provider.
+func (p *oidcProvider) Environment() (map[string]string, error) {
+	// The OIDC token is passed to downstream identities via credentials.
+	return map[string]string{}, nil
+}
+
+// Paths returns credential files/directories used by this provider.
+func (p *oidcProvider) Paths() ([]types.Path, error) {
+	// Tokens are cached in the credential store, not on the filesystem.
+	return []types.Path{}, nil
+}
+
+// PrepareEnvironment prepares environment variables for external processes.
+// OIDC tokens are only used to obtain cloud credentials in the authentication chain,
+// so the environment is returned unchanged.
+func (p *oidcProvider) PrepareEnvironment(_ context.Context, environ map[string]string) (map[string]string, error) {
+	defer perf.Track(nil, "oidc.oidcProvider.PrepareEnvironment")()
+
+	return environ, nil
+}
+
+// Logout removes provider-specific credential storage.
+func (p *oidcProvider) Logout(_ context.Context) error {
+	// Tokens, including the refresh token, are only stored in the keyring (handled by AuthManager).
+	log.Debug("Logout not supported for OIDC provider (no files to clean up)", "provider", p.name)
+	return errUtils.ErrLogoutNotSupported
+}
+
+// GetFilesDisplayPath returns the display path for credential files.
+func (p *oidcProvider) GetFilesDisplayPath() string {
+	return ""
+}
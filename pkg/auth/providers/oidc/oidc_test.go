@@ -0,0 +1,355 @@
+package oidc
+
+import (
+	"context"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	atmosCredentials "github.com/cloudposse/atmos/pkg/auth/credentials"
+	awsIdentities "github.com/cloudposse/atmos/pkg/auth/identities/aws"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// newTestProvider creates a provider for the mock issuer backed by an in-memory credential store.
+func newTestProvider(t *testing.T, issuer *mockIssuer, spec map[string]any) *oidcProvider {
+	t.Helper()
+
+	fullSpec := map[string]any{
+		"issuer_url": issuer.URL,
+		"client_id":  mockClientID,
+	}
+	for k, v := range spec {
+		fullSpec[k] = v
+	}
+
+	provider, err := NewOIDCProvider("okta", &schema.Provider{Kind: ProviderKind, Spec: fullSpec})
+	require.NoError(t, err)
+
+	p := provider.(*oidcProvider)
+	p.SetRealm("test-realm")
+	p.credentialStore = atmosCredentials.NewCredentialStoreWithConfig(&schema.AuthConfig{
+		Keyring: schema.KeyringConfig{Type: types.CredentialStoreTypeMemory},
+	})
+	return p
+}
+
+// setInteractive overrides terminal detection for the duration of the test.
+func setInteractive(t *testing.T, interactive bool) {
+	t.Helper()
+	original := isInteractive
+	isInteractive = func() bool { return interactive }
+	t.Cleanup(func() { isInteractive = original })
+}
+
+// approveInBrowser replaces the browser with an HTTP client that follows the issuer redirect
+// to the local callback server.
+func approveInBrowser(t *testing.T) {
+	t.Helper()
+	original := openURLFunc
+	openURLFunc = func(authURL string) error {
+		resp, err := http.Get(authURL) //nolint:gosec,noctx // Test-only request to the local mock issuer.
+		if err != nil {
+			return err
+		}
+		return resp.Body.Close()
+	}
+	t.Cleanup(func() { openURLFunc = original })
+}
+
+// captureDeviceCode records the device code prompt instead of printing it.
+func captureDeviceCode(t *testing.T) *string {
+	t.Helper()
+	var userCode string
+	original := displayDeviceCodeFunc
+	displayDeviceCodeFunc = func(deviceAuth *oauth2.DeviceAuthResponse) { userCode = deviceAuth.UserCode }
+	t.Cleanup(func() { displayDeviceCodeFunc = original })
+	return &userCode
+}
+
+func TestNewOIDCProvider(t *testing.T) {
+	_, err := NewOIDCProvider("", &schema.Provider{Kind: ProviderKind})
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+
+	_, err = NewOIDCProvider("okta", nil)
+	assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+
+	provider, err := NewOIDCProvider("okta", &schema.Provider{
+		Kind: ProviderKind,
+		Spec: map[string]any{"issuer_url": "https://example.okta.com/", "client_id": "abc"},
+	})
+	require.NoError(t, err)
+	p := provider.(*oidcProvider)
+	assert.Equal(t, "okta", p.Name())
+	assert.Equal(t, "oidc", p.Kind())
+	assert.Equal(t, "https://example.okta.com", p.spec.IssuerURL)
+	assert.Equal(t, defaultScopes, p.spec.Scopes)
+	assert.Equal(t, FlowAuto, p.spec.Flow)
+	assert.Equal(t, TokenTypeIDToken, p.spec.TokenType)
+	assert.NoError(t, p.Validate())
+}
+
+func TestOIDCProvider_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    map[string]any
+		wantErr bool
+	}{
+		{name: "valid", spec: map[string]any{"issuer_url": "https://id.example.com", "client_id": "abc"}},
+		{name: "local issuer", spec: map[string]any{"issuer_url": "http://127.0.0.1:8080", "client_id": "abc"}},
+		{name: "missing issuer", spec: map[string]any{"client_id": "abc"}, wantErr: true},
+		{name: "plain http issuer", spec: map[string]any{"issuer_url": "http://id.example.com", "client_id": "abc"}, wantErr: true},
+		{name: "missing client id", spec: map[string]any{"issuer_url": "https://id.example.com"}, wantErr: true},
+		{name: "unknown flow", spec: map[string]any{"issuer_url": "https://id.example.com", "client_id": "abc", "flow": "implicit"}, wantErr: true},
+		{name: "unknown token type", spec: map[string]any{"issuer_url": "https://id.example.com", "client_id": "abc", "token_type": "saml"}, wantErr: true},
+		{name: "invalid callback port", spec: map[string]any{"issuer_url": "https://id.example.com", "client_id": "abc", "callback_port": 70000}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewOIDCProvider("test", &schema.Provider{Kind: ProviderKind, Spec: tt.spec})
+			require.NoError(t, err)
+			err = provider.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errUtils.ErrInvalidProviderConfig)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDiscover_IssuerMismatch(t *testing.T) {
+	issuer := newMockIssuer(t)
+
+	_, err := discover(context.Background(), http.DefaultClient, issuer.URL+"/other")
+	assert.ErrorIs(t, err, errUtils.ErrOIDCDiscoveryFailed)
+
+	doc, err := discover(context.Background(), http.DefaultClient, issuer.URL)
+	require.NoError(t, err)
+	assert.Equal(t, issuer.URL+"/token", doc.TokenEndpoint)
+	assert.Equal(t, issuer.URL+"/device", doc.DeviceAuthorizationEndpoint)
+}
+
+func TestAuthenticate_BrowserFlow(t *testing.T) {
+	setInteractive(t, true)
+	approveInBrowser(t)
+	issuer := newMockIssuer(t)
+	p := newTestProvider(t, issuer, nil)
+
+	creds, err := p.Authenticate(context.Background())
+	require.NoError(t, err)
+
+	oidcCreds := creds.(*types.OIDCCredentials)
+	assert.Equal(t, issuer.idToken("user-1"), oidcCreds.Token)
+	assert.Equal(t, mockRefreshToken+"-1", oidcCreds.RefreshToken)
+	assert.Equal(t, issuer.URL, oidcCreds.Issuer)
+	assert.Equal(t, mockClientID, oidcCreds.Audience)
+	assert.False(t, oidcCreds.IsExpired())
+	assert.Zero(t, issuer.deviceRequests)
+}
+
+func TestAuthenticate_DeviceFlow(t *testing.T) {
+	setInteractive(t, false)
+	userCode := captureDeviceCode(t)
+	issuer := newMockIssuer(t)
+	p := newTestProvider(t, issuer, nil)
+
+	creds, err := p.Authenticate(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, mockUserCode, *userCode)
+	assert.Equal(t, 1, issuer.deviceRequests)
+	assert.Equal(t, issuer.idToken("user-1"), creds.(*types.OIDCCredentials).Token)
+}
+
+func TestAuthenticate_AccessToken(t *testing.T) {
+	setInteractive(t, false)
+	captureDeviceCode(t)
+	issuer := newMockIssuer(t)
+	p := newTestProvider(t, issuer, map[string]any{"token_type": TokenTypeAccessToken})
+
+	creds, err := p.Authenticate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "access-token-1", creds.(*types.OIDCCredentials).Token)
+}
+
+func TestAuthenticate_BrowserFlowRequiresTTY(t *testing.T) {
+	setInteractive(t, false)
+	issuer := newMockIssuer(t)
+	p := newTestProvider(t, issuer, map[string]any{"flow": FlowBrowser})
+
+	_, err := p.Authenticate(context.Background())
+	assert.ErrorIs(t, err, errUtils.ErrOIDCInteractiveRequired)
+}
+
+func TestAuthenticate_DeviceFlowUnsupported(t *testing.T) {
+	issuer := newMockIssuer(t)
+	issuer.withoutDevice = true
+	p := newTestProvider(t, issuer, map[string]any{"flow": FlowDevice})
+
+	_, err := p.Authenticate(context.Background())
+	assert.ErrorIs(t, err, errUtils.ErrOIDCDeviceFlowUnsupported)
+}
+
+func TestAuthenticate_RefreshesCachedToken(t *testing.T) {
+	setInteractive(t, false)
+	userCode := captureDeviceCode(t)
+	issuer := newMockIssuer(t)
+	p := newTestProvider(t, issuer, nil)
+
+	require.NoError(t, p.credentialStore.Store(p.name, &types.OIDCCredentials{
+		Token:        "expired",
+		Issuer:       issuer.URL,
+		RefreshToken: mockRefreshToken,
+	}, p.realm))
+
+	creds, err := p.Authenticate(context.Background())
+	require.NoError(t, err)
+
+	// The refresh token was used and rotated; no interactive flow ran.
+	oidcCreds := creds.(*types.OIDCCredentials)
+	assert.Equal(t, 1, issuer.refreshRequests)
+	assert.Zero(t, issuer.deviceRequests)
+	assert.Empty(t, *userCode)
+	assert.Equal(t, issuer.idToken("user-1"), oidcCreds.Token)
+	assert.Equal(t, mockRefreshToken+"-1", oidcCreds.RefreshToken)
+}
+
+func TestAuthenticate_RevokedRefreshTokenFallsBackToFlow(t *testing.T) {
+	setInteractive(t, false)
+	userCode := captureDeviceCode(t)
+	issuer := newMockIssuer(t)
+	p := newTestProvider(t, issuer, nil)
+
+	require.NoError(t, p.credentialStore.Store(p.name, &types.OIDCCredentials{
+		Token:        "expired",
+		Issuer:       issuer.URL,
+		RefreshToken: "revoked",
+	}, p.realm))
+
+	creds, err := p.Authenticate(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, issuer.refreshRequests)
+	assert.Equal(t, 1, issuer.deviceRequests)
+	assert.Equal(t, mockUserCode, *userCode)
+	assert.NotEmpty(t, creds.(*types.OIDCCredentials).Token)
+}
+
+func TestAuthenticate_IgnoresRefreshTokenFromOtherIssuer(t *testing.T) {
+	setInteractive(t, false)
+	captureDeviceCode(t)
+	issuer := newMockIssuer(t)
+	p := newTestProvider(t, issuer, nil)
+
+	require.NoError(t, p.credentialStore.Store(p.name, &types.OIDCCredentials{
+		Token:        "expired",
+		Issuer:       "https://other.example.com",
+		RefreshToken: mockRefreshToken,
+	}, p.realm))
+
+	_, err := p.Authenticate(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, issuer.refreshRequests)
+	assert.Equal(t, 1, issuer.deviceRequests)
+}
+
+func TestCallbackHandler(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr error
+	}{
+		{name: "success", query: "code=abc&state=expected"},
+		{name: "state mismatch", query: "code=abc&state=other", wantErr: errUtils.ErrWebflowStateMismatch},
+		{name: "missing code", query: "state=expected", wantErr: errUtils.ErrWebflowMissingCallbackCode},
+		{name: "authorization error", query: "error=access_denied&state=expected", wantErr: errUtils.ErrWebflowAuthorizationError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resultCh := make(chan callbackResult, 1)
+			handler := makeCallbackHandler("expected", resultCh)
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, httptest.NewRequest(http.MethodGet, callbackPath+"?"+tt.query, nil))
+
+			result := <-resultCh
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, result.err, tt.wantErr)
+				assert.Equal(t, http.StatusBadRequest, recorder.Code)
+				return
+			}
+			require.NoError(t, result.err)
+			assert.Equal(t, "abc", result.code)
+			assert.Equal(t, http.StatusOK, recorder.Code)
+		})
+	}
+}
+
+// stsResponse is the STS AssumeRoleWithWebIdentity response returned by the mock STS endpoint.
+type stsResponse struct {
+	XMLName     xml.Name `xml:"AssumeRoleWithWebIdentityResponse"`
+	Xmlns       string   `xml:"xmlns,attr"`
+	Credentials struct {
+		AccessKeyID     string `xml:"AccessKeyId"`
+		SecretAccessKey string `xml:"SecretAccessKey"`
+		SessionToken    string `xml:"SessionToken"`
+		Expiration      string `xml:"Expiration"`
+	} `xml:"AssumeRoleWithWebIdentityResult>Credentials"`
+}
+
+func TestAuthenticate_ChainsIntoAssumeRoleWithWebIdentity(t *testing.T) {
+	setInteractive(t, false)
+	captureDeviceCode(t)
+	issuer := newMockIssuer(t)
+	p := newTestProvider(t, issuer, nil)
+
+	// Mock STS verifies that the ID token from the issuer is presented as the web identity token.
+	var webIdentityToken, roleArn string
+	sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "AssumeRoleWithWebIdentity", r.PostForm.Get("Action"))
+		webIdentityToken = r.PostForm.Get("WebIdentityToken")
+		roleArn = r.PostForm.Get("RoleArn")
+
+		resp := stsResponse{Xmlns: "https://sts.amazonaws.com/doc/2011-06-15/"}
+		resp.Credentials.AccessKeyID = "ASIAMOCK"
+		resp.Credentials.SecretAccessKey = "secret"
+		resp.Credentials.SessionToken = "session"
+		resp.Credentials.Expiration = "2099-01-01T00:00:00Z"
+		w.Header().Set("Content-Type", "text/xml")
+		require.NoError(t, xml.NewEncoder(w).Encode(resp))
+	}))
+	t.Cleanup(sts.Close)
+
+	identity, err := awsIdentities.NewAssumeRoleWithWebIdentityIdentity("okta-admin", &schema.Identity{
+		Kind:      types.IdentityKindAWSAssumeRoleWithWebIdentity,
+		Via:       &schema.IdentityVia{Provider: "okta"},
+		Principal: map[string]any{"assume_role": "arn:aws:iam::123456789012:role/okta-admin", "region": "us-east-2"},
+		Credentials: map[string]any{
+			"aws": map[string]any{"resolver": map[string]any{"url": sts.URL}},
+		},
+	})
+	require.NoError(t, err)
+
+	oidcCreds, err := p.Authenticate(context.Background())
+	require.NoError(t, err)
+
+	awsCreds, err := identity.Authenticate(context.Background(), oidcCreds)
+	require.NoError(t, err)
+
+	assert.Equal(t, oidcCreds.(*types.OIDCCredentials).Token, webIdentityToken)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/okta-admin", roleArn)
+	creds := awsCreds.(*types.AWSCredentials)
+	assert.Equal(t, "ASIAMOCK", creds.AccessKeyID)
+	assert.Equal(t, "session", creds.SessionToken)
+	assert.Equal(t, "us-east-2", creds.Region)
+}
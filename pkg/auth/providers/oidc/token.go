@@ -0,0 +1,98 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth/types"
+	log "github.com/cloudposse/atmos/pkg/logger"
+)
+
+// toCredentials converts an OAuth2 token response into OIDC credentials.
+// The refresh token of the previous credentials is kept when the issuer doesn't rotate it.
+func (p *oidcProvider) toCredentials(token *oauth2.Token, previousRefreshToken string) (*types.OIDCCredentials, error) {
+	var value string
+	if p.spec.TokenType == TokenTypeAccessToken {
+		value = token.AccessToken
+	} else {
+		value, _ = token.Extra("id_token").(string)
+	}
+	if value == "" {
+		return nil, errUtils.Build(errUtils.ErrOIDCTokenMissing).
+			WithExplanationf("The OIDC issuer did not return an %s for provider '%s'", p.spec.TokenType, p.name).
+			WithHint("Ensure the `openid` scope is requested and allowed for the client").
+			WithContext("provider", p.name).
+			WithContext("token_type", p.spec.TokenType).
+			Err()
+	}
+
+	refreshToken := token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = previousRefreshToken
+	}
+
+	return &types.OIDCCredentials{
+		Token:        value,
+		Provider:     ProviderKind,
+		Audience:     p.spec.ClientID,
+		Issuer:       p.spec.IssuerURL,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// refreshCachedToken uses the refresh token of previously cached credentials to obtain a new token.
+// Returns nil when there is nothing to refresh or the refresh fails, so the caller falls back to an
+// interactive flow.
+func (p *oidcProvider) refreshCachedToken(ctx context.Context, oauthConfig *oauth2.Config) *types.OIDCCredentials {
+	cached, err := p.store().Retrieve(p.name, p.realm)
+	if err != nil {
+		log.Debug("No cached OIDC credentials", "provider", p.name, "error", err)
+		return nil
+	}
+	oidcCreds, ok := cached.(*types.OIDCCredentials)
+	if !ok || oidcCreds.RefreshToken == "" {
+		return nil
+	}
+	// Never send a refresh token to a different issuer than the one that issued it.
+	if oidcCreds.Issuer != p.spec.IssuerURL {
+		log.Debug("Ignoring cached OIDC refresh token from a different issuer", "provider", p.name, "issuer", oidcCreds.Issuer)
+		return nil
+	}
+
+	// An expired access token forces the token source to use the refresh token.
+	source := oauthConfig.TokenSource(ctx, &oauth2.Token{
+		RefreshToken: oidcCreds.RefreshToken,
+		Expiry:       time.Unix(1, 0),
+	})
+	token, err := source.Token()
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode != "" {
+			log.Debug("OIDC refresh token was rejected", "provider", p.name, "error", retrieveErr.ErrorCode)
+		} else {
+			log.Debug("Failed to refresh OIDC token", "provider", p.name, "error", err)
+		}
+		return nil
+	}
+
+	creds, err := p.toCredentials(token, oidcCreds.RefreshToken)
+	if err != nil {
+		log.Debug("Refreshed OIDC token is unusable", "provider", p.name, "error", err)
+		return nil
+	}
+	return creds
+}
+
+// tokenExchangeError wraps an OAuth2 token endpoint error with the authentication sentinel.
+func tokenExchangeError(flow string, err error) error {
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.ErrorCode != "" {
+		return fmt.Errorf("%w: OIDC %s flow failed: %s: %s", errUtils.ErrAuthenticationFailed, flow, retrieveErr.ErrorCode, retrieveErr.ErrorDescription)
+	}
+	return fmt.Errorf("%w: OIDC %s flow failed: %w", errUtils.ErrAuthenticationFailed, flow, err)
+}
@@ -26,4 +26,16 @@ const (
 
 	// GitHub provider kinds.
 	ProviderKindGitHubOIDC = "github/oidc"
+
+	// GitLab provider kinds.
+	ProviderKindGitLabOIDC = "gitlab/oidc"
+
+	// Generic CI provider kinds.
+	ProviderKindCIJWTFile = "ci/jwt-file"
+
+	// Generic OIDC provider kind.
+	ProviderKindOIDC = "oidc"
+
+	// AWS identity kinds.
+	IdentityKindAWSAssumeRoleWithWebIdentity = "aws/assume-role-with-web-identity"
 )
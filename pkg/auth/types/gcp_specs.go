@@ -71,6 +71,11 @@ type GCPServiceAccountIdentityPrincipal struct {
 	Lifetime string `json:"lifetime,omitempty" yaml:"lifetime,omitempty" mapstructure:"lifetime"`
 	// ProjectID to set in the auth context (optional, derived from SA email if not set).
 	ProjectID string `json:"project_id,omitempty" yaml:"project_id,omitempty" mapstructure:"project_id"`
+	// ProjectNumber, WorkloadIdentityPoolID and WorkloadIdentityProviderID configure workload identity
+	// federation when the provider returns an OIDC token (e.g. gitlab/oidc or ci/jwt-file).
+	ProjectNumber              string `json:"project_number,omitempty" yaml:"project_number,omitempty" mapstructure:"project_number"`
+	WorkloadIdentityPoolID     string `json:"workload_identity_pool_id,omitempty" yaml:"workload_identity_pool_id,omitempty" mapstructure:"workload_identity_pool_id"`
+	WorkloadIdentityProviderID string `json:"workload_identity_provider_id,omitempty" yaml:"workload_identity_provider_id,omitempty" mapstructure:"workload_identity_provider_id"`
 }
 
 // GCPProjectIdentityPrincipal defines the principal for gcp/project identity.
@@ -5,17 +5,79 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/perf"
 )
 
+// Number of dot-separated segments in a compact JWT.
+const jwtSegments = 3
+
 // OIDCCredentials defines OIDC-specific credential fields.
 type OIDCCredentials struct {
 	Token    string `json:"token,omitempty"`
 	Provider string `json:"provider,omitempty"`
 	Audience string `json:"audience,omitempty"`
+	// Issuer is the token issuer. RefreshToken is set by interactive OIDC providers so the token can be refreshed.
+	Issuer       string `json:"issuer,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+// jwtClaims holds the registered JWT claims used by CI token providers.
+type jwtClaims struct {
+	Issuer   string `json:"iss"`
+	Subject  string `json:"sub"`
+	Audience any    `json:"aud"`
+	Exp      int64  `json:"exp"`
+}
+
+// NewOIDCCredentialsFromJWT builds OIDC credentials from a raw JWT issued by a CI system.
+// The token must be well-formed and not expired; its signature is verified by the cloud
+// provider that consumes it, not here. Issuer and audience are taken from the claims.
+func NewOIDCCredentialsFromJWT(token, provider string) (*OIDCCredentials, error) {
+	defer perf.Track(nil, "types.NewOIDCCredentialsFromJWT")()
+
+	token = strings.TrimSpace(token)
+	parts := strings.Split(token, ".")
+	if len(parts) != jwtSegments {
+		return nil, fmt.Errorf("%w: expected %d segments, got %d", errUtils.ErrCITokenMalformed, jwtSegments, len(parts))
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, errors.Join(errUtils.ErrCITokenMalformed, errUtils.ErrAuthOidcDecodeFailed, err)
+	}
+	var claims jwtClaims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, errors.Join(errUtils.ErrCITokenMalformed, errUtils.ErrAuthOidcUnmarshalFailed, err)
+	}
+	if claims.Exp != 0 && time.Now().After(time.Unix(claims.Exp, 0)) {
+		return nil, fmt.Errorf("%w: token for subject %q expired at %s", errUtils.ErrAuthenticationFailed, claims.Subject, time.Unix(claims.Exp, 0).Format(time.RFC3339))
+	}
+
+	return &OIDCCredentials{
+		Token:    token,
+		Provider: provider,
+		Audience: firstAudience(claims.Audience),
+		Issuer:   claims.Issuer,
+	}, nil
+}
+
+// firstAudience returns the audience claim, which may be a string or an array of strings.
+func firstAudience(aud any) string {
+	switch v := aud.(type) {
+	case string:
+		return v
+	case []any:
+		if len(v) > 0 {
+			if s, ok := v[0].(string); ok {
+				return s
+			}
+		}
+	}
+	return ""
 }
 
 // IsExpired implements ICredentials for OIDCCredentials.
@@ -102,3 +102,41 @@ func TestOIDCCredentials_Validate(t *testing.T) {
 	assert.Error(t, err, "Should return error")
 	assert.True(t, errors.Is(err, errUtils.ErrNotImplemented), "Should return ErrNotImplemented")
 }
+
+func jwtWithClaims(claims map[string]any) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256"}`))
+	raw, _ := json.Marshal(claims)
+	return fmt.Sprintf("%s.%s.sig", header, base64.RawURLEncoding.EncodeToString(raw))
+}
+
+func TestNewOIDCCredentialsFromJWT(t *testing.T) {
+	exp := time.Now().Add(time.Hour).Unix()
+
+	// String audience.
+	token := jwtWithClaims(map[string]any{"iss": "https://gitlab.com", "aud": "sts.amazonaws.com", "exp": exp})
+	creds, err := NewOIDCCredentialsFromJWT(token+"\n", "gitlab")
+	assert.NoError(t, err)
+	assert.Equal(t, token, creds.Token)
+	assert.Equal(t, "gitlab", creds.Provider)
+	assert.Equal(t, "https://gitlab.com", creds.Issuer)
+	assert.Equal(t, "sts.amazonaws.com", creds.Audience)
+
+	// Array audience uses the first entry.
+	token = jwtWithClaims(map[string]any{"iss": "https://kubernetes.default.svc", "aud": []string{"atmos", "other"}, "exp": exp})
+	creds, err = NewOIDCCredentialsFromJWT(token, "ci")
+	assert.NoError(t, err)
+	assert.Equal(t, "atmos", creds.Audience)
+
+	// Expired token.
+	token = jwtWithClaims(map[string]any{"sub": "project_path:group/repo", "exp": time.Now().Add(-time.Minute).Unix()})
+	_, err = NewOIDCCredentialsFromJWT(token, "gitlab")
+	assert.ErrorIs(t, err, errUtils.ErrAuthenticationFailed)
+
+	// Malformed tokens.
+	_, err = NewOIDCCredentialsFromJWT("not-a-jwt", "ci")
+	assert.ErrorIs(t, err, errUtils.ErrCITokenMalformed)
+	_, err = NewOIDCCredentialsFromJWT("aGVh.Zm9v+notbase64.sig", "ci")
+	assert.ErrorIs(t, err, errUtils.ErrCITokenMalformed)
+	_, err = NewOIDCCredentialsFromJWT(fmt.Sprintf("e30.%s.sig", base64.RawURLEncoding.EncodeToString([]byte("not-json"))), "ci")
+	assert.ErrorIs(t, err, errUtils.ErrCITokenMalformed)
+}
@@ -16,6 +16,8 @@ const (
 	CredentialStoreTypeNoop          = "noop"
 	CredentialStoreTypeMemory        = "memory"
 	CredentialStoreTypeFile          = "file"
+	CredentialStoreTypeAge           = "age"
+	CredentialStoreTypePass          = "pass"
 )
 
 // PathType indicates what kind of filesystem entity the path represents.
@@ -0,0 +1,500 @@
+package azure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	azureCloud "github.com/cloudposse/atmos/pkg/auth/cloud/azure"
+	"github.com/cloudposse/atmos/pkg/ci/artifact"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	storeName = "azure/blob"
+
+	// Metadata is stored as a JSON blob alongside the artifact.
+	metadataSuffix = ".metadata.json"
+)
+
+// errBlobNotFound is returned by blobClient implementations for missing blobs.
+var errBlobNotFound = errors.New("blob not found")
+
+// blobAttrs contains the blob properties used by the store.
+type blobAttrs struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+}
+
+// blobClient is the subset of Azure Blob container operations used by the store.
+// Implementations return errBlobNotFound for missing blobs.
+type blobClient interface {
+	Put(ctx context.Context, name string, data []byte, contentType string) error
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	Attrs(ctx context.Context, name string) (*blobAttrs, error)
+	Delete(ctx context.Context, name string) error
+	List(ctx context.Context, prefix string) ([]blobAttrs, error)
+}
+
+// Store implements the artifact.Backend interface using Azure Blob Storage.
+type Store struct {
+	client    blobClient
+	container string
+	prefix    string
+}
+
+// NewStore creates a new Azure Blob Storage backend.
+//
+// Options:
+//   - storage_account_name (required unless connection_string is set): the storage account name.
+//   - container_name (required): the blob container name.
+//   - prefix: the blob name prefix for all artifacts.
+//   - environment: the Azure cloud environment ("public", "usgovernment", "china").
+//   - endpoint: a custom blob service URL (e.g., Azurite at http://127.0.0.1:10000/devstoreaccount1).
+//   - account_key: a storage account shared key.
+//   - connection_string: a storage account connection string (e.g., the Azurite development connection string).
+//
+// Credentials from the Atmos auth identity (opts.AuthContext) take precedence over
+// account keys; otherwise the default Azure credential chain is used.
+func NewStore(opts artifact.StoreOptions) (artifact.Backend, error) {
+	defer perf.Track(opts.AtmosConfig, "azure.NewStore")()
+
+	cfg := parseOptions(opts.Options)
+	if cfg.container == "" {
+		return nil, fmt.Errorf("%w: container_name is required for Azure Blob store", errUtils.ErrArtifactStoreNotFound)
+	}
+	if cfg.account == "" && cfg.connectionString == "" {
+		return nil, fmt.Errorf("%w: storage_account_name is required for Azure Blob store", errUtils.ErrArtifactStoreNotFound)
+	}
+
+	client, err := newContainerClient(&cfg, opts.AuthContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		client:    &containerClient{client: client},
+		container: cfg.container,
+		prefix:    cfg.prefix,
+	}, nil
+}
+
+// storeConfig contains the parsed store options.
+type storeConfig struct {
+	account          string
+	container        string
+	prefix           string
+	environment      string
+	endpoint         string
+	accountKey       string
+	connectionString string
+}
+
+// parseOptions parses the store options.
+func parseOptions(options map[string]any) storeConfig {
+	get := func(key string) string {
+		value, _ := options[key].(string)
+		return value
+	}
+
+	return storeConfig{
+		account:          get("storage_account_name"),
+		container:        get("container_name"),
+		prefix:           strings.Trim(get("prefix"), "/"),
+		environment:      get("environment"),
+		endpoint:         strings.TrimSuffix(get("endpoint"), "/"),
+		accountKey:       get("account_key"),
+		connectionString: get("connection_string"),
+	}
+}
+
+// containerURL returns the blob container URL.
+// The cloud environment of the Atmos auth identity takes precedence over the environment option.
+func (c *storeConfig) containerURL(authContext *schema.AuthContext) string {
+	if c.endpoint != "" {
+		return c.endpoint + "/" + c.container
+	}
+
+	environment := c.environment
+	if authContext != nil && authContext.Azure != nil && authContext.Azure.CloudEnvironment != "" {
+		environment = authContext.Azure.CloudEnvironment
+	}
+
+	suffix := azureCloud.GetCloudEnvironment(environment).BlobStorageSuffix
+	return fmt.Sprintf("https://%s.%s/%s", c.account, suffix, c.container)
+}
+
+// newContainerClient creates a container client.
+// Precedence: connection string, Atmos auth identity, account key, then the default Azure credential chain.
+func newContainerClient(cfg *storeConfig, authContext *schema.AuthContext) (*container.Client, error) {
+	clientOptions := &container.ClientOptions{
+		ClientOptions: policy.ClientOptions{
+			Telemetry: policy.TelemetryOptions{
+				ApplicationID: "atmos",
+			},
+		},
+	}
+
+	if cfg.connectionString != "" {
+		client, err := container.NewClientFromConnectionString(cfg.connectionString, cfg.container, clientOptions)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errUtils.ErrCreateAzureClient, err)
+		}
+		return client, nil
+	}
+
+	containerURL := cfg.containerURL(authContext)
+
+	if (authContext == nil || authContext.Azure == nil) && cfg.accountKey != "" {
+		cred, err := container.NewSharedKeyCredential(cfg.account, cfg.accountKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errUtils.ErrCreateAzureCredential, err)
+		}
+		client, err := container.NewClientWithSharedKeyCredential(containerURL, cred, clientOptions)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errUtils.ErrCreateAzureClient, err)
+		}
+		return client, nil
+	}
+
+	cred, err := newTokenCredential(authContext)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCreateAzureCredential, err)
+	}
+
+	client, err := container.NewClient(containerURL, cred, clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCreateAzureClient, err)
+	}
+	return client, nil
+}
+
+// newTokenCredential creates a token credential for the Atmos auth identity.
+// OIDC identities use workload identity federation; other identities use the
+// default Azure credential chain scoped to the identity's tenant.
+func newTokenCredential(authContext *schema.AuthContext) (azcore.TokenCredential, error) {
+	if authContext == nil || authContext.Azure == nil {
+		return azidentity.NewDefaultAzureCredential(nil)
+	}
+
+	azureCtx := authContext.Azure
+	if azureCtx.UseOIDC && azureCtx.ClientID != "" && azureCtx.TenantID != "" {
+		log.Debug("Using Azure workload identity credentials from Atmos auth identity", "client_id", azureCtx.ClientID)
+		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientID:      azureCtx.ClientID,
+			TenantID:      azureCtx.TenantID,
+			TokenFilePath: azureCtx.TokenFilePath,
+		})
+	}
+
+	log.Debug("Using Azure credentials from Atmos auth identity", "tenant_id", azureCtx.TenantID)
+	return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+		TenantID: azureCtx.TenantID,
+	})
+}
+
+// Name returns the store type name.
+func (s *Store) Name() string {
+	defer perf.Track(nil, "azure.Store.Name")()
+
+	return storeName
+}
+
+// fullKey returns the full blob name with prefix.
+func (s *Store) fullKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	//nolint:forbidigo // Blob names use forward slashes regardless of OS, path.Join is correct here.
+	return path.Join(s.prefix, key)
+}
+
+// Upload uploads a single data stream to Azure Blob Storage with a metadata sidecar.
+func (s *Store) Upload(ctx context.Context, key string, data io.Reader, size int64, metadata *artifact.Metadata) error {
+	defer perf.Track(nil, "azure.Upload")()
+
+	fullKey := s.fullKey(key)
+
+	dataBytes, err := io.ReadAll(data)
+	if err != nil {
+		return fmt.Errorf("%w: failed to read data: %w", errUtils.ErrArtifactUploadFailed, err)
+	}
+
+	if err := s.client.Put(ctx, fullKey, dataBytes, "application/octet-stream"); err != nil {
+		return fmt.Errorf("%w: failed to upload artifact to Azure Blob Storage: %w", errUtils.ErrArtifactUploadFailed, err)
+	}
+
+	if metadata != nil {
+		metadataJSON, err := json.MarshalIndent(metadata, "", "  ")
+		if err != nil {
+			return fmt.Errorf("%w: failed to marshal metadata: %w", errUtils.ErrArtifactUploadFailed, err)
+		}
+
+		if err := s.client.Put(ctx, fullKey+metadataSuffix, metadataJSON, "application/json"); err != nil {
+			return fmt.Errorf("%w: failed to upload metadata to Azure Blob Storage: %w", errUtils.ErrArtifactUploadFailed, err)
+		}
+	}
+
+	return nil
+}
+
+// Download downloads a single data stream from Azure Blob Storage.
+// Returns an io.ReadCloser for the data and the metadata sidecar.
+func (s *Store) Download(ctx context.Context, key string) (io.ReadCloser, *artifact.Metadata, error) {
+	defer perf.Track(nil, "azure.Download")()
+
+	fullKey := s.fullKey(key)
+
+	reader, err := s.client.Get(ctx, fullKey)
+	if err != nil {
+		if errors.Is(err, errBlobNotFound) {
+			return nil, nil, fmt.Errorf("%w: %s", errUtils.ErrArtifactNotFound, key)
+		}
+		return nil, nil, fmt.Errorf("%w: failed to download artifact from Azure Blob Storage: %w", errUtils.ErrArtifactDownloadFailed, err)
+	}
+
+	// Try to load metadata.
+	metadata, _ := s.loadMetadata(ctx, fullKey)
+
+	return reader, metadata, nil
+}
+
+// Delete deletes an artifact from Azure Blob Storage.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	defer perf.Track(nil, "azure.Delete")()
+
+	fullKey := s.fullKey(key)
+
+	// Deleting a missing artifact is not an error, matching the other backends.
+	if err := s.client.Delete(ctx, fullKey); err != nil && !errors.Is(err, errBlobNotFound) {
+		return fmt.Errorf("%w: failed to delete artifact from Azure Blob Storage: %w", errUtils.ErrArtifactDeleteFailed, err)
+	}
+
+	// Try to delete metadata (ignore errors).
+	_ = s.client.Delete(ctx, fullKey+metadataSuffix)
+
+	return nil
+}
+
+// List lists artifacts matching the given query.
+func (s *Store) List(ctx context.Context, query artifact.Query) ([]artifact.ArtifactInfo, error) {
+	defer perf.Track(nil, "azure.List")()
+
+	blobs, err := s.client.List(ctx, s.listPrefix(query))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list artifacts in Azure Blob Storage: %w", errUtils.ErrArtifactListFailed, err)
+	}
+
+	var files []artifact.ArtifactInfo
+	for _, b := range blobs {
+		// Skip metadata files.
+		if strings.HasSuffix(b.Name, metadataSuffix) {
+			continue
+		}
+
+		// Try to load metadata.
+		metadata, _ := s.loadMetadata(ctx, b.Name)
+
+		files = append(files, artifact.ArtifactInfo{
+			Name:         s.relativeKey(b.Name),
+			Size:         b.Size,
+			LastModified: b.LastModified,
+			Metadata:     metadata,
+		})
+	}
+
+	return files, nil
+}
+
+// Exists checks if an artifact exists.
+func (s *Store) Exists(ctx context.Context, key string) (bool, error) {
+	defer perf.Track(nil, "azure.Exists")()
+
+	_, err := s.client.Attrs(ctx, s.fullKey(key))
+	if err != nil {
+		if errors.Is(err, errBlobNotFound) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w: failed to check if %s exists in Azure Blob Storage: %w", errUtils.ErrArtifactListFailed, key, err)
+	}
+
+	return true, nil
+}
+
+// GetMetadata retrieves metadata for an artifact without downloading the content.
+func (s *Store) GetMetadata(ctx context.Context, key string) (*artifact.Metadata, error) {
+	defer perf.Track(nil, "azure.GetMetadata")()
+
+	fullKey := s.fullKey(key)
+
+	attrs, err := s.client.Attrs(ctx, fullKey)
+	if err != nil {
+		if errors.Is(err, errBlobNotFound) {
+			return nil, fmt.Errorf("%w: %s", errUtils.ErrArtifactNotFound, key)
+		}
+		return nil, fmt.Errorf("%w: failed to get metadata for %s from Azure Blob Storage: %w", errUtils.ErrArtifactMetadataFailed, key, err)
+	}
+
+	// Try to load metadata from the sidecar blob.
+	metadata, err := s.loadMetadata(ctx, fullKey)
+	if err != nil || metadata == nil {
+		// Return minimal metadata from the blob properties.
+		metadata = &artifact.Metadata{CreatedAt: attrs.LastModified}
+	}
+
+	return metadata, nil
+}
+
+// loadMetadata loads metadata from the metadata sidecar blob.
+func (s *Store) loadMetadata(ctx context.Context, artifactKey string) (*artifact.Metadata, error) {
+	reader, err := s.client.Get(ctx, artifactKey+metadataSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get metadata from Azure Blob Storage: %w", errUtils.ErrArtifactMetadataFailed, err)
+	}
+	defer reader.Close()
+
+	var metadata artifact.Metadata
+	if err := json.NewDecoder(reader).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode metadata JSON: %w", errUtils.ErrArtifactMetadataFailed, err)
+	}
+
+	return &metadata, nil
+}
+
+// listPrefix converts an artifact.Query to a blob name prefix.
+func (s *Store) listPrefix(query artifact.Query) string {
+	var prefix string
+	if !query.All && len(query.Stacks) > 0 {
+		prefix = query.Stacks[0]
+		if len(query.Components) > 0 {
+			prefix += "/" + query.Components[0]
+		}
+	}
+
+	switch {
+	case s.prefix == "":
+		return prefix
+	case prefix == "":
+		return s.prefix + "/"
+	default:
+		return s.fullKey(prefix)
+	}
+}
+
+// relativeKey strips the store prefix from a blob name.
+func (s *Store) relativeKey(name string) string {
+	if s.prefix == "" {
+		return name
+	}
+	return strings.TrimPrefix(name, s.prefix+"/")
+}
+
+// containerClient implements blobClient using an Azure Blob container client.
+type containerClient struct {
+	client *container.Client
+}
+
+func (c *containerClient) Put(ctx context.Context, name string, data []byte, contentType string) error {
+	defer perf.Track(nil, "azure.containerClient.Put")()
+
+	_, err := c.client.NewBlockBlobClient(name).UploadBuffer(ctx, data, &blockblob.UploadBufferOptions{
+		HTTPHeaders: &blob.HTTPHeaders{BlobContentType: &contentType},
+	})
+	return err
+}
+
+func (c *containerClient) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	defer perf.Track(nil, "azure.containerClient.Get")()
+
+	resp, err := c.client.NewBlobClient(name).DownloadStream(ctx, nil)
+	if err != nil {
+		return nil, mapNotFound(err)
+	}
+	return resp.Body, nil
+}
+
+func (c *containerClient) Attrs(ctx context.Context, name string) (*blobAttrs, error) {
+	defer perf.Track(nil, "azure.containerClient.Attrs")()
+
+	props, err := c.client.NewBlobClient(name).GetProperties(ctx, nil)
+	if err != nil {
+		return nil, mapNotFound(err)
+	}
+
+	attrs := &blobAttrs{Name: name}
+	if props.ContentLength != nil {
+		attrs.Size = *props.ContentLength
+	}
+	if props.LastModified != nil {
+		attrs.LastModified = *props.LastModified
+	}
+	return attrs, nil
+}
+
+func (c *containerClient) Delete(ctx context.Context, name string) error {
+	defer perf.Track(nil, "azure.containerClient.Delete")()
+
+	_, err := c.client.NewBlobClient(name).Delete(ctx, nil)
+	return mapNotFound(err)
+}
+
+func (c *containerClient) List(ctx context.Context, prefix string) ([]blobAttrs, error) {
+	defer perf.Track(nil, "azure.containerClient.List")()
+
+	var blobs []blobAttrs
+	pager := c.client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{Prefix: &prefix})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name == nil {
+				continue
+			}
+			attrs := blobAttrs{Name: *item.Name}
+			if item.Properties != nil {
+				if item.Properties.ContentLength != nil {
+					attrs.Size = *item.Properties.ContentLength
+				}
+				if item.Properties.LastModified != nil {
+					attrs.LastModified = *item.Properties.LastModified
+				}
+			}
+			blobs = append(blobs, attrs)
+		}
+	}
+	return blobs, nil
+}
+
+// mapNotFound converts Azure "blob not found" errors to errBlobNotFound.
+func mapNotFound(err error) error {
+	if err != nil && bloberror.HasCode(err, bloberror.BlobNotFound) {
+		return fmt.Errorf("%w: %w", errBlobNotFound, err)
+	}
+	return err
+}
+
+func init() {
+	artifact.Register(storeName, NewStore)
+}
+
+// Ensure Store implements artifact.Backend.
+var _ artifact.Backend = (*Store)(nil)
@@ -0,0 +1,379 @@
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/internal/gcp"
+	"github.com/cloudposse/atmos/pkg/ci/artifact"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	storeName = "google/gcs"
+
+	// Metadata is stored as a JSON object alongside the artifact.
+	metadataSuffix = ".metadata.json"
+)
+
+// objectAttrs contains the object attributes used by the store.
+type objectAttrs struct {
+	Name    string
+	Size    int64
+	Updated time.Time
+}
+
+// objectClient is the subset of GCS bucket operations used by the store.
+// Implementations return storage.ErrObjectNotExist for missing objects.
+type objectClient interface {
+	Put(ctx context.Context, name string, data []byte, contentType string) error
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	Attrs(ctx context.Context, name string) (*objectAttrs, error)
+	Delete(ctx context.Context, name string) error
+	List(ctx context.Context, prefix string) ([]objectAttrs, error)
+}
+
+// Store implements the artifact.Backend interface using Google Cloud Storage.
+type Store struct {
+	client objectClient
+	bucket string
+	prefix string
+}
+
+// NewStore creates a new GCS backend.
+//
+// Options:
+//   - bucket (required): the GCS bucket name.
+//   - prefix: the object name prefix for all artifacts.
+//   - credentials: service account JSON content or a path to a credentials file.
+//   - endpoint: a custom JSON API endpoint (e.g., fake-gcs-server at http://localhost:4443/storage/v1/).
+//
+// Credentials from the Atmos auth identity (opts.AuthContext) take precedence over the credentials option.
+func NewStore(opts artifact.StoreOptions) (artifact.Backend, error) {
+	defer perf.Track(opts.AtmosConfig, "gcs.NewStore")()
+
+	bucket, ok := opts.Options["bucket"].(string)
+	if !ok || bucket == "" {
+		return nil, fmt.Errorf("%w: bucket is required for GCS store", errUtils.ErrArtifactStoreNotFound)
+	}
+
+	prefix, _ := opts.Options["prefix"].(string)
+	credentials, _ := opts.Options["credentials"].(string)
+	endpoint, _ := opts.Options["endpoint"].(string)
+
+	client, err := storage.NewClient(context.Background(), clientOptions(opts.AuthContext, credentials, endpoint)...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCreateGCSClient, err)
+	}
+
+	return &Store{
+		client: &bucketClient{bucket: client.Bucket(bucket)},
+		bucket: bucket,
+		prefix: strings.Trim(prefix, "/"),
+	}, nil
+}
+
+// clientOptions returns the GCS client options.
+// Precedence: Atmos auth identity, then the credentials option, then Application Default Credentials.
+func clientOptions(authContext *schema.AuthContext, credentials, endpoint string) []option.ClientOption {
+	var opts []option.ClientOption
+
+	switch {
+	case authContext != nil && authContext.GCP != nil && authContext.GCP.CredentialsFile != "":
+		log.Debug("Using GCS credentials from Atmos auth identity", "path", authContext.GCP.CredentialsFile)
+		opts = gcp.GetClientOptions(gcp.AuthOptions{Credentials: authContext.GCP.CredentialsFile})
+	case authContext != nil && authContext.GCP != nil && authContext.GCP.AccessToken != "":
+		log.Debug("Using GCS access token from Atmos auth identity")
+		opts = append(opts, option.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{
+			AccessToken: authContext.GCP.AccessToken,
+			Expiry:      authContext.GCP.TokenExpiry,
+		})))
+	case credentials != "":
+		opts = gcp.GetClientOptions(gcp.AuthOptions{Credentials: credentials})
+	case endpoint != "":
+		// Emulators such as fake-gcs-server do not authenticate requests.
+		opts = append(opts, option.WithoutAuthentication())
+	default:
+		opts = gcp.GetClientOptions(gcp.AuthOptions{})
+	}
+
+	if endpoint != "" {
+		opts = append(opts, option.WithEndpoint(endpoint))
+	}
+
+	return opts
+}
+
+// Name returns the store type name.
+func (s *Store) Name() string {
+	defer perf.Track(nil, "gcs.Store.Name")()
+
+	return storeName
+}
+
+// fullKey returns the full object name with prefix.
+func (s *Store) fullKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	//nolint:forbidigo // GCS object names use forward slashes regardless of OS, path.Join is correct here.
+	return path.Join(s.prefix, key)
+}
+
+// Upload uploads a single data stream to GCS with a metadata sidecar.
+func (s *Store) Upload(ctx context.Context, key string, data io.Reader, size int64, metadata *artifact.Metadata) error {
+	defer perf.Track(nil, "gcs.Upload")()
+
+	fullKey := s.fullKey(key)
+
+	dataBytes, err := io.ReadAll(data)
+	if err != nil {
+		return fmt.Errorf("%w: failed to read data: %w", errUtils.ErrArtifactUploadFailed, err)
+	}
+
+	if err := s.client.Put(ctx, fullKey, dataBytes, "application/octet-stream"); err != nil {
+		return fmt.Errorf("%w: failed to upload artifact to GCS: %w", errUtils.ErrArtifactUploadFailed, err)
+	}
+
+	if metadata != nil {
+		metadataJSON, err := json.MarshalIndent(metadata, "", "  ")
+		if err != nil {
+			return fmt.Errorf("%w: failed to marshal metadata: %w", errUtils.ErrArtifactUploadFailed, err)
+		}
+
+		if err := s.client.Put(ctx, fullKey+metadataSuffix, metadataJSON, "application/json"); err != nil {
+			return fmt.Errorf("%w: failed to upload metadata to GCS: %w", errUtils.ErrArtifactUploadFailed, err)
+		}
+	}
+
+	return nil
+}
+
+// Download downloads a single data stream from GCS.
+// Returns an io.ReadCloser for the data and the metadata sidecar.
+func (s *Store) Download(ctx context.Context, key string) (io.ReadCloser, *artifact.Metadata, error) {
+	defer perf.Track(nil, "gcs.Download")()
+
+	fullKey := s.fullKey(key)
+
+	reader, err := s.client.Get(ctx, fullKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, nil, fmt.Errorf("%w: %s", errUtils.ErrArtifactNotFound, key)
+		}
+		return nil, nil, fmt.Errorf("%w: failed to download artifact from GCS: %w", errUtils.ErrArtifactDownloadFailed, err)
+	}
+
+	// Try to load metadata.
+	metadata, _ := s.loadMetadata(ctx, fullKey)
+
+	return reader, metadata, nil
+}
+
+// Delete deletes an artifact from GCS.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	defer perf.Track(nil, "gcs.Delete")()
+
+	fullKey := s.fullKey(key)
+
+	// Deleting a missing artifact is not an error, matching the other backends.
+	if err := s.client.Delete(ctx, fullKey); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+		return fmt.Errorf("%w: failed to delete artifact from GCS: %w", errUtils.ErrArtifactDeleteFailed, err)
+	}
+
+	// Try to delete metadata (ignore errors).
+	_ = s.client.Delete(ctx, fullKey+metadataSuffix)
+
+	return nil
+}
+
+// List lists artifacts matching the given query.
+func (s *Store) List(ctx context.Context, query artifact.Query) ([]artifact.ArtifactInfo, error) {
+	defer perf.Track(nil, "gcs.List")()
+
+	objects, err := s.client.List(ctx, s.listPrefix(query))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to list artifacts in GCS: %w", errUtils.ErrArtifactListFailed, err)
+	}
+
+	var files []artifact.ArtifactInfo
+	for _, obj := range objects {
+		// Skip metadata files.
+		if strings.HasSuffix(obj.Name, metadataSuffix) {
+			continue
+		}
+
+		// Try to load metadata.
+		metadata, _ := s.loadMetadata(ctx, obj.Name)
+
+		files = append(files, artifact.ArtifactInfo{
+			Name:         s.relativeKey(obj.Name),
+			Size:         obj.Size,
+			LastModified: obj.Updated,
+			Metadata:     metadata,
+		})
+	}
+
+	return files, nil
+}
+
+// Exists checks if an artifact exists.
+func (s *Store) Exists(ctx context.Context, key string) (bool, error) {
+	defer perf.Track(nil, "gcs.Exists")()
+
+	_, err := s.client.Attrs(ctx, s.fullKey(key))
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%w: failed to check if %s exists in GCS: %w", errUtils.ErrArtifactListFailed, key, err)
+	}
+
+	return true, nil
+}
+
+// GetMetadata retrieves metadata for an artifact without downloading the content.
+func (s *Store) GetMetadata(ctx context.Context, key string) (*artifact.Metadata, error) {
+	defer perf.Track(nil, "gcs.GetMetadata")()
+
+	fullKey := s.fullKey(key)
+
+	attrs, err := s.client.Attrs(ctx, fullKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrObjectNotExist) {
+			return nil, fmt.Errorf("%w: %s", errUtils.ErrArtifactNotFound, key)
+		}
+		return nil, fmt.Errorf("%w: failed to get metadata for %s from GCS: %w", errUtils.ErrArtifactMetadataFailed, key, err)
+	}
+
+	// Try to load metadata from the sidecar object.
+	metadata, err := s.loadMetadata(ctx, fullKey)
+	if err != nil || metadata == nil {
+		// Return minimal metadata from the GCS object.
+		metadata = &artifact.Metadata{CreatedAt: attrs.Updated}
+	}
+
+	return metadata, nil
+}
+
+// loadMetadata loads metadata from the metadata sidecar object in GCS.
+func (s *Store) loadMetadata(ctx context.Context, artifactKey string) (*artifact.Metadata, error) {
+	reader, err := s.client.Get(ctx, artifactKey+metadataSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to get metadata from GCS: %w", errUtils.ErrArtifactMetadataFailed, err)
+	}
+	defer reader.Close()
+
+	var metadata artifact.Metadata
+	if err := json.NewDecoder(reader).Decode(&metadata); err != nil {
+		return nil, fmt.Errorf("%w: failed to decode metadata JSON: %w", errUtils.ErrArtifactMetadataFailed, err)
+	}
+
+	return &metadata, nil
+}
+
+// listPrefix converts an artifact.Query to a GCS object name prefix.
+func (s *Store) listPrefix(query artifact.Query) string {
+	var prefix string
+	if !query.All && len(query.Stacks) > 0 {
+		prefix = query.Stacks[0]
+		if len(query.Components) > 0 {
+			prefix += "/" + query.Components[0]
+		}
+	}
+
+	switch {
+	case s.prefix == "":
+		return prefix
+	case prefix == "":
+		return s.prefix + "/"
+	default:
+		return s.fullKey(prefix)
+	}
+}
+
+// relativeKey strips the store prefix from an object name.
+func (s *Store) relativeKey(name string) string {
+	if s.prefix == "" {
+		return name
+	}
+	return strings.TrimPrefix(name, s.prefix+"/")
+}
+
+// bucketClient implements objectClient using a GCS bucket handle.
+type bucketClient struct {
+	bucket *storage.BucketHandle
+}
+
+func (c *bucketClient) Put(ctx context.Context, name string, data []byte, contentType string) error {
+	defer perf.Track(nil, "gcs.bucketClient.Put")()
+
+	writer := c.bucket.Object(name).NewWriter(ctx)
+	writer.ContentType = contentType
+	if _, err := io.Copy(writer, bytes.NewReader(data)); err != nil {
+		_ = writer.Close()
+		return err
+	}
+	return writer.Close()
+}
+
+func (c *bucketClient) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	defer perf.Track(nil, "gcs.bucketClient.Get")()
+
+	return c.bucket.Object(name).NewReader(ctx)
+}
+
+func (c *bucketClient) Attrs(ctx context.Context, name string) (*objectAttrs, error) {
+	defer perf.Track(nil, "gcs.bucketClient.Attrs")()
+
+	attrs, err := c.bucket.Object(name).Attrs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &objectAttrs{Name: attrs.Name, Size: attrs.Size, Updated: attrs.Updated}, nil
+}
+
+func (c *bucketClient) Delete(ctx context.Context, name string) error {
+	defer perf.Track(nil, "gcs.bucketClient.Delete")()
+
+	return c.bucket.Object(name).Delete(ctx)
+}
+
+func (c *bucketClient) List(ctx context.Context, prefix string) ([]objectAttrs, error) {
+	defer perf.Track(nil, "gcs.bucketClient.List")()
+
+	var objects []objectAttrs
+	it := c.bucket.Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, objectAttrs{Name: attrs.Name, Size: attrs.Size, Updated: attrs.Updated})
+	}
+}
+
+func init() {
+	artifact.Register(storeName, NewStore)
+}
+
+// Ensure Store implements artifact.Backend.
+var _ artifact.Backend = (*Store)(nil)
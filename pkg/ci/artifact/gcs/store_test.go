@@ -0,0 +1,287 @@
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/artifact"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// fakeClient is an in-memory objectClient.
+type fakeClient struct {
+	objects map[string][]byte
+	updated time.Time
+	err     error
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		objects: make(map[string][]byte),
+		updated: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+}
+
+func (f *fakeClient) Put(_ context.Context, name string, data []byte, _ string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.objects[name] = data
+	return nil
+}
+
+func (f *fakeClient) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	data, ok := f.objects[name]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (f *fakeClient) Attrs(_ context.Context, name string) (*objectAttrs, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	data, ok := f.objects[name]
+	if !ok {
+		return nil, storage.ErrObjectNotExist
+	}
+	return &objectAttrs{Name: name, Size: int64(len(data)), Updated: f.updated}, nil
+}
+
+func (f *fakeClient) Delete(_ context.Context, name string) error {
+	if f.err != nil {
+		return f.err
+	}
+	if _, ok := f.objects[name]; !ok {
+		return storage.ErrObjectNotExist
+	}
+	delete(f.objects, name)
+	return nil
+}
+
+func (f *fakeClient) List(_ context.Context, prefix string) ([]objectAttrs, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	var objects []objectAttrs
+	for name, data := range f.objects {
+		if strings.HasPrefix(name, prefix) {
+			objects = append(objects, objectAttrs{Name: name, Size: int64(len(data)), Updated: f.updated})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Name < objects[j].Name })
+	return objects, nil
+}
+
+func newTestStore(prefix string) (*Store, *fakeClient) {
+	client := newFakeClient()
+	return &Store{client: client, bucket: "test-bucket", prefix: prefix}, client
+}
+
+func TestStore_Name(t *testing.T) {
+	store, _ := newTestStore("")
+	assert.Equal(t, "google/gcs", store.Name())
+}
+
+func TestNewStore_MissingBucket(t *testing.T) {
+	for _, options := range []map[string]any{nil, {}, {"bucket": ""}} {
+		_, err := NewStore(artifact.StoreOptions{Options: options})
+		assert.ErrorIs(t, err, errUtils.ErrArtifactStoreNotFound)
+	}
+}
+
+func TestNewStore_Endpoint(t *testing.T) {
+	backend, err := NewStore(artifact.StoreOptions{Options: map[string]any{
+		"bucket":   "planfiles",
+		"prefix":   "/atmos/",
+		"endpoint": "http://localhost:4443/storage/v1/",
+	}})
+	require.NoError(t, err)
+
+	store := backend.(*Store)
+	assert.Equal(t, "planfiles", store.bucket)
+	assert.Equal(t, "atmos", store.prefix)
+}
+
+func TestClientOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		authContext *schema.AuthContext
+		credentials string
+		endpoint    string
+		wantCount   int
+	}{
+		{name: "default credentials", wantCount: 0},
+		{name: "credentials option", credentials: "/tmp/sa.json", wantCount: 1},
+		{name: "emulator endpoint", endpoint: "http://localhost:4443/storage/v1/", wantCount: 2},
+		{
+			name:        "identity credentials file",
+			authContext: &schema.AuthContext{GCP: &schema.GCPAuthContext{CredentialsFile: "/tmp/identity.json"}},
+			credentials: "/tmp/sa.json",
+			wantCount:   1,
+		},
+		{
+			name:        "identity access token",
+			authContext: &schema.AuthContext{GCP: &schema.GCPAuthContext{AccessToken: "ya29.token"}},
+			endpoint:    "http://localhost:4443/storage/v1/",
+			wantCount:   2,
+		},
+		{
+			name:        "identity without GCP context",
+			authContext: &schema.AuthContext{AWS: &schema.AWSAuthContext{Profile: "dev"}},
+			credentials: "/tmp/sa.json",
+			wantCount:   1,
+		},
+	}
+
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Len(t, clientOptions(tt.authContext, tt.credentials, tt.endpoint), tt.wantCount)
+		})
+	}
+}
+
+func TestStore_UploadDownload(t *testing.T) {
+	store, client := newTestStore("planfiles")
+	ctx := context.Background()
+
+	metadata := &artifact.Metadata{Stack: "dev", Component: "vpc", SHA: "abc123"}
+	require.NoError(t, store.Upload(ctx, "dev/vpc/abc123.tfplan", strings.NewReader("plan data"), 9, metadata))
+
+	assert.Contains(t, client.objects, "planfiles/dev/vpc/abc123.tfplan")
+	assert.Contains(t, client.objects, "planfiles/dev/vpc/abc123.tfplan.metadata.json")
+
+	reader, got, err := store.Download(ctx, "dev/vpc/abc123.tfplan")
+	require.NoError(t, err)
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	assert.Equal(t, "plan data", string(data))
+	require.NotNil(t, got)
+	assert.Equal(t, "vpc", got.Component)
+}
+
+func TestStore_UploadNilMetadata(t *testing.T) {
+	store, client := newTestStore("")
+	require.NoError(t, store.Upload(context.Background(), "a.tfplan", strings.NewReader("x"), 1, nil))
+
+	assert.Len(t, client.objects, 1)
+}
+
+func TestStore_UploadError(t *testing.T) {
+	store, client := newTestStore("")
+	client.err = errors.New("permission denied")
+
+	err := store.Upload(context.Background(), "a.tfplan", strings.NewReader("x"), 1, nil)
+	assert.ErrorIs(t, err, errUtils.ErrArtifactUploadFailed)
+}
+
+func TestStore_Download_NotFound(t *testing.T) {
+	store, _ := newTestStore("")
+
+	_, _, err := store.Download(context.Background(), "missing.tfplan")
+	assert.ErrorIs(t, err, errUtils.ErrArtifactNotFound)
+}
+
+func TestStore_Delete(t *testing.T) {
+	store, client := newTestStore("")
+	ctx := context.Background()
+	require.NoError(t, store.Upload(ctx, "a.tfplan", strings.NewReader("x"), 1, &artifact.Metadata{}))
+
+	require.NoError(t, store.Delete(ctx, "a.tfplan"))
+	assert.Empty(t, client.objects)
+
+	// Deleting a missing artifact succeeds.
+	assert.NoError(t, store.Delete(ctx, "a.tfplan"))
+
+	client.err = errors.New("boom")
+	assert.ErrorIs(t, store.Delete(ctx, "a.tfplan"), errUtils.ErrArtifactDeleteFailed)
+}
+
+func TestStore_List(t *testing.T) {
+	store, client := newTestStore("planfiles")
+	ctx := context.Background()
+	for _, key := range []string{"dev/vpc/a.tfplan", "dev/eks/b.tfplan", "prod/vpc/c.tfplan"} {
+		require.NoError(t, store.Upload(ctx, key, strings.NewReader("x"), 1, &artifact.Metadata{SHA: key}))
+	}
+	// Objects outside the store prefix are ignored.
+	client.objects["other/d.tfplan"] = []byte("x")
+
+	all, err := store.List(ctx, artifact.Query{All: true})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "dev/eks/b.tfplan", all[0].Name)
+	assert.Equal(t, "dev/eks/b.tfplan", all[0].Metadata.SHA)
+	assert.Equal(t, client.updated, all[0].LastModified)
+
+	dev, err := store.List(ctx, artifact.Query{Stacks: []string{"dev"}})
+	require.NoError(t, err)
+	assert.Len(t, dev, 2)
+
+	devVPC, err := store.List(ctx, artifact.Query{Stacks: []string{"dev"}, Components: []string{"vpc"}})
+	require.NoError(t, err)
+	require.Len(t, devVPC, 1)
+	assert.Equal(t, "dev/vpc/a.tfplan", devVPC[0].Name)
+
+	client.err = errors.New("boom")
+	_, err = store.List(ctx, artifact.Query{All: true})
+	assert.ErrorIs(t, err, errUtils.ErrArtifactListFailed)
+}
+
+func TestStore_Exists(t *testing.T) {
+	store, client := newTestStore("")
+	ctx := context.Background()
+	require.NoError(t, store.Upload(ctx, "a.tfplan", strings.NewReader("x"), 1, nil))
+
+	exists, err := store.Exists(ctx, "a.tfplan")
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	exists, err = store.Exists(ctx, "b.tfplan")
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	client.err = errors.New("boom")
+	_, err = store.Exists(ctx, "a.tfplan")
+	assert.ErrorIs(t, err, errUtils.ErrArtifactListFailed)
+}
+
+func TestStore_GetMetadata(t *testing.T) {
+	store, client := newTestStore("")
+	ctx := context.Background()
+	require.NoError(t, store.Upload(ctx, "a.tfplan", strings.NewReader("x"), 1, &artifact.Metadata{Stack: "dev"}))
+	require.NoError(t, store.Upload(ctx, "b.tfplan", strings.NewReader("x"), 1, nil))
+
+	metadata, err := store.GetMetadata(ctx, "a.tfplan")
+	require.NoError(t, err)
+	assert.Equal(t, "dev", metadata.Stack)
+
+	// Without a sidecar, metadata falls back to the object attributes.
+	metadata, err = store.GetMetadata(ctx, "b.tfplan")
+	require.NoError(t, err)
+	assert.Equal(t, client.updated, metadata.CreatedAt)
+
+	_, err = store.GetMetadata(ctx, "missing.tfplan")
+	assert.ErrorIs(t, err, errUtils.ErrArtifactNotFound)
+}
+
+func TestStore_Registered(t *testing.T) {
+	assert.Contains(t, artifact.GetRegisteredTypes(), "google/gcs")
+}
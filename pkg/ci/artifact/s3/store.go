@@ -52,6 +52,19 @@ func NewStore(opts artifact.StoreOptions) (artifact.Backend, error) {
 		awsOpts = append(awsOpts, config.WithRegion(region))
 	}
 
+	// Use the credentials of the Atmos auth identity if available.
+	if opts.AuthContext != nil && opts.AuthContext.AWS != nil {
+		awsCtx := opts.AuthContext.AWS
+		awsOpts = append(awsOpts,
+			config.WithSharedCredentialsFiles([]string{awsCtx.CredentialsFile}),
+			config.WithSharedConfigFiles([]string{awsCtx.ConfigFile}),
+			config.WithSharedConfigProfile(awsCtx.Profile),
+		)
+		if region == "" && awsCtx.Region != "" {
+			awsOpts = append(awsOpts, config.WithRegion(awsCtx.Region))
+		}
+	}
+
 	cfg, err := config.LoadDefaultConfig(context.Background(), awsOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", errUtils.ErrAWSConfigLoadFailed, err)
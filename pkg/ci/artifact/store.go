@@ -60,7 +60,7 @@ type Store interface {
 
 // StoreOptions contains options for creating a store.
 type StoreOptions struct {
-	// Type is the store type (s3, azure, gcs, github, local).
+	// Type is the store type (aws/s3, azure/blob, google/gcs, github/artifacts, local/dir).
 	Type string
 
 	// Options contains type-specific configuration options.
@@ -68,6 +68,10 @@ type StoreOptions struct {
 
 	// AtmosConfig is the Atmos configuration.
 	AtmosConfig *schema.AtmosConfiguration
+
+	// AuthContext holds the credentials of the Atmos auth identity used by the store (optional).
+	// Backends that support identities use it instead of the default credential chain.
+	AuthContext *schema.AuthContext
 }
 
 // StoreFactory is a function that creates a Store from options.
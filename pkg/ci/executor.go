@@ -1,10 +1,13 @@
 package ci
 
 import (
+	"context"
 	"os"
 	"strings"
 
 	"github.com/cloudposse/atmos/pkg/ci/artifact"
+	_ "github.com/cloudposse/atmos/pkg/ci/artifact/azure"  // Register azure artifact store.
+	_ "github.com/cloudposse/atmos/pkg/ci/artifact/gcs"    // Register gcs artifact store.
 	_ "github.com/cloudposse/atmos/pkg/ci/artifact/github" // Register github artifact store.
 	_ "github.com/cloudposse/atmos/pkg/ci/artifact/local"  // Register local artifact store.
 	_ "github.com/cloudposse/atmos/pkg/ci/artifact/s3"     // Register s3 artifact store.
@@ -20,6 +23,9 @@ import (
 
 // ExecuteOptions contains options for executing CI hooks.
 type ExecuteOptions struct {
+	// Context is the context of the hook execution. Defaults to context.Background().
+	Context context.Context
+
 	// Event is the hook event (e.g., "after.terraform.plan").
 	Event string
 
@@ -145,7 +151,13 @@ func buildHookContext(opts ExecuteOptions, platform provider.Provider) *plugin.H
 	eventPrefix := extractEventPrefix(opts.Event)
 	loader := templates.NewLoader(opts.AtmosConfig)
 
+	hookCtx := opts.Context
+	if hookCtx == nil {
+		hookCtx = context.Background()
+	}
+
 	return &plugin.HookContext{
+		Context:        hookCtx,
 		Event:          opts.Event,
 		Command:        command,
 		EventPrefix:    eventPrefix,
@@ -177,6 +189,13 @@ func createPlanfileStore(opts ExecuteOptions) (planfile.Store, error) {
 			if storeSpec, ok := planfilesConfig.Stores[planfilesConfig.Default]; ok {
 				artOpts.Type = storeSpec.Type
 				artOpts.Options = storeSpec.Options
+				if err := planfile.ResolveAuthContext(&artOpts); err != nil {
+					return nil, err
+				}
+				// Without a store identity, use the credentials of the component's identity.
+				if artOpts.AuthContext == nil && opts.Info != nil {
+					artOpts.AuthContext = opts.Info.AuthContext
+				}
 				backend, err := artifact.NewStore(artOpts)
 				if err != nil {
 					return nil, err
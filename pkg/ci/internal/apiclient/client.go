@@ -0,0 +1,164 @@
+// Package apiclient provides the minimal JSON REST API client shared by the CI providers.
+// Providers supply the parts that differ between platforms: the API address, the authentication header,
+// the error returned for failed requests and the query parameters sent with every request.
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// DefaultTimeout is the timeout for CI provider API requests.
+	DefaultTimeout = 30 * time.Second
+
+	// maxErrorBodySize limits how much of an API error response is included in error messages.
+	maxErrorBodySize = 1024
+)
+
+// APIError is returned when the API responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Message    string
+
+	// Err is the request failure error of the provider, e.g. ErrGitLabAPIRequestFailed.
+	Err error
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s %s: %d %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// Unwrap returns the request failure error of the provider, so callers can match all API errors of a provider.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Options configures the platform-specific parts of a Client.
+type Options struct {
+	// Authorize sets the authentication header of a request.
+	Authorize func(req *http.Request)
+
+	// ErrRequestFailed is wrapped by every error of a failed request.
+	ErrRequestFailed error
+
+	// Query is added to every request that doesn't set the same parameters, e.g. an API version.
+	Query url.Values
+}
+
+// Client calls a JSON REST API.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	opts       Options
+}
+
+// New creates a new Client for the API at baseURL.
+func New(httpClient *http.Client, baseURL string, opts Options) *Client {
+	defer perf.Track(nil, "apiclient.New")()
+
+	return &Client{
+		httpClient: httpClient,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		opts:       opts,
+	}
+}
+
+// BaseURL returns the API address.
+func (c *Client) BaseURL() string {
+	defer perf.Track(nil, "apiclient.Client.BaseURL")()
+
+	return c.baseURL
+}
+
+// Get calls a GET endpoint and decodes the JSON response into out.
+func (c *Client) Get(ctx context.Context, path string, query url.Values, out any) error {
+	defer perf.Track(nil, "apiclient.Client.Get")()
+
+	return c.do(ctx, http.MethodGet, path, query, nil, out)
+}
+
+// Post calls a POST endpoint with a JSON body and decodes the JSON response into out.
+func (c *Client) Post(ctx context.Context, path string, body, out any) error {
+	defer perf.Track(nil, "apiclient.Client.Post")()
+
+	return c.do(ctx, http.MethodPost, path, nil, body, out)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url(path, query), reader)
+	if err != nil {
+		return err
+	}
+	if c.opts.Authorize != nil {
+		c.opts.Authorize(req)
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: %w", c.opts.ErrRequestFailed, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			Method:     method,
+			Path:       path,
+			Message:    strings.TrimSpace(string(message)),
+			Err:        c.opts.ErrRequestFailed,
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("%w: decoding response of %s %s: %w", c.opts.ErrRequestFailed, method, path, err)
+	}
+	return nil
+}
+
+// url returns the address of an endpoint with the query of the request and the default query of the client.
+func (c *Client) url(path string, query url.Values) string {
+	merged := url.Values{}
+	for key, values := range query {
+		merged[key] = values
+	}
+	for key, values := range c.opts.Query {
+		if _, ok := merged[key]; !ok {
+			merged[key] = values
+		}
+	}
+
+	if len(merged) == 0 {
+		return c.baseURL + path
+	}
+	return c.baseURL + path + "?" + merged.Encode()
+}
@@ -0,0 +1,79 @@
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errTestRequestFailed = errors.New("test API request failed")
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return New(server.Client(), server.URL+"/", Options{
+		Authorize: func(req *http.Request) {
+			req.Header.Set("Authorization", "Bearer token")
+		},
+		ErrRequestFailed: errTestRequestFailed,
+		Query:            url.Values{"api-version": {"1.0"}},
+	})
+}
+
+func TestClient_Get(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/items", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "1.0", r.URL.Query().Get("api-version"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		_, _ = w.Write([]byte(`{"name":"vpc"}`))
+	})
+
+	var out struct {
+		Name string `json:"name"`
+	}
+	require.NoError(t, client.Get(context.Background(), "/items", url.Values{"page": {"2"}}, &out))
+	assert.Equal(t, "vpc", out.Name)
+}
+
+func TestClient_RequestQueryOverridesDefault(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "5.1", r.URL.Query().Get("api-version"))
+	})
+
+	require.NoError(t, client.Get(context.Background(), "/items", url.Values{"api-version": {"5.1"}}, nil))
+}
+
+func TestClient_Post(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	require.NoError(t, client.Post(context.Background(), "/items", map[string]string{"name": "vpc"}, nil))
+}
+
+func TestClient_APIError(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "forbidden", http.StatusForbidden)
+	})
+
+	err := client.Get(context.Background(), "/items", nil, nil)
+	require.ErrorIs(t, err, errTestRequestFailed)
+
+	var apiErr *APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	assert.Equal(t, "/items", apiErr.Path)
+	assert.Equal(t, "forbidden", apiErr.Message)
+}
@@ -2,6 +2,8 @@
 package plugin
 
 import (
+	"context"
+
 	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
 	"github.com/cloudposse/atmos/pkg/ci/templates"
 	"github.com/cloudposse/atmos/pkg/perf"
@@ -16,6 +18,9 @@ type HookHandler func(ctx *HookContext) error
 
 // HookContext provides everything a plugin callback needs to execute CI actions.
 type HookContext struct {
+	// Context is the context of the hook execution, used for CI platform API calls.
+	Context context.Context
+
 	// Event is the full hook event (e.g., "after.terraform.plan").
 	Event string
 
@@ -106,6 +111,8 @@ type OutputResult struct {
 	// Data contains component-specific parsed data.
 	// For terraform: *TerraformOutputData
 	// For helmfile: *HelmfileOutputData
+	// For packer: *PackerOutputData
+	// For ansible: *AnsibleOutputData
 	Data any
 }
 
@@ -182,6 +189,10 @@ type ResourceCounts struct {
 type HelmfileOutputData struct {
 	// Releases contains information about releases.
 	Releases []ReleaseInfo
+
+	// ResourceCounts contains Kubernetes resource change counts from the diff.
+	// Create counts added resources, Change counts changed resources and Destroy counts removed resources.
+	ResourceCounts ResourceCounts
 }
 
 // ReleaseInfo contains helmfile release information.
@@ -192,8 +203,58 @@ type ReleaseInfo struct {
 	// Namespace is the Kubernetes namespace.
 	Namespace string
 
-	// Status is the release status.
+	// Chart is the chart of the release.
+	Chart string
+
+	// Status is the release status ("unchanged", "changed", "updated", "deleted" or "failed").
+	Status string
+}
+
+// PackerOutputData contains packer-specific output data.
+type PackerOutputData struct {
+	// Builds contains the result of each build, in the order they were reported.
+	Builds []PackerBuild
+}
+
+// PackerBuild contains the result of a single packer build.
+type PackerBuild struct {
+	// Name is the build name (e.g., "amazon-ebs.ubuntu").
+	Name string
+
+	// Status is the build status ("success" or "failed").
 	Status string
+
+	// Artifact is the description of the artifact (e.g., "AMIs were created:").
+	Artifact string
+
+	// ArtifactIDs contains the artifact identifiers (e.g., "us-east-1: ami-0123456789abcdef0").
+	ArtifactIDs []string
+
+	// Error is the build error message when the build failed.
+	Error string
+}
+
+// AnsibleOutputData contains ansible-specific output data.
+type AnsibleOutputData struct {
+	// Hosts contains the PLAY RECAP statistics of each host.
+	Hosts []AnsibleHostStats
+
+	// Totals contains the statistics summed across all hosts.
+	Totals AnsibleHostStats
+}
+
+// AnsibleHostStats contains the PLAY RECAP task counts of a host.
+type AnsibleHostStats struct {
+	// Host is the host name. Empty for totals.
+	Host string
+
+	Ok          int
+	Changed     int
+	Unreachable int
+	Failed      int
+	Skipped     int
+	Rescued     int
+	Ignored     int
 }
 
 // TemplateContext contains all data available to CI summary templates.
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// CommentBehavior controls how a comment is published when one with the same marker already exists.
+type CommentBehavior string
+
+const (
+	// CommentBehaviorCreate always creates a new comment.
+	CommentBehaviorCreate CommentBehavior = "create"
+
+	// CommentBehaviorUpdate updates the existing comment, and fails if there is none.
+	CommentBehaviorUpdate CommentBehavior = "update"
+
+	// CommentBehaviorUpsert updates the existing comment, or creates one if there is none.
+	CommentBehaviorUpsert CommentBehavior = "upsert"
+)
+
+// Commenter is implemented by providers that can publish comments on pull/merge requests.
+// It's an optional capability: callers type-assert the Provider and skip comments when it's not implemented.
+type Commenter interface {
+	// UpsertComment creates or updates the comment identified by the marker on a pull/merge request.
+	UpsertComment(ctx context.Context, opts *UpsertCommentOptions) (*Comment, error)
+}
+
+// UpsertCommentOptions contains options for publishing a pull/merge request comment.
+type UpsertCommentOptions struct {
+	// Owner is the repository owner.
+	Owner string
+
+	// Repo is the repository name.
+	Repo string
+
+	// Number is the pull/merge request number.
+	Number int
+
+	// Marker is a hidden HTML comment that identifies the comment across runs (see FormatCommentMarker).
+	Marker string
+
+	// Body is the Markdown body of the comment, without the marker.
+	Body string
+
+	// Behavior controls whether an existing comment is updated. Defaults to CommentBehaviorUpsert.
+	Behavior CommentBehavior
+}
+
+// Comment is a published pull/merge request comment.
+type Comment struct {
+	// ID is the unique identifier of the comment.
+	ID int64
+
+	// URL is the link to the comment.
+	URL string
+
+	// Updated is true when an existing comment was updated rather than a new one created.
+	Updated bool
+}
+
+// FormatCommentMarker creates the hidden HTML marker that identifies an Atmos comment.
+// Parts are joined with "/" separator.
+// Example: FormatCommentMarker("terraform", "dev", "vpc") -> "<!-- atmos:terraform/dev/vpc -->".
+func FormatCommentMarker(parts ...string) string {
+	defer perf.Track(nil, "provider.FormatCommentMarker")()
+
+	return "<!-- atmos:" + strings.Join(parts, "/") + " -->"
+}
@@ -0,0 +1,12 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatCommentMarker(t *testing.T) {
+	assert.Equal(t, "<!-- atmos:terraform/dev/vpc -->", FormatCommentMarker("terraform", "dev", "vpc"))
+	assert.Equal(t, "<!-- atmos: -->", FormatCommentMarker())
+}
@@ -3,6 +3,8 @@ package provider
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -15,6 +17,9 @@ const (
 	outputFilePermissions = 0o644
 )
 
+// invalidEnvKeyChars matches the characters that are not valid in environment variable names.
+var invalidEnvKeyChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
 // NoopOutputWriter is an OutputWriter that does nothing.
 // Used when not running in CI or when CI outputs are disabled.
 type NoopOutputWriter struct{}
@@ -89,15 +94,94 @@ func (w *FileOutputWriter) WriteSummary(content string) error {
 	if w.SummaryPath == "" {
 		return nil
 	}
+	return AppendSummary(w.SummaryPath, content)
+}
+
+// EnvFileOutputWriter writes outputs to a file of environment variable assignments, for platforms
+// without step outputs that pass the file to later jobs as an artifact.
+// Summaries are written to a file when a path is set, and to the job log otherwise.
+type EnvFileOutputWriter struct {
+	OutputPath  string
+	SummaryPath string
+
+	// FormatLine returns the line written for an output, or false to skip the output.
+	// The key is already sanitized to a valid environment variable name.
+	FormatLine func(key, value string) (string, bool)
+}
+
+// NewEnvFileOutputWriter creates a new EnvFileOutputWriter.
+func NewEnvFileOutputWriter(outputPath, summaryPath string, formatLine func(key, value string) (string, bool)) *EnvFileOutputWriter {
+	defer perf.Track(nil, "provider.NewEnvFileOutputWriter")()
 
-	f, err := os.OpenFile(w.SummaryPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, outputFilePermissions)
+	return &EnvFileOutputWriter{
+		OutputPath:  outputPath,
+		SummaryPath: summaryPath,
+		FormatLine:  formatLine,
+	}
+}
+
+// WriteOutput appends the line of an output to the output file.
+// Characters that are not valid in variable names are replaced with underscores.
+func (w *EnvFileOutputWriter) WriteOutput(key, value string) error {
+	defer perf.Track(nil, "provider.EnvFileOutputWriter.WriteOutput")()
+
+	if w.OutputPath == "" {
+		return nil
+	}
+
+	line, ok := w.FormatLine(invalidEnvKeyChars.ReplaceAllString(key, "_"), value)
+	if !ok {
+		return nil
+	}
+
+	f, err := os.OpenFile(w.OutputPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, outputFilePermissions)
 	if err != nil {
-		return fmt.Errorf("%w: failed to open summary file: %w", errUtils.ErrCISummaryWriteFailed, err)
+		return fmt.Errorf("%w: failed to open output file: %w", errUtils.ErrCIOutputWriteFailed, err)
 	}
 	defer f.Close()
 
-	_, err = f.WriteString(content)
+	if _, err := fmt.Fprintln(f, line); err != nil {
+		return fmt.Errorf("%w: failed to write output: %w", errUtils.ErrCIOutputWriteFailed, err)
+	}
+	return nil
+}
+
+// WriteSummary appends content to the summary file, or writes it to the job log.
+func (w *EnvFileOutputWriter) WriteSummary(content string) error {
+	defer perf.Track(nil, "provider.EnvFileOutputWriter.WriteSummary")()
+
+	if w.SummaryPath == "" {
+		fmt.Fprintln(os.Stderr, content)
+		return nil
+	}
+	return AppendSummary(w.SummaryPath, content)
+}
+
+// EnvFilePath returns the output file set by ATMOS_CI_OUTPUT, or defaultFile in the directory
+// set by dirEnv (e.g., the checkout directory of the job).
+func EnvFilePath(dirEnv, defaultFile string) string {
+	defer perf.Track(nil, "provider.EnvFilePath")()
+
+	if path := os.Getenv("ATMOS_CI_OUTPUT"); path != "" {
+		return path
+	}
+	if dir := os.Getenv(dirEnv); dir != "" {
+		return filepath.Join(dir, defaultFile)
+	}
+	return defaultFile
+}
+
+// AppendSummary appends content to a summary file, creating it if needed.
+func AppendSummary(path, content string) error {
+	defer perf.Track(nil, "provider.AppendSummary")()
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, outputFilePermissions)
 	if err != nil {
+		return fmt.Errorf("%w: failed to open summary file: %w", errUtils.ErrCISummaryWriteFailed, err)
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(content); err != nil {
 		return fmt.Errorf("%w: failed to write summary: %w", errUtils.ErrCISummaryWriteFailed, err)
 	}
 	return nil
@@ -136,6 +136,46 @@ func TestFileOutputWriter_WriteSummary_Append(t *testing.T) {
 	assert.Equal(t, "Part 1\nPart 2\n", string(content))
 }
 
+func TestEnvFileOutputWriter_WriteOutput(t *testing.T) {
+	tmpDir := t.TempDir()
+	outputPath := filepath.Join(tmpDir, "atmos.env")
+
+	w := NewEnvFileOutputWriter(outputPath, "", func(key, value string) (string, bool) {
+		return key + "=" + value, value != "skip"
+	})
+	require.NoError(t, w.WriteOutput("output_vpc-id", "vpc-123"))
+	require.NoError(t, w.WriteOutput("ignored", "skip"))
+
+	content, err := os.ReadFile(outputPath)
+	require.NoError(t, err)
+	assert.Equal(t, "output_vpc_id=vpc-123\n", string(content))
+}
+
+func TestEnvFileOutputWriter_WriteSummary(t *testing.T) {
+	tmpDir := t.TempDir()
+	summaryPath := filepath.Join(tmpDir, "summary.md")
+
+	w := NewEnvFileOutputWriter("", summaryPath, nil)
+	require.NoError(t, w.WriteSummary("## Plan\n"))
+	require.NoError(t, w.WriteSummary("3 to add\n"))
+
+	content, err := os.ReadFile(summaryPath)
+	require.NoError(t, err)
+	assert.Equal(t, "## Plan\n3 to add\n", string(content))
+}
+
+func TestEnvFilePath(t *testing.T) {
+	t.Setenv("ATMOS_CI_OUTPUT", "")
+	t.Setenv("TEST_CHECKOUT_DIR", "")
+	assert.Equal(t, "atmos.env", EnvFilePath("TEST_CHECKOUT_DIR", "atmos.env"))
+
+	t.Setenv("TEST_CHECKOUT_DIR", "/build")
+	assert.Equal(t, filepath.Join("/build", "atmos.env"), EnvFilePath("TEST_CHECKOUT_DIR", "atmos.env"))
+
+	t.Setenv("ATMOS_CI_OUTPUT", "outputs.env")
+	assert.Equal(t, "outputs.env", EnvFilePath("TEST_CHECKOUT_DIR", "atmos.env"))
+}
+
 func TestNewOutputHelpers(t *testing.T) {
 	writer := &NoopOutputWriter{}
 	helpers := NewOutputHelpers(writer)
@@ -0,0 +1,365 @@
+// Package runner implements the CI actions shared by component plugins that report a single
+// command result: commit status checks, job summaries and output variables.
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// Runner runs the CI actions of a component plugin.
+// Plugins configure it with their parser, templates and output variables, and bind
+// OnBefore and OnAfter as the handlers of their before and after events.
+type Runner struct {
+	// ComponentType is the component type (e.g., "helmfile"). It selects template overrides
+	// and is part of the commit status context.
+	ComponentType string
+
+	// Templates contains the embedded default templates, named templates/<command>.md.
+	Templates fs.FS
+
+	// Parse parses the command output into a result.
+	Parse func(output, command string) *plugin.OutputResult
+
+	// TemplateContext extends the base template context with component-specific fields.
+	// When nil, templates receive the base context.
+	TemplateContext func(base *plugin.TemplateContext) any
+
+	// Variables returns the component-specific output variables of a result. Optional.
+	Variables func(result *plugin.OutputResult) map[string]string
+
+	// Describe returns the commit status description of a successful result.
+	Describe func(result *plugin.OutputResult) string
+}
+
+// OnBefore handles the before event of a command.
+// Creates a commit status with pending state.
+func (r *Runner) OnBefore(ctx *plugin.HookContext) error {
+	defer perf.Track(ctx.Config, "runner.Runner.OnBefore")()
+
+	if isCheckEnabled(ctx.Config) {
+		if err := r.createCheckRun(ctx); err != nil {
+			logCheckRunError("CI check run creation skipped", err)
+		}
+	}
+	return nil
+}
+
+// OnAfter handles the after event of a command.
+// Writes the summary and outputs, and updates the commit status. All actions are warn-only.
+func (r *Runner) OnAfter(ctx *plugin.HookContext) error {
+	defer perf.Track(ctx.Config, "runner.Runner.OnAfter")()
+
+	result := r.ParseOutputWithError(ctx)
+
+	var renderedSummary string
+	if isSummaryEnabled(ctx.Config) {
+		var err error
+		renderedSummary, err = r.writeSummary(ctx, result)
+		if err != nil {
+			log.Warn("CI summary failed", "error", err)
+		}
+	}
+
+	if isOutputEnabled(ctx.Config) {
+		if err := r.writeOutputs(ctx, result, renderedSummary); err != nil {
+			log.Warn("CI output failed", "error", err)
+		}
+	}
+
+	if isCheckEnabled(ctx.Config) {
+		if err := r.updateCheckRun(ctx, result); err != nil {
+			logCheckRunError("CI check run update skipped", err)
+		}
+	}
+
+	return nil
+}
+
+// ParseOutputWithError parses the command output and enriches the result with the command error.
+func (r *Runner) ParseOutputWithError(ctx *plugin.HookContext) *plugin.OutputResult {
+	defer perf.Track(ctx.Config, "runner.Runner.ParseOutputWithError")()
+
+	result := r.Parse(ctx.Output, ctx.Command)
+
+	if ctx.CommandError != nil {
+		result.HasErrors = true
+		if result.ExitCode == 0 {
+			result.ExitCode = 1
+		}
+		if len(result.Errors) == 0 {
+			result.Errors = []string{ctx.CommandError.Error()}
+		}
+	}
+
+	return result
+}
+
+// RenderTemplate builds the template context and renders the named template.
+func (r *Runner) RenderTemplate(ctx *plugin.HookContext, result *plugin.OutputResult, templateName string) (string, error) {
+	defer perf.Track(ctx.Config, "runner.Runner.RenderTemplate")()
+
+	base := &plugin.TemplateContext{
+		Component:     ctx.Info.ComponentFromArg,
+		ComponentType: r.ComponentType,
+		Stack:         ctx.Info.Stack,
+		Command:       ctx.Command,
+		CI:            ctx.CICtx,
+		Result:        result,
+		Output:        ctx.Output,
+		Custom:        make(map[string]any),
+	}
+
+	var tmplCtx any = base
+	if r.TemplateContext != nil {
+		tmplCtx = r.TemplateContext(base)
+	}
+
+	rendered, err := ctx.TemplateLoader.LoadAndRender(r.ComponentType, templateName, r.Templates, tmplCtx)
+	if err != nil {
+		return "", errUtils.Build(errUtils.ErrTemplateEvaluation).
+			WithCause(err).
+			WithExplanation("Failed to render template").
+			WithContext("template", templateName).
+			Err()
+	}
+	return rendered, nil
+}
+
+// writeSummary renders and writes the CI job summary.
+func (r *Runner) writeSummary(ctx *plugin.HookContext, result *plugin.OutputResult) (string, error) {
+	// Get template name - prefer config override, fall back to command name.
+	templateName := ctx.Command
+	if ctx.Config != nil && ctx.Config.CI.Summary.Template != "" {
+		templateName = ctx.Config.CI.Summary.Template
+	}
+
+	if templateName == "" {
+		return "", nil
+	}
+
+	writer := ctx.Provider.OutputWriter()
+	if writer == nil {
+		log.Debug("CI platform does not support summaries")
+		return "", nil
+	}
+
+	rendered, err := r.RenderTemplate(ctx, result, templateName)
+	if err != nil {
+		return "", err
+	}
+
+	if err := writer.WriteSummary(rendered); err != nil {
+		return "", errUtils.Build(errUtils.ErrCIOutputWriteFailed).
+			WithCause(err).
+			WithExplanation("Failed to write CI summary").
+			Err()
+	}
+
+	log.Debug("Wrote CI summary",
+		"stack", ctx.Info.Stack,
+		"component", ctx.Info.ComponentFromArg,
+		"template", templateName,
+	)
+	return rendered, nil
+}
+
+// writeOutputs writes CI output variables.
+func (r *Runner) writeOutputs(ctx *plugin.HookContext, result *plugin.OutputResult, renderedSummary string) error {
+	writer := ctx.Provider.OutputWriter()
+	if writer == nil {
+		log.Debug("CI platform does not support outputs")
+		return nil
+	}
+
+	vars := map[string]string{
+		"has_changes": strconv.FormatBool(result.HasChanges),
+		"has_errors":  strconv.FormatBool(result.HasErrors),
+		"exit_code":   strconv.Itoa(result.ExitCode),
+		"success":     strconv.FormatBool(!result.HasErrors),
+		"stack":       ctx.Info.Stack,
+		"component":   ctx.Info.ComponentFromArg,
+		"command":     ctx.Command,
+	}
+	if r.Variables != nil {
+		for key, value := range r.Variables(result) {
+			vars[key] = value
+		}
+	}
+	if renderedSummary != "" {
+		vars["summary"] = renderedSummary
+	}
+
+	// Filter by the configured whitelist if specified.
+	if ctx.Config != nil && len(ctx.Config.CI.Output.Variables) > 0 {
+		vars = filterVariables(vars, ctx.Config.CI.Output.Variables)
+	}
+
+	for key, value := range vars {
+		if err := writer.WriteOutput(key, value); err != nil {
+			log.Warn("Failed to write CI output", "key", key, "error", err)
+		}
+	}
+
+	log.Debug("Wrote CI outputs", "count", len(vars))
+	return nil
+}
+
+// createCheckRun creates a commit status with pending state.
+func (r *Runner) createCheckRun(ctx *plugin.HookContext) error {
+	name := r.statusContext(ctx)
+
+	opts := &provider.CreateCheckRunOptions{
+		Name:       name,
+		Status:     provider.CheckRunStateInProgress,
+		Title:      fmt.Sprintf("%s in progress...", ctx.Command),
+		DetailsURL: getGitHubActionsRunURL(),
+	}
+	if ctx.CICtx != nil {
+		opts.Owner = ctx.CICtx.RepoOwner
+		opts.Repo = ctx.CICtx.RepoName
+		opts.SHA = ctx.CICtx.SHA
+	}
+
+	checkRun, err := ctx.Provider.CreateCheckRun(context.Background(), opts)
+	if err != nil {
+		return errUtils.Build(errUtils.ErrCICheckRunCreateFailed).
+			WithCause(err).
+			WithContext("name", name).
+			Err()
+	}
+
+	log.Debug("Created commit status", "name", name, "id", checkRun.ID)
+	return nil
+}
+
+// updateCheckRun sets the final state of the commit status created by createCheckRun.
+func (r *Runner) updateCheckRun(ctx *plugin.HookContext, result *plugin.OutputResult) error {
+	name := r.statusContext(ctx)
+
+	status := provider.CheckRunStateSuccess
+	title := r.describe(result)
+	if ctx.CommandError != nil || result.HasErrors {
+		status = provider.CheckRunStateFailure
+		title = "Failed"
+	}
+
+	opts := &provider.UpdateCheckRunOptions{
+		Name:       name,
+		Status:     status,
+		Title:      title,
+		DetailsURL: getGitHubActionsRunURL(),
+	}
+	if ctx.CICtx != nil {
+		opts.Owner = ctx.CICtx.RepoOwner
+		opts.Repo = ctx.CICtx.RepoName
+		opts.SHA = ctx.CICtx.SHA
+	}
+
+	if _, err := ctx.Provider.UpdateCheckRun(context.Background(), opts); err != nil {
+		return errUtils.Build(errUtils.ErrCICheckRunUpdateFailed).
+			WithCause(err).
+			WithContext("name", name).
+			Err()
+	}
+
+	log.Debug("Updated commit status", "name", name, "status", status)
+	return nil
+}
+
+// statusContext returns the commit status context, e.g. "atmos/helmfile/diff/dev/nginx".
+// The component type keeps statuses apart from terraform components with the same name.
+func (r *Runner) statusContext(ctx *plugin.HookContext) string {
+	return provider.FormatStatusContext(getContextPrefix(ctx.Config), r.ComponentType, ctx.Command, ctx.Info.Stack, ctx.Info.ComponentFromArg)
+}
+
+// describe returns the commit status description of a successful result.
+func (r *Runner) describe(result *plugin.OutputResult) string {
+	if r.Describe != nil {
+		return r.Describe(result)
+	}
+	if result.HasChanges {
+		return "Changes detected"
+	}
+	return "No changes"
+}
+
+// isSummaryEnabled checks if summary action is enabled in config.
+func isSummaryEnabled(cfg *schema.AtmosConfiguration) bool {
+	if cfg == nil || cfg.CI.Summary.Enabled == nil {
+		return true
+	}
+	return *cfg.CI.Summary.Enabled
+}
+
+// isOutputEnabled checks if output action is enabled in config.
+func isOutputEnabled(cfg *schema.AtmosConfiguration) bool {
+	if cfg == nil || cfg.CI.Output.Enabled == nil {
+		return true
+	}
+	return *cfg.CI.Output.Enabled
+}
+
+// isCheckEnabled checks if check action is enabled in config.
+func isCheckEnabled(cfg *schema.AtmosConfiguration) bool {
+	if cfg == nil || cfg.CI.Checks.Enabled == nil {
+		return false
+	}
+	return *cfg.CI.Checks.Enabled
+}
+
+// getContextPrefix returns the context prefix from configuration, defaulting to "atmos".
+func getContextPrefix(cfg *schema.AtmosConfiguration) string {
+	if cfg != nil && cfg.CI.Checks.ContextPrefix != "" {
+		return cfg.CI.Checks.ContextPrefix
+	}
+	return "atmos"
+}
+
+// filterVariables filters a map of variables to only include those in the allowed list.
+func filterVariables(vars map[string]string, allowed []string) map[string]string {
+	allowedSet := make(map[string]bool, len(allowed))
+	for _, v := range allowed {
+		allowedSet[v] = true
+	}
+	filtered := make(map[string]string)
+	for k, v := range vars {
+		if allowedSet[k] {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
+
+// getGitHubActionsRunURL constructs the GitHub Actions run URL from environment variables.
+func getGitHubActionsRunURL() string {
+	serverURL := os.Getenv("GITHUB_SERVER_URL")
+	repo := os.Getenv("GITHUB_REPOSITORY")
+	runID := os.Getenv("GITHUB_RUN_ID")
+
+	if serverURL == "" || repo == "" || runID == "" {
+		return ""
+	}
+
+	return serverURL + "/" + repo + "/actions/runs/" + runID
+}
+
+// logCheckRunError logs check run errors at Debug level when the token is missing, else at Warn level.
+func logCheckRunError(msg string, err error) {
+	if errors.Is(err, errUtils.ErrGitHubTokenNotFound) {
+		log.Debug(msg, "reason", "GITHUB_TOKEN not set")
+		return
+	}
+	log.Warn(msg, "error", err)
+}
@@ -0,0 +1,205 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/ci/templates"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// boolPtr returns a pointer to a bool value.
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// mockOutputWriter captures WriteSummary and WriteOutput calls.
+type mockOutputWriter struct {
+	summaries []string
+	outputs   map[string]string
+}
+
+func (w *mockOutputWriter) WriteSummary(content string) error {
+	w.summaries = append(w.summaries, content)
+	return nil
+}
+
+func (w *mockOutputWriter) WriteOutput(key, value string) error {
+	w.outputs[key] = value
+	return nil
+}
+
+// mockProvider implements provider.Provider for testing.
+type mockProvider struct {
+	writer         *mockOutputWriter
+	checkRunCalls  []*provider.CreateCheckRunOptions
+	updateRunCalls []*provider.UpdateCheckRunOptions
+}
+
+func newMockProvider() *mockProvider {
+	return &mockProvider{writer: &mockOutputWriter{outputs: make(map[string]string)}}
+}
+
+func (m *mockProvider) Name() string                        { return "test" }
+func (m *mockProvider) Detect() bool                        { return true }
+func (m *mockProvider) Context() (*provider.Context, error) { return &provider.Context{}, nil }
+func (m *mockProvider) OutputWriter() provider.OutputWriter { return m.writer }
+func (m *mockProvider) GetStatus(_ context.Context, _ provider.StatusOptions) (*provider.Status, error) {
+	return &provider.Status{}, nil
+}
+
+func (m *mockProvider) CreateCheckRun(_ context.Context, opts *provider.CreateCheckRunOptions) (*provider.CheckRun, error) {
+	m.checkRunCalls = append(m.checkRunCalls, opts)
+	return &provider.CheckRun{ID: 1, Name: opts.Name, Status: opts.Status}, nil
+}
+
+func (m *mockProvider) UpdateCheckRun(_ context.Context, opts *provider.UpdateCheckRunOptions) (*provider.CheckRun, error) {
+	m.updateRunCalls = append(m.updateRunCalls, opts)
+	return &provider.CheckRun{ID: 1, Name: opts.Name, Status: opts.Status}, nil
+}
+
+func (m *mockProvider) ResolveBase() (*provider.BaseResolution, error) {
+	return nil, nil
+}
+
+// testTemplateContext is a component-specific template context.
+type testTemplateContext struct {
+	*plugin.TemplateContext
+	Count int
+}
+
+// newTestRunner returns a runner whose parser counts the lines of the output.
+func newTestRunner() *Runner {
+	return &Runner{
+		ComponentType: "packer",
+		Templates: fstest.MapFS{
+			"templates/build.md": {Data: []byte("## {{ .Command }} `{{ .Component }}` in `{{ .Stack }}`: {{ .Count }} lines")},
+		},
+		Parse: func(output, _ string) *plugin.OutputResult {
+			return &plugin.OutputResult{HasChanges: output != "", Data: len(output)}
+		},
+		TemplateContext: func(base *plugin.TemplateContext) any {
+			count, _ := base.Result.Data.(int)
+			return &testTemplateContext{TemplateContext: base, Count: count}
+		},
+		Variables: func(result *plugin.OutputResult) map[string]string {
+			count, _ := result.Data.(int)
+			return map[string]string{"count": strconv.Itoa(count)}
+		},
+		Describe: func(result *plugin.OutputResult) string {
+			return "Built"
+		},
+	}
+}
+
+func newHookContext(mp *mockProvider, cfg *schema.AtmosConfiguration) *plugin.HookContext {
+	return &plugin.HookContext{
+		Config:         cfg,
+		Provider:       mp,
+		Command:        "build",
+		Output:         "abc",
+		TemplateLoader: templates.NewLoader(cfg),
+		CICtx:          &provider.Context{RepoOwner: "owner", RepoName: "repo", SHA: "abc123"},
+		Info: &schema.ConfigAndStacksInfo{
+			Stack:            "dev",
+			ComponentFromArg: "ami",
+		},
+	}
+}
+
+func TestOnBefore(t *testing.T) {
+	t.Run("creates a pending commit status when checks are enabled", func(t *testing.T) {
+		mp := newMockProvider()
+		ctx := newHookContext(mp, &schema.AtmosConfiguration{
+			CI: schema.CIConfig{Checks: schema.CIChecksConfig{Enabled: boolPtr(true)}},
+		})
+
+		require.NoError(t, newTestRunner().OnBefore(ctx))
+
+		require.Len(t, mp.checkRunCalls, 1)
+		assert.Equal(t, "atmos/packer/build/dev/ami", mp.checkRunCalls[0].Name)
+		assert.Equal(t, provider.CheckRunStateInProgress, mp.checkRunCalls[0].Status)
+		assert.Equal(t, "abc123", mp.checkRunCalls[0].SHA)
+	})
+
+	t.Run("does nothing when checks are disabled", func(t *testing.T) {
+		mp := newMockProvider()
+		require.NoError(t, newTestRunner().OnBefore(newHookContext(mp, &schema.AtmosConfiguration{})))
+		assert.Empty(t, mp.checkRunCalls)
+	})
+}
+
+func TestOnAfter(t *testing.T) {
+	cfg := &schema.AtmosConfiguration{
+		CI: schema.CIConfig{Checks: schema.CIChecksConfig{Enabled: boolPtr(true), ContextPrefix: "ci"}},
+	}
+
+	t.Run("writes summary, outputs and a successful commit status", func(t *testing.T) {
+		mp := newMockProvider()
+		require.NoError(t, newTestRunner().OnAfter(newHookContext(mp, cfg)))
+
+		require.Len(t, mp.writer.summaries, 1)
+		assert.Equal(t, "## build `ami` in `dev`: 3 lines", mp.writer.summaries[0])
+
+		assert.Equal(t, "true", mp.writer.outputs["has_changes"])
+		assert.Equal(t, "true", mp.writer.outputs["success"])
+		assert.Equal(t, "3", mp.writer.outputs["count"])
+		assert.Equal(t, "dev", mp.writer.outputs["stack"])
+		assert.Equal(t, "ami", mp.writer.outputs["component"])
+		assert.Equal(t, "build", mp.writer.outputs["command"])
+		assert.Equal(t, mp.writer.summaries[0], mp.writer.outputs["summary"])
+
+		require.Len(t, mp.updateRunCalls, 1)
+		assert.Equal(t, "ci/packer/build/dev/ami", mp.updateRunCalls[0].Name)
+		assert.Equal(t, provider.CheckRunStateSuccess, mp.updateRunCalls[0].Status)
+		assert.Equal(t, "Built", mp.updateRunCalls[0].Title)
+	})
+
+	t.Run("reports command failures", func(t *testing.T) {
+		mp := newMockProvider()
+		ctx := newHookContext(mp, cfg)
+		ctx.CommandError = errors.New("build failed")
+
+		require.NoError(t, newTestRunner().OnAfter(ctx))
+
+		assert.Equal(t, "false", mp.writer.outputs["success"])
+		assert.Equal(t, "1", mp.writer.outputs["exit_code"])
+		require.Len(t, mp.updateRunCalls, 1)
+		assert.Equal(t, provider.CheckRunStateFailure, mp.updateRunCalls[0].Status)
+		assert.Equal(t, "Failed", mp.updateRunCalls[0].Title)
+	})
+
+	t.Run("filters outputs by the configured variables", func(t *testing.T) {
+		mp := newMockProvider()
+		ctx := newHookContext(mp, &schema.AtmosConfiguration{
+			CI: schema.CIConfig{
+				Summary: schema.CISummaryConfig{Enabled: boolPtr(false)},
+				Output:  schema.CIOutputConfig{Variables: []string{"count"}},
+			},
+		})
+
+		require.NoError(t, newTestRunner().OnAfter(ctx))
+
+		assert.Empty(t, mp.writer.summaries)
+		assert.Equal(t, map[string]string{"count": "3"}, mp.writer.outputs)
+		assert.Empty(t, mp.updateRunCalls)
+	})
+}
+
+func TestParseOutputWithError(t *testing.T) {
+	ctx := &plugin.HookContext{Output: "", CommandError: errors.New("exit status 2")}
+
+	result := newTestRunner().ParseOutputWithError(ctx)
+
+	assert.True(t, result.HasErrors)
+	assert.Equal(t, 1, result.ExitCode)
+	assert.Equal(t, []string{"exit status 2"}, result.Errors)
+}
@@ -0,0 +1,120 @@
+package ansible
+
+import (
+	"bufio"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// Regular expressions for parsing ansible-playbook output.
+var (
+	// Matches the PLAY RECAP header:
+	//   PLAY RECAP *********************************************************************
+	playRecapRe = regexp.MustCompile(`^PLAY RECAP\b`)
+
+	// Matches PLAY RECAP host lines:
+	//   web1    : ok=3    changed=1    unreachable=0    failed=0    skipped=0    rescued=0    ignored=0
+	recapHostRe = regexp.MustCompile(`^(\S+)\s*:\s*(ok=\d+.*)$`)
+
+	// Matches the counters of a PLAY RECAP host line.
+	recapCounterRe = regexp.MustCompile(`(\w+)=(\d+)`)
+
+	// Matches task failures:
+	//   fatal: [web1]: FAILED! => {"changed": false, "msg": "..."}
+	//   fatal: [web2]: UNREACHABLE! => {"changed": false, "msg": "..."}
+	fatalRe = regexp.MustCompile(`^fatal: \[([^\]]+)\]: (.+)$`)
+
+	// Matches playbook errors:
+	//   ERROR! the playbook: site.yml could not be found
+	errorRe = regexp.MustCompile(`^ERROR! (.+)$`)
+)
+
+// ParseOutput parses ansible-playbook output.
+// Host statistics come from the PLAY RECAP; errors come from fatal task results and playbook errors.
+func ParseOutput(output, _ string) *plugin.OutputResult {
+	defer perf.Track(nil, "ansible.ParseOutput")()
+
+	data := &plugin.AnsibleOutputData{}
+	result := &plugin.OutputResult{Data: data}
+
+	inRecap := false
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if playRecapRe.MatchString(line) {
+			inRecap = true
+			continue
+		}
+
+		if inRecap {
+			if m := recapHostRe.FindStringSubmatch(line); m != nil {
+				data.Hosts = append(data.Hosts, parseRecapCounters(m[1], m[2]))
+				continue
+			}
+			if line != "" {
+				inRecap = false
+			}
+		}
+
+		if m := fatalRe.FindStringSubmatch(line); m != nil {
+			result.Errors = append(result.Errors, m[1]+": "+m[2])
+			continue
+		}
+		if m := errorRe.FindStringSubmatch(line); m != nil {
+			result.Errors = append(result.Errors, m[1])
+		}
+	}
+
+	for _, host := range data.Hosts {
+		data.Totals.Ok += host.Ok
+		data.Totals.Changed += host.Changed
+		data.Totals.Unreachable += host.Unreachable
+		data.Totals.Failed += host.Failed
+		data.Totals.Skipped += host.Skipped
+		data.Totals.Rescued += host.Rescued
+		data.Totals.Ignored += host.Ignored
+	}
+
+	// Fatal task results can be ignored by the playbook, so the recap decides when there is one.
+	result.HasChanges = data.Totals.Changed > 0
+	result.HasErrors = data.Totals.Failed > 0 || data.Totals.Unreachable > 0 || (len(data.Hosts) == 0 && len(result.Errors) > 0)
+	if result.HasErrors {
+		result.ExitCode = 1
+	}
+
+	return result
+}
+
+// parseRecapCounters parses the counters of a PLAY RECAP host line.
+func parseRecapCounters(host, counters string) plugin.AnsibleHostStats {
+	stats := plugin.AnsibleHostStats{Host: host}
+	for _, m := range recapCounterRe.FindAllStringSubmatch(counters, -1) {
+		value, err := strconv.Atoi(m[2])
+		if err != nil {
+			continue
+		}
+		switch m[1] {
+		case "ok":
+			stats.Ok = value
+		case "changed":
+			stats.Changed = value
+		case "unreachable":
+			stats.Unreachable = value
+		case "failed":
+			stats.Failed = value
+		case "skipped":
+			stats.Skipped = value
+		case "rescued":
+			stats.Rescued = value
+		case "ignored":
+			stats.Ignored = value
+		}
+	}
+	return stats
+}
@@ -0,0 +1,85 @@
+package ansible
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+)
+
+const changedOutput = `
+PLAY [webservers] **************************************************************
+
+TASK [Gathering Facts] *********************************************************
+ok: [web1]
+ok: [web2]
+
+TASK [Install nginx] ***********************************************************
+changed: [web1]
+ok: [web2]
+
+TASK [Check legacy service] ****************************************************
+fatal: [web2]: FAILED! => {"changed": false, "msg": "Could not find the requested service legacy"}
+...ignoring
+
+PLAY RECAP *********************************************************************
+web1                       : ok=2    changed=1    unreachable=0    failed=0    skipped=0    rescued=0    ignored=0
+web2                       : ok=3    changed=0    unreachable=0    failed=0    skipped=1    rescued=0    ignored=1
+`
+
+const failedOutput = `
+PLAY [webservers] **************************************************************
+
+TASK [Gathering Facts] *********************************************************
+fatal: [web3]: UNREACHABLE! => {"changed": false, "msg": "Failed to connect to the host via ssh"}
+ok: [web1]
+
+TASK [Install nginx] ***********************************************************
+fatal: [web1]: FAILED! => {"changed": false, "msg": "No package matching 'nginx' is available"}
+
+PLAY RECAP *********************************************************************
+web1                       : ok=1    changed=0    unreachable=0    failed=1    skipped=0    rescued=0    ignored=0
+web3                       : ok=0    changed=0    unreachable=1    failed=0    skipped=0    rescued=0    ignored=0
+`
+
+func TestParseOutput_Changed(t *testing.T) {
+	result := ParseOutput(changedOutput, "playbook")
+
+	assert.True(t, result.HasChanges)
+	assert.False(t, result.HasErrors, "ignored task failures must not fail the result")
+	assert.Equal(t, 0, result.ExitCode)
+
+	data, ok := result.Data.(*plugin.AnsibleOutputData)
+	require.True(t, ok)
+	assert.Equal(t, []plugin.AnsibleHostStats{
+		{Host: "web1", Ok: 2, Changed: 1},
+		{Host: "web2", Ok: 3, Skipped: 1, Ignored: 1},
+	}, data.Hosts)
+	assert.Equal(t, plugin.AnsibleHostStats{Ok: 5, Changed: 1, Skipped: 1, Ignored: 1}, data.Totals)
+}
+
+func TestParseOutput_Failed(t *testing.T) {
+	result := ParseOutput(failedOutput, "playbook")
+
+	assert.False(t, result.HasChanges)
+	assert.True(t, result.HasErrors)
+	assert.Equal(t, 1, result.ExitCode)
+	assert.Equal(t, []string{
+		`web3: UNREACHABLE! => {"changed": false, "msg": "Failed to connect to the host via ssh"}`,
+		`web1: FAILED! => {"changed": false, "msg": "No package matching 'nginx' is available"}`,
+	}, result.Errors)
+
+	data := result.Data.(*plugin.AnsibleOutputData)
+	assert.Equal(t, 1, data.Totals.Failed)
+	assert.Equal(t, 1, data.Totals.Unreachable)
+}
+
+func TestParseOutput_PlaybookError(t *testing.T) {
+	result := ParseOutput("ERROR! the playbook: site.yml could not be found\n", "playbook")
+
+	assert.True(t, result.HasErrors)
+	assert.Equal(t, []string{"the playbook: site.yml could not be found"}, result.Errors)
+	assert.Empty(t, result.Data.(*plugin.AnsibleOutputData).Hosts)
+}
@@ -0,0 +1,129 @@
+// Package ansible provides the CI Plugin implementation for Ansible.
+package ansible
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/internal/runner"
+	"github.com/cloudposse/atmos/pkg/perf"
+
+	ci "github.com/cloudposse/atmos/pkg/ci"
+)
+
+//go:embed templates/*.md
+var defaultTemplates embed.FS
+
+// Plugin implements plugin.Plugin for Ansible.
+type Plugin struct {
+	runner *runner.Runner
+}
+
+// Ensure Plugin implements plugin.Plugin.
+var _ plugin.Plugin = (*Plugin)(nil)
+
+func init() {
+	// Self-register on package import.
+	if err := ci.RegisterPlugin(New()); err != nil {
+		// Panic on registration failure - this is a programming error.
+		panic(fmt.Sprintf("failed to register ansible CI plugin: %v", err))
+	}
+}
+
+// New creates the Ansible CI plugin.
+func New() *Plugin {
+	defer perf.Track(nil, "ansible.New")()
+
+	return &Plugin{
+		runner: &runner.Runner{
+			ComponentType:   "ansible",
+			Templates:       defaultTemplates,
+			Parse:           ParseOutput,
+			TemplateContext: newTemplateContext,
+			Variables:       getOutputVariables,
+			Describe:        buildStatusDescription,
+		},
+	}
+}
+
+// GetType returns the component type.
+func (p *Plugin) GetType() string {
+	return "ansible"
+}
+
+// GetHookBindings returns the hook bindings for Ansible CI integration.
+func (p *Plugin) GetHookBindings() []plugin.HookBinding {
+	defer perf.Track(nil, "ansible.Plugin.GetHookBindings")()
+
+	return []plugin.HookBinding{
+		{Event: "before.ansible.playbook", Handler: p.runner.OnBefore},
+		{Event: "after.ansible.playbook", Handler: p.runner.OnAfter},
+	}
+}
+
+// TemplateContext extends the base TemplateContext with ansible-specific fields.
+type TemplateContext struct {
+	*plugin.TemplateContext
+
+	// Hosts contains the PLAY RECAP statistics of each host.
+	Hosts []plugin.AnsibleHostStats
+
+	// Totals contains the statistics summed across all hosts.
+	Totals plugin.AnsibleHostStats
+}
+
+// newTemplateContext creates a TemplateContext from the base context.
+func newTemplateContext(base *plugin.TemplateContext) any {
+	ctx := &TemplateContext{TemplateContext: base}
+	if base.Result != nil {
+		if data, ok := base.Result.Data.(*plugin.AnsibleOutputData); ok {
+			ctx.Hosts = data.Hosts
+			ctx.Totals = data.Totals
+		}
+	}
+	return ctx
+}
+
+// getOutputVariables returns the ansible-specific CI output variables.
+func getOutputVariables(result *plugin.OutputResult) map[string]string {
+	vars := make(map[string]string)
+
+	data, ok := result.Data.(*plugin.AnsibleOutputData)
+	if !ok {
+		return vars
+	}
+
+	vars["hosts"] = strconv.Itoa(len(data.Hosts))
+	vars["tasks_ok"] = strconv.Itoa(data.Totals.Ok)
+	vars["tasks_changed"] = strconv.Itoa(data.Totals.Changed)
+	vars["tasks_failed"] = strconv.Itoa(data.Totals.Failed)
+	vars["hosts_unreachable"] = strconv.Itoa(countUnreachableHosts(data))
+	return vars
+}
+
+// buildStatusDescription creates the commit status description, e.g. "3 changed on 2 hosts".
+func buildStatusDescription(result *plugin.OutputResult) string {
+	data, ok := result.Data.(*plugin.AnsibleOutputData)
+	if !ok || len(data.Hosts) == 0 {
+		return "No hosts"
+	}
+
+	hosts := "1 host"
+	if len(data.Hosts) != 1 {
+		hosts = fmt.Sprintf("%d hosts", len(data.Hosts))
+	}
+	return fmt.Sprintf("%d ok, %d changed on %s", data.Totals.Ok, data.Totals.Changed, hosts)
+}
+
+// countUnreachableHosts returns the number of hosts that could not be reached.
+func countUnreachableHosts(data *plugin.AnsibleOutputData) int {
+	count := 0
+	for _, host := range data.Hosts {
+		if host.Unreachable > 0 {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,94 @@
+package ansible
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/templates"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestPlugin_GetType(t *testing.T) {
+	assert.Equal(t, "ansible", New().GetType())
+}
+
+func TestPlugin_GetHookBindings(t *testing.T) {
+	bindings := plugin.HookBindings(New().GetHookBindings())
+
+	for _, event := range []string{"before.ansible.playbook", "after.ansible.playbook"} {
+		binding := bindings.GetBindingForEvent(event)
+		require.NotNil(t, binding, event)
+		assert.NotNil(t, binding.Handler, event)
+	}
+}
+
+func TestGetOutputVariables(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"hosts":             "2",
+		"tasks_ok":          "1",
+		"tasks_changed":     "0",
+		"tasks_failed":      "1",
+		"hosts_unreachable": "1",
+	}, getOutputVariables(ParseOutput(failedOutput, "playbook")))
+
+	assert.Empty(t, getOutputVariables(&plugin.OutputResult{}))
+}
+
+func TestBuildStatusDescription(t *testing.T) {
+	assert.Equal(t, "5 ok, 1 changed on 2 hosts", buildStatusDescription(ParseOutput(changedOutput, "playbook")))
+	assert.Equal(t, "No hosts", buildStatusDescription(ParseOutput("", "playbook")))
+}
+
+func TestTemplateRendering(t *testing.T) {
+	tests := []struct {
+		name         string
+		output       string
+		commandErr   error
+		wantContains []string
+	}{
+		{
+			name:   "changed hosts",
+			output: changedOutput,
+			wantContains: []string{
+				"## Playbook Changed Hosts for `webserver` in `dev`",
+				"PLAYBOOK-CHANGED",
+				"| `web1` | 2 | 1 | 0 | 0 | 0 | 0 | 0 |",
+				"| **Total** | 5 | 1 | 0 | 0 | 1 | 0 | 1 |",
+				"atmos ansible playbook webserver -s dev",
+			},
+		},
+		{
+			name:       "failed playbook",
+			output:     failedOutput,
+			commandErr: errors.New("exit status 2"),
+			wantContains: []string{
+				"## Playbook Failed for `webserver` in `dev`",
+				"PLAYBOOK-FAILED",
+				"No package matching 'nginx' is available",
+			},
+		},
+	}
+
+	p := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &plugin.HookContext{
+				Command:        "playbook",
+				Output:         tt.output,
+				CommandError:   tt.commandErr,
+				TemplateLoader: templates.NewLoader(nil),
+				Info:           &schema.ConfigAndStacksInfo{Stack: "dev", ComponentFromArg: "webserver"},
+			}
+
+			rendered, err := p.runner.RenderTemplate(ctx, p.runner.ParseOutputWithError(ctx), "playbook")
+			require.NoError(t, err)
+			for _, want := range tt.wantContains {
+				assert.Contains(t, rendered, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,206 @@
+package helmfile
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// Release statuses reported in plugin.ReleaseInfo.Status.
+const (
+	releaseStatusUnchanged = "unchanged"
+	releaseStatusChanged   = "changed"
+	releaseStatusUpdated   = "updated"
+	releaseStatusDeleted   = "deleted"
+	releaseStatusFailed    = "failed"
+)
+
+// Regular expressions for parsing helmfile and helm-diff output.
+var (
+	// Matches the helm-diff header of each release:
+	//   Comparing release=nginx, chart=bitnami/nginx, namespace=default
+	comparingReleaseRe = regexp.MustCompile(`^Comparing release=([^,\s]+), chart=([^,\s]+)(?:, namespace=([^,\s]+))?`)
+
+	// Matches helm-diff resource change headers:
+	//   default, nginx, Deployment (apps) has changed:
+	//   default, nginx-config, ConfigMap (v1) has been added:
+	//   default, old-secret, Secret (v1) has been removed:
+	resourceChangeRe = regexp.MustCompile(`^\S+, \S+, .+ has (changed|been added|been removed):\s*$`)
+
+	// Matches error lines from helmfile and helm.
+	errorRe = regexp.MustCompile(`^(?:Error|err|ERROR):\s*(.+)$`)
+)
+
+// releaseSections maps the release tables printed by helmfile apply/sync to release statuses.
+var releaseSections = map[string]string{
+	"UPDATED RELEASES:": releaseStatusUpdated,
+	"DELETED RELEASES:": releaseStatusDeleted,
+	"FAILED RELEASES:":  releaseStatusFailed,
+}
+
+// ParseOutput parses helmfile diff/apply/sync output.
+// Releases come from the helm-diff headers and the release tables of apply/sync;
+// resource counts come from the helm-diff resource change headers.
+func ParseOutput(output, command string) *plugin.OutputResult {
+	defer perf.Track(nil, "helmfile.ParseOutput")()
+
+	data := &plugin.HelmfileOutputData{}
+	result := &plugin.OutputResult{Data: data}
+
+	p := &outputParser{data: data, index: make(map[string]int), current: -1}
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		p.parseLine(strings.TrimRight(scanner.Text(), "\r"), result)
+	}
+
+	result.HasErrors = len(result.Errors) > 0
+	for _, release := range data.Releases {
+		if release.Status == releaseStatusFailed {
+			result.HasErrors = true
+		}
+	}
+	if result.HasErrors {
+		result.ExitCode = 1
+	}
+
+	// Diff reports pending changes; apply and sync report releases that were actually changed.
+	result.HasChanges = countChangedReleases(data) > 0
+	if command == "diff" {
+		resources := data.ResourceCounts
+		result.HasChanges = result.HasChanges || resources.Create+resources.Change+resources.Destroy > 0
+	}
+
+	return result
+}
+
+// countChangedReleases returns the number of releases with pending or applied changes.
+func countChangedReleases(data *plugin.HelmfileOutputData) int {
+	count := 0
+	for _, release := range data.Releases {
+		switch release.Status {
+		case releaseStatusChanged, releaseStatusUpdated, releaseStatusDeleted:
+			count++
+		}
+	}
+	return count
+}
+
+// outputParser holds the line parser state.
+type outputParser struct {
+	data *plugin.HelmfileOutputData
+
+	// index maps release names to their position in data.Releases.
+	index map[string]int
+
+	// current is the index of the release being diffed, or -1.
+	current int
+
+	// section is the release status of the table being read, and columns its header.
+	section string
+	columns []string
+}
+
+// parseLine parses a single output line.
+func (p *outputParser) parseLine(line string, result *plugin.OutputResult) {
+	trimmed := strings.TrimSpace(line)
+
+	// Release tables of apply/sync: a section title, a header row, then one row per release.
+	if status, ok := releaseSections[trimmed]; ok {
+		p.section = status
+		p.columns = nil
+		return
+	}
+	if p.section != "" {
+		p.parseTableLine(trimmed)
+		return
+	}
+
+	if m := comparingReleaseRe.FindStringSubmatch(trimmed); m != nil {
+		p.current = p.release(m[1], m[3], m[2])
+		return
+	}
+
+	if m := resourceChangeRe.FindStringSubmatch(trimmed); m != nil {
+		switch m[1] {
+		case "changed":
+			p.data.ResourceCounts.Change++
+		case "been added":
+			p.data.ResourceCounts.Create++
+		case "been removed":
+			p.data.ResourceCounts.Destroy++
+		}
+		if p.current >= 0 {
+			p.data.Releases[p.current].Status = releaseStatusChanged
+		}
+		return
+	}
+
+	if m := errorRe.FindStringSubmatch(trimmed); m != nil {
+		result.Errors = append(result.Errors, m[1])
+	}
+}
+
+// parseTableLine parses a line of a release table. A blank line ends the table.
+func (p *outputParser) parseTableLine(line string) {
+	if line == "" {
+		if p.columns != nil {
+			p.section = ""
+		}
+		return
+	}
+
+	fields := strings.Fields(line)
+	if p.columns == nil {
+		p.columns = fields
+		return
+	}
+
+	var name, namespace, chart string
+	for i, column := range p.columns {
+		if i >= len(fields) {
+			break
+		}
+		switch column {
+		case "NAME":
+			name = fields[i]
+		case "NAMESPACE":
+			namespace = fields[i]
+		case "CHART":
+			chart = fields[i]
+		}
+	}
+	if name == "" {
+		return
+	}
+
+	idx := p.release(name, namespace, chart)
+	p.data.Releases[idx].Status = p.section
+}
+
+// release returns the index of the named release, adding it as unchanged when it was not seen before.
+func (p *outputParser) release(name, namespace, chart string) int {
+	if idx, ok := p.index[name]; ok {
+		release := &p.data.Releases[idx]
+		if release.Namespace == "" {
+			release.Namespace = namespace
+		}
+		if release.Chart == "" {
+			release.Chart = chart
+		}
+		return idx
+	}
+
+	p.data.Releases = append(p.data.Releases, plugin.ReleaseInfo{
+		Name:      name,
+		Namespace: namespace,
+		Chart:     chart,
+		Status:    releaseStatusUnchanged,
+	})
+	idx := len(p.data.Releases) - 1
+	p.index[name] = idx
+	return idx
+}
@@ -0,0 +1,108 @@
+package helmfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+)
+
+const diffOutput = `Building dependency release=nginx, chart=bitnami/nginx
+Comparing release=nginx, chart=bitnami/nginx, namespace=web
+web, nginx, Deployment (apps) has changed:
+  # Source: nginx/templates/deployment.yaml
+  apiVersion: apps/v1
+-   replicas: 1
++   replicas: 2
+web, nginx-config, ConfigMap (v1) has been added:
++ apiVersion: v1
+Comparing release=redis, chart=bitnami/redis, namespace=cache
+`
+
+const applyOutput = `Comparing release=nginx, chart=bitnami/nginx, namespace=web
+web, nginx, Deployment (apps) has changed:
+-   replicas: 1
++   replicas: 2
+web, old-secret, Secret (v1) has been removed:
+- apiVersion: v1
+
+UPDATED RELEASES:
+NAME    NAMESPACE   CHART           VERSION   DURATION
+nginx   web         bitnami/nginx   15.0.0          12s
+
+
+DELETED RELEASES:
+NAME      DURATION
+legacy          2s
+`
+
+const failedSyncOutput = `Upgrading release=nginx, chart=bitnami/nginx
+
+FAILED RELEASES:
+NAME    NAMESPACE   CHART           VERSION   DURATION
+nginx   web         bitnami/nginx   15.0.0          30s
+
+in ./helmfile.yaml: failed processing release nginx: command "/usr/local/bin/helm" exited with non-zero status:
+Error: UPGRADE FAILED: timed out waiting for the condition
+`
+
+func TestParseOutput_Diff(t *testing.T) {
+	result := ParseOutput(diffOutput, "diff")
+
+	assert.True(t, result.HasChanges)
+	assert.False(t, result.HasErrors)
+
+	data, ok := result.Data.(*plugin.HelmfileOutputData)
+	require.True(t, ok)
+	assert.Equal(t, plugin.ResourceCounts{Create: 1, Change: 1}, data.ResourceCounts)
+	assert.Equal(t, []plugin.ReleaseInfo{
+		{Name: "nginx", Namespace: "web", Chart: "bitnami/nginx", Status: releaseStatusChanged},
+		{Name: "redis", Namespace: "cache", Chart: "bitnami/redis", Status: releaseStatusUnchanged},
+	}, data.Releases)
+}
+
+func TestParseOutput_DiffNoChanges(t *testing.T) {
+	result := ParseOutput("Comparing release=redis, chart=bitnami/redis, namespace=cache\n", "diff")
+
+	assert.False(t, result.HasChanges)
+	data := result.Data.(*plugin.HelmfileOutputData)
+	require.Len(t, data.Releases, 1)
+	assert.Equal(t, releaseStatusUnchanged, data.Releases[0].Status)
+}
+
+func TestParseOutput_Apply(t *testing.T) {
+	result := ParseOutput(applyOutput, "apply")
+
+	assert.True(t, result.HasChanges)
+	assert.False(t, result.HasErrors)
+
+	data := result.Data.(*plugin.HelmfileOutputData)
+	assert.Equal(t, plugin.ResourceCounts{Change: 1, Destroy: 1}, data.ResourceCounts)
+	assert.Equal(t, []plugin.ReleaseInfo{
+		{Name: "nginx", Namespace: "web", Chart: "bitnami/nginx", Status: releaseStatusUpdated},
+		{Name: "legacy", Status: releaseStatusDeleted},
+	}, data.Releases)
+}
+
+func TestParseOutput_FailedSync(t *testing.T) {
+	result := ParseOutput(failedSyncOutput, "sync")
+
+	assert.True(t, result.HasErrors)
+	assert.False(t, result.HasChanges)
+	assert.Equal(t, 1, result.ExitCode)
+	assert.Equal(t, []string{"UPGRADE FAILED: timed out waiting for the condition"}, result.Errors)
+
+	data := result.Data.(*plugin.HelmfileOutputData)
+	require.Len(t, data.Releases, 1)
+	assert.Equal(t, releaseStatusFailed, data.Releases[0].Status)
+}
+
+func TestParseOutput_Empty(t *testing.T) {
+	result := ParseOutput("", "apply")
+
+	assert.False(t, result.HasChanges)
+	assert.False(t, result.HasErrors)
+	assert.Empty(t, result.Data.(*plugin.HelmfileOutputData).Releases)
+}
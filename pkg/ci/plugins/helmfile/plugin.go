@@ -0,0 +1,125 @@
+// Package helmfile provides the CI Plugin implementation for Helmfile.
+package helmfile
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/internal/runner"
+	"github.com/cloudposse/atmos/pkg/perf"
+
+	ci "github.com/cloudposse/atmos/pkg/ci"
+)
+
+//go:embed templates/*.md
+var defaultTemplates embed.FS
+
+// Plugin implements plugin.Plugin for Helmfile.
+type Plugin struct {
+	runner *runner.Runner
+}
+
+// Ensure Plugin implements plugin.Plugin.
+var _ plugin.Plugin = (*Plugin)(nil)
+
+func init() {
+	// Self-register on package import.
+	if err := ci.RegisterPlugin(New()); err != nil {
+		// Panic on registration failure - this is a programming error.
+		panic(fmt.Sprintf("failed to register helmfile CI plugin: %v", err))
+	}
+}
+
+// New creates the Helmfile CI plugin.
+func New() *Plugin {
+	defer perf.Track(nil, "helmfile.New")()
+
+	return &Plugin{
+		runner: &runner.Runner{
+			ComponentType:   "helmfile",
+			Templates:       defaultTemplates,
+			Parse:           ParseOutput,
+			TemplateContext: newTemplateContext,
+			Variables:       getOutputVariables,
+			Describe:        buildStatusDescription,
+		},
+	}
+}
+
+// GetType returns the component type.
+func (p *Plugin) GetType() string {
+	return "helmfile"
+}
+
+// GetHookBindings returns the hook bindings for Helmfile CI integration.
+func (p *Plugin) GetHookBindings() []plugin.HookBinding {
+	defer perf.Track(nil, "helmfile.Plugin.GetHookBindings")()
+
+	return []plugin.HookBinding{
+		{Event: "before.helmfile.diff", Handler: p.runner.OnBefore},
+		{Event: "after.helmfile.diff", Handler: p.runner.OnAfter},
+		{Event: "before.helmfile.apply", Handler: p.runner.OnBefore},
+		{Event: "after.helmfile.apply", Handler: p.runner.OnAfter},
+		{Event: "before.helmfile.sync", Handler: p.runner.OnBefore},
+		{Event: "after.helmfile.sync", Handler: p.runner.OnAfter},
+	}
+}
+
+// TemplateContext extends the base TemplateContext with helmfile-specific fields.
+type TemplateContext struct {
+	*plugin.TemplateContext
+
+	// Releases contains the releases found in the output.
+	Releases []plugin.ReleaseInfo
+
+	// Resources contains Kubernetes resource change counts from the diff.
+	Resources plugin.ResourceCounts
+}
+
+// newTemplateContext creates a TemplateContext from the base context.
+func newTemplateContext(base *plugin.TemplateContext) any {
+	ctx := &TemplateContext{TemplateContext: base}
+	if base.Result != nil {
+		if data, ok := base.Result.Data.(*plugin.HelmfileOutputData); ok {
+			ctx.Releases = data.Releases
+			ctx.Resources = data.ResourceCounts
+		}
+	}
+	return ctx
+}
+
+// getOutputVariables returns the helmfile-specific CI output variables.
+func getOutputVariables(result *plugin.OutputResult) map[string]string {
+	vars := make(map[string]string)
+
+	data, ok := result.Data.(*plugin.HelmfileOutputData)
+	if !ok {
+		return vars
+	}
+
+	vars["releases"] = strconv.Itoa(len(data.Releases))
+	vars["releases_changed"] = strconv.Itoa(countChangedReleases(data))
+	vars["resources_to_add"] = strconv.Itoa(data.ResourceCounts.Create)
+	vars["resources_to_change"] = strconv.Itoa(data.ResourceCounts.Change)
+	vars["resources_to_remove"] = strconv.Itoa(data.ResourceCounts.Destroy)
+	return vars
+}
+
+// buildStatusDescription creates the commit status description, e.g. "2 releases changed".
+func buildStatusDescription(result *plugin.OutputResult) string {
+	changed := 0
+	if data, ok := result.Data.(*plugin.HelmfileOutputData); ok {
+		changed = countChangedReleases(data)
+	}
+
+	switch changed {
+	case 0:
+		return "No changes"
+	case 1:
+		return "1 release changed"
+	default:
+		return fmt.Sprintf("%d releases changed", changed)
+	}
+}
@@ -0,0 +1,124 @@
+package helmfile
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/templates"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestPlugin_GetType(t *testing.T) {
+	assert.Equal(t, "helmfile", New().GetType())
+}
+
+func TestPlugin_GetHookBindings(t *testing.T) {
+	bindings := plugin.HookBindings(New().GetHookBindings())
+
+	for _, event := range []string{
+		"before.helmfile.diff", "after.helmfile.diff",
+		"before.helmfile.apply", "after.helmfile.apply",
+		"before.helmfile.sync", "after.helmfile.sync",
+	} {
+		binding := bindings.GetBindingForEvent(event)
+		require.NotNil(t, binding, event)
+		assert.NotNil(t, binding.Handler, event)
+	}
+}
+
+func TestGetOutputVariables(t *testing.T) {
+	vars := getOutputVariables(ParseOutput(applyOutput, "apply"))
+
+	assert.Equal(t, map[string]string{
+		"releases":            "2",
+		"releases_changed":    "2",
+		"resources_to_add":    "0",
+		"resources_to_change": "1",
+		"resources_to_remove": "1",
+	}, vars)
+	assert.Empty(t, getOutputVariables(&plugin.OutputResult{}))
+}
+
+func TestBuildStatusDescription(t *testing.T) {
+	assert.Equal(t, "1 release changed", buildStatusDescription(ParseOutput(diffOutput, "diff")))
+	assert.Equal(t, "2 releases changed", buildStatusDescription(ParseOutput(applyOutput, "apply")))
+	assert.Equal(t, "No changes", buildStatusDescription(ParseOutput("", "diff")))
+}
+
+func TestTemplateRendering(t *testing.T) {
+	tests := []struct {
+		name         string
+		command      string
+		output       string
+		commandErr   error
+		wantContains []string
+	}{
+		{
+			name:    "diff with changes",
+			command: "diff",
+			output:  diffOutput,
+			wantContains: []string{
+				"## Release Changes Found for `nginx` in `dev`",
+				"DIFF-ADD-success",
+				"DIFF-CHANGE-important",
+				"| `nginx` | `web` | `bitnami/nginx` | changed |",
+				"| `redis` | `cache` | `bitnami/redis` | unchanged |",
+				"Diff: 1 to add, 1 to change, 0 to remove.",
+				"atmos helmfile diff nginx -s dev",
+				"+   replicas: 2",
+			},
+		},
+		{
+			name:         "diff without changes",
+			command:      "diff",
+			output:       "Comparing release=redis, chart=bitnami/redis, namespace=cache\n",
+			wantContains: []string{"## No Changes for `nginx` in `dev`", "NO_CHANGE"},
+		},
+		{
+			name:    "apply with removed resources",
+			command: "apply",
+			output:  applyOutput,
+			wantContains: []string{
+				"## Apply Succeeded for `nginx` in `dev`",
+				"Helmfile removed Kubernetes resources!",
+				"| `nginx` | `web` | `bitnami/nginx` | updated |",
+				"| `legacy` | `` | `` | deleted |",
+				"Resources: 0 added, 1 changed, 1 removed.",
+			},
+		},
+		{
+			name:       "failed sync",
+			command:    "sync",
+			output:     failedSyncOutput,
+			commandErr: errors.New("exit status 1"),
+			wantContains: []string{
+				"## Sync Failed for `nginx` in `dev`",
+				"SYNC-FAILED",
+				"UPGRADE FAILED: timed out waiting for the condition",
+			},
+		},
+	}
+
+	p := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &plugin.HookContext{
+				Command:        tt.command,
+				Output:         tt.output,
+				CommandError:   tt.commandErr,
+				TemplateLoader: templates.NewLoader(nil),
+				Info:           &schema.ConfigAndStacksInfo{Stack: "dev", ComponentFromArg: "nginx"},
+			}
+
+			rendered, err := p.runner.RenderTemplate(ctx, p.runner.ParseOutputWithError(ctx), tt.command)
+			require.NoError(t, err)
+			for _, want := range tt.wantContains {
+				assert.Contains(t, rendered, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package packer
+
+import (
+	"bufio"
+	"regexp"
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// Build statuses reported in plugin.PackerBuild.Status.
+const (
+	buildStatusSuccess = "success"
+	buildStatusFailed  = "failed"
+)
+
+// Regular expressions for parsing packer build output.
+var (
+	// Matches build completion lines:
+	//   Build 'amazon-ebs.ubuntu' finished after 3 minutes 12 seconds.
+	buildFinishedRe = regexp.MustCompile(`^Build '([^']+)' finished\b`)
+
+	// Matches build failure lines:
+	//   Build 'amazon-ebs.ubuntu' errored after 2 seconds: error validating ...
+	buildErroredRe = regexp.MustCompile(`^Build '([^']+)' errored(?: after [^:]+)?: (.+)$`)
+
+	// Matches the entries of the artifacts and errors sections:
+	//   --> amazon-ebs.ubuntu: AMIs were created:
+	sectionEntryRe = regexp.MustCompile(`^--> ([^:]+): (.*)$`)
+
+	// Matches HCL and configuration errors:
+	//   Error: Unsupported argument
+	errorRe = regexp.MustCompile(`^Error: (.+)$`)
+)
+
+// Section headers of the build summary printed at the end of packer build.
+const (
+	artifactsSectionHeader = "==> Builds finished. The artifacts of successful builds are:"
+	errorsSectionHeader    = "==> Some builds didn't complete successfully and had errors:"
+)
+
+// ParseOutput parses packer build output.
+// Builds and their artifacts come from the build summary that packer prints when all builds are done.
+func ParseOutput(output, _ string) *plugin.OutputResult {
+	defer perf.Track(nil, "packer.ParseOutput")()
+
+	data := &plugin.PackerOutputData{}
+	result := &plugin.OutputResult{Data: data}
+
+	p := &outputParser{data: data, index: make(map[string]int), current: -1}
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		p.parseLine(strings.TrimSpace(scanner.Text()), result)
+	}
+
+	succeeded, failed := countBuilds(data)
+	result.HasChanges = succeeded > 0
+	result.HasErrors = failed > 0 || len(result.Errors) > 0
+	if result.HasErrors {
+		result.ExitCode = 1
+	}
+
+	return result
+}
+
+// outputParser holds the line parser state.
+type outputParser struct {
+	data *plugin.PackerOutputData
+
+	// index maps build names to their position in data.Builds.
+	index map[string]int
+
+	// section is the build summary section being read, and current the index of its last entry.
+	section string
+	current int
+}
+
+// parseLine parses a single output line.
+func (p *outputParser) parseLine(line string, result *plugin.OutputResult) {
+	switch {
+	case line == artifactsSectionHeader || line == errorsSectionHeader:
+		p.section = line
+		p.current = -1
+		return
+	case strings.HasPrefix(line, "==>"):
+		p.section = ""
+		return
+	case line == "":
+		// Artifacts are separated by blank lines.
+		p.current = -1
+		return
+	}
+
+	if p.section != "" {
+		p.parseSectionLine(line, result)
+		return
+	}
+
+	if m := buildFinishedRe.FindStringSubmatch(line); m != nil {
+		p.build(m[1]).Status = buildStatusSuccess
+		return
+	}
+
+	if m := buildErroredRe.FindStringSubmatch(line); m != nil {
+		build := p.build(m[1])
+		build.Status = buildStatusFailed
+		build.Error = m[2]
+		result.Errors = append(result.Errors, m[1]+": "+m[2])
+		return
+	}
+
+	if m := errorRe.FindStringSubmatch(line); m != nil {
+		result.Errors = append(result.Errors, m[1])
+	}
+}
+
+// parseSectionLine parses a line of the artifacts or errors section of the build summary.
+// Artifact entries may be followed by lines with the artifact IDs (e.g., "us-east-1: ami-0123").
+func (p *outputParser) parseSectionLine(line string, result *plugin.OutputResult) {
+	if m := sectionEntryRe.FindStringSubmatch(line); m != nil {
+		build := p.build(m[1])
+		p.current = p.index[m[1]]
+		if p.section == errorsSectionHeader {
+			build.Status = buildStatusFailed
+			if build.Error == "" {
+				build.Error = m[2]
+				result.Errors = append(result.Errors, m[1]+": "+m[2])
+			}
+			return
+		}
+		build.Status = buildStatusSuccess
+		build.Artifact = m[2]
+		return
+	}
+
+	if p.section == artifactsSectionHeader && p.current >= 0 {
+		build := &p.data.Builds[p.current]
+		build.ArtifactIDs = append(build.ArtifactIDs, line)
+	}
+}
+
+// build returns the named build, adding it when it was not seen before.
+func (p *outputParser) build(name string) *plugin.PackerBuild {
+	if idx, ok := p.index[name]; ok {
+		return &p.data.Builds[idx]
+	}
+
+	p.data.Builds = append(p.data.Builds, plugin.PackerBuild{Name: name})
+	p.index[name] = len(p.data.Builds) - 1
+	return &p.data.Builds[len(p.data.Builds)-1]
+}
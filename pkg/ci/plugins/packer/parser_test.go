@@ -0,0 +1,94 @@
+package packer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+)
+
+const successOutput = `amazon-ebs.ubuntu: output will be in this color.
+docker.ubuntu: output will be in this color.
+
+==> amazon-ebs.ubuntu: Prevalidating any provided VPC information
+==> amazon-ebs.ubuntu: Creating AMI ubuntu-base from instance i-0abc
+Build 'docker.ubuntu' finished after 41 seconds 120 milliseconds.
+Build 'amazon-ebs.ubuntu' finished after 3 minutes 12 seconds.
+
+==> Wait completed after 3 minutes 12 seconds
+
+==> Builds finished. The artifacts of successful builds are:
+--> amazon-ebs.ubuntu: AMIs were created:
+us-east-1: ami-0123456789abcdef0
+us-west-2: ami-0fedcba9876543210
+
+--> docker.ubuntu: Imported Docker image: sha256:4e07f3bd88fb
+`
+
+const failedOutput = `==> amazon-ebs.ubuntu: Prevalidating any provided VPC information
+Build 'amazon-ebs.ubuntu' errored after 2 seconds 100 milliseconds: error validating regions: us-east-9
+
+==> Wait completed after 2 seconds 100 milliseconds
+
+==> Some builds didn't complete successfully and had errors:
+--> amazon-ebs.ubuntu: error validating regions: us-east-9
+--> qemu.ubuntu: Failed creating Qemu driver: exec: "qemu-system-x86_64": executable file not found in $PATH
+
+==> Builds finished but no artifacts were created.
+`
+
+func TestParseOutput_Success(t *testing.T) {
+	result := ParseOutput(successOutput, "build")
+
+	assert.True(t, result.HasChanges)
+	assert.False(t, result.HasErrors)
+	assert.Equal(t, 0, result.ExitCode)
+
+	data, ok := result.Data.(*plugin.PackerOutputData)
+	require.True(t, ok)
+	assert.Equal(t, []plugin.PackerBuild{
+		{
+			Name:     "docker.ubuntu",
+			Status:   buildStatusSuccess,
+			Artifact: "Imported Docker image: sha256:4e07f3bd88fb",
+		},
+		{
+			Name:        "amazon-ebs.ubuntu",
+			Status:      buildStatusSuccess,
+			Artifact:    "AMIs were created:",
+			ArtifactIDs: []string{"us-east-1: ami-0123456789abcdef0", "us-west-2: ami-0fedcba9876543210"},
+		},
+	}, data.Builds)
+}
+
+func TestParseOutput_Failed(t *testing.T) {
+	result := ParseOutput(failedOutput, "build")
+
+	assert.False(t, result.HasChanges)
+	assert.True(t, result.HasErrors)
+	assert.Equal(t, 1, result.ExitCode)
+	assert.Equal(t, []string{
+		"amazon-ebs.ubuntu: error validating regions: us-east-9",
+		`qemu.ubuntu: Failed creating Qemu driver: exec: "qemu-system-x86_64": executable file not found in $PATH`,
+	}, result.Errors)
+
+	data := result.Data.(*plugin.PackerOutputData)
+	require.Len(t, data.Builds, 2)
+	for _, build := range data.Builds {
+		assert.Equal(t, buildStatusFailed, build.Status)
+		assert.NotEmpty(t, build.Error)
+		assert.Empty(t, build.ArtifactIDs)
+	}
+}
+
+func TestParseOutput_ConfigurationError(t *testing.T) {
+	output := "Error: Unsupported argument\n\n  on template.pkr.hcl line 3:\n"
+
+	result := ParseOutput(output, "build")
+
+	assert.True(t, result.HasErrors)
+	assert.Equal(t, []string{"Unsupported argument"}, result.Errors)
+	assert.Empty(t, result.Data.(*plugin.PackerOutputData).Builds)
+}
@@ -0,0 +1,136 @@
+// Package packer provides the CI Plugin implementation for Packer.
+package packer
+
+import (
+	"embed"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/internal/runner"
+	"github.com/cloudposse/atmos/pkg/perf"
+
+	ci "github.com/cloudposse/atmos/pkg/ci"
+)
+
+//go:embed templates/*.md
+var defaultTemplates embed.FS
+
+// Plugin implements plugin.Plugin for Packer.
+type Plugin struct {
+	runner *runner.Runner
+}
+
+// Ensure Plugin implements plugin.Plugin.
+var _ plugin.Plugin = (*Plugin)(nil)
+
+func init() {
+	// Self-register on package import.
+	if err := ci.RegisterPlugin(New()); err != nil {
+		// Panic on registration failure - this is a programming error.
+		panic(fmt.Sprintf("failed to register packer CI plugin: %v", err))
+	}
+}
+
+// New creates the Packer CI plugin.
+func New() *Plugin {
+	defer perf.Track(nil, "packer.New")()
+
+	return &Plugin{
+		runner: &runner.Runner{
+			ComponentType:   "packer",
+			Templates:       defaultTemplates,
+			Parse:           ParseOutput,
+			TemplateContext: newTemplateContext,
+			Variables:       getOutputVariables,
+			Describe:        buildStatusDescription,
+		},
+	}
+}
+
+// GetType returns the component type.
+func (p *Plugin) GetType() string {
+	return "packer"
+}
+
+// GetHookBindings returns the hook bindings for Packer CI integration.
+func (p *Plugin) GetHookBindings() []plugin.HookBinding {
+	defer perf.Track(nil, "packer.Plugin.GetHookBindings")()
+
+	return []plugin.HookBinding{
+		{Event: "before.packer.build", Handler: p.runner.OnBefore},
+		{Event: "after.packer.build", Handler: p.runner.OnAfter},
+	}
+}
+
+// TemplateContext extends the base TemplateContext with packer-specific fields.
+type TemplateContext struct {
+	*plugin.TemplateContext
+
+	// Builds contains the result of each build.
+	Builds []plugin.PackerBuild
+}
+
+// newTemplateContext creates a TemplateContext from the base context.
+func newTemplateContext(base *plugin.TemplateContext) any {
+	ctx := &TemplateContext{TemplateContext: base}
+	if base.Result != nil {
+		if data, ok := base.Result.Data.(*plugin.PackerOutputData); ok {
+			ctx.Builds = data.Builds
+		}
+	}
+	return ctx
+}
+
+// getOutputVariables returns the packer-specific CI output variables.
+// The artifact IDs of all successful builds are exported as a comma-separated list.
+func getOutputVariables(result *plugin.OutputResult) map[string]string {
+	vars := make(map[string]string)
+
+	data, ok := result.Data.(*plugin.PackerOutputData)
+	if !ok {
+		return vars
+	}
+
+	succeeded, failed := countBuilds(data)
+	var artifactIDs []string
+	for _, build := range data.Builds {
+		artifactIDs = append(artifactIDs, build.ArtifactIDs...)
+	}
+
+	vars["builds_succeeded"] = strconv.Itoa(succeeded)
+	vars["builds_failed"] = strconv.Itoa(failed)
+	vars["artifact_ids"] = strings.Join(artifactIDs, ",")
+	return vars
+}
+
+// buildStatusDescription creates the commit status description, e.g. "2 builds succeeded".
+func buildStatusDescription(result *plugin.OutputResult) string {
+	succeeded := 0
+	if data, ok := result.Data.(*plugin.PackerOutputData); ok {
+		succeeded, _ = countBuilds(data)
+	}
+
+	switch succeeded {
+	case 0:
+		return "No artifacts"
+	case 1:
+		return "1 build succeeded"
+	default:
+		return fmt.Sprintf("%d builds succeeded", succeeded)
+	}
+}
+
+// countBuilds returns the number of successful and failed builds.
+func countBuilds(data *plugin.PackerOutputData) (succeeded, failed int) {
+	for _, build := range data.Builds {
+		switch build.Status {
+		case buildStatusSuccess:
+			succeeded++
+		case buildStatusFailed:
+			failed++
+		}
+	}
+	return succeeded, failed
+}
@@ -0,0 +1,99 @@
+package packer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/templates"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestPlugin_GetType(t *testing.T) {
+	assert.Equal(t, "packer", New().GetType())
+}
+
+func TestPlugin_GetHookBindings(t *testing.T) {
+	bindings := plugin.HookBindings(New().GetHookBindings())
+
+	for _, event := range []string{"before.packer.build", "after.packer.build"} {
+		binding := bindings.GetBindingForEvent(event)
+		require.NotNil(t, binding, event)
+		assert.NotNil(t, binding.Handler, event)
+	}
+}
+
+func TestGetOutputVariables(t *testing.T) {
+	assert.Equal(t, map[string]string{
+		"builds_succeeded": "2",
+		"builds_failed":    "0",
+		"artifact_ids":     "us-east-1: ami-0123456789abcdef0,us-west-2: ami-0fedcba9876543210",
+	}, getOutputVariables(ParseOutput(successOutput, "build")))
+
+	assert.Equal(t, "2", getOutputVariables(ParseOutput(failedOutput, "build"))["builds_failed"])
+	assert.Empty(t, getOutputVariables(&plugin.OutputResult{}))
+}
+
+func TestBuildStatusDescription(t *testing.T) {
+	assert.Equal(t, "2 builds succeeded", buildStatusDescription(ParseOutput(successOutput, "build")))
+	assert.Equal(t, "No artifacts", buildStatusDescription(ParseOutput("", "build")))
+}
+
+func TestTemplateRendering(t *testing.T) {
+	tests := []struct {
+		name         string
+		output       string
+		commandErr   error
+		wantContains []string
+	}{
+		{
+			name:   "successful build",
+			output: successOutput,
+			wantContains: []string{
+				"## Build Succeeded for `ubuntu-base` in `dev`",
+				"BUILD-SUCCEEDED",
+				"| `amazon-ebs.ubuntu` | success | AMIs were created: `us-east-1: ami-0123456789abcdef0` `us-west-2: ami-0fedcba9876543210` |",
+				"| `docker.ubuntu` | success | Imported Docker image: sha256:4e07f3bd88fb |",
+				"atmos packer build ubuntu-base -s dev",
+			},
+		},
+		{
+			name:       "failed build",
+			output:     failedOutput,
+			commandErr: errors.New("exit status 1"),
+			wantContains: []string{
+				"## Build Failed for `ubuntu-base` in `dev`",
+				"BUILD-FAILED",
+				"| `amazon-ebs.ubuntu` | failed | error validating regions: us-east-9 |",
+				":warning: Error summary",
+			},
+		},
+		{
+			name:         "no artifacts",
+			output:       "",
+			wantContains: []string{"## No Artifacts Built for `ubuntu-base` in `dev`", "NO_ARTIFACTS"},
+		},
+	}
+
+	p := New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &plugin.HookContext{
+				Command:        "build",
+				Output:         tt.output,
+				CommandError:   tt.commandErr,
+				TemplateLoader: templates.NewLoader(nil),
+				Info:           &schema.ConfigAndStacksInfo{Stack: "dev", ComponentFromArg: "ubuntu-base"},
+			}
+
+			rendered, err := p.runner.RenderTemplate(ctx, p.runner.ParseOutputWithError(ctx), "build")
+			require.NoError(t, err)
+			for _, want := range tt.wantContains {
+				assert.Contains(t, rendered, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package terraform
+
+import (
+	"fmt"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// publishComment publishes the rendered plan template as a sticky pull request comment.
+// The summary rendered by writeSummary is reused, unless comments use their own template or no summary was written.
+// There is one comment per stack/component, identified by a hidden marker and updated in place on each run.
+// Plans without changes are collapsed; the default template shows a warning banner when resources are destroyed.
+func (p *Plugin) publishComment(ctx *plugin.HookContext, result *plugin.OutputResult, renderedSummary string) error {
+	defer perf.Track(ctx.Config, "terraform.Plugin.publishComment")()
+
+	if ctx.CICtx == nil || ctx.CICtx.PullRequest == nil || ctx.CICtx.PullRequest.Number == 0 {
+		log.Debug("Not running for a pull request, skipping CI comment")
+		return nil
+	}
+
+	commenter, ok := ctx.Provider.(provider.Commenter)
+	if !ok {
+		log.Debug("CI provider does not support pull request comments", "provider", ctx.Provider.Name())
+		return nil
+	}
+
+	behavior, err := getCommentBehavior(ctx)
+	if err != nil {
+		return err
+	}
+
+	rendered := renderedSummary
+	if templateName := commentTemplateName(ctx); rendered == "" || templateName != summaryTemplateName(ctx) {
+		rendered, err = p.renderTemplate(ctx, templateName)
+		if err != nil {
+			return err
+		}
+	}
+
+	marker := provider.FormatCommentMarker("terraform", ctx.Info.Stack, ctx.Info.ComponentFromArg)
+	comment, err := commenter.UpsertComment(ctx.Context, &provider.UpsertCommentOptions{
+		Owner:    ctx.CICtx.RepoOwner,
+		Repo:     ctx.CICtx.RepoName,
+		Number:   ctx.CICtx.PullRequest.Number,
+		Marker:   marker,
+		Body:     buildCommentBody(ctx, result, rendered),
+		Behavior: behavior,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Debug("Published pull request comment",
+		"stack", ctx.Info.Stack,
+		"component", ctx.Info.ComponentFromArg,
+		"pr", ctx.CICtx.PullRequest.Number,
+		"updated", comment.Updated,
+		"url", comment.URL,
+	)
+	return nil
+}
+
+// commentTemplateName returns the comment template - prefers the config override, falls back to the command name.
+func commentTemplateName(ctx *plugin.HookContext) string {
+	if ctx.Config != nil && ctx.Config.CI.Comments.Template != "" {
+		return ctx.Config.CI.Comments.Template
+	}
+	return ctx.Command
+}
+
+// buildCommentBody wraps the rendered template in a collapsed section when the plan has no changes,
+// so comments for unchanged components don't crowd the pull request.
+func buildCommentBody(ctx *plugin.HookContext, result *plugin.OutputResult, rendered string) string {
+	if result == nil || result.HasChanges || result.HasErrors {
+		return rendered
+	}
+
+	return fmt.Sprintf("<details><summary>No changes for <code>%s</code> in <code>%s</code></summary>\n\n%s\n\n</details>\n",
+		ctx.Info.ComponentFromArg, ctx.Info.Stack, rendered)
+}
+
+// getCommentBehavior returns the configured comment behavior, defaulting to upsert.
+func getCommentBehavior(ctx *plugin.HookContext) (provider.CommentBehavior, error) {
+	if ctx.Config == nil || ctx.Config.CI.Comments.Behavior == "" {
+		return provider.CommentBehaviorUpsert, nil
+	}
+
+	behavior := provider.CommentBehavior(ctx.Config.CI.Comments.Behavior)
+	switch behavior {
+	case provider.CommentBehaviorCreate, provider.CommentBehaviorUpdate, provider.CommentBehaviorUpsert:
+		return behavior, nil
+	default:
+		return "", errUtils.Build(errUtils.ErrCICommentFailed).
+			WithExplanationf("Invalid `ci.comments.behavior` value `%s`.", behavior).
+			WithHint("Use one of `create`, `update` or `upsert`.").
+			Err()
+	}
+}
@@ -0,0 +1,207 @@
+package terraform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/plugin"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/ci/templates"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// mockCommenter is a mockProvider that also implements provider.Commenter.
+type mockCommenter struct {
+	mockProvider
+	commentCalls []*provider.UpsertCommentOptions
+	commentCtx   context.Context
+}
+
+func (m *mockCommenter) UpsertComment(ctx context.Context, opts *provider.UpsertCommentOptions) (*provider.Comment, error) {
+	m.commentCtx = ctx
+	m.commentCalls = append(m.commentCalls, opts)
+	return &provider.Comment{ID: int64(len(m.commentCalls))}, nil
+}
+
+func newCommentHookContext(prov provider.Provider, comments schema.CICommentsConfig, output string) *plugin.HookContext {
+	cfg := &schema.AtmosConfiguration{
+		CI: schema.CIConfig{
+			Summary:  schema.CISummaryConfig{Enabled: boolPtr(false)},
+			Output:   schema.CIOutputConfig{Enabled: boolPtr(false)},
+			Checks:   schema.CIChecksConfig{Enabled: boolPtr(false)},
+			Comments: comments,
+		},
+	}
+	return &plugin.HookContext{
+		Context:        context.Background(),
+		Config:         cfg,
+		Provider:       prov,
+		Command:        "plan",
+		TemplateLoader: templates.NewLoader(cfg),
+		CICtx: &provider.Context{
+			RepoOwner:   "owner",
+			RepoName:    "repo",
+			SHA:         "abc123",
+			PullRequest: &provider.PRInfo{Number: 7},
+		},
+		Info: &schema.ConfigAndStacksInfo{
+			Stack:            "dev",
+			ComponentFromArg: "vpc",
+		},
+		Output: output,
+	}
+}
+
+func TestIsCommentEnabled(t *testing.T) {
+	assert.False(t, isCommentEnabled(nil))
+	assert.False(t, isCommentEnabled(&schema.AtmosConfiguration{}))
+	assert.True(t, isCommentEnabled(&schema.AtmosConfiguration{
+		CI: schema.CIConfig{Comments: schema.CICommentsConfig{Enabled: true}},
+	}))
+}
+
+func TestGetCommentBehavior(t *testing.T) {
+	tests := []struct {
+		name     string
+		behavior string
+		expected provider.CommentBehavior
+		wantErr  bool
+	}{
+		{name: "default", behavior: "", expected: provider.CommentBehaviorUpsert},
+		{name: "create", behavior: "create", expected: provider.CommentBehaviorCreate},
+		{name: "update", behavior: "update", expected: provider.CommentBehaviorUpdate},
+		{name: "upsert", behavior: "upsert", expected: provider.CommentBehaviorUpsert},
+		{name: "invalid", behavior: "replace", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := newCommentHookContext(newMockProvider(), schema.CICommentsConfig{Behavior: tt.behavior}, "")
+			behavior, err := getCommentBehavior(ctx)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errUtils.ErrCICommentFailed)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, behavior)
+		})
+	}
+}
+
+func TestOnAfterPlan_CommentDisabled(t *testing.T) {
+	p := &Plugin{}
+	mc := &mockCommenter{mockProvider: *newMockProvider()}
+	ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: false}, "Plan: 1 to add, 0 to change, 0 to destroy.")
+
+	require.NoError(t, p.onAfterPlan(ctx))
+	assert.Empty(t, mc.commentCalls)
+}
+
+func TestOnAfterPlan_CommentWithChanges(t *testing.T) {
+	p := &Plugin{}
+	mc := &mockCommenter{mockProvider: *newMockProvider()}
+	ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: true, Behavior: "update"}, "Plan: 1 to add, 0 to change, 0 to destroy.")
+
+	require.NoError(t, p.onAfterPlan(ctx))
+	require.Len(t, mc.commentCalls, 1)
+
+	call := mc.commentCalls[0]
+	assert.Equal(t, "owner", call.Owner)
+	assert.Equal(t, "repo", call.Repo)
+	assert.Equal(t, 7, call.Number)
+	assert.Equal(t, "<!-- atmos:terraform/dev/vpc -->", call.Marker)
+	assert.Equal(t, provider.CommentBehaviorUpdate, call.Behavior)
+	assert.NotContains(t, call.Body, "<details><summary>No changes")
+}
+
+type commentCtxKey struct{}
+
+func TestPublishComment_UsesHookContext(t *testing.T) {
+	p := &Plugin{}
+	mc := &mockCommenter{mockProvider: *newMockProvider()}
+	ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: true}, "Plan: 1 to add, 0 to change, 0 to destroy.")
+	ctx.Context = context.WithValue(context.Background(), commentCtxKey{}, "hook")
+
+	require.NoError(t, p.publishComment(ctx, &plugin.OutputResult{HasChanges: true}, ""))
+	require.NotNil(t, mc.commentCtx)
+	assert.Equal(t, "hook", mc.commentCtx.Value(commentCtxKey{}))
+}
+
+func TestPublishComment_ReusesRenderedSummary(t *testing.T) {
+	t.Run("same template", func(t *testing.T) {
+		p := &Plugin{}
+		mc := &mockCommenter{mockProvider: *newMockProvider()}
+		ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: true}, "Plan: 1 to add, 0 to change, 0 to destroy.")
+
+		require.NoError(t, p.publishComment(ctx, &plugin.OutputResult{HasChanges: true}, "rendered summary"))
+		require.Len(t, mc.commentCalls, 1)
+		assert.Equal(t, "rendered summary", mc.commentCalls[0].Body)
+	})
+
+	t.Run("comment template override", func(t *testing.T) {
+		p := &Plugin{}
+		mc := &mockCommenter{mockProvider: *newMockProvider()}
+		ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: true, Template: "apply"}, "Plan: 1 to add, 0 to change, 0 to destroy.")
+
+		require.NoError(t, p.publishComment(ctx, &plugin.OutputResult{HasChanges: true}, "rendered summary"))
+		require.Len(t, mc.commentCalls, 1)
+		assert.NotEqual(t, "rendered summary", mc.commentCalls[0].Body)
+	})
+}
+
+func TestOnAfterPlan_CommentNoChangesCollapsed(t *testing.T) {
+	p := &Plugin{}
+	mc := &mockCommenter{mockProvider: *newMockProvider()}
+	ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: true}, "No changes. Your infrastructure matches the configuration.")
+
+	require.NoError(t, p.onAfterPlan(ctx))
+	require.Len(t, mc.commentCalls, 1)
+	assert.Contains(t, mc.commentCalls[0].Body, "<details><summary>No changes for <code>vpc</code> in <code>dev</code></summary>")
+	assert.Contains(t, mc.commentCalls[0].Body, "</details>")
+}
+
+func TestOnAfterPlan_CommentDestroyBanner(t *testing.T) {
+	p := &Plugin{}
+	mc := &mockCommenter{mockProvider: *newMockProvider()}
+	output := `  # aws_instance.web will be destroyed
+  - resource "aws_instance" "web" {}
+
+Plan: 0 to add, 0 to change, 1 to destroy.`
+	ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: true}, output)
+
+	require.NoError(t, p.onAfterPlan(ctx))
+	require.Len(t, mc.commentCalls, 1)
+	assert.Contains(t, mc.commentCalls[0].Body, "Terraform will delete resources!")
+}
+
+func TestOnAfterPlan_CommentSkipped(t *testing.T) {
+	t.Run("not a pull request", func(t *testing.T) {
+		p := &Plugin{}
+		mc := &mockCommenter{mockProvider: *newMockProvider()}
+		ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: true}, "Plan: 1 to add, 0 to change, 0 to destroy.")
+		ctx.CICtx.PullRequest = nil
+
+		require.NoError(t, p.onAfterPlan(ctx))
+		assert.Empty(t, mc.commentCalls)
+	})
+
+	t.Run("provider without comment support", func(t *testing.T) {
+		p := &Plugin{}
+		ctx := newCommentHookContext(newMockProvider(), schema.CICommentsConfig{Enabled: true}, "Plan: 1 to add, 0 to change, 0 to destroy.")
+
+		require.NoError(t, p.publishComment(ctx, &plugin.OutputResult{HasChanges: true}, ""))
+	})
+
+	t.Run("invalid behavior is warn-only", func(t *testing.T) {
+		p := &Plugin{}
+		mc := &mockCommenter{mockProvider: *newMockProvider()}
+		ctx := newCommentHookContext(mc, schema.CICommentsConfig{Enabled: true, Behavior: "replace"}, "Plan: 1 to add, 0 to change, 0 to destroy.")
+
+		require.NoError(t, p.onAfterPlan(ctx))
+		assert.Empty(t, mc.commentCalls)
+	})
+}
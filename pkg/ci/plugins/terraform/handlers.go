@@ -33,7 +33,7 @@ func (p *Plugin) onBeforePlan(ctx *plugin.HookContext) error {
 }
 
 // onAfterPlan handles the after.terraform.plan event.
-// Writes summary, outputs, uploads planfile, and updates check run.
+// Writes summary, outputs, uploads planfile, updates check run, and publishes the PR comment.
 func (p *Plugin) onAfterPlan(ctx *plugin.HookContext) error {
 	defer perf.Track(ctx.Config, "terraform.Plugin.onAfterPlan")()
 
@@ -71,6 +71,13 @@ func (p *Plugin) onAfterPlan(ctx *plugin.HookContext) error {
 		}
 	}
 
+	// Comment -- warn-only.
+	if isCommentEnabled(ctx.Config) {
+		if err := p.publishComment(ctx, result, renderedSummary); err != nil {
+			logCheckRunError("CI pull request comment skipped", err)
+		}
+	}
+
 	return nil
 }
 
@@ -271,12 +278,7 @@ func (p *Plugin) parseOutputWithError(ctx *plugin.HookContext) *plugin.OutputRes
 func (p *Plugin) writeSummary(ctx *plugin.HookContext, _ *plugin.OutputResult) (string, error) {
 	defer perf.Track(ctx.Config, "terraform.Plugin.writeSummary")()
 
-	// Get template name - prefer config override, fall back to command name.
-	templateName := ctx.Command
-	if ctx.Config != nil && ctx.Config.CI.Summary.Template != "" {
-		templateName = ctx.Config.CI.Summary.Template
-	}
-
+	templateName := summaryTemplateName(ctx)
 	if templateName == "" {
 		return "", nil
 	}
@@ -287,7 +289,37 @@ func (p *Plugin) writeSummary(ctx *plugin.HookContext, _ *plugin.OutputResult) (
 		return "", nil
 	}
 
-	// Build template context.
+	rendered, err := p.renderTemplate(ctx, templateName)
+	if err != nil {
+		return "", err
+	}
+
+	// Write to job summary.
+	if err := writer.WriteSummary(rendered); err != nil {
+		return "", errUtils.Build(errUtils.ErrCIOutputWriteFailed).
+			WithCause(err).
+			WithExplanation("Failed to write CI summary").
+			Err()
+	}
+
+	log.Debug("Wrote CI summary",
+		"stack", ctx.Info.Stack,
+		"component", ctx.Info.ComponentFromArg,
+		"template", templateName,
+	)
+	return rendered, nil
+}
+
+// summaryTemplateName returns the summary template - prefers the config override, falls back to the command name.
+func summaryTemplateName(ctx *plugin.HookContext) string {
+	if ctx.Config != nil && ctx.Config.CI.Summary.Template != "" {
+		return ctx.Config.CI.Summary.Template
+	}
+	return ctx.Command
+}
+
+// renderTemplate builds the template context and renders the named terraform template.
+func (p *Plugin) renderTemplate(ctx *plugin.HookContext, templateName string) (string, error) {
 	tmplCtx, err := p.buildTemplateContext(ctx.Info, ctx.CICtx, ctx.Output, ctx.Command)
 	if err != nil {
 		return "", errUtils.Build(errUtils.ErrTemplateEvaluation).
@@ -296,7 +328,6 @@ func (p *Plugin) writeSummary(ctx *plugin.HookContext, _ *plugin.OutputResult) (
 			Err()
 	}
 
-	// Load and render template.
 	rendered, err := ctx.TemplateLoader.LoadAndRender(
 		"terraform",
 		templateName,
@@ -310,20 +341,6 @@ func (p *Plugin) writeSummary(ctx *plugin.HookContext, _ *plugin.OutputResult) (
 			WithContext("template", templateName).
 			Err()
 	}
-
-	// Write to job summary.
-	if err := writer.WriteSummary(rendered); err != nil {
-		return "", errUtils.Build(errUtils.ErrCIOutputWriteFailed).
-			WithCause(err).
-			WithExplanation("Failed to write CI summary").
-			Err()
-	}
-
-	log.Debug("Wrote CI summary",
-		"stack", ctx.Info.Stack,
-		"component", ctx.Info.ComponentFromArg,
-		"template", templateName,
-	)
 	return rendered, nil
 }
 
@@ -739,6 +756,14 @@ func isCheckEnabled(cfg *schema.AtmosConfiguration) bool {
 	return *cfg.CI.Checks.Enabled
 }
 
+// isCommentEnabled checks if pull request comments are enabled in config.
+func isCommentEnabled(cfg *schema.AtmosConfiguration) bool {
+	if cfg == nil {
+		return false
+	}
+	return cfg.CI.Comments.Enabled
+}
+
 // filterVariables filters a map of variables to only include those in the allowed list.
 func filterVariables(vars map[string]string, allowed []string) map[string]string {
 	if len(allowed) == 0 {
@@ -0,0 +1,47 @@
+package planfile
+
+import (
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/auth"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// IdentityOption is the store option naming the Atmos auth identity used for store credentials.
+const IdentityOption = "identity"
+
+// ResolveAuthContext authenticates the Atmos auth identity named by the "identity" store option
+// and sets opts.AuthContext to its credentials. Options without an identity are left unchanged.
+func ResolveAuthContext(opts *StoreOptions) error {
+	defer perf.Track(opts.AtmosConfig, "planfile.ResolveAuthContext")()
+
+	identity, _ := opts.Options[IdentityOption].(string)
+	if identity == "" {
+		return nil
+	}
+
+	if opts.AtmosConfig == nil {
+		return errUtils.Build(errUtils.ErrAuthNotConfigured).
+			WithExplanationf("Planfile store `%s` uses identity `%s`, but no Atmos configuration is available", opts.Type, identity).
+			WithContext("store", opts.Type).
+			WithContext("identity", identity).
+			Err()
+	}
+
+	authManager, err := auth.CreateAndAuthenticateManagerWithAtmosConfig(identity, &opts.AtmosConfig.Auth, cfg.IdentityFlagSelectValue, opts.AtmosConfig)
+	if err != nil {
+		return errUtils.Build(errUtils.ErrIdentityAuthFailed).
+			WithCause(err).
+			WithExplanationf("Failed to authenticate identity `%s` for planfile store `%s`", identity, opts.Type).
+			WithHintf("Check the identity in the `auth` section of `atmos.yaml`, or run `atmos auth login --identity %s`", identity).
+			WithContext("store", opts.Type).
+			WithContext("identity", identity).
+			Err()
+	}
+
+	if authManager != nil && authManager.GetStackInfo() != nil {
+		opts.AuthContext = authManager.GetStackInfo().AuthContext
+	}
+
+	return nil
+}
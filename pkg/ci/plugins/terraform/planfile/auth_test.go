@@ -0,0 +1,46 @@
+package planfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestResolveAuthContext_NoIdentity(t *testing.T) {
+	authContext := &schema.AuthContext{GCP: &schema.GCPAuthContext{ProjectID: "dev"}}
+	opts := StoreOptions{
+		Type:        "google/gcs",
+		Options:     map[string]any{"bucket": "planfiles"},
+		AuthContext: authContext,
+	}
+
+	require.NoError(t, ResolveAuthContext(&opts))
+	assert.Same(t, authContext, opts.AuthContext)
+}
+
+func TestResolveAuthContext_NoAtmosConfig(t *testing.T) {
+	opts := StoreOptions{
+		Type:    "google/gcs",
+		Options: map[string]any{"bucket": "planfiles", IdentityOption: "gcp-dev"},
+	}
+
+	err := ResolveAuthContext(&opts)
+	assert.ErrorIs(t, err, errUtils.ErrAuthNotConfigured)
+	assert.Nil(t, opts.AuthContext)
+}
+
+func TestResolveAuthContext_AuthNotConfigured(t *testing.T) {
+	opts := StoreOptions{
+		Type:        "azure/blob",
+		Options:     map[string]any{"container_name": "planfiles", IdentityOption: "azure-dev"},
+		AtmosConfig: &schema.AtmosConfiguration{},
+	}
+
+	err := ResolveAuthContext(&opts)
+	assert.ErrorIs(t, err, errUtils.ErrIdentityAuthFailed)
+	assert.Nil(t, opts.AuthContext)
+}
@@ -0,0 +1,60 @@
+package azuredevops
+
+import (
+	"os"
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// defaultRef is the fallback git reference when no base can be resolved.
+	defaultRef = "refs/remotes/origin/HEAD"
+	// sourceDefault is the source label for default fallback resolution.
+	sourceDefault = "default"
+)
+
+// ResolveBase returns the base commit for affected detection in Azure DevOps Pipelines.
+// Pull request builds compare against the target branch. Azure Pipelines doesn't expose the
+// previous commit of CI builds, so they fall back to the default branch.
+func (p *Provider) ResolveBase() (*provider.BaseResolution, error) {
+	defer perf.Track(nil, "azuredevops.Provider.ResolveBase")()
+
+	eventName := os.Getenv("BUILD_REASON")
+
+	if eventName == reasonPullRequest {
+		return resolvePRBase(eventName), nil
+	}
+
+	return &provider.BaseResolution{
+		Ref:       defaultRef,
+		Source:    sourceDefault,
+		EventType: eventName,
+	}, nil
+}
+
+// resolvePRBase resolves the base commit for pull request builds.
+// The head SHA is the latest commit of the source branch, since BUILD_SOURCEVERSION is the merge commit.
+func resolvePRBase(eventName string) *provider.BaseResolution {
+	headSHA := os.Getenv("SYSTEM_PULLREQUEST_SOURCECOMMITID")
+	if headSHA == "" {
+		headSHA = os.Getenv("BUILD_SOURCEVERSION")
+	}
+
+	if target := strings.TrimPrefix(os.Getenv("SYSTEM_PULLREQUEST_TARGETBRANCH"), branchRefPrefix); target != "" {
+		return &provider.BaseResolution{
+			Ref:       "refs/remotes/origin/" + target,
+			HeadSHA:   headSHA,
+			Source:    "SYSTEM_PULLREQUEST_TARGETBRANCH",
+			EventType: eventName,
+		}
+	}
+
+	return &provider.BaseResolution{
+		Ref:       defaultRef,
+		HeadSHA:   headSHA,
+		Source:    sourceDefault + " (SYSTEM_PULLREQUEST_TARGETBRANCH empty)",
+		EventType: eventName,
+	}
+}
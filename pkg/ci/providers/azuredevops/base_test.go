@@ -0,0 +1,73 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+func TestProvider_ResolveBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected *provider.BaseResolution
+	}{
+		{
+			name: "pull request uses the target branch",
+			env: map[string]string{
+				"BUILD_REASON":                      "PullRequest",
+				"BUILD_SOURCEVERSION":               "3333333333333333333333333333333333333333",
+				"SYSTEM_PULLREQUEST_SOURCECOMMITID": "2222222222222222222222222222222222222222",
+				"SYSTEM_PULLREQUEST_TARGETBRANCH":   "refs/heads/main",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       "refs/remotes/origin/main",
+				HeadSHA:   "2222222222222222222222222222222222222222",
+				Source:    "SYSTEM_PULLREQUEST_TARGETBRANCH",
+				EventType: "PullRequest",
+			},
+		},
+		{
+			name: "pull request without target branch falls back to default",
+			env: map[string]string{
+				"BUILD_REASON":        "PullRequest",
+				"BUILD_SOURCEVERSION": "3333333333333333333333333333333333333333",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       defaultRef,
+				HeadSHA:   "3333333333333333333333333333333333333333",
+				Source:    "default (SYSTEM_PULLREQUEST_TARGETBRANCH empty)",
+				EventType: "PullRequest",
+			},
+		},
+		{
+			name: "CI build falls back to default",
+			env: map[string]string{
+				"BUILD_REASON": "IndividualCI",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       defaultRef,
+				Source:    sourceDefault,
+				EventType: "IndividualCI",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"BUILD_REASON", "BUILD_SOURCEVERSION", "SYSTEM_PULLREQUEST_SOURCECOMMITID", "SYSTEM_PULLREQUEST_TARGETBRANCH"} {
+				t.Setenv(key, "")
+			}
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			base, err := NewProvider().ResolveBase()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, base)
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// maxDescriptionLength is the length commit status descriptions are truncated to.
+	// Azure DevOps shows the description on a single line of the pull request status panel.
+	maxDescriptionLength = 140
+
+	// defaultGenre is the status genre used when the check name has no "/" separator.
+	defaultGenre = "atmos"
+)
+
+// statusContext identifies a commit status. Azure DevOps shows statuses as "genre/name".
+type statusContext struct {
+	Name  string `json:"name"`
+	Genre string `json:"genre,omitempty"`
+}
+
+// gitStatus is an Azure DevOps Git commit status.
+type gitStatus struct {
+	ID          int64         `json:"id,omitempty"`
+	State       string        `json:"state"`
+	Description string        `json:"description,omitempty"`
+	TargetURL   string        `json:"targetUrl,omitempty"`
+	Context     statusContext `json:"context"`
+}
+
+// fullName returns the name of the status as shown in Azure DevOps.
+func (s *gitStatus) fullName() string {
+	if s.Context.Genre == "" {
+		return s.Context.Name
+	}
+	return s.Context.Genre + "/" + s.Context.Name
+}
+
+// newStatusContext splits a check name like "atmos/plan/dev/vpc" into its genre ("atmos") and name ("plan/dev/vpc").
+func newStatusContext(name string) statusContext {
+	genre, rest, found := strings.Cut(name, "/")
+	if !found {
+		return statusContext{Name: name, Genre: defaultGenre}
+	}
+	return statusContext{Name: rest, Genre: genre}
+}
+
+// setCommitStatus adds a status to a commit using the Azure DevOps Git Statuses API.
+// Azure DevOps commit statuses take the place of GitHub check runs. Both CreateCheckRun and
+// UpdateCheckRun delegate to this method; the latest status for a genre/name pair is shown.
+func (p *Provider) setCommitStatus(ctx context.Context, project, repo, sha, name, state, description, targetURL string) (*gitStatus, error) {
+	if err := p.ensureClient(); err != nil {
+		return nil, err
+	}
+
+	body := gitStatus{
+		State:       state,
+		Description: truncateDescription(description),
+		TargetURL:   targetURL,
+		Context:     newStatusContext(name),
+	}
+
+	var status gitStatus
+	path := repositoryPath(project, repo) + "/commits/" + url.PathEscape(sha) + "/statuses"
+	if err := p.client.post(ctx, path, body, &status); err != nil {
+		return nil, wrapAzureDevOpsAPIError(err)
+	}
+	return &status, nil
+}
+
+// wrapAzureDevOpsAPIError wraps Azure DevOps API errors with actionable hints for common
+// authentication and permission failures (401, 403, 404).
+func wrapAzureDevOpsAPIError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return errUtils.Build(err).
+			WithHint("The Azure DevOps token is invalid or expired.").
+			WithHint("Map the job access token with 'env: SYSTEM_ACCESSTOKEN: $(System.AccessToken)', or set ATMOS_CI_AZURE_DEVOPS_TOKEN to a personal access token.").
+			Err()
+	case http.StatusForbidden, http.StatusNotFound:
+		return errUtils.Build(err).
+			WithHint("The token does not have permission to set statuses on this repository. Commit statuses are only supported for Azure Repos Git repositories.").
+			WithHint("Grant the build service identity the 'Contribute to pull requests' permission, or use a personal access token with the 'Code (status)' scope.").
+			Err()
+	default:
+		return err
+	}
+}
+
+// createCheckRun adds a commit status to a commit.
+func (p *Provider) createCheckRun(ctx context.Context, opts *provider.CreateCheckRunOptions) (*provider.CheckRun, error) {
+	state := mapCheckRunStateToStatusState(opts.Status)
+
+	status, err := p.setCommitStatus(ctx, opts.Owner, opts.Repo, opts.SHA, opts.Name, state, opts.Title, opts.DetailsURL)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCICheckRunCreateFailed).WithCause(err).Err()
+	}
+
+	return &provider.CheckRun{
+		ID:         status.ID,
+		Name:       status.fullName(),
+		Status:     opts.Status,
+		Title:      status.Description,
+		DetailsURL: status.TargetURL,
+	}, nil
+}
+
+// updateCheckRun adds a new commit status that supersedes the previous one with the same name.
+func (p *Provider) updateCheckRun(ctx context.Context, opts *provider.UpdateCheckRunOptions) (*provider.CheckRun, error) {
+	state := mapCheckRunStateToStatusState(opts.Status)
+
+	status, err := p.setCommitStatus(ctx, opts.Owner, opts.Repo, opts.SHA, opts.Name, state, opts.Title, opts.DetailsURL)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCICheckRunUpdateFailed).WithCause(err).Err()
+	}
+
+	return &provider.CheckRun{
+		ID:         status.ID,
+		Name:       status.fullName(),
+		Status:     opts.Status,
+		Title:      status.Description,
+		DetailsURL: status.TargetURL,
+	}, nil
+}
+
+// mapCheckRunStateToStatusState maps CheckRunState to an Azure DevOps commit status state.
+// Azure DevOps has no in-progress state, so running checks are reported as pending.
+func mapCheckRunStateToStatusState(state provider.CheckRunState) string {
+	switch state {
+	case provider.CheckRunStatePending, provider.CheckRunStateInProgress:
+		return "pending"
+	case provider.CheckRunStateSuccess:
+		return "succeeded"
+	case provider.CheckRunStateFailure:
+		return "failed"
+	case provider.CheckRunStateError, provider.CheckRunStateCancelled:
+		return "error"
+	default:
+		return "pending"
+	}
+}
+
+// truncateDescription truncates a description to 140 characters.
+// Uses character count (runes), not byte count, to avoid mid-character truncation.
+func truncateDescription(desc string) string {
+	if utf8.RuneCountInString(desc) <= maxDescriptionLength {
+		return desc
+	}
+	runes := []rune(desc)
+	return string(runes[:maxDescriptionLength-3]) + "..."
+}
+
+// CreateCheckRun creates a new commit status on a commit.
+func (p *Provider) CreateCheckRun(ctx context.Context, opts *provider.CreateCheckRunOptions) (*provider.CheckRun, error) {
+	defer perf.Track(nil, "azuredevops.Provider.CreateCheckRun")()
+
+	return p.createCheckRun(ctx, opts)
+}
+
+// UpdateCheckRun updates an existing commit status on a commit.
+func (p *Provider) UpdateCheckRun(ctx context.Context, opts *provider.UpdateCheckRunOptions) (*provider.CheckRun, error) {
+	defer perf.Track(nil, "azuredevops.Provider.UpdateCheckRun")()
+
+	return p.updateCheckRun(ctx, opts)
+}
@@ -0,0 +1,181 @@
+package azuredevops
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// fakeAzureDevOps is a local stand-in for the Azure DevOps REST API.
+type fakeAzureDevOps struct {
+	mu       sync.Mutex
+	statuses map[string][]gitStatus
+	prs      []pullRequest
+	nextID   int64
+}
+
+func newFakeAzureDevOps(t *testing.T) (*fakeAzureDevOps, *Provider) {
+	t.Helper()
+
+	fake := &fakeAzureDevOps{statuses: map[string][]gitStatus{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(server.Client(), server.URL+"/acme/", "pat-test")
+	return fake, NewProviderWithClient(client)
+}
+
+func (f *fakeAzureDevOps) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Basic "+base64.StdEncoding.EncodeToString([]byte(":pat-test")) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	if r.URL.Query().Get("api-version") == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/acme")
+	const repo = "/platform/_apis/git/repositories/infra"
+
+	switch {
+	case strings.HasPrefix(path, repo+"/commits/") && strings.HasSuffix(path, "/statuses"):
+		sha := strings.TrimSuffix(strings.TrimPrefix(path, repo+"/commits/"), "/statuses")
+		if r.Method == http.MethodPost {
+			var status gitStatus
+			if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			f.nextID++
+			status.ID = f.nextID
+			f.statuses[sha] = append(f.statuses[sha], status)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(status)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(listResponse[gitStatus]{Value: f.statuses[sha]})
+	case r.Method == http.MethodGet && path == repo+"/pullrequests":
+		query := r.URL.Query()
+		result := []pullRequest{}
+		for _, pr := range f.prs {
+			if ref := query.Get("searchCriteria.sourceRefName"); ref != "" && pr.SourceRefName != ref {
+				continue
+			}
+			result = append(result, pr)
+		}
+		_ = json.NewEncoder(w).Encode(listResponse[pullRequest]{Value: result})
+	case r.Method == http.MethodGet && path == "/_apis/connectionData":
+		_, _ = w.Write([]byte(`{"authenticatedUser":{"id":"user-1"}}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"TF200016: The following project does not exist"}`))
+	}
+}
+
+func TestMapCheckRunStateToStatusState(t *testing.T) {
+	tests := []struct {
+		state    provider.CheckRunState
+		expected string
+	}{
+		{provider.CheckRunStatePending, "pending"},
+		{provider.CheckRunStateInProgress, "pending"},
+		{provider.CheckRunStateSuccess, "succeeded"},
+		{provider.CheckRunStateFailure, "failed"},
+		{provider.CheckRunStateError, "error"},
+		{provider.CheckRunStateCancelled, "error"},
+		{provider.CheckRunState("unknown"), "pending"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			assert.Equal(t, tt.expected, mapCheckRunStateToStatusState(tt.state))
+		})
+	}
+}
+
+func TestNewStatusContext(t *testing.T) {
+	assert.Equal(t, statusContext{Genre: "atmos", Name: "plan/dev/vpc"}, newStatusContext("atmos/plan/dev/vpc"))
+	assert.Equal(t, statusContext{Genre: "atmos", Name: "plan"}, newStatusContext("plan"))
+}
+
+func TestProvider_CreateAndUpdateCheckRun(t *testing.T) {
+	fake, p := newFakeAzureDevOps(t)
+	sha := "abc123def456abc123def456abc123def456abcd"
+
+	created, err := p.CreateCheckRun(t.Context(), &provider.CreateCheckRunOptions{
+		Owner:      "platform",
+		Repo:       "infra",
+		SHA:        sha,
+		Name:       "atmos/plan/dev/vpc",
+		Status:     provider.CheckRunStateInProgress,
+		Title:      "Plan in progress",
+		DetailsURL: "https://dev.azure.com/acme/platform/_build/results?buildId=1001",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "atmos/plan/dev/vpc", created.Name)
+	assert.Equal(t, provider.CheckRunStateInProgress, created.Status)
+	assert.Equal(t, "Plan in progress", created.Title)
+
+	updated, err := p.UpdateCheckRun(t.Context(), &provider.UpdateCheckRunOptions{
+		Owner:  "platform",
+		Repo:   "infra",
+		SHA:    sha,
+		Name:   "atmos/plan/dev/vpc",
+		Status: provider.CheckRunStateSuccess,
+		Title:  "3 to add, 1 to change, 0 to destroy",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "3 to add, 1 to change, 0 to destroy", updated.Title)
+
+	require.Len(t, fake.statuses[sha], 2)
+	assert.Equal(t, "pending", fake.statuses[sha][0].State)
+	assert.Equal(t, "succeeded", fake.statuses[sha][1].State)
+	assert.Equal(t, statusContext{Genre: "atmos", Name: "plan/dev/vpc"}, fake.statuses[sha][1].Context)
+}
+
+func TestProvider_CreateCheckRun_APIErrors(t *testing.T) {
+	t.Run("repository not found", func(t *testing.T) {
+		_, p := newFakeAzureDevOps(t)
+
+		_, err := p.CreateCheckRun(t.Context(), &provider.CreateCheckRunOptions{
+			Owner: "platform", Repo: "missing", SHA: "abc", Name: "atmos/plan/dev/vpc", Status: provider.CheckRunStatePending,
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errUtils.ErrCICheckRunCreateFailed)
+		assert.ErrorIs(t, err, errUtils.ErrAzureDevOpsAPIRequestFailed)
+		assert.Contains(t, strings.Join(errors.GetAllHints(err), "\n"), "Azure Repos")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		fake := &fakeAzureDevOps{statuses: map[string][]gitStatus{}}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+		p := NewProviderWithClient(NewClientWithHTTPClient(server.Client(), server.URL+"/acme", "invalid"))
+
+		_, err := p.UpdateCheckRun(t.Context(), &provider.UpdateCheckRunOptions{
+			Owner: "platform", Repo: "infra", SHA: "abc", Name: "atmos/plan/dev/vpc", Status: provider.CheckRunStateFailure,
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errUtils.ErrCICheckRunUpdateFailed)
+		assert.Contains(t, strings.Join(errors.GetAllHints(err), "\n"), "SYSTEM_ACCESSTOKEN")
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	})
+}
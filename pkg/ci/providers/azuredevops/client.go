@@ -0,0 +1,90 @@
+// Package azuredevops provides Azure DevOps Pipelines provider implementation.
+package azuredevops
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/apiclient"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// apiVersion is the Azure DevOps REST API version sent with every request.
+	apiVersion = "7.1"
+)
+
+// Client is a minimal Azure DevOps REST API client for the endpoints used by the CI provider.
+type Client struct {
+	api   *apiclient.Client
+	token string
+}
+
+// APIError is returned when the Azure DevOps API responds with a non-2xx status code.
+// It unwraps to ErrAzureDevOpsAPIRequestFailed, so callers can match all API errors.
+type APIError = apiclient.APIError
+
+// NewClient creates a new Azure DevOps API client.
+// Token precedence: ATMOS_CI_AZURE_DEVOPS_TOKEN > SYSTEM_ACCESSTOKEN.
+// SYSTEM_ACCESSTOKEN is only set when the pipeline maps it explicitly (env: SYSTEM_ACCESSTOKEN: $(System.AccessToken)).
+// The organization address is read from SYSTEM_COLLECTIONURI, which Azure Pipelines sets in every job.
+func NewClient() (*Client, error) {
+	defer perf.Track(nil, "azuredevops.NewClient")()
+
+	token := os.Getenv("ATMOS_CI_AZURE_DEVOPS_TOKEN")
+	if token == "" {
+		token = os.Getenv("SYSTEM_ACCESSTOKEN")
+	}
+	if token == "" {
+		return nil, errUtils.ErrAzureDevOpsTokenNotFound
+	}
+
+	return NewClientWithHTTPClient(&http.Client{Timeout: apiclient.DefaultTimeout}, collectionURI(), token), nil
+}
+
+// NewClientWithHTTPClient creates a new Azure DevOps API client with a custom HTTP client and organization address.
+// Useful for testing.
+func NewClientWithHTTPClient(httpClient *http.Client, baseURL, token string) *Client {
+	defer perf.Track(nil, "azuredevops.NewClientWithHTTPClient")()
+
+	// Personal access tokens and the job access token are both accepted as the password of basic authentication.
+	authorization := "Basic " + base64.StdEncoding.EncodeToString([]byte(":"+token))
+	return &Client{
+		api: apiclient.New(httpClient, baseURL, apiclient.Options{
+			Authorize: func(req *http.Request) {
+				req.Header.Set("Authorization", authorization)
+			},
+			ErrRequestFailed: errUtils.ErrAzureDevOpsAPIRequestFailed,
+			Query:            url.Values{"api-version": {apiVersion}},
+		}),
+		token: token,
+	}
+}
+
+// collectionURI returns the address of the Azure DevOps organization (e.g., "https://dev.azure.com/acme/").
+func collectionURI() string {
+	if v := os.Getenv("SYSTEM_COLLECTIONURI"); v != "" {
+		return v
+	}
+	return os.Getenv("SYSTEM_TEAMFOUNDATIONCOLLECTIONURI")
+}
+
+// repositoryPath returns the API path of a Git repository. The repository name can be used in place of its ID.
+func repositoryPath(project, repo string) string {
+	return fmt.Sprintf("/%s/_apis/git/repositories/%s", url.PathEscape(project), url.PathEscape(repo))
+}
+
+// get calls a GET endpoint and decodes the JSON response into out.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	return c.api.Get(ctx, path, query, out)
+}
+
+// post calls a POST endpoint with a JSON body and decodes the JSON response into out.
+func (c *Client) post(ctx context.Context, path string, body, out any) error {
+	return c.api.Post(ctx, path, body, out)
+}
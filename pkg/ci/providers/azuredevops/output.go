@@ -0,0 +1,100 @@
+package azuredevops
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// summaryFilePrefix is the name prefix of the Markdown files uploaded as build summaries.
+	summaryFilePrefix = "atmos-summary"
+)
+
+// summaryCounter gives each summary written by the process its own file,
+// since Azure Pipelines shows every uploaded file as a separate section of the build summary.
+var summaryCounter atomic.Int64
+
+// Escapers for logging command data and properties, which the agent unescapes.
+// See https://learn.microsoft.com/en-us/azure/devops/pipelines/scripts/logging-commands.
+var (
+	dataEscaper     = strings.NewReplacer("%", "%AZP25", "\r", "%0D", "\n", "%0A")
+	propertyEscaper = strings.NewReplacer("%", "%AZP25", "\r", "%0D", "\n", "%0A", "]", "%5D", ";", "%3B")
+)
+
+// summaryPath returns the summary file set by ATMOS_CI_SUMMARY, or an empty string to generate
+// one file per summary in the agent's temporary directory.
+func summaryPath() string {
+	return os.Getenv("ATMOS_CI_SUMMARY")
+}
+
+// LoggingCommandWriter writes outputs and summaries using Azure Pipelines logging commands.
+// Outputs become output variables of the step (task.setvariable with isOutput=true), and
+// summaries are written to Markdown files attached to the build summary (task.uploadsummary).
+type LoggingCommandWriter struct {
+	Writer      io.Writer
+	SummaryPath string
+}
+
+// NewLoggingCommandWriter creates a new LoggingCommandWriter.
+func NewLoggingCommandWriter(w io.Writer, summaryPath string) *LoggingCommandWriter {
+	defer perf.Track(nil, "azuredevops.NewLoggingCommandWriter")()
+
+	return &LoggingCommandWriter{
+		Writer:      w,
+		SummaryPath: summaryPath,
+	}
+}
+
+// WriteOutput sets an output variable of the current step.
+// Later jobs read it as dependencies.<job>.outputs['<step>.<key>'].
+// Multiline values are escaped and restored by the agent.
+func (w *LoggingCommandWriter) WriteOutput(key, value string) error {
+	defer perf.Track(nil, "azuredevops.LoggingCommandWriter.WriteOutput")()
+
+	if _, err := fmt.Fprintf(w.Writer, "##vso[task.setvariable variable=%s;isOutput=true]%s\n",
+		propertyEscaper.Replace(key), dataEscaper.Replace(value)); err != nil {
+		return fmt.Errorf("%w: failed to write output: %w", errUtils.ErrCIOutputWriteFailed, err)
+	}
+	return nil
+}
+
+// WriteSummary writes content to a Markdown file and attaches it to the build summary.
+func (w *LoggingCommandWriter) WriteSummary(content string) error {
+	defer perf.Track(nil, "azuredevops.LoggingCommandWriter.WriteSummary")()
+
+	path := w.SummaryPath
+	if path == "" {
+		dir := os.Getenv("AGENT_TEMPDIRECTORY")
+		if dir == "" {
+			dir = os.TempDir()
+		}
+		path = filepath.Join(dir, fmt.Sprintf("%s-%d-%d.md", summaryFilePrefix, os.Getpid(), summaryCounter.Add(1)))
+	}
+
+	// A summary file is uploaded once, when it's created. Later summaries appended to the same file are included.
+	_, statErr := os.Stat(path)
+	isNew := os.IsNotExist(statErr)
+
+	if err := provider.AppendSummary(path, content); err != nil {
+		return err
+	}
+
+	if !isNew {
+		log.Debug("Appended to CI summary file", "path", path)
+		return nil
+	}
+
+	if _, err := fmt.Fprintf(w.Writer, "##vso[task.uploadsummary]%s\n", dataEscaper.Replace(path)); err != nil {
+		return fmt.Errorf("%w: failed to write summary: %w", errUtils.ErrCISummaryWriteFailed, err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package azuredevops
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoggingCommandWriter_WriteOutput(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewLoggingCommandWriter(&buf, "")
+
+	require.NoError(t, w.WriteOutput("has_changes", "true"))
+	require.NoError(t, w.WriteOutput("summary", "line 1\nline 2 is 100%"))
+	require.NoError(t, w.WriteOutput("bad;key]", "x"))
+
+	assert.Equal(t, "##vso[task.setvariable variable=has_changes;isOutput=true]true\n"+
+		"##vso[task.setvariable variable=summary;isOutput=true]line 1%0Aline 2 is 100%AZP25\n"+
+		"##vso[task.setvariable variable=bad%3Bkey%5D;isOutput=true]x\n", buf.String())
+}
+
+func TestLoggingCommandWriter_WriteSummary(t *testing.T) {
+	t.Run("one file per summary", func(t *testing.T) {
+		dir := t.TempDir()
+		t.Setenv("AGENT_TEMPDIRECTORY", dir)
+
+		var buf bytes.Buffer
+		w := NewLoggingCommandWriter(&buf, "")
+
+		require.NoError(t, w.WriteSummary("## Plan dev/vpc\n"))
+		require.NoError(t, w.WriteSummary("## Plan dev/eks\n"))
+
+		files, err := filepath.Glob(filepath.Join(dir, "atmos-summary-*.md"))
+		require.NoError(t, err)
+		require.Len(t, files, 2)
+		assert.Contains(t, buf.String(), "##vso[task.uploadsummary]"+files[0]+"\n")
+		assert.Contains(t, buf.String(), "##vso[task.uploadsummary]"+files[1]+"\n")
+	})
+
+	t.Run("fixed summary file is uploaded once", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "summary.md")
+
+		var buf bytes.Buffer
+		w := NewLoggingCommandWriter(&buf, path)
+
+		require.NoError(t, w.WriteSummary("## Plan\n"))
+		require.NoError(t, w.WriteSummary("3 to add\n"))
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "## Plan\n3 to add\n", string(data))
+		assert.Equal(t, "##vso[task.uploadsummary]"+path+"\n", buf.String())
+	})
+}
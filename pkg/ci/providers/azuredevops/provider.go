@@ -0,0 +1,187 @@
+package azuredevops
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudposse/atmos/pkg/ci"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/git"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// ProviderName is the name of the Azure DevOps Pipelines provider.
+	ProviderName = "azure-devops"
+
+	// reasonPullRequest is the build reason of pull request validation builds.
+	reasonPullRequest = "PullRequest"
+
+	// branchRefPrefix is the prefix of branch references in BUILD_SOURCEBRANCH and SYSTEM_PULLREQUEST_* variables.
+	branchRefPrefix = "refs/heads/"
+)
+
+// Ensure Provider implements provider.Provider.
+var _ provider.Provider = (*Provider)(nil)
+
+// Provider implements provider.Provider for Azure DevOps Pipelines.
+// The client is lazily initialized on first use, so the provider can be
+// registered at init time based on environment detection alone, without
+// requiring SYSTEM_ACCESSTOKEN to be available at startup.
+type Provider struct {
+	client     *Client
+	clientOnce sync.Once
+	clientErr  error
+}
+
+// NewProvider creates a new Azure DevOps Pipelines provider.
+// The Azure DevOps API client is lazily initialized on first use.
+func NewProvider() *Provider {
+	defer perf.Track(nil, "azuredevops.NewProvider")()
+
+	return &Provider{}
+}
+
+// NewProviderWithClient creates a new Azure DevOps Pipelines provider with a custom client.
+func NewProviderWithClient(client *Client) *Provider {
+	defer perf.Track(nil, "azuredevops.NewProviderWithClient")()
+
+	p := &Provider{client: client}
+	// Mark client as already initialized so ensureClient() is a no-op.
+	p.clientOnce.Do(func() {})
+	return p
+}
+
+// ensureClient lazily initializes the Azure DevOps API client.
+func (p *Provider) ensureClient() error {
+	p.clientOnce.Do(func() {
+		if p.client != nil {
+			return
+		}
+		client, err := NewClient()
+		if err != nil {
+			p.clientErr = err
+			return
+		}
+		p.client = client
+	})
+	return p.clientErr
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	defer perf.Track(nil, "azuredevops.Provider.Name")()
+
+	return ProviderName
+}
+
+// Detect returns true if running in Azure DevOps Pipelines.
+func (p *Provider) Detect() bool {
+	defer perf.Track(nil, "azuredevops.Provider.Detect")()
+
+	return strings.EqualFold(os.Getenv("TF_BUILD"), "true")
+}
+
+// Context returns CI metadata from Azure DevOps Pipelines predefined variables.
+// The repository owner is the Azure DevOps project, since repositories are scoped to projects.
+func (p *Provider) Context() (*provider.Context, error) {
+	defer perf.Track(nil, "azuredevops.Provider.Context")()
+
+	runNumber, _ := strconv.Atoi(os.Getenv("BUILD_BUILDID"))
+
+	ctx := &provider.Context{
+		Provider:  ProviderName,
+		RunID:     os.Getenv("BUILD_BUILDID"),
+		RunNumber: runNumber,
+		Workflow:  os.Getenv("BUILD_DEFINITIONNAME"),
+		Job:       os.Getenv("SYSTEM_JOBDISPLAYNAME"),
+		Actor:     os.Getenv("BUILD_REQUESTEDFOR"),
+		EventName: os.Getenv("BUILD_REASON"),
+		SHA:       resolveGitSHA(),
+		Ref:       os.Getenv("BUILD_SOURCEBRANCH"),
+		RepoOwner: os.Getenv("SYSTEM_TEAMPROJECT"),
+		RepoName:  os.Getenv("BUILD_REPOSITORY_NAME"),
+	}
+	if ctx.Job == "" {
+		ctx.Job = os.Getenv("AGENT_JOBNAME")
+	}
+	if ctx.RepoOwner != "" && ctx.RepoName != "" {
+		ctx.Repository = ctx.RepoOwner + "/" + ctx.RepoName
+	}
+
+	// Pull request builds run on the merge commit (refs/pull/N/merge); the source branch is in SYSTEM_PULLREQUEST_SOURCEBRANCH.
+	if ctx.EventName == reasonPullRequest {
+		ctx.PullRequest = parsePRInfo()
+		ctx.Branch = ctx.PullRequest.HeadRef
+		return ctx, nil
+	}
+
+	if strings.HasPrefix(ctx.Ref, branchRefPrefix) {
+		ctx.Branch = strings.TrimPrefix(ctx.Ref, branchRefPrefix)
+	}
+
+	return ctx, nil
+}
+
+// resolveGitSHA returns the commit SHA of the build from BUILD_SOURCEVERSION,
+// falling back to git HEAD when the variable isn't set.
+func resolveGitSHA() string {
+	if sha := os.Getenv("BUILD_SOURCEVERSION"); sha != "" {
+		return sha
+	}
+	sha, err := git.NewDefaultGitRepo().GetCurrentCommitSHA()
+	if err != nil {
+		log.Debug("Failed to resolve SHA from git HEAD", "error", err)
+		return ""
+	}
+	return sha
+}
+
+// parsePRInfo extracts pull request information from the predefined variables of pull request builds.
+// Azure Repos set SYSTEM_PULLREQUEST_PULLREQUESTID; GitHub repositories set SYSTEM_PULLREQUEST_PULLREQUESTNUMBER.
+func parsePRInfo() *provider.PRInfo {
+	number, _ := strconv.Atoi(os.Getenv("SYSTEM_PULLREQUEST_PULLREQUESTNUMBER"))
+	if number == 0 {
+		number, _ = strconv.Atoi(os.Getenv("SYSTEM_PULLREQUEST_PULLREQUESTID"))
+	}
+
+	var prURL string
+	if repoURL := os.Getenv("SYSTEM_PULLREQUEST_SOURCEREPOSITORYURI"); repoURL != "" && number > 0 &&
+		os.Getenv("BUILD_REPOSITORY_PROVIDER") == "TfsGit" {
+		prURL = repoURL + "/pullrequest/" + strconv.Itoa(number)
+	}
+
+	return &provider.PRInfo{
+		Number:  number,
+		HeadRef: strings.TrimPrefix(os.Getenv("SYSTEM_PULLREQUEST_SOURCEBRANCH"), branchRefPrefix),
+		BaseRef: strings.TrimPrefix(os.Getenv("SYSTEM_PULLREQUEST_TARGETBRANCH"), branchRefPrefix),
+		URL:     prURL,
+	}
+}
+
+// GetStatus returns the CI status for the current branch.
+func (p *Provider) GetStatus(ctx context.Context, opts provider.StatusOptions) (*provider.Status, error) {
+	defer perf.Track(nil, "azuredevops.Provider.GetStatus")()
+
+	return p.getStatus(ctx, opts)
+}
+
+// OutputWriter returns an OutputWriter that emits Azure Pipelines logging commands.
+func (p *Provider) OutputWriter() provider.OutputWriter {
+	defer perf.Track(nil, "azuredevops.Provider.OutputWriter")()
+
+	return NewLoggingCommandWriter(os.Stdout, summaryPath())
+}
+
+func init() {
+	// Only register if we can detect Azure DevOps Pipelines.
+	// The client is lazily initialized — SYSTEM_ACCESSTOKEN is not required at init time.
+	p := NewProvider()
+	if p.Detect() {
+		ci.Register(p)
+	}
+}
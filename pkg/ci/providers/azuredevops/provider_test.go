@@ -0,0 +1,145 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// setAzureDevOpsEnv sets the predefined variables common to all Azure Pipelines builds.
+func setAzureDevOpsEnv(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("TF_BUILD", "True")
+	t.Setenv("BUILD_BUILDID", "1001")
+	t.Setenv("BUILD_DEFINITIONNAME", "infra-ci")
+	t.Setenv("SYSTEM_JOBDISPLAYNAME", "plan")
+	t.Setenv("BUILD_REQUESTEDFOR", "Jane Doe")
+	t.Setenv("BUILD_SOURCEVERSION", "abc123def456abc123def456abc123def456abcd")
+	t.Setenv("SYSTEM_TEAMPROJECT", "platform")
+	t.Setenv("BUILD_REPOSITORY_NAME", "infra")
+	t.Setenv("BUILD_REPOSITORY_PROVIDER", "TfsGit")
+	t.Setenv("BUILD_REASON", "IndividualCI")
+	t.Setenv("BUILD_SOURCEBRANCH", "refs/heads/main")
+	t.Setenv("SYSTEM_PULLREQUEST_PULLREQUESTID", "")
+	t.Setenv("SYSTEM_PULLREQUEST_PULLREQUESTNUMBER", "")
+}
+
+func TestProvider_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected bool
+	}{
+		{name: "detects when TF_BUILD is True", envValue: "True", expected: true},
+		{name: "detects when TF_BUILD is true", envValue: "true", expected: true},
+		{name: "does not detect when TF_BUILD is empty", envValue: "", expected: false},
+		{name: "does not detect when TF_BUILD is false", envValue: "False", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TF_BUILD", tt.envValue)
+			assert.Equal(t, tt.expected, NewProvider().Detect())
+		})
+	}
+}
+
+func TestProvider_Name(t *testing.T) {
+	assert.Equal(t, "azure-devops", NewProvider().Name())
+}
+
+func TestProvider_Context(t *testing.T) {
+	t.Run("CI build", func(t *testing.T) {
+		setAzureDevOpsEnv(t)
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		assert.Equal(t, &provider.Context{
+			Provider:   ProviderName,
+			RunID:      "1001",
+			RunNumber:  1001,
+			Workflow:   "infra-ci",
+			Job:        "plan",
+			Actor:      "Jane Doe",
+			EventName:  "IndividualCI",
+			Ref:        "refs/heads/main",
+			SHA:        "abc123def456abc123def456abc123def456abcd",
+			Branch:     "main",
+			Repository: "platform/infra",
+			RepoOwner:  "platform",
+			RepoName:   "infra",
+		}, ctx)
+	})
+
+	t.Run("pull request build", func(t *testing.T) {
+		setAzureDevOpsEnv(t)
+		t.Setenv("BUILD_REASON", "PullRequest")
+		t.Setenv("BUILD_SOURCEBRANCH", "refs/pull/7/merge")
+		t.Setenv("SYSTEM_PULLREQUEST_PULLREQUESTID", "7")
+		t.Setenv("SYSTEM_PULLREQUEST_SOURCEBRANCH", "refs/heads/feature/vpc")
+		t.Setenv("SYSTEM_PULLREQUEST_TARGETBRANCH", "refs/heads/main")
+		t.Setenv("SYSTEM_PULLREQUEST_SOURCEREPOSITORYURI", "https://dev.azure.com/acme/platform/_git/infra")
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		assert.Equal(t, "refs/pull/7/merge", ctx.Ref)
+		assert.Equal(t, "feature/vpc", ctx.Branch)
+		require.NotNil(t, ctx.PullRequest)
+		assert.Equal(t, &provider.PRInfo{
+			Number:  7,
+			HeadRef: "feature/vpc",
+			BaseRef: "main",
+			URL:     "https://dev.azure.com/acme/platform/_git/infra/pullrequest/7",
+		}, ctx.PullRequest)
+	})
+
+	t.Run("pull request build of a GitHub repository", func(t *testing.T) {
+		setAzureDevOpsEnv(t)
+		t.Setenv("BUILD_REASON", "PullRequest")
+		t.Setenv("BUILD_REPOSITORY_PROVIDER", "GitHub")
+		t.Setenv("SYSTEM_PULLREQUEST_PULLREQUESTID", "123456789")
+		t.Setenv("SYSTEM_PULLREQUEST_PULLREQUESTNUMBER", "12")
+		t.Setenv("SYSTEM_PULLREQUEST_SOURCEBRANCH", "feature/vpc")
+		t.Setenv("SYSTEM_PULLREQUEST_TARGETBRANCH", "main")
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		require.NotNil(t, ctx.PullRequest)
+		assert.Equal(t, 12, ctx.PullRequest.Number)
+		assert.Equal(t, "feature/vpc", ctx.PullRequest.HeadRef)
+		assert.Equal(t, "main", ctx.PullRequest.BaseRef)
+		assert.Empty(t, ctx.PullRequest.URL)
+	})
+}
+
+func TestProvider_OutputWriter(t *testing.T) {
+	assert.IsType(t, &LoggingCommandWriter{}, NewProvider().OutputWriter())
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("no token", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_AZURE_DEVOPS_TOKEN", "")
+		t.Setenv("SYSTEM_ACCESSTOKEN", "")
+
+		_, err := NewClient()
+		assert.Error(t, err)
+	})
+
+	t.Run("token precedence", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_AZURE_DEVOPS_TOKEN", "pat")
+		t.Setenv("SYSTEM_ACCESSTOKEN", "job-token")
+		t.Setenv("SYSTEM_COLLECTIONURI", "https://dev.azure.com/acme/")
+
+		client, err := NewClient()
+		require.NoError(t, err)
+		assert.Equal(t, "pat", client.token)
+		assert.Equal(t, "https://dev.azure.com/acme", client.api.BaseURL())
+	})
+}
@@ -0,0 +1,220 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+const (
+	// pullRequestListLimit is the number of pull requests listed per status section.
+	pullRequestListLimit = "10"
+
+	// connectionDataAPIVersion is the API version of the connection data endpoint, which is only available as a preview.
+	connectionDataAPIVersion = "7.1-preview"
+)
+
+// pullRequest is an Azure DevOps Git pull request.
+type pullRequest struct {
+	PullRequestID         int    `json:"pullRequestId"`
+	Title                 string `json:"title"`
+	SourceRefName         string `json:"sourceRefName"`
+	TargetRefName         string `json:"targetRefName"`
+	LastMergeSourceCommit struct {
+		CommitID string `json:"commitId"`
+	} `json:"lastMergeSourceCommit"`
+	Repository struct {
+		WebURL string `json:"webUrl"`
+	} `json:"repository"`
+}
+
+// listResponse is the envelope of Azure DevOps list endpoints.
+type listResponse[T any] struct {
+	Value []T `json:"value"`
+}
+
+// connectionData describes the authenticated identity.
+type connectionData struct {
+	AuthenticatedUser struct {
+		ID string `json:"id"`
+	} `json:"authenticatedUser"`
+}
+
+// getStatus fetches the CI status for the given options.
+func (p *Provider) getStatus(ctx context.Context, opts provider.StatusOptions) (*provider.Status, error) {
+	if err := p.ensureClient(); err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCIStatusFetchFailed, err)
+	}
+
+	status := &provider.Status{
+		Repository: fmt.Sprintf("%s/%s", opts.Owner, opts.Repo),
+	}
+
+	// Get status for current branch.
+	branchStatus, err := p.getBranchStatus(ctx, opts.Owner, opts.Repo, opts.Branch, opts.SHA)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCIStatusFetchFailed, wrapAzureDevOpsAPIError(err))
+	}
+	status.CurrentBranch = branchStatus
+
+	if !opts.IncludeUserPRs && !opts.IncludeReviewRequests {
+		return status, nil
+	}
+
+	userID, err := p.getAuthenticatedUserID(ctx)
+	if err != nil {
+		// Non-fatal: continue without user and review PRs.
+		return status, nil
+	}
+
+	// Get PRs created by the authenticated user.
+	if opts.IncludeUserPRs {
+		userPRs, err := p.listPullRequests(ctx, opts.Owner, opts.Repo, url.Values{"searchCriteria.creatorId": {userID}})
+		if err != nil {
+			// Non-fatal: continue without user PRs.
+			userPRs = nil
+		}
+		status.CreatedByUser = userPRs
+	}
+
+	// Get PRs that have the authenticated user as a reviewer.
+	if opts.IncludeReviewRequests {
+		reviewPRs, err := p.listPullRequests(ctx, opts.Owner, opts.Repo, url.Values{"searchCriteria.reviewerId": {userID}})
+		if err != nil {
+			// Non-fatal: continue without review requests.
+			reviewPRs = nil
+		}
+		status.ReviewRequests = reviewPRs
+	}
+
+	return status, nil
+}
+
+// getBranchStatus gets the status for a specific branch.
+func (p *Provider) getBranchStatus(ctx context.Context, project, repo, branch, sha string) (*provider.BranchStatus, error) {
+	status := &provider.BranchStatus{
+		Branch:    branch,
+		CommitSHA: sha,
+	}
+
+	// Get the active PR for this branch (if any).
+	if branch != "" {
+		prs, err := p.listPullRequests(ctx, project, repo, url.Values{"searchCriteria.sourceRefName": {branchRefPrefix + branch}})
+		if err == nil && len(prs) > 0 {
+			status.PullRequest = prs[0]
+		}
+	}
+
+	// Get commit statuses for the SHA.
+	if sha != "" {
+		checks, err := p.getCommitStatuses(ctx, project, repo, sha)
+		if err != nil {
+			return nil, err
+		}
+		status.Checks = checks
+	}
+
+	return status, nil
+}
+
+// getAuthenticatedUserID returns the ID of the identity the token belongs to.
+func (p *Provider) getAuthenticatedUserID(ctx context.Context) (string, error) {
+	var data connectionData
+	if err := p.client.get(ctx, "/_apis/connectionData", url.Values{"api-version": {connectionDataAPIVersion}}, &data); err != nil {
+		return "", err
+	}
+	return data.AuthenticatedUser.ID, nil
+}
+
+// listPullRequests lists the active pull requests of the repository matching the query.
+func (p *Provider) listPullRequests(ctx context.Context, project, repo string, query url.Values) ([]*provider.PRStatus, error) {
+	query.Set("searchCriteria.status", "active")
+	query.Set("$top", pullRequestListLimit)
+
+	var resp listResponse[pullRequest]
+	if err := p.client.get(ctx, repositoryPath(project, repo)+"/pullrequests", query, &resp); err != nil {
+		return nil, err
+	}
+
+	result := make([]*provider.PRStatus, 0, len(resp.Value))
+	for i := range resp.Value {
+		pr := &resp.Value[i]
+		prStatus := &provider.PRStatus{
+			Number:     pr.PullRequestID,
+			Title:      pr.Title,
+			Branch:     strings.TrimPrefix(pr.SourceRefName, branchRefPrefix),
+			BaseBranch: strings.TrimPrefix(pr.TargetRefName, branchRefPrefix),
+		}
+		if pr.Repository.WebURL != "" {
+			prStatus.URL = pr.Repository.WebURL + "/pullrequest/" + strconv.Itoa(pr.PullRequestID)
+		}
+
+		// Get statuses for this PR's head SHA.
+		if sha := pr.LastMergeSourceCommit.CommitID; sha != "" {
+			checks, _ := p.getCommitStatuses(ctx, project, repo, sha)
+			prStatus.Checks = checks
+			prStatus.AllPassed = allChecksPassed(checks)
+		}
+
+		result = append(result, prStatus)
+	}
+
+	return result, nil
+}
+
+// getCommitStatuses fetches the latest status of each genre/name pair of a commit.
+func (p *Provider) getCommitStatuses(ctx context.Context, project, repo, sha string) ([]*provider.CheckStatus, error) {
+	var resp listResponse[gitStatus]
+	path := repositoryPath(project, repo) + "/commits/" + url.PathEscape(sha) + "/statuses"
+	if err := p.client.get(ctx, path, url.Values{"latestOnly": {"true"}}, &resp); err != nil {
+		return nil, err
+	}
+
+	checks := make([]*provider.CheckStatus, 0, len(resp.Value))
+	for i := range resp.Value {
+		state, conclusion := mapStatusToCheckStatus(resp.Value[i].State)
+		checks = append(checks, &provider.CheckStatus{
+			Name:       resp.Value[i].fullName(),
+			Status:     state,
+			Conclusion: conclusion,
+			DetailsURL: resp.Value[i].TargetURL,
+		})
+	}
+
+	return checks, nil
+}
+
+// mapStatusToCheckStatus maps an Azure DevOps commit status state to the status and conclusion of a check.
+func mapStatusToCheckStatus(state string) (string, string) {
+	switch state {
+	case "succeeded":
+		return "completed", "success"
+	case "failed", "error":
+		return "completed", "failure"
+	case "notApplicable":
+		return "completed", "skipped"
+	default:
+		// notSet and pending.
+		return "pending", ""
+	}
+}
+
+// allChecksPassed returns true if all checks have passed.
+func allChecksPassed(checks []*provider.CheckStatus) bool {
+	if len(checks) == 0 {
+		return true
+	}
+
+	for _, check := range checks {
+		state := check.CheckState()
+		if state != provider.CheckStatusStateSuccess && state != provider.CheckStatusStateSkipped {
+			return false
+		}
+	}
+	return true
+}
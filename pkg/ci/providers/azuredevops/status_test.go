@@ -0,0 +1,84 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+func TestMapStatusToCheckStatus(t *testing.T) {
+	tests := []struct {
+		state              string
+		expectedStatus     string
+		expectedConclusion string
+	}{
+		{"succeeded", "completed", "success"},
+		{"failed", "completed", "failure"},
+		{"error", "completed", "failure"},
+		{"notApplicable", "completed", "skipped"},
+		{"pending", "pending", ""},
+		{"notSet", "pending", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			status, conclusion := mapStatusToCheckStatus(tt.state)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedConclusion, conclusion)
+		})
+	}
+}
+
+func TestProvider_GetStatus(t *testing.T) {
+	fake, p := newFakeAzureDevOps(t)
+	fake.statuses["sha-main"] = []gitStatus{
+		{State: "succeeded", Context: statusContext{Genre: "atmos", Name: "plan/dev/vpc"}, TargetURL: "https://dev.azure.com/acme/build/1"},
+	}
+	fake.statuses["sha-feature"] = []gitStatus{
+		{State: "succeeded", Context: statusContext{Genre: "atmos", Name: "plan/dev/vpc"}},
+		{State: "failed", Context: statusContext{Genre: "atmos", Name: "plan/dev/eks"}},
+	}
+	pr := pullRequest{PullRequestID: 7, Title: "Add VPC", SourceRefName: "refs/heads/feature/vpc", TargetRefName: "refs/heads/main"}
+	pr.LastMergeSourceCommit.CommitID = "sha-feature"
+	pr.Repository.WebURL = "https://dev.azure.com/acme/platform/_git/infra"
+	fake.prs = []pullRequest{pr}
+
+	status, err := p.GetStatus(t.Context(), provider.StatusOptions{
+		Owner:                 "platform",
+		Repo:                  "infra",
+		Branch:                "feature/vpc",
+		SHA:                   "sha-main",
+		IncludeUserPRs:        true,
+		IncludeReviewRequests: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "platform/infra", status.Repository)
+	require.NotNil(t, status.CurrentBranch)
+	require.Len(t, status.CurrentBranch.Checks, 1)
+	assert.Equal(t, "atmos/plan/dev/vpc", status.CurrentBranch.Checks[0].Name)
+	assert.Equal(t, provider.CheckStatusStateSuccess, status.CurrentBranch.Checks[0].CheckState())
+
+	got := status.CurrentBranch.PullRequest
+	require.NotNil(t, got)
+	assert.Equal(t, 7, got.Number)
+	assert.Equal(t, "feature/vpc", got.Branch)
+	assert.Equal(t, "main", got.BaseBranch)
+	assert.Equal(t, "https://dev.azure.com/acme/platform/_git/infra/pullrequest/7", got.URL)
+	assert.Len(t, got.Checks, 2)
+	assert.False(t, got.AllPassed)
+
+	assert.Len(t, status.CreatedByUser, 1)
+	assert.Len(t, status.ReviewRequests, 1)
+}
+
+func TestProvider_GetStatus_RepositoryNotFound(t *testing.T) {
+	_, p := newFakeAzureDevOps(t)
+
+	_, err := p.GetStatus(t.Context(), provider.StatusOptions{Owner: "platform", Repo: "missing", SHA: "abc"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to fetch CI status")
+}
@@ -0,0 +1,68 @@
+package bitbucket
+
+import (
+	"os"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// defaultRef is the fallback git reference when no base can be resolved.
+	defaultRef = "refs/remotes/origin/HEAD"
+	// sourceDefault is the source label for default fallback resolution.
+	sourceDefault = "default"
+)
+
+// ResolveBase returns the base commit for affected detection in Bitbucket Pipelines.
+// Pull request pipelines use BITBUCKET_PR_DESTINATION_COMMIT, falling back to the destination branch.
+// Bitbucket Pipelines doesn't expose the previous commit of branch pipelines, so they fall back to the default branch.
+func (p *Provider) ResolveBase() (*provider.BaseResolution, error) {
+	defer perf.Track(nil, "bitbucket.Provider.ResolveBase")()
+
+	if os.Getenv("BITBUCKET_PR_ID") != "" {
+		return resolvePRBase(), nil
+	}
+
+	eventName := eventPush
+	if os.Getenv("BITBUCKET_TAG") != "" {
+		eventName = eventTag
+	}
+
+	return &provider.BaseResolution{
+		Ref:       defaultRef,
+		Source:    sourceDefault,
+		EventType: eventName,
+	}, nil
+}
+
+// resolvePRBase resolves the base commit for pull request pipelines.
+// Pull request pipelines run on the source branch commit, which is the head SHA.
+func resolvePRBase() *provider.BaseResolution {
+	headSHA := os.Getenv("BITBUCKET_COMMIT")
+
+	if sha := os.Getenv("BITBUCKET_PR_DESTINATION_COMMIT"); sha != "" {
+		return &provider.BaseResolution{
+			SHA:       sha,
+			HeadSHA:   headSHA,
+			Source:    "BITBUCKET_PR_DESTINATION_COMMIT",
+			EventType: eventPullRequest,
+		}
+	}
+
+	if target := os.Getenv("BITBUCKET_PR_DESTINATION_BRANCH"); target != "" {
+		return &provider.BaseResolution{
+			Ref:       "refs/remotes/origin/" + target,
+			HeadSHA:   headSHA,
+			Source:    "BITBUCKET_PR_DESTINATION_BRANCH",
+			EventType: eventPullRequest,
+		}
+	}
+
+	return &provider.BaseResolution{
+		Ref:       defaultRef,
+		HeadSHA:   headSHA,
+		Source:    sourceDefault + " (BITBUCKET_PR_DESTINATION_BRANCH empty)",
+		EventType: eventPullRequest,
+	}
+}
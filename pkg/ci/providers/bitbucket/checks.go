@@ -0,0 +1,169 @@
+package bitbucket
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// maxDescriptionLength is the length build status descriptions are truncated to.
+// The pull request view only shows a single line of the description.
+const maxDescriptionLength = 140
+
+// buildStatus is a Bitbucket commit build status.
+type buildStatus struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	Name        string `json:"name,omitempty"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// setBuildStatus sets a build status on a commit using the Bitbucket Commit Statuses API.
+// Bitbucket build statuses take the place of GitHub check runs. Both CreateCheckRun and
+// UpdateCheckRun delegate to this method since statuses are keyed by name.
+// Bitbucket requires a URL, so the pipeline URL is used when no details URL is given.
+func (p *Provider) setBuildStatus(ctx context.Context, workspace, repoSlug, sha, name, state, description, targetURL string) (*buildStatus, error) {
+	if err := p.ensureClient(); err != nil {
+		return nil, err
+	}
+
+	if targetURL == "" {
+		targetURL = pipelineURL(workspace, repoSlug)
+	}
+
+	body := buildStatus{
+		Key:         name,
+		State:       state,
+		Name:        name,
+		URL:         targetURL,
+		Description: truncateDescription(description),
+	}
+
+	var status buildStatus
+	path := repositoryPath(workspace, repoSlug) + "/commit/" + url.PathEscape(sha) + "/statuses/build"
+	if err := p.client.post(ctx, path, body, &status); err != nil {
+		return nil, wrapBitbucketAPIError(err)
+	}
+	return &status, nil
+}
+
+// pipelineURL returns the address of the current pipeline run.
+func pipelineURL(workspace, repoSlug string) string {
+	origin := os.Getenv("BITBUCKET_GIT_HTTP_ORIGIN")
+	if origin == "" {
+		origin = "https://bitbucket.org/" + workspace + "/" + repoSlug
+	}
+	origin = strings.TrimPrefix(origin, "http://")
+	if !strings.HasPrefix(origin, "https://") {
+		origin = "https://" + origin
+	}
+	return origin + "/pipelines/results/" + os.Getenv("BITBUCKET_BUILD_NUMBER")
+}
+
+// wrapBitbucketAPIError wraps Bitbucket API errors with actionable hints for common
+// authentication and permission failures (401, 403, 404).
+func wrapBitbucketAPIError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return errUtils.Build(err).
+			WithHint("The Bitbucket credentials are invalid or expired.").
+			WithHint("Set ATMOS_CI_BITBUCKET_TOKEN or BITBUCKET_ACCESS_TOKEN to a repository access token, or BITBUCKET_USERNAME and BITBUCKET_APP_PASSWORD.").
+			Err()
+	case http.StatusForbidden, http.StatusNotFound:
+		return errUtils.Build(err).
+			WithHint("The credentials do not have permission to set build statuses on this repository.").
+			WithHint("Use a repository access token with the 'repository:write' and 'pullrequest' scopes.").
+			Err()
+	default:
+		return err
+	}
+}
+
+// createCheckRun sets a build status on a commit.
+func (p *Provider) createCheckRun(ctx context.Context, opts *provider.CreateCheckRunOptions) (*provider.CheckRun, error) {
+	state := mapCheckRunStateToStatusState(opts.Status)
+
+	status, err := p.setBuildStatus(ctx, opts.Owner, opts.Repo, opts.SHA, opts.Name, state, opts.Title, opts.DetailsURL)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCICheckRunCreateFailed).WithCause(err).Err()
+	}
+
+	return &provider.CheckRun{
+		Name:       status.Key,
+		Status:     opts.Status,
+		Title:      status.Description,
+		DetailsURL: status.URL,
+	}, nil
+}
+
+// updateCheckRun updates a build status on a commit.
+func (p *Provider) updateCheckRun(ctx context.Context, opts *provider.UpdateCheckRunOptions) (*provider.CheckRun, error) {
+	state := mapCheckRunStateToStatusState(opts.Status)
+
+	status, err := p.setBuildStatus(ctx, opts.Owner, opts.Repo, opts.SHA, opts.Name, state, opts.Title, opts.DetailsURL)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCICheckRunUpdateFailed).WithCause(err).Err()
+	}
+
+	return &provider.CheckRun{
+		Name:       status.Key,
+		Status:     opts.Status,
+		Title:      status.Description,
+		DetailsURL: status.URL,
+	}, nil
+}
+
+// mapCheckRunStateToStatusState maps CheckRunState to a Bitbucket build status state.
+// Bitbucket has no pending state, so pending checks are reported as in progress.
+func mapCheckRunStateToStatusState(state provider.CheckRunState) string {
+	switch state {
+	case provider.CheckRunStatePending, provider.CheckRunStateInProgress:
+		return "INPROGRESS"
+	case provider.CheckRunStateSuccess:
+		return "SUCCESSFUL"
+	case provider.CheckRunStateFailure, provider.CheckRunStateError:
+		return "FAILED"
+	case provider.CheckRunStateCancelled:
+		return "STOPPED"
+	default:
+		return "INPROGRESS"
+	}
+}
+
+// truncateDescription truncates a description to 140 characters.
+// Uses character count (runes), not byte count, to avoid mid-character truncation.
+func truncateDescription(desc string) string {
+	if utf8.RuneCountInString(desc) <= maxDescriptionLength {
+		return desc
+	}
+	runes := []rune(desc)
+	return string(runes[:maxDescriptionLength-3]) + "..."
+}
+
+// CreateCheckRun creates a new build status on a commit.
+func (p *Provider) CreateCheckRun(ctx context.Context, opts *provider.CreateCheckRunOptions) (*provider.CheckRun, error) {
+	defer perf.Track(nil, "bitbucket.Provider.CreateCheckRun")()
+
+	return p.createCheckRun(ctx, opts)
+}
+
+// UpdateCheckRun updates an existing build status on a commit.
+func (p *Provider) UpdateCheckRun(ctx context.Context, opts *provider.UpdateCheckRunOptions) (*provider.CheckRun, error) {
+	defer perf.Track(nil, "bitbucket.Provider.UpdateCheckRun")()
+
+	return p.updateCheckRun(ctx, opts)
+}
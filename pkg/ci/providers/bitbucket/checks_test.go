@@ -0,0 +1,180 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// fakeBitbucket is a local stand-in for the Bitbucket Cloud REST API.
+type fakeBitbucket struct {
+	mu       sync.Mutex
+	statuses map[string][]buildStatus
+	prs      []pullRequest
+	queries  []string
+}
+
+func newFakeBitbucket(t *testing.T) (*fakeBitbucket, *Provider) {
+	t.Helper()
+
+	fake := &fakeBitbucket{statuses: map[string][]buildStatus{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(server.Client(), server.URL+"/2.0", "token-test")
+	return fake, NewProviderWithClient(client)
+}
+
+func (f *fakeBitbucket) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Bearer token-test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(r.URL.Path, "/2.0")
+	const repo = "/repositories/acme/infra"
+
+	switch {
+	case r.Method == http.MethodPost && strings.HasPrefix(path, repo+"/commit/") && strings.HasSuffix(path, "/statuses/build"):
+		var status buildStatus
+		if err := json.NewDecoder(r.Body).Decode(&status); err != nil || status.URL == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		sha := strings.TrimSuffix(strings.TrimPrefix(path, repo+"/commit/"), "/statuses/build")
+		f.statuses[sha] = append(f.statuses[sha], status)
+		_ = json.NewEncoder(w).Encode(status)
+	case r.Method == http.MethodGet && strings.HasPrefix(path, repo+"/commit/") && strings.HasSuffix(path, "/statuses"):
+		sha := strings.TrimSuffix(strings.TrimPrefix(path, repo+"/commit/"), "/statuses")
+		_ = json.NewEncoder(w).Encode(page[buildStatus]{Values: f.statuses[sha]})
+	case r.Method == http.MethodGet && path == repo+"/pullrequests":
+		query := r.URL.Query().Get("q")
+		f.queries = append(f.queries, query)
+		result := []pullRequest{}
+		for _, pr := range f.prs {
+			if strings.HasPrefix(query, "source.branch.name=") && !strings.HasPrefix(query, `source.branch.name="`+pr.Source.Branch.Name+`"`) {
+				continue
+			}
+			result = append(result, pr)
+		}
+		_ = json.NewEncoder(w).Encode(page[pullRequest]{Values: result})
+	case r.Method == http.MethodGet && path == "/user":
+		_, _ = w.Write([]byte(`{"uuid":"{user-1}"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"type":"error","error":{"message":"Repository not found"}}`))
+	}
+}
+
+func TestMapCheckRunStateToStatusState(t *testing.T) {
+	tests := []struct {
+		state    provider.CheckRunState
+		expected string
+	}{
+		{provider.CheckRunStatePending, "INPROGRESS"},
+		{provider.CheckRunStateInProgress, "INPROGRESS"},
+		{provider.CheckRunStateSuccess, "SUCCESSFUL"},
+		{provider.CheckRunStateFailure, "FAILED"},
+		{provider.CheckRunStateError, "FAILED"},
+		{provider.CheckRunStateCancelled, "STOPPED"},
+		{provider.CheckRunState("unknown"), "INPROGRESS"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			assert.Equal(t, tt.expected, mapCheckRunStateToStatusState(tt.state))
+		})
+	}
+}
+
+func TestPipelineURL(t *testing.T) {
+	t.Setenv("BITBUCKET_BUILD_NUMBER", "42")
+
+	t.Setenv("BITBUCKET_GIT_HTTP_ORIGIN", "http://bitbucket.org/acme/infra")
+	assert.Equal(t, "https://bitbucket.org/acme/infra/pipelines/results/42", pipelineURL("acme", "infra"))
+
+	t.Setenv("BITBUCKET_GIT_HTTP_ORIGIN", "")
+	assert.Equal(t, "https://bitbucket.org/acme/infra/pipelines/results/42", pipelineURL("acme", "infra"))
+}
+
+func TestProvider_CreateAndUpdateCheckRun(t *testing.T) {
+	t.Setenv("BITBUCKET_BUILD_NUMBER", "42")
+	t.Setenv("BITBUCKET_GIT_HTTP_ORIGIN", "http://bitbucket.org/acme/infra")
+
+	fake, p := newFakeBitbucket(t)
+	sha := "abc123def456abc123def456abc123def456abcd"
+
+	created, err := p.CreateCheckRun(t.Context(), &provider.CreateCheckRunOptions{
+		Owner:  "acme",
+		Repo:   "infra",
+		SHA:    sha,
+		Name:   "atmos/plan/dev/vpc",
+		Status: provider.CheckRunStatePending,
+		Title:  "Plan in progress",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "atmos/plan/dev/vpc", created.Name)
+	assert.Equal(t, "https://bitbucket.org/acme/infra/pipelines/results/42", created.DetailsURL)
+
+	updated, err := p.UpdateCheckRun(t.Context(), &provider.UpdateCheckRunOptions{
+		Owner:      "acme",
+		Repo:       "infra",
+		SHA:        sha,
+		Name:       "atmos/plan/dev/vpc",
+		Status:     provider.CheckRunStateSuccess,
+		Title:      "3 to add, 1 to change, 0 to destroy",
+		DetailsURL: "https://example.com/plan",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "3 to add, 1 to change, 0 to destroy", updated.Title)
+	assert.Equal(t, "https://example.com/plan", updated.DetailsURL)
+
+	require.Len(t, fake.statuses[sha], 2)
+	assert.Equal(t, "INPROGRESS", fake.statuses[sha][0].State)
+	assert.Equal(t, "SUCCESSFUL", fake.statuses[sha][1].State)
+	assert.Equal(t, "atmos/plan/dev/vpc", fake.statuses[sha][1].Key)
+}
+
+func TestProvider_CreateCheckRun_APIErrors(t *testing.T) {
+	t.Run("repository not found", func(t *testing.T) {
+		_, p := newFakeBitbucket(t)
+
+		_, err := p.CreateCheckRun(t.Context(), &provider.CreateCheckRunOptions{
+			Owner: "acme", Repo: "missing", SHA: "abc", Name: "atmos/plan/dev/vpc", Status: provider.CheckRunStatePending,
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errUtils.ErrCICheckRunCreateFailed)
+		assert.ErrorIs(t, err, errUtils.ErrBitbucketAPIRequestFailed)
+		assert.Contains(t, strings.Join(errors.GetAllHints(err), "\n"), "repository:write")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		fake := &fakeBitbucket{statuses: map[string][]buildStatus{}}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+		p := NewProviderWithClient(NewClientWithHTTPClient(server.Client(), server.URL+"/2.0", "invalid"))
+
+		_, err := p.UpdateCheckRun(t.Context(), &provider.UpdateCheckRunOptions{
+			Owner: "acme", Repo: "infra", SHA: "abc", Name: "atmos/plan/dev/vpc", Status: provider.CheckRunStateFailure,
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errUtils.ErrCICheckRunUpdateFailed)
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	})
+}
@@ -0,0 +1,104 @@
+// Package bitbucket provides Bitbucket Pipelines provider implementation.
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/apiclient"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// defaultAPIURL is the Bitbucket Cloud REST API address.
+	defaultAPIURL = "https://api.bitbucket.org/2.0"
+)
+
+// Client is a minimal Bitbucket Cloud REST API client for the endpoints used by the CI provider.
+type Client struct {
+	api      *apiclient.Client
+	token    string
+	username string
+	password string
+}
+
+// APIError is returned when the Bitbucket API responds with a non-2xx status code.
+// It unwraps to ErrBitbucketAPIRequestFailed, so callers can match all API errors.
+type APIError = apiclient.APIError
+
+// NewClient creates a new Bitbucket API client.
+// Credential precedence: ATMOS_CI_BITBUCKET_TOKEN > BITBUCKET_ACCESS_TOKEN (bearer access tokens),
+// then BITBUCKET_USERNAME with BITBUCKET_APP_PASSWORD (basic authentication).
+func NewClient() (*Client, error) {
+	defer perf.Track(nil, "bitbucket.NewClient")()
+
+	httpClient := &http.Client{Timeout: apiclient.DefaultTimeout}
+
+	token := os.Getenv("ATMOS_CI_BITBUCKET_TOKEN")
+	if token == "" {
+		token = os.Getenv("BITBUCKET_ACCESS_TOKEN")
+	}
+	if token != "" {
+		return newClient(httpClient, apiURL(), token, "", ""), nil
+	}
+
+	username, password := os.Getenv("BITBUCKET_USERNAME"), os.Getenv("BITBUCKET_APP_PASSWORD")
+	if username != "" && password != "" {
+		return newClient(httpClient, apiURL(), "", username, password), nil
+	}
+
+	return nil, errUtils.ErrBitbucketTokenNotFound
+}
+
+// NewClientWithHTTPClient creates a new Bitbucket API client with a custom HTTP client, API address and access token.
+// Useful for testing.
+func NewClientWithHTTPClient(httpClient *http.Client, baseURL, token string) *Client {
+	defer perf.Track(nil, "bitbucket.NewClientWithHTTPClient")()
+
+	return newClient(httpClient, baseURL, token, "", "")
+}
+
+func newClient(httpClient *http.Client, baseURL, token, username, password string) *Client {
+	c := &Client{token: token, username: username, password: password}
+	c.api = apiclient.New(httpClient, baseURL, apiclient.Options{
+		Authorize:        c.authorize,
+		ErrRequestFailed: errUtils.ErrBitbucketAPIRequestFailed,
+	})
+	return c
+}
+
+// authorize sets the bearer access token, or the app password with basic authentication.
+func (c *Client) authorize(req *http.Request) {
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+		return
+	}
+	req.SetBasicAuth(c.username, c.password)
+}
+
+// apiURL returns the Bitbucket REST API address.
+func apiURL() string {
+	if v := os.Getenv("ATMOS_CI_BITBUCKET_API_URL"); v != "" {
+		return v
+	}
+	return defaultAPIURL
+}
+
+// repositoryPath returns the API path of a repository.
+func repositoryPath(workspace, repoSlug string) string {
+	return fmt.Sprintf("/repositories/%s/%s", url.PathEscape(workspace), url.PathEscape(repoSlug))
+}
+
+// get calls a GET endpoint and decodes the JSON response into out.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	return c.api.Get(ctx, path, query, out)
+}
+
+// post calls a POST endpoint with a JSON body and decodes the JSON response into out.
+func (c *Client) post(ctx context.Context, path string, body, out any) error {
+	return c.api.Post(ctx, path, body, out)
+}
@@ -0,0 +1,40 @@
+package bitbucket
+
+import (
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// defaultEnvFile is the file outputs are written to when ATMOS_CI_OUTPUT isn't set.
+	// Declare it as a step artifact and run `source atmos.env` in later steps to read the outputs.
+	defaultEnvFile = "atmos.env"
+)
+
+// envFilePath returns the path of the output file, relative to the clone directory of the step.
+func envFilePath() string {
+	return provider.EnvFilePath("BITBUCKET_CLONE_DIR", defaultEnvFile)
+}
+
+// NewEnvFileOutputWriter creates an output writer for a shell file of export statements.
+// Bitbucket Pipelines has no step outputs, so outputs are passed to later steps as an artifact.
+// Bitbucket has no job summary either, so summaries are written to a file when a path is set,
+// and to the step log otherwise.
+func NewEnvFileOutputWriter(outputPath, summaryPath string) *provider.EnvFileOutputWriter {
+	defer perf.Track(nil, "bitbucket.NewEnvFileOutputWriter")()
+
+	return provider.NewEnvFileOutputWriter(outputPath, summaryPath, formatExportLine)
+}
+
+// formatExportLine returns an `export KEY='value'` line.
+// Values are single-quoted, so multiline values are preserved.
+func formatExportLine(key, value string) (string, bool) {
+	return "export " + key + "=" + shellQuote(value), true
+}
+
+// shellQuote single-quotes a value for POSIX shells.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
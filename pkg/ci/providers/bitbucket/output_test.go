@@ -0,0 +1,53 @@
+package bitbucket
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvFileOutputWriter_WriteOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "atmos.env")
+	w := NewEnvFileOutputWriter(path, "")
+
+	require.NoError(t, w.WriteOutput("has_changes", "true"))
+	require.NoError(t, w.WriteOutput("output_vpc-id", "vpc-123"))
+	require.NoError(t, w.WriteOutput("summary", "it's\nmultiline"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "export has_changes='true'\nexport output_vpc_id='vpc-123'\nexport summary='it'\\''s\nmultiline'\n", string(data))
+}
+
+func TestEnvFileOutputWriter_NoPath(t *testing.T) {
+	w := NewEnvFileOutputWriter("", "")
+	assert.NoError(t, w.WriteOutput("has_changes", "true"))
+}
+
+func TestEnvFileOutputWriter_WriteSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.md")
+	w := NewEnvFileOutputWriter("", path)
+
+	require.NoError(t, w.WriteSummary("## Plan\n"))
+	require.NoError(t, w.WriteSummary("3 to add\n"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "## Plan\n3 to add\n", string(data))
+}
+
+func TestEnvFilePath(t *testing.T) {
+	t.Run("ATMOS_CI_OUTPUT", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_OUTPUT", "outputs.env")
+		assert.Equal(t, "outputs.env", envFilePath())
+	})
+
+	t.Run("clone directory", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_OUTPUT", "")
+		t.Setenv("BITBUCKET_CLONE_DIR", "/opt/atlassian/pipelines/agent/build")
+		assert.Equal(t, filepath.Join("/opt/atlassian/pipelines/agent/build", "atmos.env"), envFilePath())
+	})
+}
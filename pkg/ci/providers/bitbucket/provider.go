@@ -0,0 +1,177 @@
+package bitbucket
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"sync"
+
+	"github.com/cloudposse/atmos/pkg/ci"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/git"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// ProviderName is the name of the Bitbucket Pipelines provider.
+	ProviderName = "bitbucket-pipelines"
+
+	// Bitbucket Pipelines has no event variable; the event is derived from the variables that are set.
+	eventPullRequest = "pull_request"
+	eventTag         = "tag"
+	eventPush        = "push"
+)
+
+// Ensure Provider implements provider.Provider.
+var _ provider.Provider = (*Provider)(nil)
+
+// Provider implements provider.Provider for Bitbucket Pipelines.
+// The client is lazily initialized on first use, so the provider can be
+// registered at init time based on environment detection alone, without
+// requiring Bitbucket credentials to be available at startup.
+type Provider struct {
+	client     *Client
+	clientOnce sync.Once
+	clientErr  error
+}
+
+// NewProvider creates a new Bitbucket Pipelines provider.
+// The Bitbucket API client is lazily initialized on first use.
+func NewProvider() *Provider {
+	defer perf.Track(nil, "bitbucket.NewProvider")()
+
+	return &Provider{}
+}
+
+// NewProviderWithClient creates a new Bitbucket Pipelines provider with a custom client.
+func NewProviderWithClient(client *Client) *Provider {
+	defer perf.Track(nil, "bitbucket.NewProviderWithClient")()
+
+	p := &Provider{client: client}
+	// Mark client as already initialized so ensureClient() is a no-op.
+	p.clientOnce.Do(func() {})
+	return p
+}
+
+// ensureClient lazily initializes the Bitbucket API client.
+func (p *Provider) ensureClient() error {
+	p.clientOnce.Do(func() {
+		if p.client != nil {
+			return
+		}
+		client, err := NewClient()
+		if err != nil {
+			p.clientErr = err
+			return
+		}
+		p.client = client
+	})
+	return p.clientErr
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	defer perf.Track(nil, "bitbucket.Provider.Name")()
+
+	return ProviderName
+}
+
+// Detect returns true if running in Bitbucket Pipelines.
+func (p *Provider) Detect() bool {
+	defer perf.Track(nil, "bitbucket.Provider.Detect")()
+
+	return os.Getenv("BITBUCKET_BUILD_NUMBER") != ""
+}
+
+// Context returns CI metadata from Bitbucket Pipelines default variables.
+func (p *Provider) Context() (*provider.Context, error) {
+	defer perf.Track(nil, "bitbucket.Provider.Context")()
+
+	runNumber, _ := strconv.Atoi(os.Getenv("BITBUCKET_BUILD_NUMBER"))
+
+	ctx := &provider.Context{
+		Provider:   ProviderName,
+		RunID:      os.Getenv("BITBUCKET_PIPELINE_UUID"),
+		RunNumber:  runNumber,
+		Job:        os.Getenv("BITBUCKET_STEP_UUID"),
+		Actor:      os.Getenv("BITBUCKET_STEP_TRIGGERER_UUID"),
+		SHA:        resolveGitSHA(),
+		Repository: os.Getenv("BITBUCKET_REPO_FULL_NAME"),
+		RepoOwner:  os.Getenv("BITBUCKET_WORKSPACE"),
+		RepoName:   os.Getenv("BITBUCKET_REPO_SLUG"),
+		Branch:     os.Getenv("BITBUCKET_BRANCH"),
+	}
+
+	switch {
+	case os.Getenv("BITBUCKET_PR_ID") != "":
+		ctx.EventName = eventPullRequest
+		ctx.PullRequest = parsePRInfo()
+	case os.Getenv("BITBUCKET_TAG") != "":
+		ctx.EventName = eventTag
+		ctx.Ref = "refs/tags/" + os.Getenv("BITBUCKET_TAG")
+		return ctx, nil
+	default:
+		ctx.EventName = eventPush
+	}
+
+	if ctx.Branch != "" {
+		ctx.Ref = "refs/heads/" + ctx.Branch
+	}
+
+	return ctx, nil
+}
+
+// resolveGitSHA returns the commit SHA of the pipeline from BITBUCKET_COMMIT,
+// falling back to git HEAD when the variable isn't set.
+func resolveGitSHA() string {
+	if sha := os.Getenv("BITBUCKET_COMMIT"); sha != "" {
+		return sha
+	}
+	sha, err := git.NewDefaultGitRepo().GetCurrentCommitSHA()
+	if err != nil {
+		log.Debug("Failed to resolve SHA from git HEAD", "error", err)
+		return ""
+	}
+	return sha
+}
+
+// parsePRInfo extracts pull request information from the default variables of pull request pipelines.
+func parsePRInfo() *provider.PRInfo {
+	number, _ := strconv.Atoi(os.Getenv("BITBUCKET_PR_ID"))
+
+	var prURL string
+	if origin := os.Getenv("BITBUCKET_GIT_HTTP_ORIGIN"); origin != "" && number > 0 {
+		prURL = origin + "/pull-requests/" + strconv.Itoa(number)
+	}
+
+	return &provider.PRInfo{
+		Number:  number,
+		HeadRef: os.Getenv("BITBUCKET_BRANCH"),
+		BaseRef: os.Getenv("BITBUCKET_PR_DESTINATION_BRANCH"),
+		URL:     prURL,
+	}
+}
+
+// GetStatus returns the CI status for the current branch.
+func (p *Provider) GetStatus(ctx context.Context, opts provider.StatusOptions) (*provider.Status, error) {
+	defer perf.Track(nil, "bitbucket.Provider.GetStatus")()
+
+	return p.getStatus(ctx, opts)
+}
+
+// OutputWriter returns an OutputWriter that writes outputs to a shell file that later steps can source.
+func (p *Provider) OutputWriter() provider.OutputWriter {
+	defer perf.Track(nil, "bitbucket.Provider.OutputWriter")()
+
+	return NewEnvFileOutputWriter(envFilePath(), os.Getenv("ATMOS_CI_SUMMARY"))
+}
+
+func init() {
+	// Only register if we can detect Bitbucket Pipelines.
+	// The client is lazily initialized — credentials are not required at init time.
+	p := NewProvider()
+	if p.Detect() {
+		ci.Register(p)
+	}
+}
@@ -0,0 +1,216 @@
+package bitbucket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// setBitbucketEnv sets the default variables common to all Bitbucket Pipelines steps.
+func setBitbucketEnv(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("BITBUCKET_BUILD_NUMBER", "42")
+	t.Setenv("BITBUCKET_PIPELINE_UUID", "{pipeline-uuid}")
+	t.Setenv("BITBUCKET_STEP_UUID", "{step-uuid}")
+	t.Setenv("BITBUCKET_STEP_TRIGGERER_UUID", "{user-uuid}")
+	t.Setenv("BITBUCKET_COMMIT", "abc123def456abc123def456abc123def456abcd")
+	t.Setenv("BITBUCKET_WORKSPACE", "acme")
+	t.Setenv("BITBUCKET_REPO_SLUG", "infra")
+	t.Setenv("BITBUCKET_REPO_FULL_NAME", "acme/infra")
+	t.Setenv("BITBUCKET_GIT_HTTP_ORIGIN", "http://bitbucket.org/acme/infra")
+	t.Setenv("BITBUCKET_BRANCH", "")
+	t.Setenv("BITBUCKET_TAG", "")
+	t.Setenv("BITBUCKET_PR_ID", "")
+	t.Setenv("BITBUCKET_PR_DESTINATION_BRANCH", "")
+	t.Setenv("BITBUCKET_PR_DESTINATION_COMMIT", "")
+}
+
+func TestProvider_Detect(t *testing.T) {
+	t.Setenv("BITBUCKET_BUILD_NUMBER", "42")
+	assert.True(t, NewProvider().Detect())
+
+	t.Setenv("BITBUCKET_BUILD_NUMBER", "")
+	assert.False(t, NewProvider().Detect())
+}
+
+func TestProvider_Name(t *testing.T) {
+	assert.Equal(t, "bitbucket-pipelines", NewProvider().Name())
+}
+
+func TestProvider_Context(t *testing.T) {
+	t.Run("branch pipeline", func(t *testing.T) {
+		setBitbucketEnv(t)
+		t.Setenv("BITBUCKET_BRANCH", "main")
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		assert.Equal(t, &provider.Context{
+			Provider:   ProviderName,
+			RunID:      "{pipeline-uuid}",
+			RunNumber:  42,
+			Job:        "{step-uuid}",
+			Actor:      "{user-uuid}",
+			EventName:  "push",
+			Ref:        "refs/heads/main",
+			SHA:        "abc123def456abc123def456abc123def456abcd",
+			Branch:     "main",
+			Repository: "acme/infra",
+			RepoOwner:  "acme",
+			RepoName:   "infra",
+		}, ctx)
+	})
+
+	t.Run("pull request pipeline", func(t *testing.T) {
+		setBitbucketEnv(t)
+		t.Setenv("BITBUCKET_BRANCH", "feature/vpc")
+		t.Setenv("BITBUCKET_PR_ID", "7")
+		t.Setenv("BITBUCKET_PR_DESTINATION_BRANCH", "main")
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		assert.Equal(t, "pull_request", ctx.EventName)
+		assert.Equal(t, "refs/heads/feature/vpc", ctx.Ref)
+		assert.Equal(t, &provider.PRInfo{
+			Number:  7,
+			HeadRef: "feature/vpc",
+			BaseRef: "main",
+			URL:     "http://bitbucket.org/acme/infra/pull-requests/7",
+		}, ctx.PullRequest)
+	})
+
+	t.Run("tag pipeline", func(t *testing.T) {
+		setBitbucketEnv(t)
+		t.Setenv("BITBUCKET_TAG", "v1.2.3")
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		assert.Equal(t, "tag", ctx.EventName)
+		assert.Equal(t, "refs/tags/v1.2.3", ctx.Ref)
+		assert.Empty(t, ctx.Branch)
+		assert.Nil(t, ctx.PullRequest)
+	})
+}
+
+func TestProvider_ResolveBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected *provider.BaseResolution
+	}{
+		{
+			name: "pull request with destination commit",
+			env: map[string]string{
+				"BITBUCKET_PR_ID":                 "7",
+				"BITBUCKET_COMMIT":                "2222222222222222222222222222222222222222",
+				"BITBUCKET_PR_DESTINATION_COMMIT": "111111111111",
+				"BITBUCKET_PR_DESTINATION_BRANCH": "main",
+			},
+			expected: &provider.BaseResolution{
+				SHA:       "111111111111",
+				HeadSHA:   "2222222222222222222222222222222222222222",
+				Source:    "BITBUCKET_PR_DESTINATION_COMMIT",
+				EventType: "pull_request",
+			},
+		},
+		{
+			name: "pull request without destination commit falls back to the destination branch",
+			env: map[string]string{
+				"BITBUCKET_PR_ID":                 "7",
+				"BITBUCKET_COMMIT":                "2222222222222222222222222222222222222222",
+				"BITBUCKET_PR_DESTINATION_BRANCH": "main",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       "refs/remotes/origin/main",
+				HeadSHA:   "2222222222222222222222222222222222222222",
+				Source:    "BITBUCKET_PR_DESTINATION_BRANCH",
+				EventType: "pull_request",
+			},
+		},
+		{
+			name: "pull request without destination falls back to default",
+			env: map[string]string{
+				"BITBUCKET_PR_ID": "7",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       defaultRef,
+				Source:    "default (BITBUCKET_PR_DESTINATION_BRANCH empty)",
+				EventType: "pull_request",
+			},
+		},
+		{
+			name: "branch pipeline falls back to default",
+			env:  map[string]string{},
+			expected: &provider.BaseResolution{
+				Ref:       defaultRef,
+				Source:    sourceDefault,
+				EventType: "push",
+			},
+		},
+		{
+			name: "tag pipeline falls back to default",
+			env: map[string]string{
+				"BITBUCKET_TAG": "v1.2.3",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       defaultRef,
+				Source:    sourceDefault,
+				EventType: "tag",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setBitbucketEnv(t)
+			t.Setenv("BITBUCKET_COMMIT", "")
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			base, err := NewProvider().ResolveBase()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, base)
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Run("no credentials", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_BITBUCKET_TOKEN", "")
+		t.Setenv("BITBUCKET_ACCESS_TOKEN", "")
+		t.Setenv("BITBUCKET_USERNAME", "")
+		t.Setenv("BITBUCKET_APP_PASSWORD", "")
+
+		_, err := NewClient()
+		assert.Error(t, err)
+	})
+
+	t.Run("access token", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_BITBUCKET_TOKEN", "")
+		t.Setenv("BITBUCKET_ACCESS_TOKEN", "token")
+
+		client, err := NewClient()
+		require.NoError(t, err)
+		assert.Equal(t, "token", client.token)
+		assert.Equal(t, defaultAPIURL, client.api.BaseURL())
+	})
+
+	t.Run("app password", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_BITBUCKET_TOKEN", "")
+		t.Setenv("BITBUCKET_ACCESS_TOKEN", "")
+		t.Setenv("BITBUCKET_USERNAME", "jane")
+		t.Setenv("BITBUCKET_APP_PASSWORD", "secret")
+
+		client, err := NewClient()
+		require.NoError(t, err)
+		assert.Empty(t, client.token)
+		assert.Equal(t, "jane", client.username)
+	})
+}
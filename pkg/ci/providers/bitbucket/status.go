@@ -0,0 +1,218 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// pullRequestListLimit is the number of pull requests listed per status section.
+const pullRequestListLimit = "10"
+
+// pullRequest is a Bitbucket pull request.
+type pullRequest struct {
+	ID     int    `json:"id"`
+	Title  string `json:"title"`
+	Source struct {
+		Branch struct {
+			Name string `json:"name"`
+		} `json:"branch"`
+		Commit struct {
+			Hash string `json:"hash"`
+		} `json:"commit"`
+	} `json:"source"`
+	Destination struct {
+		Branch struct {
+			Name string `json:"name"`
+		} `json:"branch"`
+	} `json:"destination"`
+	Links struct {
+		HTML struct {
+			Href string `json:"href"`
+		} `json:"html"`
+	} `json:"links"`
+}
+
+// page is the envelope of Bitbucket paginated endpoints.
+type page[T any] struct {
+	Values []T `json:"values"`
+}
+
+// user is the authenticated Bitbucket user.
+type user struct {
+	UUID string `json:"uuid"`
+}
+
+// getStatus fetches the CI status for the given options.
+func (p *Provider) getStatus(ctx context.Context, opts provider.StatusOptions) (*provider.Status, error) {
+	if err := p.ensureClient(); err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCIStatusFetchFailed, err)
+	}
+
+	status := &provider.Status{
+		Repository: fmt.Sprintf("%s/%s", opts.Owner, opts.Repo),
+	}
+
+	// Get status for current branch.
+	branchStatus, err := p.getBranchStatus(ctx, opts.Owner, opts.Repo, opts.Branch, opts.SHA)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCIStatusFetchFailed, wrapBitbucketAPIError(err))
+	}
+	status.CurrentBranch = branchStatus
+
+	if !opts.IncludeUserPRs && !opts.IncludeReviewRequests {
+		return status, nil
+	}
+
+	// Repository access tokens aren't users, so /user fails for them.
+	var u user
+	if err := p.client.get(ctx, "/user", nil, &u); err != nil {
+		// Non-fatal: continue without user and review PRs.
+		return status, nil
+	}
+
+	// Get PRs created by the authenticated user.
+	if opts.IncludeUserPRs {
+		userPRs, err := p.listPullRequests(ctx, opts.Owner, opts.Repo, fmt.Sprintf("author.uuid=%q", u.UUID))
+		if err != nil {
+			// Non-fatal: continue without user PRs.
+			userPRs = nil
+		}
+		status.CreatedByUser = userPRs
+	}
+
+	// Get PRs that have the authenticated user as a reviewer.
+	if opts.IncludeReviewRequests {
+		reviewPRs, err := p.listPullRequests(ctx, opts.Owner, opts.Repo, fmt.Sprintf("reviewers.uuid=%q", u.UUID))
+		if err != nil {
+			// Non-fatal: continue without review requests.
+			reviewPRs = nil
+		}
+		status.ReviewRequests = reviewPRs
+	}
+
+	return status, nil
+}
+
+// getBranchStatus gets the status for a specific branch.
+func (p *Provider) getBranchStatus(ctx context.Context, workspace, repoSlug, branch, sha string) (*provider.BranchStatus, error) {
+	status := &provider.BranchStatus{
+		Branch:    branch,
+		CommitSHA: sha,
+	}
+
+	// Get the open PR for this branch (if any).
+	if branch != "" {
+		prs, err := p.listPullRequests(ctx, workspace, repoSlug, fmt.Sprintf("source.branch.name=%q", branch))
+		if err == nil && len(prs) > 0 {
+			status.PullRequest = prs[0]
+		}
+	}
+
+	// Get build statuses for the SHA.
+	if sha != "" {
+		checks, err := p.getBuildStatuses(ctx, workspace, repoSlug, sha)
+		if err != nil {
+			return nil, err
+		}
+		status.Checks = checks
+	}
+
+	return status, nil
+}
+
+// listPullRequests lists the open pull requests of the repository matching the filter.
+// See https://developer.atlassian.com/cloud/bitbucket/rest/intro/#filtering for the filter syntax.
+func (p *Provider) listPullRequests(ctx context.Context, workspace, repoSlug, filter string) ([]*provider.PRStatus, error) {
+	query := url.Values{
+		"q":       {filter + ` AND state="OPEN"`},
+		"pagelen": {pullRequestListLimit},
+	}
+
+	var resp page[pullRequest]
+	if err := p.client.get(ctx, repositoryPath(workspace, repoSlug)+"/pullrequests", query, &resp); err != nil {
+		return nil, err
+	}
+
+	result := make([]*provider.PRStatus, 0, len(resp.Values))
+	for i := range resp.Values {
+		pr := &resp.Values[i]
+		prStatus := &provider.PRStatus{
+			Number:     pr.ID,
+			Title:      pr.Title,
+			Branch:     pr.Source.Branch.Name,
+			BaseBranch: pr.Destination.Branch.Name,
+			URL:        pr.Links.HTML.Href,
+		}
+
+		// Get statuses for this PR's head SHA.
+		if sha := pr.Source.Commit.Hash; sha != "" {
+			checks, _ := p.getBuildStatuses(ctx, workspace, repoSlug, sha)
+			prStatus.Checks = checks
+			prStatus.AllPassed = allChecksPassed(checks)
+		}
+
+		result = append(result, prStatus)
+	}
+
+	return result, nil
+}
+
+// getBuildStatuses fetches the build statuses of a commit.
+func (p *Provider) getBuildStatuses(ctx context.Context, workspace, repoSlug, sha string) ([]*provider.CheckStatus, error) {
+	var resp page[buildStatus]
+	path := repositoryPath(workspace, repoSlug) + "/commit/" + url.PathEscape(sha) + "/statuses"
+	if err := p.client.get(ctx, path, url.Values{"pagelen": {"100"}}, &resp); err != nil {
+		return nil, err
+	}
+
+	checks := make([]*provider.CheckStatus, 0, len(resp.Values))
+	for i := range resp.Values {
+		state, conclusion := mapStatusToCheckStatus(resp.Values[i].State)
+		name := resp.Values[i].Name
+		if name == "" {
+			name = resp.Values[i].Key
+		}
+		checks = append(checks, &provider.CheckStatus{
+			Name:       name,
+			Status:     state,
+			Conclusion: conclusion,
+			DetailsURL: resp.Values[i].URL,
+		})
+	}
+
+	return checks, nil
+}
+
+// mapStatusToCheckStatus maps a Bitbucket build status state to the status and conclusion of a check.
+func mapStatusToCheckStatus(state string) (string, string) {
+	switch state {
+	case "SUCCESSFUL":
+		return "completed", "success"
+	case "FAILED":
+		return "completed", "failure"
+	case "STOPPED":
+		return "completed", "cancelled"
+	default:
+		// INPROGRESS.
+		return "in_progress", ""
+	}
+}
+
+// allChecksPassed returns true if all checks have passed.
+func allChecksPassed(checks []*provider.CheckStatus) bool {
+	if len(checks) == 0 {
+		return true
+	}
+
+	for _, check := range checks {
+		state := check.CheckState()
+		if state != provider.CheckStatusStateSuccess && state != provider.CheckStatusStateSkipped {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package bitbucket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+func TestMapStatusToCheckStatus(t *testing.T) {
+	tests := []struct {
+		state              string
+		expectedStatus     string
+		expectedConclusion string
+	}{
+		{"SUCCESSFUL", "completed", "success"},
+		{"FAILED", "completed", "failure"},
+		{"STOPPED", "completed", "cancelled"},
+		{"INPROGRESS", "in_progress", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.state, func(t *testing.T) {
+			status, conclusion := mapStatusToCheckStatus(tt.state)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedConclusion, conclusion)
+		})
+	}
+}
+
+func TestProvider_GetStatus(t *testing.T) {
+	fake, p := newFakeBitbucket(t)
+	fake.statuses["sha-main"] = []buildStatus{
+		{Key: "atmos/plan/dev/vpc", Name: "atmos/plan/dev/vpc", State: "SUCCESSFUL", URL: "https://bitbucket.org/acme/infra/pipelines/results/1"},
+	}
+	fake.statuses["sha-feature"] = []buildStatus{
+		{Key: "atmos/plan/dev/vpc", State: "SUCCESSFUL"},
+		{Key: "atmos/plan/dev/eks", State: "FAILED"},
+	}
+	var pr pullRequest
+	pr.ID = 7
+	pr.Title = "Add VPC"
+	pr.Source.Branch.Name = "feature/vpc"
+	pr.Source.Commit.Hash = "sha-feature"
+	pr.Destination.Branch.Name = "main"
+	pr.Links.HTML.Href = "https://bitbucket.org/acme/infra/pull-requests/7"
+	fake.prs = []pullRequest{pr}
+
+	status, err := p.GetStatus(t.Context(), provider.StatusOptions{
+		Owner:                 "acme",
+		Repo:                  "infra",
+		Branch:                "feature/vpc",
+		SHA:                   "sha-main",
+		IncludeUserPRs:        true,
+		IncludeReviewRequests: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme/infra", status.Repository)
+	require.NotNil(t, status.CurrentBranch)
+	require.Len(t, status.CurrentBranch.Checks, 1)
+	assert.Equal(t, provider.CheckStatusStateSuccess, status.CurrentBranch.Checks[0].CheckState())
+
+	got := status.CurrentBranch.PullRequest
+	require.NotNil(t, got)
+	assert.Equal(t, 7, got.Number)
+	assert.Equal(t, "main", got.BaseBranch)
+	assert.Equal(t, "https://bitbucket.org/acme/infra/pull-requests/7", got.URL)
+	require.Len(t, got.Checks, 2)
+	assert.Equal(t, "atmos/plan/dev/eks", got.Checks[1].Name)
+	assert.False(t, got.AllPassed)
+
+	assert.Len(t, status.CreatedByUser, 1)
+	assert.Len(t, status.ReviewRequests, 1)
+	assert.Contains(t, fake.queries, `source.branch.name="feature/vpc" AND state="OPEN"`)
+	assert.Contains(t, fake.queries, `author.uuid="{user-1}" AND state="OPEN"`)
+	assert.Contains(t, fake.queries, `reviewers.uuid="{user-1}" AND state="OPEN"`)
+}
+
+func TestProvider_GetStatus_RepositoryNotFound(t *testing.T) {
+	_, p := newFakeBitbucket(t)
+
+	_, err := p.GetStatus(t.Context(), provider.StatusOptions{Owner: "acme", Repo: "missing", SHA: "abc"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to fetch CI status")
+}
@@ -0,0 +1,127 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/go-github/v59/github"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// maxCommentLength is the GitHub limit for the body of an issue comment (in characters).
+	maxCommentLength = 65536
+
+	// truncatedCommentNotice is appended to comments that exceed maxCommentLength.
+	truncatedCommentNotice = "\n\n> [!NOTE]\n> The comment was truncated because it exceeds the GitHub comment size limit. See the job summary for the full output.\n"
+
+	// commentsPerPage is the page size used when searching for an existing comment.
+	commentsPerPage = 100
+)
+
+// Ensure Provider implements provider.Commenter.
+var _ provider.Commenter = (*Provider)(nil)
+
+// UpsertComment creates or updates the PR comment identified by the marker.
+// The marker is prepended to the body so the comment can be found and updated on subsequent runs.
+func (p *Provider) UpsertComment(ctx context.Context, opts *provider.UpsertCommentOptions) (*provider.Comment, error) {
+	defer perf.Track(nil, "github.Provider.UpsertComment")()
+
+	if err := p.ensureClient(); err != nil {
+		return nil, err
+	}
+
+	body := buildCommentBody(opts.Marker, opts.Body)
+
+	behavior := opts.Behavior
+	if behavior == "" {
+		behavior = provider.CommentBehaviorUpsert
+	}
+
+	var existing *github.IssueComment
+	if behavior != provider.CommentBehaviorCreate {
+		var err error
+		existing, err = p.findComment(ctx, opts.Owner, opts.Repo, opts.Number, opts.Marker)
+		if err != nil {
+			return nil, errUtils.Build(errUtils.ErrCICommentFailed).WithCause(wrapCommentAPIError(err)).Err()
+		}
+	}
+
+	if existing != nil {
+		comment, _, err := p.client.GitHub().Issues.EditComment(ctx, opts.Owner, opts.Repo, existing.GetID(), &github.IssueComment{Body: github.String(body)})
+		if err != nil {
+			return nil, errUtils.Build(errUtils.ErrCICommentFailed).WithCause(wrapCommentAPIError(err)).Err()
+		}
+		return &provider.Comment{ID: comment.GetID(), URL: comment.GetHTMLURL(), Updated: true}, nil
+	}
+
+	if behavior == provider.CommentBehaviorUpdate {
+		return nil, errUtils.Build(errUtils.ErrCICommentNotFound).
+			WithExplanationf("No comment with marker `%s` exists on pull request #%d.", opts.Marker, opts.Number).
+			WithHint("Set `ci.comments.behavior` to `upsert` to create the comment when it doesn't exist.").
+			Err()
+	}
+
+	comment, _, err := p.client.GitHub().Issues.CreateComment(ctx, opts.Owner, opts.Repo, opts.Number, &github.IssueComment{Body: github.String(body)})
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCICommentFailed).WithCause(wrapCommentAPIError(err)).Err()
+	}
+	return &provider.Comment{ID: comment.GetID(), URL: comment.GetHTMLURL()}, nil
+}
+
+// findComment returns the first comment on the PR that contains the marker, or nil if there is none.
+func (p *Provider) findComment(ctx context.Context, owner, repo string, number int, marker string) (*github.IssueComment, error) {
+	opts := &github.IssueListCommentsOptions{
+		ListOptions: github.ListOptions{PerPage: commentsPerPage},
+	}
+
+	for {
+		comments, resp, err := p.client.GitHub().Issues.ListComments(ctx, owner, repo, number, opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, comment := range comments {
+			if strings.Contains(comment.GetBody(), marker) {
+				return comment, nil
+			}
+		}
+		if resp == nil || resp.NextPage == 0 {
+			return nil, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// buildCommentBody prepends the marker to the body and truncates it to the GitHub comment size limit.
+func buildCommentBody(marker, body string) string {
+	full := marker + "\n" + body
+	if utf8.RuneCountInString(full) <= maxCommentLength {
+		return full
+	}
+	runes := []rune(full)
+	return string(runes[:maxCommentLength-utf8.RuneCountInString(truncatedCommentNotice)]) + truncatedCommentNotice
+}
+
+// wrapCommentAPIError wraps GitHub API errors with actionable hints for missing PR comment permissions.
+func wrapCommentAPIError(err error) error {
+	var ghErr *github.ErrorResponse
+	if !errors.As(err, &ghErr) || ghErr.Response == nil {
+		return err
+	}
+
+	switch ghErr.Response.StatusCode {
+	case http.StatusForbidden, http.StatusNotFound:
+		return errUtils.Build(err).
+			WithHint("The token does not have permission to comment on pull requests in this repository.").
+			WithHint("Grant the workflow 'permissions: pull-requests: write', or set ATMOS_CI_GITHUB_TOKEN to a token that can write pull request comments.").
+			Err()
+	default:
+		return err
+	}
+}
@@ -0,0 +1,184 @@
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/cockroachdb/errors"
+	"github.com/google/go-github/v59/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// fakeIssueComments is a local stand-in for the GitHub issue comments API.
+// Comments are served one per page so lookups exercise pagination.
+type fakeIssueComments struct {
+	mu       sync.Mutex
+	comments []map[string]any
+	created  int
+	edited   int
+	status   int
+}
+
+func newFakeIssueComments(t *testing.T) (*fakeIssueComments, *Provider) {
+	t.Helper()
+
+	fake := &fakeIssueComments{}
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/repos/owner/repo/issues/7/comments", func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		if fake.status != 0 {
+			w.WriteHeader(fake.status)
+			_, _ = w.Write([]byte(`{"message":"Resource not accessible by integration"}`))
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+			if page == 0 {
+				page = 1
+			}
+			if page < len(fake.comments) {
+				w.Header().Set("Link", fmt.Sprintf(`<%s%s?page=%d>; rel="next"`, server.URL, r.URL.Path, page+1))
+			}
+			result := []map[string]any{}
+			if page <= len(fake.comments) {
+				result = append(result, fake.comments[page-1])
+			}
+			_ = json.NewEncoder(w).Encode(result)
+		case http.MethodPost:
+			var body map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			fake.created++
+			comment := map[string]any{
+				"id":       int64(len(fake.comments) + 100),
+				"body":     body["body"],
+				"html_url": "https://github.com/owner/repo/pull/7#issuecomment-new",
+			}
+			fake.comments = append(fake.comments, comment)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(comment)
+		}
+	})
+
+	mux.HandleFunc("/repos/owner/repo/issues/comments/", func(w http.ResponseWriter, r *http.Request) {
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+
+		id, _ := strconv.ParseInt(strings.TrimPrefix(r.URL.Path, "/repos/owner/repo/issues/comments/"), 10, 64)
+		var body map[string]any
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		for _, comment := range fake.comments {
+			if comment["id"] == id {
+				fake.edited++
+				comment["body"] = body["body"]
+				_ = json.NewEncoder(w).Encode(comment)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	serverURL, err := url.Parse(server.URL + "/")
+	require.NoError(t, err)
+	ghClient := github.NewClient(nil)
+	ghClient.BaseURL = serverURL
+
+	return fake, NewProviderWithClient(&Client{client: ghClient})
+}
+
+func TestProvider_UpsertComment(t *testing.T) {
+	const marker = "<!-- atmos:terraform/dev/vpc -->"
+
+	t.Run("creates a comment when none exists", func(t *testing.T) {
+		fake, p := newFakeIssueComments(t)
+		fake.comments = []map[string]any{{"id": int64(1), "body": "LGTM"}}
+
+		comment, err := p.UpsertComment(t.Context(), &provider.UpsertCommentOptions{
+			Owner: "owner", Repo: "repo", Number: 7, Marker: marker, Body: "## Plan",
+		})
+		require.NoError(t, err)
+		assert.False(t, comment.Updated)
+		assert.Equal(t, 1, fake.created)
+		assert.Equal(t, marker+"\n## Plan", fake.comments[1]["body"])
+	})
+
+	t.Run("updates the comment with the marker on a later page", func(t *testing.T) {
+		fake, p := newFakeIssueComments(t)
+		fake.comments = []map[string]any{
+			{"id": int64(1), "body": "LGTM"},
+			{"id": int64(2), "body": "<!-- atmos:terraform/dev/rds -->\nold"},
+			{"id": int64(3), "body": marker + "\nold"},
+		}
+
+		comment, err := p.UpsertComment(t.Context(), &provider.UpsertCommentOptions{
+			Owner: "owner", Repo: "repo", Number: 7, Marker: marker, Body: "new",
+		})
+		require.NoError(t, err)
+		assert.True(t, comment.Updated)
+		assert.Equal(t, int64(3), comment.ID)
+		assert.Equal(t, 0, fake.created)
+		assert.Equal(t, 1, fake.edited)
+		assert.Equal(t, marker+"\nnew", fake.comments[2]["body"])
+	})
+
+	t.Run("create behavior always creates", func(t *testing.T) {
+		fake, p := newFakeIssueComments(t)
+		fake.comments = []map[string]any{{"id": int64(1), "body": marker + "\nold"}}
+
+		_, err := p.UpsertComment(t.Context(), &provider.UpsertCommentOptions{
+			Owner: "owner", Repo: "repo", Number: 7, Marker: marker, Body: "new", Behavior: provider.CommentBehaviorCreate,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, fake.created)
+		assert.Equal(t, 0, fake.edited)
+	})
+
+	t.Run("update behavior fails when no comment exists", func(t *testing.T) {
+		fake, p := newFakeIssueComments(t)
+
+		_, err := p.UpsertComment(t.Context(), &provider.UpsertCommentOptions{
+			Owner: "owner", Repo: "repo", Number: 7, Marker: marker, Body: "new", Behavior: provider.CommentBehaviorUpdate,
+		})
+		assert.ErrorIs(t, err, errUtils.ErrCICommentNotFound)
+		assert.Equal(t, 0, fake.created)
+	})
+
+	t.Run("forbidden adds permission hint", func(t *testing.T) {
+		fake, p := newFakeIssueComments(t)
+		fake.status = http.StatusForbidden
+
+		_, err := p.UpsertComment(t.Context(), &provider.UpsertCommentOptions{
+			Owner: "owner", Repo: "repo", Number: 7, Marker: marker, Body: "new",
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errUtils.ErrCICommentFailed)
+		assert.Contains(t, strings.Join(errors.GetAllHints(err), "\n"), "pull-requests: write")
+	})
+}
+
+func TestBuildCommentBody(t *testing.T) {
+	assert.Equal(t, "<!-- m -->\nbody", buildCommentBody("<!-- m -->", "body"))
+
+	truncated := buildCommentBody("<!-- m -->", strings.Repeat("あ", maxCommentLength))
+	assert.Equal(t, maxCommentLength, utf8.RuneCountInString(truncated))
+	assert.True(t, strings.HasPrefix(truncated, "<!-- m -->\n"))
+	assert.True(t, strings.HasSuffix(truncated, truncatedCommentNotice))
+}
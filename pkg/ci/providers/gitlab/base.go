@@ -0,0 +1,87 @@
+package gitlab
+
+import (
+	"os"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// defaultRef is the fallback git reference when no base can be resolved.
+	defaultRef = "refs/remotes/origin/HEAD"
+	// sourceDefault is the source label for default fallback resolution.
+	sourceDefault = "default"
+	// eventPush is the pipeline source of branch pipelines.
+	eventPush = "push"
+	// zeroSHA is the value of CI_COMMIT_BEFORE_SHA for the first pipeline of a branch and for merge request pipelines.
+	zeroSHA = "0000000000000000000000000000000000000000"
+)
+
+// ResolveBase returns the base commit for affected detection in GitLab CI.
+// Merge request pipelines use CI_MERGE_REQUEST_DIFF_BASE_SHA, the merge-base GitLab uses for the MR diff,
+// falling back to the target branch. Branch pipelines use CI_COMMIT_BEFORE_SHA.
+func (p *Provider) ResolveBase() (*provider.BaseResolution, error) {
+	defer perf.Track(nil, "gitlab.Provider.ResolveBase")()
+
+	eventName := os.Getenv("CI_PIPELINE_SOURCE")
+
+	if os.Getenv("CI_MERGE_REQUEST_IID") != "" {
+		return resolveMRBase(eventName), nil
+	}
+
+	if eventName == eventPush {
+		if before := os.Getenv("CI_COMMIT_BEFORE_SHA"); before != "" && before != zeroSHA {
+			return &provider.BaseResolution{
+				SHA:       before,
+				Source:    "CI_COMMIT_BEFORE_SHA",
+				EventType: eventName,
+			}, nil
+		}
+		return &provider.BaseResolution{
+			Ref:       defaultRef,
+			Source:    sourceDefault + " (no before SHA)",
+			EventType: eventName,
+		}, nil
+	}
+
+	return &provider.BaseResolution{
+		Ref:       defaultRef,
+		Source:    sourceDefault,
+		EventType: eventName,
+	}, nil
+}
+
+// resolveMRBase resolves the base commit for merge request pipelines.
+// The head SHA is the latest commit of the source branch, used for upload correlation with Atmos Pro.
+func resolveMRBase(eventName string) *provider.BaseResolution {
+	headSHA := os.Getenv("CI_MERGE_REQUEST_SOURCE_BRANCH_SHA")
+	if headSHA == "" {
+		headSHA = os.Getenv("CI_COMMIT_SHA")
+	}
+
+	if sha := os.Getenv("CI_MERGE_REQUEST_DIFF_BASE_SHA"); sha != "" {
+		return &provider.BaseResolution{
+			SHA:       sha,
+			HeadSHA:   headSHA,
+			Source:    "CI_MERGE_REQUEST_DIFF_BASE_SHA",
+			EventType: eventName,
+		}
+	}
+
+	if target := os.Getenv("CI_MERGE_REQUEST_TARGET_BRANCH_NAME"); target != "" {
+		return &provider.BaseResolution{
+			Ref:       "refs/remotes/origin/" + target,
+			HeadSHA:   headSHA,
+			Source:    "CI_MERGE_REQUEST_TARGET_BRANCH_NAME",
+			EventType: eventName,
+		}
+	}
+
+	return &provider.BaseResolution{
+		Ref:       defaultRef,
+		HeadSHA:   headSHA,
+		Source:    sourceDefault + " (CI_MERGE_REQUEST_TARGET_BRANCH_NAME empty)",
+		EventType: eventName,
+	}
+}
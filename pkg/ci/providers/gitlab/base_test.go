@@ -0,0 +1,113 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+func TestProvider_ResolveBase(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected *provider.BaseResolution
+	}{
+		{
+			name: "merge request with diff base SHA",
+			env: map[string]string{
+				"CI_PIPELINE_SOURCE":                  "merge_request_event",
+				"CI_MERGE_REQUEST_IID":                "7",
+				"CI_MERGE_REQUEST_DIFF_BASE_SHA":      "1111111111111111111111111111111111111111",
+				"CI_MERGE_REQUEST_SOURCE_BRANCH_SHA":  "2222222222222222222222222222222222222222",
+				"CI_MERGE_REQUEST_TARGET_BRANCH_NAME": "main",
+			},
+			expected: &provider.BaseResolution{
+				SHA:       "1111111111111111111111111111111111111111",
+				HeadSHA:   "2222222222222222222222222222222222222222",
+				Source:    "CI_MERGE_REQUEST_DIFF_BASE_SHA",
+				EventType: "merge_request_event",
+			},
+		},
+		{
+			name: "merge request without diff base SHA falls back to the target branch",
+			env: map[string]string{
+				"CI_PIPELINE_SOURCE":                  "merge_request_event",
+				"CI_MERGE_REQUEST_IID":                "7",
+				"CI_COMMIT_SHA":                       "3333333333333333333333333333333333333333",
+				"CI_MERGE_REQUEST_TARGET_BRANCH_NAME": "main",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       "refs/remotes/origin/main",
+				HeadSHA:   "3333333333333333333333333333333333333333",
+				Source:    "CI_MERGE_REQUEST_TARGET_BRANCH_NAME",
+				EventType: "merge_request_event",
+			},
+		},
+		{
+			name: "merge request without target branch",
+			env: map[string]string{
+				"CI_PIPELINE_SOURCE":   "merge_request_event",
+				"CI_MERGE_REQUEST_IID": "7",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       defaultRef,
+				Source:    "default (CI_MERGE_REQUEST_TARGET_BRANCH_NAME empty)",
+				EventType: "merge_request_event",
+			},
+		},
+		{
+			name: "push uses the before SHA",
+			env: map[string]string{
+				"CI_PIPELINE_SOURCE":   "push",
+				"CI_COMMIT_BEFORE_SHA": "4444444444444444444444444444444444444444",
+			},
+			expected: &provider.BaseResolution{
+				SHA:       "4444444444444444444444444444444444444444",
+				Source:    "CI_COMMIT_BEFORE_SHA",
+				EventType: "push",
+			},
+		},
+		{
+			name: "first push of a branch",
+			env: map[string]string{
+				"CI_PIPELINE_SOURCE":   "push",
+				"CI_COMMIT_BEFORE_SHA": zeroSHA,
+			},
+			expected: &provider.BaseResolution{
+				Ref:       defaultRef,
+				Source:    "default (no before SHA)",
+				EventType: "push",
+			},
+		},
+		{
+			name: "scheduled pipeline",
+			env: map[string]string{
+				"CI_PIPELINE_SOURCE": "schedule",
+			},
+			expected: &provider.BaseResolution{
+				Ref:       defaultRef,
+				Source:    sourceDefault,
+				EventType: "schedule",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{
+				"CI_PIPELINE_SOURCE", "CI_MERGE_REQUEST_IID", "CI_MERGE_REQUEST_DIFF_BASE_SHA",
+				"CI_MERGE_REQUEST_SOURCE_BRANCH_SHA", "CI_MERGE_REQUEST_TARGET_BRANCH_NAME",
+				"CI_COMMIT_SHA", "CI_COMMIT_BEFORE_SHA",
+			} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			result, err := NewProvider().ResolveBase()
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
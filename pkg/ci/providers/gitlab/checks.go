@@ -0,0 +1,160 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"unicode/utf8"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+// maxDescriptionLength is the length commit status descriptions are truncated to.
+// GitLab doesn't enforce a limit, but the merge request widget only shows a single line.
+const maxDescriptionLength = 140
+
+// commitStatus is a GitLab commit status.
+type commitStatus struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Description string `json:"description"`
+	TargetURL   string `json:"target_url"`
+}
+
+// setCommitStatusRequest is the body of POST /projects/:id/statuses/:sha.
+type setCommitStatusRequest struct {
+	State       string `json:"state"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	TargetURL   string `json:"target_url,omitempty"`
+}
+
+// setCommitStatus sets a commit status on a commit using the GitLab Commit Status API.
+// GitLab commit statuses take the place of GitHub check runs. Both CreateCheckRun and
+// UpdateCheckRun delegate to this method since statuses are keyed by name.
+func (p *Provider) setCommitStatus(ctx context.Context, owner, repo, sha, name, state, description, targetURL string) (*commitStatus, error) {
+	if err := p.ensureClient(); err != nil {
+		return nil, err
+	}
+
+	body := setCommitStatusRequest{
+		State:       state,
+		Name:        name,
+		Description: truncateDescription(description),
+		TargetURL:   targetURL,
+	}
+
+	var status commitStatus
+	path := fmt.Sprintf("/projects/%s/statuses/%s", projectPath(owner, repo), url.PathEscape(sha))
+	if err := p.client.post(ctx, path, body, &status); err != nil {
+		return nil, wrapGitLabAPIError(err)
+	}
+	return &status, nil
+}
+
+// wrapGitLabAPIError wraps GitLab API errors with actionable hints for common
+// authentication and permission failures (401, 403, 404).
+func wrapGitLabAPIError(err error) error {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusUnauthorized:
+		return errUtils.Build(err).
+			WithHint("The GitLab token is invalid or expired.").
+			WithHint("Set ATMOS_CI_GITLAB_TOKEN or GITLAB_TOKEN to a project, group or personal access token with the 'api' scope.").
+			Err()
+	case http.StatusForbidden, http.StatusNotFound:
+		return errUtils.Build(err).
+			WithHint("The token does not have permission to set commit statuses on this project. The CI_JOB_TOKEN can't be used for commit statuses.").
+			WithHint("Use a project or group access token with the 'api' scope and at least the Developer role.").
+			Err()
+	default:
+		return err
+	}
+}
+
+// createCheckRun sets a commit status on a commit.
+func (p *Provider) createCheckRun(ctx context.Context, opts *provider.CreateCheckRunOptions) (*provider.CheckRun, error) {
+	state := mapCheckRunStateToStatusState(opts.Status)
+
+	status, err := p.setCommitStatus(ctx, opts.Owner, opts.Repo, opts.SHA, opts.Name, state, opts.Title, opts.DetailsURL)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCICheckRunCreateFailed).WithCause(err).Err()
+	}
+
+	return &provider.CheckRun{
+		ID:         status.ID,
+		Name:       status.Name,
+		Status:     opts.Status,
+		Title:      status.Description,
+		DetailsURL: status.TargetURL,
+	}, nil
+}
+
+// updateCheckRun updates a commit status on a commit.
+func (p *Provider) updateCheckRun(ctx context.Context, opts *provider.UpdateCheckRunOptions) (*provider.CheckRun, error) {
+	state := mapCheckRunStateToStatusState(opts.Status)
+
+	status, err := p.setCommitStatus(ctx, opts.Owner, opts.Repo, opts.SHA, opts.Name, state, opts.Title, opts.DetailsURL)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCICheckRunUpdateFailed).WithCause(err).Err()
+	}
+
+	return &provider.CheckRun{
+		ID:         status.ID,
+		Name:       status.Name,
+		Status:     opts.Status,
+		Title:      status.Description,
+		DetailsURL: status.TargetURL,
+	}, nil
+}
+
+// mapCheckRunStateToStatusState maps CheckRunState to a GitLab commit status state.
+func mapCheckRunStateToStatusState(state provider.CheckRunState) string {
+	switch state {
+	case provider.CheckRunStatePending:
+		return "pending"
+	case provider.CheckRunStateInProgress:
+		return "running"
+	case provider.CheckRunStateSuccess:
+		return "success"
+	case provider.CheckRunStateFailure, provider.CheckRunStateError:
+		return "failed"
+	case provider.CheckRunStateCancelled:
+		return "canceled"
+	default:
+		return "pending"
+	}
+}
+
+// truncateDescription truncates a description to 140 characters.
+// Uses character count (runes), not byte count, to avoid mid-character truncation.
+func truncateDescription(desc string) string {
+	if utf8.RuneCountInString(desc) <= maxDescriptionLength {
+		return desc
+	}
+	runes := []rune(desc)
+	return string(runes[:maxDescriptionLength-3]) + "..."
+}
+
+// CreateCheckRun creates a new commit status on a commit.
+func (p *Provider) CreateCheckRun(ctx context.Context, opts *provider.CreateCheckRunOptions) (*provider.CheckRun, error) {
+	defer perf.Track(nil, "gitlab.Provider.CreateCheckRun")()
+
+	return p.createCheckRun(ctx, opts)
+}
+
+// UpdateCheckRun updates an existing commit status on a commit.
+func (p *Provider) UpdateCheckRun(ctx context.Context, opts *provider.UpdateCheckRunOptions) (*provider.CheckRun, error) {
+	defer perf.Track(nil, "gitlab.Provider.UpdateCheckRun")()
+
+	return p.updateCheckRun(ctx, opts)
+}
@@ -0,0 +1,178 @@
+package gitlab
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// fakeGitLab is a local stand-in for the GitLab REST API.
+type fakeGitLab struct {
+	mu       sync.Mutex
+	statuses map[string][]commitStatus
+	mrs      []mergeRequest
+	nextID   int64
+}
+
+func newFakeGitLab(t *testing.T) (*fakeGitLab, *Provider) {
+	t.Helper()
+
+	fake := &fakeGitLab{statuses: map[string][]commitStatus{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client := NewClientWithHTTPClient(server.Client(), server.URL+"/api/v4", "glpat-test")
+	return fake, NewProviderWithClient(client)
+}
+
+func (f *fakeGitLab) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if r.Header.Get("PRIVATE-TOKEN") != "glpat-test" {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"message":"401 Unauthorized"}`))
+		return
+	}
+
+	// The project ID is URL-encoded, so the raw path has a single segment for it.
+	path := strings.TrimPrefix(r.URL.EscapedPath(), "/api/v4")
+	const project = "/projects/acme%2Fplatform%2Finfra"
+
+	switch {
+	case r.Method == http.MethodPost && strings.HasPrefix(path, project+"/statuses/"):
+		var body setCommitStatusRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.nextID++
+		status := commitStatus{ID: f.nextID, Name: body.Name, Status: body.State, Description: body.Description, TargetURL: body.TargetURL}
+		sha := strings.TrimPrefix(path, project+"/statuses/")
+		f.statuses[sha] = append(f.statuses[sha], status)
+		_ = json.NewEncoder(w).Encode(status)
+	case r.Method == http.MethodGet && strings.HasPrefix(path, project+"/repository/commits/"):
+		sha := strings.TrimSuffix(strings.TrimPrefix(path, project+"/repository/commits/"), "/statuses")
+		_ = json.NewEncoder(w).Encode(f.statuses[sha])
+	case r.Method == http.MethodGet && path == project+"/merge_requests":
+		query := r.URL.Query()
+		result := []mergeRequest{}
+		for _, mr := range f.mrs {
+			if branch := query.Get("source_branch"); branch != "" && mr.SourceBranch != branch {
+				continue
+			}
+			result = append(result, mr)
+		}
+		_ = json.NewEncoder(w).Encode(result)
+	case r.Method == http.MethodGet && path == "/user":
+		_, _ = w.Write([]byte(`{"username":"octocat"}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Project Not Found"}`))
+	}
+}
+
+func TestMapCheckRunStateToStatusState(t *testing.T) {
+	tests := []struct {
+		state    provider.CheckRunState
+		expected string
+	}{
+		{provider.CheckRunStatePending, "pending"},
+		{provider.CheckRunStateInProgress, "running"},
+		{provider.CheckRunStateSuccess, "success"},
+		{provider.CheckRunStateFailure, "failed"},
+		{provider.CheckRunStateError, "failed"},
+		{provider.CheckRunStateCancelled, "canceled"},
+		{provider.CheckRunState("unknown"), "pending"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			assert.Equal(t, tt.expected, mapCheckRunStateToStatusState(tt.state))
+		})
+	}
+}
+
+func TestTruncateDescription(t *testing.T) {
+	assert.Equal(t, "3 to add", truncateDescription("3 to add"))
+
+	long := strings.Repeat("あ", 200)
+	truncated := truncateDescription(long)
+	assert.Equal(t, maxDescriptionLength, len([]rune(truncated)))
+	assert.True(t, strings.HasSuffix(truncated, "..."))
+}
+
+func TestProvider_CreateAndUpdateCheckRun(t *testing.T) {
+	fake, p := newFakeGitLab(t)
+	sha := "abc123def456abc123def456abc123def456abcd"
+
+	created, err := p.CreateCheckRun(t.Context(), &provider.CreateCheckRunOptions{
+		Owner:      "acme/platform",
+		Repo:       "infra",
+		SHA:        sha,
+		Name:       "atmos/plan/dev/vpc",
+		Status:     provider.CheckRunStateInProgress,
+		Title:      "Plan in progress",
+		DetailsURL: "https://gitlab.example.com/acme/platform/infra/-/jobs/1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "atmos/plan/dev/vpc", created.Name)
+	assert.Equal(t, provider.CheckRunStateInProgress, created.Status)
+	assert.Equal(t, "Plan in progress", created.Title)
+
+	updated, err := p.UpdateCheckRun(t.Context(), &provider.UpdateCheckRunOptions{
+		Owner:  "acme/platform",
+		Repo:   "infra",
+		SHA:    sha,
+		Name:   "atmos/plan/dev/vpc",
+		Status: provider.CheckRunStateSuccess,
+		Title:  "3 to add, 1 to change, 0 to destroy",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "3 to add, 1 to change, 0 to destroy", updated.Title)
+
+	require.Len(t, fake.statuses[sha], 2)
+	assert.Equal(t, "running", fake.statuses[sha][0].Status)
+	assert.Equal(t, "success", fake.statuses[sha][1].Status)
+}
+
+func TestProvider_CreateCheckRun_APIErrors(t *testing.T) {
+	t.Run("project not found", func(t *testing.T) {
+		_, p := newFakeGitLab(t)
+
+		_, err := p.CreateCheckRun(t.Context(), &provider.CreateCheckRunOptions{
+			Owner: "acme", Repo: "missing", SHA: "abc", Name: "atmos/plan/dev/vpc", Status: provider.CheckRunStatePending,
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errUtils.ErrCICheckRunCreateFailed)
+		assert.ErrorIs(t, err, errUtils.ErrGitLabAPIRequestFailed)
+		assert.Contains(t, strings.Join(errors.GetAllHints(err), "\n"), "CI_JOB_TOKEN")
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		fake := &fakeGitLab{statuses: map[string][]commitStatus{}}
+		server := httptest.NewServer(fake)
+		defer server.Close()
+		p := NewProviderWithClient(NewClientWithHTTPClient(server.Client(), server.URL+"/api/v4", "invalid"))
+
+		_, err := p.UpdateCheckRun(t.Context(), &provider.UpdateCheckRunOptions{
+			Owner: "acme/platform", Repo: "infra", SHA: "abc", Name: "atmos/plan/dev/vpc", Status: provider.CheckRunStateFailure,
+		})
+		require.Error(t, err)
+		assert.ErrorIs(t, err, errUtils.ErrCICheckRunUpdateFailed)
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusUnauthorized, apiErr.StatusCode)
+	})
+}
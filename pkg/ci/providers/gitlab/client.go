@@ -0,0 +1,87 @@
+// Package gitlab provides GitLab CI provider implementation.
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/apiclient"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// defaultAPIURL is the GitLab.com REST API address.
+	defaultAPIURL = "https://gitlab.com/api/v4"
+)
+
+// Client is a minimal GitLab REST API client for the endpoints used by the CI provider.
+type Client struct {
+	api *apiclient.Client
+}
+
+// APIError is returned when the GitLab API responds with a non-2xx status code.
+// It unwraps to ErrGitLabAPIRequestFailed, so callers can match all API errors.
+type APIError = apiclient.APIError
+
+// NewClient creates a new GitLab API client.
+// Token precedence: ATMOS_CI_GITLAB_TOKEN > GITLAB_TOKEN.
+// The CI_JOB_TOKEN is not used because it can't create commit statuses.
+// The API address is read from CI_API_V4_URL, which GitLab sets in every job.
+func NewClient() (*Client, error) {
+	defer perf.Track(nil, "gitlab.NewClient")()
+
+	token := os.Getenv("ATMOS_CI_GITLAB_TOKEN")
+	if token == "" {
+		token = os.Getenv("GITLAB_TOKEN")
+	}
+	if token == "" {
+		return nil, errUtils.ErrGitLabTokenNotFound
+	}
+
+	return NewClientWithHTTPClient(&http.Client{Timeout: apiclient.DefaultTimeout}, apiURL(), token), nil
+}
+
+// NewClientWithHTTPClient creates a new GitLab API client with a custom HTTP client and API address.
+// Useful for testing.
+func NewClientWithHTTPClient(httpClient *http.Client, baseURL, token string) *Client {
+	defer perf.Track(nil, "gitlab.NewClientWithHTTPClient")()
+
+	return &Client{
+		api: apiclient.New(httpClient, baseURL, apiclient.Options{
+			Authorize: func(req *http.Request) {
+				req.Header.Set("PRIVATE-TOKEN", token)
+			},
+			ErrRequestFailed: errUtils.ErrGitLabAPIRequestFailed,
+		}),
+	}
+}
+
+// apiURL returns the GitLab REST API address of the current instance.
+func apiURL() string {
+	if v := os.Getenv("CI_API_V4_URL"); v != "" {
+		return v
+	}
+	if v := os.Getenv("CI_SERVER_URL"); v != "" {
+		return strings.TrimSuffix(v, "/") + "/api/v4"
+	}
+	return defaultAPIURL
+}
+
+// projectPath returns the URL-encoded project ID used in API paths (e.g., "group%2Fsubgroup%2Fproject").
+func projectPath(owner, repo string) string {
+	return url.PathEscape(owner + "/" + repo)
+}
+
+// get calls a GET endpoint and decodes the JSON response into out.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	return c.api.Get(ctx, path, query, out)
+}
+
+// post calls a POST endpoint with a JSON body and decodes the JSON response into out.
+func (c *Client) post(ctx context.Context, path string, body, out any) error {
+	return c.api.Post(ctx, path, body, out)
+}
@@ -0,0 +1,39 @@
+package gitlab
+
+import (
+	"strings"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// defaultDotenvFile is the dotenv report written when ATMOS_CI_OUTPUT isn't set.
+	// Declare it in the job as `artifacts: reports: dotenv: atmos.env` to pass the outputs to later jobs.
+	defaultDotenvFile = "atmos.env"
+)
+
+// dotenvPath returns the path of the dotenv report, relative to the project directory of the job.
+func dotenvPath() string {
+	return provider.EnvFilePath("CI_PROJECT_DIR", defaultDotenvFile)
+}
+
+// NewDotenvOutputWriter creates an output writer for a GitLab dotenv report artifact.
+// GitLab has no job summary, so summaries are written to a file when a path is set,
+// and to the job log otherwise.
+func NewDotenvOutputWriter(outputPath, summaryPath string) *provider.EnvFileOutputWriter {
+	defer perf.Track(nil, "gitlab.NewDotenvOutputWriter")()
+
+	return provider.NewEnvFileOutputWriter(outputPath, summaryPath, formatDotenvLine)
+}
+
+// formatDotenvLine returns a KEY=value line of the dotenv report.
+// Multiline values are skipped, since dotenv reports don't support them.
+func formatDotenvLine(key, value string) (string, bool) {
+	if strings.ContainsAny(value, "\r\n") {
+		log.Debug("Skipping multiline CI output, dotenv reports don't support multiline values", "key", key)
+		return "", false
+	}
+	return key + "=" + value, true
+}
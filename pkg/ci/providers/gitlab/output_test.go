@@ -0,0 +1,53 @@
+package gitlab
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDotenvOutputWriter_WriteOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "atmos.env")
+	w := NewDotenvOutputWriter(path, "")
+
+	require.NoError(t, w.WriteOutput("has_changes", "true"))
+	require.NoError(t, w.WriteOutput("output_vpc-id", "vpc-123"))
+	require.NoError(t, w.WriteOutput("plan_summary", "line 1\nline 2"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "has_changes=true\noutput_vpc_id=vpc-123\n", string(data))
+}
+
+func TestDotenvOutputWriter_NoPath(t *testing.T) {
+	w := NewDotenvOutputWriter("", "")
+	assert.NoError(t, w.WriteOutput("has_changes", "true"))
+}
+
+func TestDotenvOutputWriter_WriteSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.md")
+	w := NewDotenvOutputWriter("", path)
+
+	require.NoError(t, w.WriteSummary("## Plan\n"))
+	require.NoError(t, w.WriteSummary("3 to add\n"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "## Plan\n3 to add\n", string(data))
+}
+
+func TestDotenvPath(t *testing.T) {
+	t.Run("ATMOS_CI_OUTPUT", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_OUTPUT", "outputs.env")
+		assert.Equal(t, "outputs.env", dotenvPath())
+	})
+
+	t.Run("project directory", func(t *testing.T) {
+		t.Setenv("ATMOS_CI_OUTPUT", "")
+		t.Setenv("CI_PROJECT_DIR", "/builds/acme/infra")
+		assert.Equal(t, filepath.Join("/builds/acme/infra", "atmos.env"), dotenvPath())
+	})
+}
@@ -0,0 +1,183 @@
+package gitlab
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/cloudposse/atmos/pkg/ci"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+	"github.com/cloudposse/atmos/pkg/git"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+)
+
+const (
+	// ProviderName is the name of the GitLab CI provider.
+	ProviderName = "gitlab-ci"
+
+	// eventMergeRequest is the pipeline source of merge request pipelines.
+	eventMergeRequest = "merge_request_event"
+)
+
+// Ensure Provider implements provider.Provider.
+var _ provider.Provider = (*Provider)(nil)
+
+// Provider implements provider.Provider for GitLab CI.
+// The client is lazily initialized on first use, so the provider can be
+// registered at init time based on environment detection alone, without
+// requiring GITLAB_TOKEN to be available at startup.
+type Provider struct {
+	client     *Client
+	clientOnce sync.Once
+	clientErr  error
+}
+
+// NewProvider creates a new GitLab CI provider.
+// The GitLab API client is lazily initialized on first use.
+func NewProvider() *Provider {
+	defer perf.Track(nil, "gitlab.NewProvider")()
+
+	return &Provider{}
+}
+
+// NewProviderWithClient creates a new GitLab CI provider with a custom client.
+func NewProviderWithClient(client *Client) *Provider {
+	defer perf.Track(nil, "gitlab.NewProviderWithClient")()
+
+	p := &Provider{client: client}
+	// Mark client as already initialized so ensureClient() is a no-op.
+	p.clientOnce.Do(func() {})
+	return p
+}
+
+// ensureClient lazily initializes the GitLab API client.
+func (p *Provider) ensureClient() error {
+	p.clientOnce.Do(func() {
+		if p.client != nil {
+			return
+		}
+		client, err := NewClient()
+		if err != nil {
+			p.clientErr = err
+			return
+		}
+		p.client = client
+	})
+	return p.clientErr
+}
+
+// Name returns the provider name.
+func (p *Provider) Name() string {
+	defer perf.Track(nil, "gitlab.Provider.Name")()
+
+	return ProviderName
+}
+
+// Detect returns true if running in GitLab CI.
+func (p *Provider) Detect() bool {
+	defer perf.Track(nil, "gitlab.Provider.Detect")()
+
+	return os.Getenv("GITLAB_CI") == "true"
+}
+
+// Context returns CI metadata from GitLab CI predefined variables.
+func (p *Provider) Context() (*provider.Context, error) {
+	defer perf.Track(nil, "gitlab.Provider.Context")()
+
+	runNumber, _ := strconv.Atoi(os.Getenv("CI_PIPELINE_IID"))
+
+	ctx := &provider.Context{
+		Provider:   ProviderName,
+		RunID:      os.Getenv("CI_PIPELINE_ID"),
+		RunNumber:  runNumber,
+		Workflow:   os.Getenv("CI_PIPELINE_NAME"),
+		Job:        os.Getenv("CI_JOB_NAME"),
+		Actor:      os.Getenv("GITLAB_USER_LOGIN"),
+		EventName:  os.Getenv("CI_PIPELINE_SOURCE"),
+		SHA:        resolveGitSHA(),
+		Repository: os.Getenv("CI_PROJECT_PATH"),
+	}
+
+	// Split the project path at the last slash, since the namespace can contain subgroups.
+	if i := strings.LastIndex(ctx.Repository, "/"); i > 0 {
+		ctx.RepoOwner = ctx.Repository[:i]
+		ctx.RepoName = ctx.Repository[i+1:]
+	}
+
+	// Merge request pipelines run on the source branch; branch and tag pipelines set CI_COMMIT_BRANCH or CI_COMMIT_TAG.
+	switch {
+	case os.Getenv("CI_MERGE_REQUEST_IID") != "":
+		ctx.PullRequest = parseMRInfo()
+		ctx.Branch = ctx.PullRequest.HeadRef
+		ctx.Ref = "refs/merge-requests/" + os.Getenv("CI_MERGE_REQUEST_IID") + "/head"
+	case os.Getenv("CI_COMMIT_TAG") != "":
+		ctx.Ref = "refs/tags/" + os.Getenv("CI_COMMIT_TAG")
+	default:
+		ctx.Branch = os.Getenv("CI_COMMIT_BRANCH")
+		if ctx.Branch == "" {
+			ctx.Branch = os.Getenv("CI_COMMIT_REF_NAME")
+		}
+		if ctx.Branch != "" {
+			ctx.Ref = "refs/heads/" + ctx.Branch
+		}
+	}
+
+	return ctx, nil
+}
+
+// resolveGitSHA returns the commit SHA of the pipeline from CI_COMMIT_SHA,
+// falling back to git HEAD when the variable isn't set.
+func resolveGitSHA() string {
+	if sha := os.Getenv("CI_COMMIT_SHA"); sha != "" {
+		return sha
+	}
+	sha, err := git.NewDefaultGitRepo().GetCurrentCommitSHA()
+	if err != nil {
+		log.Debug("Failed to resolve SHA from git HEAD", "error", err)
+		return ""
+	}
+	return sha
+}
+
+// parseMRInfo extracts merge request information from the predefined variables of merge request pipelines.
+func parseMRInfo() *provider.PRInfo {
+	iid, _ := strconv.Atoi(os.Getenv("CI_MERGE_REQUEST_IID"))
+
+	var mrURL string
+	if projectURL := os.Getenv("CI_MERGE_REQUEST_PROJECT_URL"); projectURL != "" && iid > 0 {
+		mrURL = projectURL + "/-/merge_requests/" + strconv.Itoa(iid)
+	}
+
+	return &provider.PRInfo{
+		Number:  iid,
+		HeadRef: os.Getenv("CI_MERGE_REQUEST_SOURCE_BRANCH_NAME"),
+		BaseRef: os.Getenv("CI_MERGE_REQUEST_TARGET_BRANCH_NAME"),
+		URL:     mrURL,
+	}
+}
+
+// GetStatus returns the CI status for the current branch.
+func (p *Provider) GetStatus(ctx context.Context, opts provider.StatusOptions) (*provider.Status, error) {
+	defer perf.Track(nil, "gitlab.Provider.GetStatus")()
+
+	return p.getStatus(ctx, opts)
+}
+
+// OutputWriter returns an OutputWriter that writes outputs to a dotenv report artifact.
+func (p *Provider) OutputWriter() provider.OutputWriter {
+	defer perf.Track(nil, "gitlab.Provider.OutputWriter")()
+
+	return NewDotenvOutputWriter(dotenvPath(), os.Getenv("ATMOS_CI_SUMMARY"))
+}
+
+func init() {
+	// Only register if we can detect GitLab CI.
+	// The client is lazily initialized — GITLAB_TOKEN is not required at init time.
+	p := NewProvider()
+	if p.Detect() {
+		ci.Register(p)
+	}
+}
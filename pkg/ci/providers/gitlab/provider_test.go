@@ -0,0 +1,143 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// setGitLabEnv sets the predefined variables common to all GitLab CI pipelines.
+func setGitLabEnv(t *testing.T) {
+	t.Helper()
+
+	t.Setenv("GITLAB_CI", "true")
+	t.Setenv("CI_PIPELINE_ID", "1001")
+	t.Setenv("CI_PIPELINE_IID", "42")
+	t.Setenv("CI_PIPELINE_NAME", "deploy")
+	t.Setenv("CI_JOB_NAME", "plan")
+	t.Setenv("GITLAB_USER_LOGIN", "octocat")
+	t.Setenv("CI_COMMIT_SHA", "abc123def456abc123def456abc123def456abcd")
+	t.Setenv("CI_PROJECT_PATH", "acme/platform/infra")
+	t.Setenv("CI_PIPELINE_SOURCE", "push")
+	t.Setenv("CI_COMMIT_BRANCH", "")
+	t.Setenv("CI_COMMIT_REF_NAME", "")
+	t.Setenv("CI_COMMIT_TAG", "")
+	t.Setenv("CI_MERGE_REQUEST_IID", "")
+}
+
+func TestProvider_Detect(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		expected bool
+	}{
+		{name: "detects when GITLAB_CI is true", envValue: "true", expected: true},
+		{name: "does not detect when GITLAB_CI is empty", envValue: "", expected: false},
+		{name: "does not detect when GITLAB_CI is false", envValue: "false", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GITLAB_CI", tt.envValue)
+			assert.Equal(t, tt.expected, NewProvider().Detect())
+		})
+	}
+}
+
+func TestProvider_Name(t *testing.T) {
+	assert.Equal(t, "gitlab-ci", NewProvider().Name())
+}
+
+func TestProvider_Context(t *testing.T) {
+	t.Run("branch pipeline", func(t *testing.T) {
+		setGitLabEnv(t)
+		t.Setenv("CI_COMMIT_BRANCH", "main")
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		assert.Equal(t, &provider.Context{
+			Provider:   ProviderName,
+			RunID:      "1001",
+			RunNumber:  42,
+			Workflow:   "deploy",
+			Job:        "plan",
+			Actor:      "octocat",
+			EventName:  "push",
+			Ref:        "refs/heads/main",
+			Branch:     "main",
+			SHA:        "abc123def456abc123def456abc123def456abcd",
+			Repository: "acme/platform/infra",
+			RepoOwner:  "acme/platform",
+			RepoName:   "infra",
+		}, ctx)
+	})
+
+	t.Run("merge request pipeline", func(t *testing.T) {
+		setGitLabEnv(t)
+		t.Setenv("CI_PIPELINE_SOURCE", "merge_request_event")
+		t.Setenv("CI_COMMIT_REF_NAME", "feature/vpc")
+		t.Setenv("CI_MERGE_REQUEST_IID", "7")
+		t.Setenv("CI_MERGE_REQUEST_SOURCE_BRANCH_NAME", "feature/vpc")
+		t.Setenv("CI_MERGE_REQUEST_TARGET_BRANCH_NAME", "main")
+		t.Setenv("CI_MERGE_REQUEST_PROJECT_URL", "https://gitlab.example.com/acme/platform/infra")
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		assert.Equal(t, "merge_request_event", ctx.EventName)
+		assert.Equal(t, "feature/vpc", ctx.Branch)
+		assert.Equal(t, "refs/merge-requests/7/head", ctx.Ref)
+		assert.Equal(t, &provider.PRInfo{
+			Number:  7,
+			HeadRef: "feature/vpc",
+			BaseRef: "main",
+			URL:     "https://gitlab.example.com/acme/platform/infra/-/merge_requests/7",
+		}, ctx.PullRequest)
+	})
+
+	t.Run("tag pipeline", func(t *testing.T) {
+		setGitLabEnv(t)
+		t.Setenv("CI_COMMIT_TAG", "v1.2.0")
+		t.Setenv("CI_COMMIT_REF_NAME", "v1.2.0")
+
+		ctx, err := NewProvider().Context()
+		require.NoError(t, err)
+
+		assert.Equal(t, "refs/tags/v1.2.0", ctx.Ref)
+		assert.Empty(t, ctx.Branch)
+		assert.Nil(t, ctx.PullRequest)
+	})
+}
+
+func TestProvider_EnsureClient_NoToken(t *testing.T) {
+	t.Setenv("ATMOS_CI_GITLAB_TOKEN", "")
+	t.Setenv("GITLAB_TOKEN", "")
+
+	p := NewProvider()
+	_, err := p.CreateCheckRun(t.Context(), &provider.CreateCheckRunOptions{Owner: "acme", Repo: "infra", SHA: "abc", Name: "atmos/plan"})
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "GitLab token not found")
+}
+
+func TestAPIURL(t *testing.T) {
+	t.Run("CI_API_V4_URL", func(t *testing.T) {
+		t.Setenv("CI_API_V4_URL", "https://gitlab.example.com/api/v4")
+		assert.Equal(t, "https://gitlab.example.com/api/v4", apiURL())
+	})
+
+	t.Run("CI_SERVER_URL", func(t *testing.T) {
+		t.Setenv("CI_API_V4_URL", "")
+		t.Setenv("CI_SERVER_URL", "https://gitlab.example.com/")
+		assert.Equal(t, "https://gitlab.example.com/api/v4", apiURL())
+	})
+
+	t.Run("default", func(t *testing.T) {
+		t.Setenv("CI_API_V4_URL", "")
+		t.Setenv("CI_SERVER_URL", "")
+		assert.Equal(t, defaultAPIURL, apiURL())
+	})
+}
@@ -0,0 +1,195 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+// mergeRequestListLimit is the number of merge requests listed per status section.
+const mergeRequestListLimit = "10"
+
+// mergeRequest is a GitLab merge request.
+type mergeRequest struct {
+	IID          int    `json:"iid"`
+	Title        string `json:"title"`
+	SourceBranch string `json:"source_branch"`
+	TargetBranch string `json:"target_branch"`
+	WebURL       string `json:"web_url"`
+	SHA          string `json:"sha"`
+}
+
+// user is the authenticated GitLab user.
+type user struct {
+	Username string `json:"username"`
+}
+
+// getStatus fetches the CI status for the given options.
+func (p *Provider) getStatus(ctx context.Context, opts provider.StatusOptions) (*provider.Status, error) {
+	if err := p.ensureClient(); err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCIStatusFetchFailed, err)
+	}
+
+	status := &provider.Status{
+		Repository: fmt.Sprintf("%s/%s", opts.Owner, opts.Repo),
+	}
+
+	// Get status for current branch.
+	branchStatus, err := p.getBranchStatus(ctx, opts.Owner, opts.Repo, opts.Branch, opts.SHA)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrCIStatusFetchFailed, wrapGitLabAPIError(err))
+	}
+	status.CurrentBranch = branchStatus
+
+	// Get MRs created by the authenticated user.
+	if opts.IncludeUserPRs {
+		userMRs, err := p.listMergeRequests(ctx, opts.Owner, opts.Repo, url.Values{"scope": {"created_by_me"}})
+		if err != nil {
+			// Non-fatal: continue without user MRs.
+			userMRs = nil
+		}
+		status.CreatedByUser = userMRs
+	}
+
+	// Get MRs requesting review from the authenticated user.
+	if opts.IncludeReviewRequests {
+		reviewMRs, err := p.getMRsRequestingReview(ctx, opts.Owner, opts.Repo)
+		if err != nil {
+			// Non-fatal: continue without review requests.
+			reviewMRs = nil
+		}
+		status.ReviewRequests = reviewMRs
+	}
+
+	return status, nil
+}
+
+// getBranchStatus gets the status for a specific branch.
+func (p *Provider) getBranchStatus(ctx context.Context, owner, repo, branch, sha string) (*provider.BranchStatus, error) {
+	status := &provider.BranchStatus{
+		Branch:    branch,
+		CommitSHA: sha,
+	}
+
+	// Get the open MR for this branch (if any).
+	if branch != "" {
+		mrs, err := p.listMergeRequests(ctx, owner, repo, url.Values{"source_branch": {branch}})
+		if err == nil && len(mrs) > 0 {
+			status.PullRequest = mrs[0]
+		}
+	}
+
+	// Get commit statuses for the SHA.
+	if sha != "" {
+		checks, err := p.getCommitStatuses(ctx, owner, repo, sha)
+		if err != nil {
+			return nil, err
+		}
+		status.Checks = checks
+	}
+
+	return status, nil
+}
+
+// getMRsRequestingReview fetches MRs that have the authenticated user as a reviewer.
+func (p *Provider) getMRsRequestingReview(ctx context.Context, owner, repo string) ([]*provider.PRStatus, error) {
+	var u user
+	if err := p.client.get(ctx, "/user", nil, &u); err != nil {
+		return nil, err
+	}
+
+	return p.listMergeRequests(ctx, owner, repo, url.Values{"scope": {"all"}, "reviewer_username": {u.Username}})
+}
+
+// listMergeRequests lists the open merge requests of the project matching the query.
+func (p *Provider) listMergeRequests(ctx context.Context, owner, repo string, query url.Values) ([]*provider.PRStatus, error) {
+	query.Set("state", "opened")
+	query.Set("per_page", mergeRequestListLimit)
+
+	var mrs []mergeRequest
+	if err := p.client.get(ctx, fmt.Sprintf("/projects/%s/merge_requests", projectPath(owner, repo)), query, &mrs); err != nil {
+		return nil, err
+	}
+
+	result := make([]*provider.PRStatus, 0, len(mrs))
+	for i := range mrs {
+		mr := &mrs[i]
+		prStatus := &provider.PRStatus{
+			Number:     mr.IID,
+			Title:      mr.Title,
+			Branch:     mr.SourceBranch,
+			BaseBranch: mr.TargetBranch,
+			URL:        mr.WebURL,
+		}
+
+		// Get statuses for this MR's head SHA.
+		if mr.SHA != "" {
+			checks, _ := p.getCommitStatuses(ctx, owner, repo, mr.SHA)
+			prStatus.Checks = checks
+			prStatus.AllPassed = allChecksPassed(checks)
+		}
+
+		result = append(result, prStatus)
+	}
+
+	return result, nil
+}
+
+// getCommitStatuses fetches the commit statuses of a commit, including the statuses of its pipeline jobs.
+func (p *Provider) getCommitStatuses(ctx context.Context, owner, repo, sha string) ([]*provider.CheckStatus, error) {
+	var statuses []commitStatus
+	path := fmt.Sprintf("/projects/%s/repository/commits/%s/statuses", projectPath(owner, repo), url.PathEscape(sha))
+	if err := p.client.get(ctx, path, url.Values{"per_page": {"100"}}, &statuses); err != nil {
+		return nil, err
+	}
+
+	checks := make([]*provider.CheckStatus, 0, len(statuses))
+	for i := range statuses {
+		state, conclusion := mapStatusToCheckStatus(statuses[i].Status)
+		checks = append(checks, &provider.CheckStatus{
+			Name:       statuses[i].Name,
+			Status:     state,
+			Conclusion: conclusion,
+			DetailsURL: statuses[i].TargetURL,
+		})
+	}
+
+	return checks, nil
+}
+
+// mapStatusToCheckStatus maps a GitLab commit status to the status and conclusion of a check.
+func mapStatusToCheckStatus(status string) (string, string) {
+	switch status {
+	case "success":
+		return "completed", "success"
+	case "failed":
+		return "completed", "failure"
+	case "canceled":
+		return "completed", "cancelled"
+	case "skipped":
+		return "completed", "skipped"
+	case "running":
+		return "in_progress", ""
+	default:
+		// created, pending, waiting_for_resource, preparing, scheduled and manual.
+		return "pending", ""
+	}
+}
+
+// allChecksPassed returns true if all checks have passed.
+func allChecksPassed(checks []*provider.CheckStatus) bool {
+	if len(checks) == 0 {
+		return true
+	}
+
+	for _, check := range checks {
+		state := check.CheckState()
+		if state != provider.CheckStatusStateSuccess && state != provider.CheckStatusStateSkipped {
+			return false
+		}
+	}
+	return true
+}
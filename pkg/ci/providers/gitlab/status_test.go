@@ -0,0 +1,82 @@
+package gitlab
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/ci/internal/provider"
+)
+
+func TestMapStatusToCheckStatus(t *testing.T) {
+	tests := []struct {
+		status             string
+		expectedStatus     string
+		expectedConclusion string
+	}{
+		{"success", "completed", "success"},
+		{"failed", "completed", "failure"},
+		{"canceled", "completed", "cancelled"},
+		{"skipped", "completed", "skipped"},
+		{"running", "in_progress", ""},
+		{"pending", "pending", ""},
+		{"manual", "pending", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			status, conclusion := mapStatusToCheckStatus(tt.status)
+			assert.Equal(t, tt.expectedStatus, status)
+			assert.Equal(t, tt.expectedConclusion, conclusion)
+		})
+	}
+}
+
+func TestProvider_GetStatus(t *testing.T) {
+	fake, p := newFakeGitLab(t)
+	fake.statuses["sha-main"] = []commitStatus{
+		{Name: "atmos/plan/dev/vpc", Status: "success", TargetURL: "https://gitlab.example.com/jobs/1"},
+	}
+	fake.statuses["sha-feature"] = []commitStatus{
+		{Name: "atmos/plan/dev/vpc", Status: "success"},
+		{Name: "atmos/plan/dev/eks", Status: "failed"},
+	}
+	fake.mrs = []mergeRequest{
+		{IID: 7, Title: "Add VPC", SourceBranch: "feature/vpc", TargetBranch: "main", WebURL: "https://gitlab.example.com/mr/7", SHA: "sha-feature"},
+	}
+
+	status, err := p.GetStatus(t.Context(), provider.StatusOptions{
+		Owner:                 "acme/platform",
+		Repo:                  "infra",
+		Branch:                "feature/vpc",
+		SHA:                   "sha-main",
+		IncludeUserPRs:        true,
+		IncludeReviewRequests: true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme/platform/infra", status.Repository)
+	require.NotNil(t, status.CurrentBranch)
+	assert.Equal(t, "feature/vpc", status.CurrentBranch.Branch)
+	require.Len(t, status.CurrentBranch.Checks, 1)
+	assert.Equal(t, provider.CheckStatusStateSuccess, status.CurrentBranch.Checks[0].CheckState())
+
+	mr := status.CurrentBranch.PullRequest
+	require.NotNil(t, mr)
+	assert.Equal(t, 7, mr.Number)
+	assert.Equal(t, "main", mr.BaseBranch)
+	assert.Len(t, mr.Checks, 2)
+	assert.False(t, mr.AllPassed)
+
+	assert.Len(t, status.CreatedByUser, 1)
+	assert.Len(t, status.ReviewRequests, 1)
+}
+
+func TestProvider_GetStatus_ProjectNotFound(t *testing.T) {
+	_, p := newFakeGitLab(t)
+
+	_, err := p.GetStatus(t.Context(), provider.StatusOptions{Owner: "acme", Repo: "missing", SHA: "abc"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to fetch CI status")
+}
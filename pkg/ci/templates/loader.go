@@ -167,6 +167,10 @@ func (l *Loader) getComponentOverrides(componentType string) map[string]string {
 		return cfg.Terraform
 	case "helmfile":
 		return cfg.Helmfile
+	case "packer":
+		return cfg.Packer
+	case "ansible":
+		return cfg.Ansible
 	default:
 		return nil
 	}
@@ -222,6 +222,8 @@ func TestLoaderGetComponentOverrides(t *testing.T) {
 			Templates: schema.CITemplatesConfig{
 				Terraform: map[string]string{"plan": "custom-plan.md"},
 				Helmfile:  map[string]string{"diff": "custom-diff.md"},
+				Packer:    map[string]string{"build": "custom-build.md"},
+				Ansible:   map[string]string{"playbook": "custom-playbook.md"},
 			},
 		},
 	}
@@ -235,6 +237,8 @@ func TestLoaderGetComponentOverrides(t *testing.T) {
 	}{
 		{"terraform", "plan", "custom-plan.md"},
 		{"helmfile", "diff", "custom-diff.md"},
+		{"packer", "build", "custom-build.md"},
+		{"ansible", "playbook", "custom-playbook.md"},
 		{"unknown", "", ""},
 	}
 
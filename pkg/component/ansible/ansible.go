@@ -6,6 +6,7 @@ import (
 	"sort"
 
 	errUtils "github.com/cloudposse/atmos/errors"
+	e "github.com/cloudposse/atmos/internal/exec"
 	"github.com/cloudposse/atmos/pkg/component"
 	"github.com/cloudposse/atmos/pkg/perf"
 	"github.com/cloudposse/atmos/pkg/schema"
@@ -138,10 +139,10 @@ func (a *AnsibleComponentProvider) Execute(ctx *component.ExecutionContext) erro
 	case "version":
 		return ExecuteVersion(&ctx.ConfigAndStacksInfo)
 	case "playbook":
-		return ExecutePlaybook(&ctx.ConfigAndStacksInfo, flags)
+		return ExecutePlaybook(&ctx.ConfigAndStacksInfo, flags, e.WithOutputCapture(ctx.OutputCapture))
 	default:
 		// For unknown subcommands, default to playbook behavior.
-		return ExecutePlaybook(&ctx.ConfigAndStacksInfo, flags)
+		return ExecutePlaybook(&ctx.ConfigAndStacksInfo, flags, e.WithOutputCapture(ctx.OutputCapture))
 	}
 }
 
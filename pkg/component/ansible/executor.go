@@ -44,9 +44,11 @@ func checkConfig(atmosConfig *schema.AtmosConfiguration) error {
 }
 
 // ExecutePlaybook executes an Ansible playbook command.
+// Optional shell command options are applied to the ansible-playbook process (e.g., to capture its output for CI).
 func ExecutePlaybook(
 	info *schema.ConfigAndStacksInfo,
 	flags *Flags,
+	opts ...e.ShellCommandOption,
 ) error {
 	defer perf.Track(nil, "ansible.ExecutePlaybook")()
 
@@ -210,6 +212,7 @@ func ExecutePlaybook(
 		envVars,
 		info.DryRun,
 		info.RedirectStdErr,
+		opts...,
 	)
 }
 
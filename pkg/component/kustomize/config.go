@@ -0,0 +1,33 @@
+package kustomize
+
+import "github.com/cloudposse/atmos/pkg/perf"
+
+// Config represents the configuration structure for kustomize components.
+// This configuration mirrors the schema.Kustomize struct from pkg/schema/schema.go.
+// and is used for type-safe configuration access within the provider.
+type Config struct {
+	// BasePath is the logical path to kustomize components (uses forward slashes on all platforms).
+	// Filesystem path conversion happens during actual file operations.
+	BasePath string `yaml:"base_path" json:"base_path" mapstructure:"base_path"`
+
+	// Command is the kubectl binary used to build, diff and apply kustomizations (default: kubectl).
+	Command string `yaml:"command" json:"command" mapstructure:"command"`
+
+	// AutoGenerateFiles enables automatic generation of auxiliary configuration files
+	// during Kustomize operations when set to true.
+	// Generated files are defined in the component's generate section.
+	AutoGenerateFiles bool `yaml:"auto_generate_files" json:"auto_generate_files" mapstructure:"auto_generate_files"`
+}
+
+// DefaultConfig returns the default configuration for kustomize components.
+func DefaultConfig() Config {
+	defer perf.Track(nil, "kustomize.DefaultConfig")()
+
+	// Use forward slashes for configuration paths (consistent with terraform/helmfile/packer).
+	// Filesystem path conversion happens during actual file operations.
+	return Config{
+		BasePath:          "components/kustomize",
+		Command:           "kubectl",
+		AutoGenerateFiles: false,
+	}
+}
@@ -0,0 +1,505 @@
+package kustomize
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	e "github.com/cloudposse/atmos/internal/exec"
+	"github.com/cloudposse/atmos/pkg/auth"
+	"github.com/cloudposse/atmos/pkg/auth/cloud/kube"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/data"
+	"github.com/cloudposse/atmos/pkg/dependencies"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	provSource "github.com/cloudposse/atmos/pkg/provisioner/source"
+	provWorkdir "github.com/cloudposse/atmos/pkg/provisioner/workdir"
+	"github.com/cloudposse/atmos/pkg/schema"
+	u "github.com/cloudposse/atmos/pkg/utils"
+)
+
+const (
+	// KubeconfigEnvVar is the environment variable kubectl reads the kubeconfig path from.
+	KubeconfigEnvVar = "KUBECONFIG"
+
+	// diffExitCodeChanges is the exit code of `kubectl diff` when differences are found.
+	diffExitCodeChanges = 1
+
+	kustomizeBinary = "kustomize"
+)
+
+// checkConfig validates that the necessary Kustomize configuration is present.
+func checkConfig(atmosConfig *schema.AtmosConfiguration) error {
+	defer perf.Track(atmosConfig, "kustomize.checkConfig")()
+
+	if atmosConfig.Components.Kustomize.BasePath == "" {
+		return errUtils.ErrMissingKustomizeBasePath
+	}
+	return nil
+}
+
+// ExecuteCommand executes a Kustomize command (`build`, `diff` or `apply`) for a component in a stack.
+// It generates a kustomization wrapping the component with the component `vars` as a ConfigMap,
+// and runs it through kubectl. `diff` and `apply` authenticate with the component `auth` section first,
+// so the kubeconfig written by the Atmos `kube` integration is used for cluster credentials.
+// Optional shell command options are applied to the kubectl process (e.g., to capture its output for CI).
+func ExecuteCommand(info *schema.ConfigAndStacksInfo, opts ...e.ShellCommandOption) error {
+	defer perf.Track(nil, "kustomize.ExecuteCommand")()
+
+	atmosConfig, err := cfg.InitCliConfig(*info, true)
+	if err != nil {
+		return err
+	}
+
+	// Validate kustomize configuration.
+	if err := checkConfig(&atmosConfig); err != nil {
+		return err
+	}
+
+	// Add the `command` from `components.kustomize.command` from `atmos.yaml`.
+	if info.Command == "" {
+		if atmosConfig.Components.Kustomize.Command != "" {
+			info.Command = atmosConfig.Components.Kustomize.Command
+		} else {
+			info.Command = DefaultConfig().Command
+		}
+	}
+
+	*info, err = e.ProcessStacks(&atmosConfig, *info, true, true, true, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(info.Stack) < 1 {
+		return errUtils.ErrMissingStack
+	}
+
+	if !info.ComponentIsEnabled {
+		log.Info("Component is not enabled and skipped", "component", info.ComponentFromArg)
+		return nil
+	}
+
+	// Check if the component exists as a Kustomize component.
+	initialPath, err := u.GetComponentPath(&atmosConfig, "kustomize", info.ComponentFolderPrefix, info.FinalComponent)
+	if err != nil {
+		return errors.Join(errUtils.ErrPathResolution, fmt.Errorf("component path: %w", err))
+	}
+
+	// Auto-generate files BEFORE path validation.
+	// This allows generating entire components from stack configuration.
+	if err := maybeAutoGenerateFiles(&atmosConfig, info, initialPath); err != nil {
+		return err
+	}
+
+	// Resolve component path with JIT provisioning support.
+	componentPath, err := resolveComponentPath(&atmosConfig, info, initialPath)
+	if err != nil {
+		return err
+	}
+
+	// Validate component metadata (abstract/locked checks).
+	if err := validateComponentMetadata(info); err != nil {
+		return err
+	}
+
+	// Resolve and install component dependencies.
+	info.ComponentEnvList, err = ensureDependencies(&atmosConfig, info)
+	if err != nil {
+		return err
+	}
+
+	// Check if the component 'settings.validation' section is specified and validate the component.
+	valid, err := e.ValidateComponent(
+		&atmosConfig,
+		info.ComponentFromArg,
+		info.ComponentSection,
+		"",
+		"",
+		nil,
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("%w: the component '%s' did not pass the validation policies",
+			errUtils.ErrInvalidComponent,
+			info.ComponentFromArg,
+		)
+	}
+
+	// Authenticate before talking to the cluster.
+	// Identities linked to a `kube` integration add its kubeconfig to the environment.
+	if requiresCluster(info.SubCommand) {
+		if err := auth.TerraformPreHook(&atmosConfig, info); err != nil {
+			log.Error("Error executing 'atmos auth' pre-hook", "component", info.ComponentFromArg, "error", err)
+			return err
+		}
+	}
+
+	// Generate the kustomization wrapping the component into a temporary directory.
+	kustomizationDir, err := os.MkdirTemp("", "atmos-kustomize-")
+	if err != nil {
+		return errors.Join(errUtils.ErrCreateDirectory, err)
+	}
+	defer func() {
+		if err := os.RemoveAll(kustomizationDir); err != nil {
+			log.Debug("Failed to remove the generated kustomization", "dir", kustomizationDir, "error", err)
+		}
+	}()
+
+	kustomizationFile, err := GenerateKustomization(
+		componentPath,
+		kustomizationDir,
+		info.Component,
+		info.ComponentVarsSection,
+		&info.ComponentSettingsSection,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Print component variables.
+	log.Debug("Variables for component in stack", "component", info.ComponentFromArg, "stack", info.Stack, "variables", info.ComponentVarsSection)
+
+	// Log context for debugging.
+	var inheritance string
+	if len(info.ComponentInheritanceChain) > 0 {
+		inheritance = info.ComponentFromArg + " -> " + strings.Join(info.ComponentInheritanceChain, " -> ")
+	}
+
+	log.Debug("Kustomize context",
+		"executable", info.Command,
+		"command", info.SubCommand,
+		"atmos component", info.ComponentFromArg,
+		"atmos stack", info.StackFromArg,
+		"kustomize component", info.BaseComponentPath,
+		"kustomization", kustomizationFile,
+		"working directory", componentPath,
+		"inheritance", inheritance,
+		"arguments and flags", info.AdditionalArgsAndFlags,
+	)
+
+	cmdArgs, err := buildCommandArgs(info, kustomizationDir)
+	if err != nil {
+		return err
+	}
+
+	// Convert ComponentEnvSection to ComponentEnvList.
+	e.ConvertComponentEnvSectionToList(info)
+
+	// Prepare ENV vars.
+	envVars, err := prepareEnvVars(&atmosConfig, info)
+	if err != nil {
+		return err
+	}
+	log.Debug("Using ENV", "variables", envVars)
+
+	// In dry-run mode, print the command that would be executed.
+	if info.DryRun {
+		data.Writeln(strings.Join(append([]string{cmdArgs.Command}, cmdArgs.Args...), " "))
+		return nil
+	}
+
+	err = e.ExecuteShellCommand(
+		atmosConfig,
+		cmdArgs.Command,
+		cmdArgs.Args,
+		componentPath,
+		envVars,
+		info.DryRun,
+		info.RedirectStdErr,
+		append([]e.ShellCommandOption{e.WithEnvironment(info.SanitizedEnv)}, opts...)...,
+	)
+
+	return normalizeExitCode(info.SubCommand, err)
+}
+
+// normalizeExitCode treats the `kubectl diff` exit code for found differences as success.
+func normalizeExitCode(subCommand string, err error) error {
+	var exitCodeErr errUtils.ExitCodeError
+	if subCommand == "diff" && errors.As(err, &exitCodeErr) && exitCodeErr.Code == diffExitCodeChanges {
+		log.Debug("Differences found between the kustomization and the cluster")
+		return nil
+	}
+	return err
+}
+
+// requiresCluster reports whether a Kustomize subcommand talks to the cluster.
+func requiresCluster(subCommand string) bool {
+	switch subCommand {
+	case "diff", "apply":
+		return true
+	default:
+		return false
+	}
+}
+
+// isKustomizeBinary reports whether the command is the standalone kustomize binary rather than kubectl.
+func isKustomizeBinary(command string) bool {
+	name := strings.TrimSuffix(filepath.Base(command), ".exe")
+	return name == kustomizeBinary
+}
+
+// maybeAutoGenerateFiles conditionally generates files for a component before path validation.
+// It generates files when:
+//   - auto_generate_files is enabled in the Kustomize configuration.
+//   - the component has a generate section.
+//   - not in dry-run mode (to avoid filesystem modifications).
+//
+// Returns nil if generation is skipped or succeeds, error otherwise.
+func maybeAutoGenerateFiles(
+	atmosConfig *schema.AtmosConfiguration,
+	info *schema.ConfigAndStacksInfo,
+	componentPath string,
+) error {
+	defer perf.Track(atmosConfig, "kustomize.maybeAutoGenerateFiles")()
+
+	// Skip if auto-generation is disabled or in dry-run mode.
+	if !atmosConfig.Components.Kustomize.AutoGenerateFiles || info.DryRun {
+		return nil
+	}
+
+	// Skip if component has no generate section.
+	generateSection, ok := info.ComponentSection["generate"].(map[string]any)
+	if !ok || generateSection == nil {
+		return nil
+	}
+
+	// Ensure component directory exists for file generation.
+	if mkdirErr := os.MkdirAll(componentPath, 0o755); mkdirErr != nil { //nolint:revive
+		return errors.Join(errUtils.ErrCreateDirectory, fmt.Errorf("auto-generation: %w", mkdirErr))
+	}
+
+	// Generate files before path validation.
+	if genErr := e.GenerateFilesForComponent(atmosConfig, info, componentPath); genErr != nil {
+		return errors.Join(errUtils.ErrFileOperation, genErr)
+	}
+
+	return nil
+}
+
+// resolveComponentPath resolves the component path, handling JIT source provisioning if needed.
+// It returns the resolved component path or an error if the component cannot be found.
+func resolveComponentPath(
+	atmosConfig *schema.AtmosConfiguration,
+	info *schema.ConfigAndStacksInfo,
+	initialPath string,
+) (string, error) {
+	defer perf.Track(atmosConfig, "kustomize.resolveComponentPath")()
+
+	componentPath := initialPath
+	componentPathExists, err := u.IsDirectory(componentPath)
+
+	// If path exists, return it directly.
+	if err == nil && componentPathExists {
+		return componentPath, nil
+	}
+
+	// Check if component has source configured for JIT provisioning.
+	if provSource.HasSource(info.ComponentSection) {
+		// Run JIT source provisioning before path validation.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		if provErr := provSource.AutoProvisionSource(ctx, atmosConfig, cfg.KustomizeComponentType, info.ComponentSection, info.AuthContext); provErr != nil {
+			return "", errors.Join(errUtils.ErrProvisionerFailed, fmt.Errorf("auto-provision source: %w", provErr))
+		}
+
+		// Check if source provisioner set a workdir path (source + workdir case).
+		if workdirPath, ok := info.ComponentSection[provWorkdir.WorkdirPathKey].(string); ok {
+			return workdirPath, nil
+		}
+
+		// Re-check if component path now exists after provisioning (source only case).
+		componentPathExists, err = u.IsDirectory(componentPath)
+		if err == nil && componentPathExists {
+			return componentPath, nil
+		}
+	}
+
+	// If still doesn't exist, return the error.
+	basePath, basePathErr := u.GetComponentBasePath(atmosConfig, "kustomize")
+	if basePathErr != nil {
+		return "", fmt.Errorf("%w: '%s' points to the Kustomize component '%s', but failed to resolve base path: %v",
+			errUtils.ErrInvalidComponent,
+			info.ComponentFromArg,
+			info.FinalComponent,
+			basePathErr,
+		)
+	}
+	return "", fmt.Errorf("%w: '%s' points to the Kustomize component '%s', but it does not exist in '%s'",
+		errUtils.ErrInvalidComponent,
+		info.ComponentFromArg,
+		info.FinalComponent,
+		basePath,
+	)
+}
+
+// validateComponentMetadata checks if the component can be provisioned based on metadata.
+// Returns an error if the component is abstract or locked and the subcommand is `apply`.
+func validateComponentMetadata(info *schema.ConfigAndStacksInfo) error {
+	if info.SubCommand != "apply" {
+		return nil
+	}
+
+	// Check if the component is allowed to be provisioned (`metadata.type` attribute).
+	if info.ComponentIsAbstract {
+		return fmt.Errorf("%w: the component '%s' cannot be provisioned because it's marked as abstract (metadata.type: abstract)",
+			errUtils.ErrAbstractComponentCantBeProvisioned,
+			filepath.Join(info.ComponentFolderPrefix, info.Component))
+	}
+
+	// Check if the component is locked (`metadata.locked` is set to true).
+	if info.ComponentIsLocked {
+		return fmt.Errorf("%w: component '%s' cannot be modified (metadata.locked: true)",
+			errUtils.ErrLockedComponentCantBeProvisioned,
+			filepath.Join(info.ComponentFolderPrefix, info.Component))
+	}
+
+	return nil
+}
+
+// ensureDependencies resolves and installs component dependencies, returning the updated environment list.
+// If dependencies are found, it installs them and adds the toolchain PATH to the environment.
+func ensureDependencies(
+	atmosConfig *schema.AtmosConfiguration,
+	info *schema.ConfigAndStacksInfo,
+) ([]string, error) {
+	defer perf.Track(atmosConfig, "kustomize.ensureDependencies")()
+
+	resolver := dependencies.NewResolver(atmosConfig)
+	deps, err := resolver.ResolveComponentDependencies("kustomize", info.StackSection, info.ComponentSection)
+	if err != nil {
+		return nil, errors.Join(errUtils.ErrDependencyResolution, err)
+	}
+
+	envList := info.ComponentEnvList
+
+	if len(deps) > 0 {
+		log.Debug("Installing component dependencies", "component", info.ComponentFromArg, "stack", info.Stack, "tools", deps)
+		installer := dependencies.NewInstaller(atmosConfig)
+		if err := installer.EnsureTools(deps); err != nil {
+			return nil, errors.Join(errUtils.ErrDependencyResolution, fmt.Errorf("install dependencies: %w", err))
+		}
+
+		// Build PATH with toolchain binaries and add to component environment.
+		// This does NOT modify the global process environment - only the subprocess environment.
+		toolchainPATH, err := dependencies.BuildToolchainPATH(atmosConfig, deps)
+		if err != nil {
+			return nil, errors.Join(errUtils.ErrPathResolution, fmt.Errorf("toolchain PATH: %w", err))
+		}
+
+		// Propagate toolchain PATH into environment for subprocess.
+		envList = append(envList, fmt.Sprintf("PATH=%s", toolchainPATH))
+	}
+
+	return envList, nil
+}
+
+// CommandArgs holds the command and arguments for execution.
+type CommandArgs struct {
+	Command string
+	Args    []string
+}
+
+// buildCommandArgs builds the kubectl command for the subcommand against the generated kustomization.
+// `build` also works with the standalone kustomize binary; `diff` and `apply` require kubectl.
+// The kubeconfig context from `settings.kustomize.context` is used for `diff` and `apply`.
+func buildCommandArgs(info *schema.ConfigAndStacksInfo, kustomizationDir string) (*CommandArgs, error) {
+	defer perf.Track(nil, "kustomize.buildCommandArgs")()
+
+	var args []string
+	switch info.SubCommand {
+	case "build":
+		if isKustomizeBinary(info.Command) {
+			args = []string{"build", kustomizationDir}
+		} else {
+			args = []string{"kustomize", kustomizationDir}
+		}
+	case "diff", "apply":
+		if isKustomizeBinary(info.Command) {
+			return nil, fmt.Errorf("%w: '%s' cannot run '%s'", errUtils.ErrKustomizeKubectlRequired, info.Command, info.SubCommand)
+		}
+		args = []string{info.SubCommand, "-k", kustomizationDir}
+		if kubeContext := getKustomizeSetting(&info.ComponentSettingsSection, settingContext); kubeContext != "" {
+			args = append(args, "--context", kubeContext)
+		}
+	default:
+		return nil, fmt.Errorf("%w: '%s' for kustomize components", errUtils.ErrUnknownSubcommand, info.SubCommand)
+	}
+
+	args = append(args, info.AdditionalArgsAndFlags...)
+
+	return &CommandArgs{Command: info.Command, Args: args}, nil
+}
+
+// hasEnvVar reports whether the environment list sets the variable.
+func hasEnvVar(envList []string, name string) bool {
+	for _, v := range envList {
+		if strings.HasPrefix(v, name+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveKubeconfigPath returns the kubeconfig path to set for kubectl, or an empty string to keep the environment as is.
+// A kubeconfig set in the component `env` or by an auth integration is kept. Otherwise `settings.kustomize.kubeconfig_path`
+// is used, falling back to the Atmos-managed kubeconfig when authentication ran and that file exists.
+func resolveKubeconfigPath(info *schema.ConfigAndStacksInfo) (string, error) {
+	defer perf.Track(nil, "kustomize.resolveKubeconfigPath")()
+
+	if hasEnvVar(info.ComponentEnvList, KubeconfigEnvVar) || hasEnvVar(info.SanitizedEnv, KubeconfigEnvVar) {
+		return "", nil
+	}
+
+	customPath := getKustomizeSetting(&info.ComponentSettingsSection, settingKubeconfigPath)
+	if customPath == "" && info.SanitizedEnv == nil {
+		return "", nil
+	}
+
+	manager, err := kube.NewKubeconfigManager(customPath, "")
+	if err != nil {
+		return "", err
+	}
+
+	path := manager.GetPath()
+	if customPath == "" && !u.FileExists(path) {
+		return "", nil
+	}
+
+	return path, nil
+}
+
+// prepareEnvVars prepares the environment variables for command execution.
+func prepareEnvVars(atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStacksInfo) ([]string, error) {
+	defer perf.Track(atmosConfig, "kustomize.prepareEnvVars")()
+
+	envVars := append(info.ComponentEnvList, fmt.Sprintf("ATMOS_CLI_CONFIG_PATH=%s", atmosConfig.CliConfigPath))
+
+	basePath, err := filepath.Abs(atmosConfig.BasePath)
+	if err != nil {
+		return nil, errors.Join(errUtils.ErrPathResolution, fmt.Errorf("failed to resolve base path: %w", err))
+	}
+
+	envVars = append(envVars, fmt.Sprintf("ATMOS_BASE_PATH=%s", basePath))
+
+	if requiresCluster(info.SubCommand) {
+		kubeconfigPath, err := resolveKubeconfigPath(info)
+		if err != nil {
+			return nil, err
+		}
+		if kubeconfigPath != "" {
+			envVars = append(envVars, fmt.Sprintf("%s=%s", KubeconfigEnvVar, kubeconfigPath))
+		}
+	}
+
+	return envVars, nil
+}
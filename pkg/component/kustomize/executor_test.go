@@ -0,0 +1,169 @@
+package kustomize
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestCheckConfig(t *testing.T) {
+	err := checkConfig(&schema.AtmosConfiguration{})
+	assert.ErrorIs(t, err, errUtils.ErrMissingKustomizeBasePath)
+
+	err = checkConfig(&schema.AtmosConfiguration{
+		Components: schema.Components{Kustomize: schema.Kustomize{BasePath: "components/kustomize"}},
+	})
+	assert.NoError(t, err)
+}
+
+func TestValidateComponentMetadata(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    schema.ConfigAndStacksInfo
+		wantErr error
+	}{
+		{
+			name: "abstract component can be built",
+			info: schema.ConfigAndStacksInfo{SubCommand: "build", ComponentIsAbstract: true},
+		},
+		{
+			name: "locked component can be diffed",
+			info: schema.ConfigAndStacksInfo{SubCommand: "diff", ComponentIsLocked: true},
+		},
+		{
+			name:    "abstract component can't be applied",
+			info:    schema.ConfigAndStacksInfo{SubCommand: "apply", ComponentIsAbstract: true},
+			wantErr: errUtils.ErrAbstractComponentCantBeProvisioned,
+		},
+		{
+			name:    "locked component can't be applied",
+			info:    schema.ConfigAndStacksInfo{SubCommand: "apply", ComponentIsLocked: true},
+			wantErr: errUtils.ErrLockedComponentCantBeProvisioned,
+		},
+		{
+			name: "regular component",
+			info: schema.ConfigAndStacksInfo{SubCommand: "apply"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateComponentMetadata(&tt.info)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestBuildCommandArgs(t *testing.T) {
+	settings := schema.AtmosSectionMapType{"kustomize": map[string]any{"context": "dev-cluster"}}
+
+	tests := []struct {
+		name     string
+		info     schema.ConfigAndStacksInfo
+		expected *CommandArgs
+		wantErr  error
+	}{
+		{
+			name:     "kubectl build",
+			info:     schema.ConfigAndStacksInfo{Command: "kubectl", SubCommand: "build", ComponentSettingsSection: settings},
+			expected: &CommandArgs{Command: "kubectl", Args: []string{"kustomize", "/tmp/k"}},
+		},
+		{
+			name:     "kustomize build",
+			info:     schema.ConfigAndStacksInfo{Command: "/usr/local/bin/kustomize", SubCommand: "build"},
+			expected: &CommandArgs{Command: "/usr/local/bin/kustomize", Args: []string{"build", "/tmp/k"}},
+		},
+		{
+			name: "diff with context",
+			info: schema.ConfigAndStacksInfo{Command: "kubectl", SubCommand: "diff", ComponentSettingsSection: settings},
+			expected: &CommandArgs{
+				Command: "kubectl",
+				Args:    []string{"diff", "-k", "/tmp/k", "--context", "dev-cluster"},
+			},
+		},
+		{
+			name: "apply with additional args",
+			info: schema.ConfigAndStacksInfo{
+				Command:                "kubectl",
+				SubCommand:             "apply",
+				AdditionalArgsAndFlags: []string{"--server-side", "--prune"},
+			},
+			expected: &CommandArgs{
+				Command: "kubectl",
+				Args:    []string{"apply", "-k", "/tmp/k", "--server-side", "--prune"},
+			},
+		},
+		{
+			name:    "kustomize can't apply",
+			info:    schema.ConfigAndStacksInfo{Command: "kustomize", SubCommand: "apply"},
+			wantErr: errUtils.ErrKustomizeKubectlRequired,
+		},
+		{
+			name:    "unknown subcommand",
+			info:    schema.ConfigAndStacksInfo{Command: "kubectl", SubCommand: "delete"},
+			wantErr: errUtils.ErrUnknownSubcommand,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, err := buildCommandArgs(&tt.info, "/tmp/k")
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, args)
+		})
+	}
+}
+
+func TestNormalizeExitCode(t *testing.T) {
+	changes := errUtils.ExitCodeError{Code: 1}
+	failure := errUtils.ExitCodeError{Code: 2}
+
+	assert.NoError(t, normalizeExitCode("diff", changes))
+	assert.NoError(t, normalizeExitCode("diff", fmt.Errorf("wrapped: %w", changes)))
+	assert.ErrorIs(t, normalizeExitCode("diff", failure), failure)
+	assert.ErrorIs(t, normalizeExitCode("apply", changes), changes)
+	assert.NoError(t, normalizeExitCode("apply", nil))
+}
+
+func TestPrepareEnvVars(t *testing.T) {
+	atmosConfig := &schema.AtmosConfiguration{BasePath: ".", CliConfigPath: "/etc/atmos"}
+	kubeconfigPath := filepath.Join(t.TempDir(), "config")
+
+	info := &schema.ConfigAndStacksInfo{
+		SubCommand:               "apply",
+		ComponentEnvList:         []string{"FOO=bar"},
+		ComponentSettingsSection: schema.AtmosSectionMapType{"kustomize": map[string]any{"kubeconfig_path": kubeconfigPath}},
+	}
+	envVars, err := prepareEnvVars(atmosConfig, info)
+	require.NoError(t, err)
+	assert.Contains(t, envVars, "FOO=bar")
+	assert.Contains(t, envVars, "ATMOS_CLI_CONFIG_PATH=/etc/atmos")
+	assert.Contains(t, envVars, "KUBECONFIG="+kubeconfigPath)
+
+	// A kubeconfig set by an auth integration takes precedence.
+	info.SanitizedEnv = []string{"KUBECONFIG=/auth/kubeconfig"}
+	envVars, err = prepareEnvVars(atmosConfig, info)
+	require.NoError(t, err)
+	assert.NotContains(t, envVars, "KUBECONFIG="+kubeconfigPath)
+
+	// Build doesn't talk to the cluster.
+	info.SanitizedEnv = nil
+	info.SubCommand = "build"
+	envVars, err = prepareEnvVars(atmosConfig, info)
+	require.NoError(t, err)
+	assert.NotContains(t, envVars, "KUBECONFIG="+kubeconfigPath)
+}
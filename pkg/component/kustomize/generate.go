@@ -0,0 +1,292 @@
+package kustomize
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	// KustomizationFileName is the name of the kustomization file generated by Atmos.
+	KustomizationFileName = "kustomization.yaml"
+
+	kustomizationAPIVersion = "kustomize.config.k8s.io/v1beta1"
+	kustomizationKind       = "Kustomization"
+
+	// ConfigMapNameSuffix is appended to the component name to name the ConfigMap generated from `vars`.
+	ConfigMapNameSuffix = "-vars"
+
+	// Keys of the `settings.kustomize` section.
+	settingNamespace      = "namespace"
+	settingNamePrefix     = "name_prefix"
+	settingNameSuffix     = "name_suffix"
+	settingConfigMapName  = "config_map_name"
+	settingPatches        = "patches"
+	settingImages         = "images"
+	settingContext        = "context"
+	settingKubeconfigPath = "kubeconfig_path"
+)
+
+// kustomizationFileNames are the file names kustomize recognizes as a kustomization, in lookup order.
+var kustomizationFileNames = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// manifestExtensions are the extensions of raw Kubernetes manifests included when a component has no kustomization.
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// invalidNameChars matches characters not allowed in Kubernetes resource names.
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+// Kustomization is the kustomization generated by Atmos to wrap a component.
+type Kustomization struct {
+	APIVersion         string          `yaml:"apiVersion"`
+	Kind               string          `yaml:"kind"`
+	Namespace          string          `yaml:"namespace,omitempty"`
+	NamePrefix         string          `yaml:"namePrefix,omitempty"`
+	NameSuffix         string          `yaml:"nameSuffix,omitempty"`
+	Resources          []string        `yaml:"resources"`
+	ConfigMapGenerator []ConfigMapArgs `yaml:"configMapGenerator,omitempty"`
+	Images             []any           `yaml:"images,omitempty"`
+	Patches            []any           `yaml:"patches,omitempty"`
+}
+
+// ConfigMapArgs is a `configMapGenerator` entry.
+type ConfigMapArgs struct {
+	Name     string            `yaml:"name"`
+	Literals []string          `yaml:"literals"`
+	Options  *GeneratorOptions `yaml:"options,omitempty"`
+}
+
+// GeneratorOptions are the options of a `configMapGenerator` entry.
+type GeneratorOptions struct {
+	DisableNameSuffixHash bool `yaml:"disableNameSuffixHash,omitempty"`
+}
+
+// getKustomizeSettings returns the `settings.kustomize` section.
+func getKustomizeSettings(settingsSection *schema.AtmosSectionMapType) map[string]any {
+	if settingsSection == nil {
+		return nil
+	}
+
+	kustomizeSection, ok := (*settingsSection)["kustomize"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	return kustomizeSection
+}
+
+// getKustomizeSetting extracts a string setting from settings.kustomize.
+func getKustomizeSetting(settingsSection *schema.AtmosSectionMapType, key string) string {
+	value, ok := getKustomizeSettings(settingsSection)[key].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+// getKustomizeListSetting extracts a list setting from settings.kustomize.
+func getKustomizeListSetting(settingsSection *schema.AtmosSectionMapType, key string) []any {
+	value, ok := getKustomizeSettings(settingsSection)[key].([]any)
+	if !ok {
+		return nil
+	}
+
+	return value
+}
+
+// ConfigMapName returns the name of the ConfigMap generated from the component `vars`.
+// `settings.kustomize.config_map_name` overrides the default `<component>-vars`.
+func ConfigMapName(componentName string, settingsSection *schema.AtmosSectionMapType) string {
+	defer perf.Track(nil, "kustomize.ConfigMapName")()
+
+	if name := getKustomizeSetting(settingsSection, settingConfigMapName); name != "" {
+		return name
+	}
+
+	name := invalidNameChars.ReplaceAllString(strings.ToLower(componentName), "-")
+	return strings.Trim(name, "-.") + ConfigMapNameSuffix
+}
+
+// ConfigMapLiterals converts the component variables into sorted `key=value` ConfigMap literals.
+// Scalar values are written as is; lists and maps are encoded as JSON.
+func ConfigMapLiterals(vars schema.AtmosSectionMapType) ([]string, error) {
+	defer perf.Track(nil, "kustomize.ConfigMapLiterals")()
+
+	literals := make([]string, 0, len(vars))
+	for key, value := range vars {
+		var s string
+		switch v := value.(type) {
+		case nil:
+			s = ""
+		case string:
+			s = v
+		case map[string]any, []any:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, errors.Join(errUtils.ErrKustomizeGenerate, fmt.Errorf("failed to encode var '%s': %w", key, err))
+			}
+			s = string(encoded)
+		default:
+			s = fmt.Sprint(v)
+		}
+		literals = append(literals, key+"="+s)
+	}
+
+	sort.Strings(literals)
+	return literals, nil
+}
+
+// hasKustomization reports whether the directory contains a kustomization file.
+func hasKustomization(dir string) bool {
+	for _, name := range kustomizationFileNames {
+		if info, err := os.Stat(filepath.Join(dir, name)); err == nil && !info.IsDir() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findManifests returns the sorted names of the raw Kubernetes manifests in the directory.
+func findManifests(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Join(errUtils.ErrKustomizeGenerate, err)
+	}
+
+	var manifests []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		for _, manifestExt := range manifestExtensions {
+			if ext == manifestExt {
+				manifests = append(manifests, entry.Name())
+				break
+			}
+		}
+	}
+
+	sort.Strings(manifests)
+	return manifests, nil
+}
+
+// resolveResources returns the `resources` of the generated kustomization.
+// A component with a kustomization is referenced by its absolute path. Raw manifests are copied into
+// the output directory, since kustomize only loads files from within the kustomization root.
+func resolveResources(componentPath string, outputDir string) ([]string, error) {
+	absComponentPath, err := filepath.Abs(componentPath)
+	if err != nil {
+		return nil, errors.Join(errUtils.ErrPathResolution, err)
+	}
+
+	if hasKustomization(absComponentPath) {
+		return []string{filepath.ToSlash(absComponentPath)}, nil
+	}
+
+	manifests, err := findManifests(absComponentPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("%w: '%s'", errUtils.ErrKustomizeManifestsMissing, componentPath)
+	}
+
+	for _, name := range manifests {
+		content, err := os.ReadFile(filepath.Join(absComponentPath, name))
+		if err != nil {
+			return nil, errors.Join(errUtils.ErrKustomizeGenerate, err)
+		}
+		if err := os.WriteFile(filepath.Join(outputDir, name), content, 0o644); err != nil {
+			return nil, errors.Join(errUtils.ErrKustomizeGenerate, err)
+		}
+	}
+
+	return manifests, nil
+}
+
+// BuildKustomization returns the kustomization that wraps the given resources.
+// The component `vars` become a ConfigMap generated without a name hash, so workloads can reference it
+// by a stable name. Namespace, name prefix and suffix, images and patches come from `settings.kustomize`.
+func BuildKustomization(
+	componentName string,
+	resources []string,
+	vars schema.AtmosSectionMapType,
+	settingsSection *schema.AtmosSectionMapType,
+) (*Kustomization, error) {
+	defer perf.Track(nil, "kustomize.BuildKustomization")()
+
+	kustomization := &Kustomization{
+		APIVersion: kustomizationAPIVersion,
+		Kind:       kustomizationKind,
+		Namespace:  getKustomizeSetting(settingsSection, settingNamespace),
+		NamePrefix: getKustomizeSetting(settingsSection, settingNamePrefix),
+		NameSuffix: getKustomizeSetting(settingsSection, settingNameSuffix),
+		Resources:  resources,
+		Images:     getKustomizeListSetting(settingsSection, settingImages),
+		Patches:    getKustomizeListSetting(settingsSection, settingPatches),
+	}
+
+	if len(vars) > 0 {
+		literals, err := ConfigMapLiterals(vars)
+		if err != nil {
+			return nil, err
+		}
+		kustomization.ConfigMapGenerator = []ConfigMapArgs{
+			{
+				Name:     ConfigMapName(componentName, settingsSection),
+				Literals: literals,
+				Options:  &GeneratorOptions{DisableNameSuffixHash: true},
+			},
+		}
+	}
+
+	return kustomization, nil
+}
+
+// GenerateKustomization writes the kustomization that wraps the component into the output directory.
+// Returns the path of the written kustomization file.
+func GenerateKustomization(
+	componentPath string,
+	outputDir string,
+	componentName string,
+	vars schema.AtmosSectionMapType,
+	settingsSection *schema.AtmosSectionMapType,
+) (string, error) {
+	defer perf.Track(nil, "kustomize.GenerateKustomization")()
+
+	resources, err := resolveResources(componentPath, outputDir)
+	if err != nil {
+		return "", err
+	}
+
+	kustomization, err := BuildKustomization(componentName, resources, vars, settingsSection)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := yaml.Marshal(kustomization)
+	if err != nil {
+		return "", errors.Join(errUtils.ErrKustomizeGenerate, err)
+	}
+
+	filePath := filepath.Join(outputDir, KustomizationFileName)
+	if err := os.WriteFile(filePath, content, 0o644); err != nil {
+		return "", errors.Join(errUtils.ErrKustomizeGenerate, fmt.Errorf("%s: %w", filePath, err))
+	}
+
+	return filePath, nil
+}
@@ -0,0 +1,188 @@
+package kustomize
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/tests"
+)
+
+const deploymentManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  selector:
+    matchLabels:
+      app: app
+  template:
+    metadata:
+      labels:
+        app: app
+    spec:
+      containers:
+        - name: app
+          image: nginx:1.27
+          envFrom:
+            - configMapRef:
+                name: app-vars
+`
+
+func writeFile(t *testing.T, path string, content string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+}
+
+func readKustomization(t *testing.T, path string) map[string]any {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var kustomization map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &kustomization))
+	return kustomization
+}
+
+func TestConfigMapName(t *testing.T) {
+	assert.Equal(t, "app-vars", ConfigMapName("app", nil))
+	assert.Equal(t, "apps-web-blue-vars", ConfigMapName("apps/Web_Blue", nil))
+
+	settings := schema.AtmosSectionMapType{"kustomize": map[string]any{"config_map_name": "app-config"}}
+	assert.Equal(t, "app-config", ConfigMapName("app", &settings))
+}
+
+func TestConfigMapLiterals(t *testing.T) {
+	literals, err := ConfigMapLiterals(schema.AtmosSectionMapType{
+		"replicas": 3,
+		"enabled":  true,
+		"image":    "nginx:1.27",
+		"empty":    nil,
+		"hosts":    []any{"a.example.com", "b.example.com"},
+		"labels":   map[string]any{"team": "platform"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		"empty=",
+		"enabled=true",
+		`hosts=["a.example.com","b.example.com"]`,
+		"image=nginx:1.27",
+		`labels={"team":"platform"}`,
+		"replicas=3",
+	}, literals)
+}
+
+func TestBuildKustomization(t *testing.T) {
+	settings := schema.AtmosSectionMapType{
+		"kustomize": map[string]any{
+			"namespace":   "apps",
+			"name_prefix": "dev-",
+			"images":      []any{map[string]any{"name": "nginx", "newTag": "1.28"}},
+			"patches":     []any{map[string]any{"patch": "- op: replace\n  path: /spec/replicas\n  value: 2", "target": map[string]any{"kind": "Deployment"}}},
+		},
+	}
+
+	kustomization, err := BuildKustomization("app", []string{"deployment.yaml"}, schema.AtmosSectionMapType{"replicas": 2}, &settings)
+	require.NoError(t, err)
+
+	assert.Equal(t, "kustomize.config.k8s.io/v1beta1", kustomization.APIVersion)
+	assert.Equal(t, "Kustomization", kustomization.Kind)
+	assert.Equal(t, "apps", kustomization.Namespace)
+	assert.Equal(t, "dev-", kustomization.NamePrefix)
+	assert.Empty(t, kustomization.NameSuffix)
+	assert.Equal(t, []string{"deployment.yaml"}, kustomization.Resources)
+	assert.Len(t, kustomization.Images, 1)
+	assert.Len(t, kustomization.Patches, 1)
+	require.Len(t, kustomization.ConfigMapGenerator, 1)
+	assert.Equal(t, "app-vars", kustomization.ConfigMapGenerator[0].Name)
+	assert.Equal(t, []string{"replicas=2"}, kustomization.ConfigMapGenerator[0].Literals)
+	assert.True(t, kustomization.ConfigMapGenerator[0].Options.DisableNameSuffixHash)
+}
+
+func TestBuildKustomization_NoVars(t *testing.T) {
+	kustomization, err := BuildKustomization("app", []string{"deployment.yaml"}, nil, nil)
+	require.NoError(t, err)
+	assert.Empty(t, kustomization.ConfigMapGenerator)
+	assert.Empty(t, kustomization.Namespace)
+}
+
+func TestGenerateKustomization_RawManifests(t *testing.T) {
+	componentPath := t.TempDir()
+	writeFile(t, filepath.Join(componentPath, "deployment.yaml"), deploymentManifest)
+	writeFile(t, filepath.Join(componentPath, "service.yml"), "apiVersion: v1\nkind: Service\nmetadata:\n  name: app\n")
+	writeFile(t, filepath.Join(componentPath, "README.md"), "# app\n")
+
+	outputDir := t.TempDir()
+	filePath, err := GenerateKustomization(componentPath, outputDir, "app", schema.AtmosSectionMapType{"greeting": "hello"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(outputDir, KustomizationFileName), filePath)
+
+	// Raw manifests are copied next to the generated kustomization.
+	assert.FileExists(t, filepath.Join(outputDir, "deployment.yaml"))
+	assert.FileExists(t, filepath.Join(outputDir, "service.yml"))
+	assert.NoFileExists(t, filepath.Join(outputDir, "README.md"))
+
+	kustomization := readKustomization(t, filePath)
+	assert.Equal(t, []any{"deployment.yaml", "service.yml"}, kustomization["resources"])
+	assert.Equal(t, []any{
+		map[string]any{
+			"name":     "app-vars",
+			"literals": []any{"greeting=hello"},
+			"options":  map[string]any{"disableNameSuffixHash": true},
+		},
+	}, kustomization["configMapGenerator"])
+}
+
+func TestGenerateKustomization_ExistingKustomization(t *testing.T) {
+	componentPath := t.TempDir()
+	writeFile(t, filepath.Join(componentPath, "kustomization.yaml"), "resources:\n  - deployment.yaml\n")
+	writeFile(t, filepath.Join(componentPath, "deployment.yaml"), deploymentManifest)
+
+	outputDir := t.TempDir()
+	filePath, err := GenerateKustomization(componentPath, outputDir, "app", nil, nil)
+	require.NoError(t, err)
+
+	// The component kustomization is referenced, not copied.
+	absComponentPath, err := filepath.Abs(componentPath)
+	require.NoError(t, err)
+	kustomization := readKustomization(t, filePath)
+	assert.Equal(t, []any{filepath.ToSlash(absComponentPath)}, kustomization["resources"])
+	assert.NotContains(t, kustomization, "configMapGenerator")
+	assert.NoFileExists(t, filepath.Join(outputDir, "deployment.yaml"))
+}
+
+func TestGenerateKustomization_NoManifests(t *testing.T) {
+	componentPath := t.TempDir()
+	writeFile(t, filepath.Join(componentPath, "README.md"), "# app\n")
+
+	_, err := GenerateKustomization(componentPath, t.TempDir(), "app", nil, nil)
+	assert.ErrorIs(t, err, errUtils.ErrKustomizeManifestsMissing)
+}
+
+func TestGenerateKustomization_KustomizeBuild(t *testing.T) {
+	tests.RequireExecutable(t, "kustomize", "rendering the generated kustomization")
+
+	componentPath := t.TempDir()
+	writeFile(t, filepath.Join(componentPath, "deployment.yaml"), deploymentManifest)
+
+	settings := schema.AtmosSectionMapType{"kustomize": map[string]any{"namespace": "apps"}}
+	outputDir := t.TempDir()
+	_, err := GenerateKustomization(componentPath, outputDir, "app", schema.AtmosSectionMapType{"greeting": "hello"}, &settings)
+	require.NoError(t, err)
+
+	output, err := exec.Command("kustomize", "build", outputDir).CombinedOutput()
+	require.NoError(t, err, string(output))
+	assert.Contains(t, string(output), "kind: ConfigMap")
+	assert.Contains(t, string(output), "name: app-vars")
+	assert.Contains(t, string(output), "greeting: hello")
+	assert.Contains(t, string(output), "namespace: apps")
+}
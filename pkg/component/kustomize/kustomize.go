@@ -0,0 +1,154 @@
+package kustomize
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	e "github.com/cloudposse/atmos/internal/exec"
+	"github.com/cloudposse/atmos/pkg/component"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// KustomizeComponentProvider implements ComponentProvider for Kustomize components.
+// A Kustomize component is a kustomization (base or overlay) or a folder of raw Kubernetes manifests.
+// Component `vars` are rendered into a generated kustomization that wraps the component, which is then
+// built, diffed or applied with kubectl.
+type KustomizeComponentProvider struct{}
+
+func init() {
+	defer perf.Track(nil, "kustomize.init")()
+
+	// Self-register with the component registry.
+	if err := component.Register(&KustomizeComponentProvider{}); err != nil {
+		panic(fmt.Sprintf("failed to register kustomize component provider: %v", err))
+	}
+}
+
+// GetType returns the component type identifier.
+func (p *KustomizeComponentProvider) GetType() string {
+	defer perf.Track(nil, "kustomize.GetType")()
+
+	return "kustomize"
+}
+
+// GetGroup returns the component group for categorization.
+func (p *KustomizeComponentProvider) GetGroup() string {
+	defer perf.Track(nil, "kustomize.GetGroup")()
+
+	return "Kubernetes"
+}
+
+// GetBasePath returns the base directory path for this component type.
+func (p *KustomizeComponentProvider) GetBasePath(atmosConfig *schema.AtmosConfiguration) string {
+	defer perf.Track(atmosConfig, "kustomize.GetBasePath")()
+
+	if atmosConfig == nil {
+		return DefaultConfig().BasePath
+	}
+
+	// Use the built-in Kustomize configuration from schema.
+	if atmosConfig.Components.Kustomize.BasePath != "" {
+		return atmosConfig.Components.Kustomize.BasePath
+	}
+
+	return DefaultConfig().BasePath
+}
+
+// ListComponents discovers all kustomize components in a stack.
+func (p *KustomizeComponentProvider) ListComponents(ctx context.Context, stack string, stackConfig map[string]any) ([]string, error) {
+	defer perf.Track(nil, "kustomize.ListComponents")()
+
+	componentsSection, ok := stackConfig["components"].(map[string]any)
+	if !ok {
+		return []string{}, nil
+	}
+
+	kustomizeComponents, ok := componentsSection["kustomize"].(map[string]any)
+	if !ok {
+		return []string{}, nil
+	}
+
+	componentNames := make([]string, 0, len(kustomizeComponents))
+	for name := range kustomizeComponents {
+		componentNames = append(componentNames, name)
+	}
+
+	sort.Strings(componentNames)
+	return componentNames, nil
+}
+
+// ValidateComponent validates kustomize component configuration.
+func (p *KustomizeComponentProvider) ValidateComponent(config map[string]any) error {
+	defer perf.Track(nil, "kustomize.ValidateComponent")()
+
+	if config == nil {
+		return nil
+	}
+
+	// Validate metadata section if present.
+	if metadata, ok := config["metadata"].(map[string]any); ok {
+		// Abstract components are valid but cannot be executed.
+		if componentType, ok := metadata["type"].(string); ok && componentType == "abstract" {
+			return nil
+		}
+	}
+
+	settings, ok := config["settings"].(map[string]any)
+	if !ok {
+		return nil
+	}
+	kustomize, ok := settings["kustomize"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	// Validate settings.kustomize section if present.
+	for _, key := range []string{
+		settingNamespace, settingNamePrefix, settingNameSuffix,
+		settingConfigMapName, settingContext, settingKubeconfigPath,
+	} {
+		if value, ok := kustomize[key]; ok {
+			if _, isString := value.(string); !isString && value != nil {
+				return fmt.Errorf("%w: settings.kustomize.%s must be a string", errUtils.ErrComponentValidationFailed, key)
+			}
+		}
+	}
+	for _, key := range []string{settingPatches, settingImages} {
+		if value, ok := kustomize[key]; ok {
+			if _, isList := value.([]any); !isList && value != nil {
+				return fmt.Errorf("%w: settings.kustomize.%s must be a list", errUtils.ErrComponentValidationFailed, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Execute runs a command for kustomize components.
+// Delegates to the appropriate executor function based on the subcommand.
+func (p *KustomizeComponentProvider) Execute(ctx *component.ExecutionContext) error {
+	defer perf.Track(ctx.AtmosConfig, "kustomize.Execute")()
+
+	return ExecuteCommand(&ctx.ConfigAndStacksInfo, e.WithOutputCapture(ctx.OutputCapture))
+}
+
+// GenerateArtifacts creates necessary files for kustomize component execution.
+// For Kustomize, this is the kustomization that wraps the component.
+func (p *KustomizeComponentProvider) GenerateArtifacts(ctx *component.ExecutionContext) error {
+	defer perf.Track(ctx.AtmosConfig, "kustomize.GenerateArtifacts")()
+
+	// The kustomization is generated into a temporary directory within ExecuteCommand
+	// and removed after the command runs.
+	// This method exists to satisfy the ComponentProvider interface.
+	return nil
+}
+
+// GetAvailableCommands returns list of commands this component type supports.
+func (p *KustomizeComponentProvider) GetAvailableCommands() []string {
+	defer perf.Track(nil, "kustomize.GetAvailableCommands")()
+
+	return []string{"build", "diff", "apply"}
+}
@@ -0,0 +1,203 @@
+package kustomize
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/component"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestKustomizeComponentProvider_GetType(t *testing.T) {
+	provider := &KustomizeComponentProvider{}
+	assert.Equal(t, "kustomize", provider.GetType())
+}
+
+func TestKustomizeComponentProvider_GetGroup(t *testing.T) {
+	provider := &KustomizeComponentProvider{}
+	assert.Equal(t, "Kubernetes", provider.GetGroup())
+}
+
+func TestKustomizeComponentProvider_GetBasePath(t *testing.T) {
+	provider := &KustomizeComponentProvider{}
+
+	tests := []struct {
+		name         string
+		atmosConfig  *schema.AtmosConfiguration
+		expectedPath string
+	}{
+		{
+			name:         "nil config returns default",
+			atmosConfig:  nil,
+			expectedPath: "components/kustomize",
+		},
+		{
+			name: "with configured base_path",
+			atmosConfig: &schema.AtmosConfiguration{
+				Components: schema.Components{
+					Kustomize: schema.Kustomize{BasePath: "custom/kustomize"},
+				},
+			},
+			expectedPath: "custom/kustomize",
+		},
+		{
+			name:         "with empty base_path returns default",
+			atmosConfig:  &schema.AtmosConfiguration{},
+			expectedPath: "components/kustomize",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedPath, provider.GetBasePath(tt.atmosConfig))
+		})
+	}
+}
+
+func TestKustomizeComponentProvider_ListComponents(t *testing.T) {
+	provider := &KustomizeComponentProvider{}
+
+	tests := []struct {
+		name        string
+		stackConfig map[string]any
+		expected    []string
+	}{
+		{
+			name:        "no components section",
+			stackConfig: map[string]any{},
+			expected:    []string{},
+		},
+		{
+			name: "no kustomize section",
+			stackConfig: map[string]any{
+				"components": map[string]any{"terraform": map[string]any{"vpc": map[string]any{}}},
+			},
+			expected: []string{},
+		},
+		{
+			name: "sorted kustomize components",
+			stackConfig: map[string]any{
+				"components": map[string]any{
+					"kustomize": map[string]any{
+						"ingress": map[string]any{},
+						"app":     map[string]any{},
+					},
+				},
+			},
+			expected: []string{"app", "ingress"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			components, err := provider.ListComponents(context.Background(), "dev", tt.stackConfig)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, components)
+		})
+	}
+}
+
+func TestKustomizeComponentProvider_ValidateComponent(t *testing.T) {
+	provider := &KustomizeComponentProvider{}
+
+	tests := []struct {
+		name    string
+		config  map[string]any
+		wantErr bool
+	}{
+		{
+			name:   "nil config",
+			config: nil,
+		},
+		{
+			name: "abstract component",
+			config: map[string]any{
+				"metadata": map[string]any{"type": "abstract"},
+				"settings": map[string]any{"kustomize": map[string]any{"namespace": 1}},
+			},
+		},
+		{
+			name: "valid settings",
+			config: map[string]any{
+				"settings": map[string]any{"kustomize": map[string]any{
+					"namespace": "apps",
+					"context":   "dev",
+					"patches":   []any{map[string]any{"patch": "- op: add"}},
+				}},
+			},
+		},
+		{
+			name:    "invalid settings.kustomize.namespace",
+			config:  map[string]any{"settings": map[string]any{"kustomize": map[string]any{"namespace": 42}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid settings.kustomize.patches",
+			config:  map[string]any{"settings": map[string]any{"kustomize": map[string]any{"patches": "patch.yaml"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := provider.ValidateComponent(tt.config)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errUtils.ErrComponentValidationFailed)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestKustomizeComponentProvider_Execute(t *testing.T) {
+	provider := &KustomizeComponentProvider{}
+
+	// Without proper configuration, Execute fails during config initialization or stack processing.
+	ctx := component.ExecutionContext{
+		ComponentType: "kustomize",
+		Component:     "app",
+		Stack:         "dev",
+		Command:       "kustomize",
+		SubCommand:    "build",
+	}
+
+	err := provider.Execute(&ctx)
+	assert.Error(t, err)
+}
+
+func TestKustomizeComponentProvider_GenerateArtifacts(t *testing.T) {
+	provider := &KustomizeComponentProvider{}
+
+	err := provider.GenerateArtifacts(&component.ExecutionContext{Component: "app", Stack: "dev"})
+	assert.NoError(t, err)
+}
+
+func TestKustomizeComponentProvider_GetAvailableCommands(t *testing.T) {
+	provider := &KustomizeComponentProvider{}
+
+	assert.Equal(t, []string{"build", "diff", "apply"}, provider.GetAvailableCommands())
+}
+
+func TestDefaultConfig(t *testing.T) {
+	config := DefaultConfig()
+
+	assert.Equal(t, "components/kustomize", config.BasePath)
+	assert.Equal(t, "kubectl", config.Command)
+	assert.False(t, config.AutoGenerateFiles)
+}
+
+func TestKustomizeComponentProvider_Registered(t *testing.T) {
+	provider, ok := component.GetProvider("kustomize")
+	require.True(t, ok)
+	assert.IsType(t, &KustomizeComponentProvider{}, provider)
+}
+
+// TestKustomizeComponentProvider_ImplementsInterface verifies the provider implements ComponentProvider.
+func TestKustomizeComponentProvider_ImplementsInterface(t *testing.T) {
+	var _ component.ComponentProvider = (*KustomizeComponentProvider)(nil)
+}
@@ -2,6 +2,7 @@ package component
 
 import (
 	"context"
+	"io"
 
 	"github.com/cloudposse/atmos/pkg/schema"
 )
@@ -56,6 +57,9 @@ type ExecutionContext struct {
 	ConfigAndStacksInfo schema.ConfigAndStacksInfo
 	Args                []string
 	Flags               map[string]any
+
+	// OutputCapture, when set, receives a copy of the command's stdout and stderr (e.g., for CI summaries).
+	OutputCapture io.Writer
 }
 
 // ComponentInfo provides metadata about a component provider.
@@ -0,0 +1,663 @@
+package pulumi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	e "github.com/cloudposse/atmos/internal/exec"
+	cfg "github.com/cloudposse/atmos/pkg/config"
+	"github.com/cloudposse/atmos/pkg/data"
+	"github.com/cloudposse/atmos/pkg/dependencies"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	provSource "github.com/cloudposse/atmos/pkg/provisioner/source"
+	provWorkdir "github.com/cloudposse/atmos/pkg/provisioner/workdir"
+	"github.com/cloudposse/atmos/pkg/schema"
+	u "github.com/cloudposse/atmos/pkg/utils"
+)
+
+const (
+	// BackendURLEnvVar is the environment variable Pulumi reads the state backend URL from.
+	BackendURLEnvVar = "PULUMI_BACKEND_URL"
+
+	fileBackendScheme = "file://"
+)
+
+// projectFileNames are the Pulumi project files, in lookup order.
+var projectFileNames = []string{"Pulumi.yaml", "Pulumi.yml"}
+
+// checkConfig validates that the necessary Pulumi configuration is present.
+func checkConfig(atmosConfig *schema.AtmosConfiguration) error {
+	defer perf.Track(atmosConfig, "pulumi.checkConfig")()
+
+	if atmosConfig.Components.Pulumi.BasePath == "" {
+		return errUtils.ErrMissingPulumiBasePath
+	}
+	return nil
+}
+
+// ExecuteCommand executes a Pulumi command (`preview`, `up`, `destroy` or `refresh`) for a component in a stack.
+// It selects (creating if needed) the Pulumi stack derived from the Atmos stack, writes the component `vars`
+// into the Pulumi stack config, and runs the command against the state backend from the `backend` section.
+// Optional shell command options are applied to the pulumi process (e.g., to capture its output for CI).
+func ExecuteCommand(info *schema.ConfigAndStacksInfo, opts ...e.ShellCommandOption) error {
+	defer perf.Track(nil, "pulumi.ExecuteCommand")()
+
+	atmosConfig, err := cfg.InitCliConfig(*info, true)
+	if err != nil {
+		return err
+	}
+
+	// Validate pulumi configuration.
+	if err := checkConfig(&atmosConfig); err != nil {
+		return err
+	}
+
+	// Add the `command` from `components.pulumi.command` from `atmos.yaml`.
+	if info.Command == "" {
+		if atmosConfig.Components.Pulumi.Command != "" {
+			info.Command = atmosConfig.Components.Pulumi.Command
+		} else {
+			info.Command = cfg.PulumiComponentType
+		}
+	}
+
+	*info, err = e.ProcessStacks(&atmosConfig, *info, true, true, true, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	if len(info.Stack) < 1 {
+		return errUtils.ErrMissingStack
+	}
+
+	if !info.ComponentIsEnabled {
+		log.Info("Component is not enabled and skipped", "component", info.ComponentFromArg)
+		return nil
+	}
+
+	// Check if the component exists as a Pulumi component.
+	initialPath, err := u.GetComponentPath(&atmosConfig, "pulumi", info.ComponentFolderPrefix, info.FinalComponent)
+	if err != nil {
+		return errors.Join(errUtils.ErrPathResolution, fmt.Errorf("component path: %w", err))
+	}
+
+	// Auto-generate files BEFORE path validation.
+	// This allows generating entire components from stack configuration.
+	if err := maybeAutoGenerateFiles(&atmosConfig, info, initialPath); err != nil {
+		return err
+	}
+
+	// Resolve component path with JIT provisioning support.
+	componentPath, err := resolveComponentPath(&atmosConfig, info, initialPath)
+	if err != nil {
+		return err
+	}
+
+	// Validate component metadata (abstract/locked checks).
+	if err := validateComponentMetadata(info); err != nil {
+		return err
+	}
+
+	// Resolve and install component dependencies.
+	info.ComponentEnvList, err = ensureDependencies(&atmosConfig, info)
+	if err != nil {
+		return err
+	}
+
+	// Check if the component 'settings.validation' section is specified and validate the component.
+	valid, err := e.ValidateComponent(
+		&atmosConfig,
+		info.ComponentFromArg,
+		info.ComponentSection,
+		"",
+		"",
+		nil,
+		0,
+	)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("%w: the component '%s' did not pass the validation policies",
+			errUtils.ErrInvalidComponent,
+			info.ComponentFromArg,
+		)
+	}
+
+	stackName := ConstructStackName(info)
+
+	backendURL, err := ResolveBackendURL(info.ComponentBackendSection, componentPath)
+	if err != nil {
+		return err
+	}
+
+	// Print component variables.
+	log.Debug("Variables for component in stack", "component", info.ComponentFromArg, "stack", info.Stack, "variables", info.ComponentVarsSection)
+
+	// Log context for debugging.
+	var inheritance string
+	if len(info.ComponentInheritanceChain) > 0 {
+		inheritance = info.ComponentFromArg + " -> " + strings.Join(info.ComponentInheritanceChain, " -> ")
+	}
+
+	log.Debug("Pulumi context",
+		"executable", info.Command,
+		"command", info.SubCommand,
+		"atmos component", info.ComponentFromArg,
+		"atmos stack", info.StackFromArg,
+		"pulumi component", info.BaseComponentPath,
+		"pulumi stack", stackName,
+		"pulumi backend", backendURL,
+		"working directory", componentPath,
+		"inheritance", inheritance,
+		"arguments and flags", info.AdditionalArgsAndFlags,
+	)
+
+	selectArgs := buildSelectArgs(info, stackName)
+	cmdArgs := buildCommandArgs(info, stackName)
+
+	// Convert ComponentEnvSection to ComponentEnvList.
+	e.ConvertComponentEnvSectionToList(info)
+
+	// Prepare ENV vars.
+	envVars, err := prepareEnvVars(&atmosConfig, info.ComponentEnvList, backendURL)
+	if err != nil {
+		return err
+	}
+	log.Debug("Using ENV", "variables", envVars)
+
+	// In dry-run mode, print the commands that would be executed.
+	if info.DryRun {
+		data.Writeln(strings.Join(append([]string{selectArgs.Command}, selectArgs.Args...), " "))
+		data.Writeln(strings.Join(append([]string{cmdArgs.Command}, cmdArgs.Args...), " "))
+		return nil
+	}
+
+	// Select the Pulumi stack, creating it on first use.
+	err = e.ExecuteShellCommand(
+		atmosConfig,
+		selectArgs.Command,
+		selectArgs.Args,
+		componentPath,
+		envVars,
+		info.DryRun,
+		info.RedirectStdErr,
+		e.WithEnvironment(info.SanitizedEnv),
+	)
+	if err != nil {
+		return err
+	}
+
+	// Write the component variables into the Pulumi stack config.
+	// This runs after `stack select --create` so the config written by Pulumi itself (e.g. `encryptionsalt`) is preserved.
+	if err := WriteStackConfig(componentPath, stackName, info.ComponentVarsSection, &info.ComponentSettingsSection); err != nil {
+		return err
+	}
+
+	return e.ExecuteShellCommand(
+		atmosConfig,
+		cmdArgs.Command,
+		cmdArgs.Args,
+		componentPath,
+		envVars,
+		info.DryRun,
+		info.RedirectStdErr,
+		append([]e.ShellCommandOption{e.WithEnvironment(info.SanitizedEnv)}, opts...)...,
+	)
+}
+
+// ExecuteVersion executes the pulumi version command.
+func ExecuteVersion(info *schema.ConfigAndStacksInfo) error {
+	defer perf.Track(nil, "pulumi.ExecuteVersion")()
+
+	atmosConfig, err := cfg.InitCliConfig(*info, false)
+	if err != nil {
+		return err
+	}
+
+	// Get pulumi command from config, defaulting to "pulumi".
+	command := atmosConfig.Components.Pulumi.Command
+	if command == "" {
+		command = "pulumi"
+	}
+
+	// Execute pulumi version directly.
+	return e.ExecuteShellCommand(
+		atmosConfig,
+		command,
+		[]string{"version"},
+		"",    // dir
+		nil,   // env
+		false, // dryRun
+		"",    // redirectStdError
+	)
+}
+
+// getPulumiSetting extracts a string setting from settings.pulumi.
+func getPulumiSetting(settingsSection *schema.AtmosSectionMapType, key string) string {
+	if settingsSection == nil {
+		return ""
+	}
+
+	pulumiSection, ok := (*settingsSection)["pulumi"].(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	value, ok := pulumiSection[key].(string)
+	if !ok {
+		return ""
+	}
+
+	return value
+}
+
+// ConstructStackName returns the Pulumi stack name for a component in an Atmos stack.
+// The name is the Atmos stack name with path separators replaced by dashes. When the Atmos component
+// differs from the Pulumi project folder it points to, the component name is appended, so several Atmos
+// components sharing one project get separate Pulumi stacks. `settings.pulumi.stack` overrides the name.
+func ConstructStackName(info *schema.ConfigAndStacksInfo) string {
+	defer perf.Track(nil, "pulumi.ConstructStackName")()
+
+	if stackName := getPulumiSetting(&info.ComponentSettingsSection, "stack"); stackName != "" {
+		return stackName
+	}
+
+	stackName := sanitizeName(info.Stack)
+	if info.Component != "" && info.FinalComponent != "" && info.Component != info.FinalComponent {
+		stackName = stackName + "-" + sanitizeName(info.Component)
+	}
+
+	return stackName
+}
+
+// sanitizeName replaces path separators with dashes.
+func sanitizeName(name string) string {
+	name = strings.ReplaceAll(name, "/", "-")
+	return strings.ReplaceAll(name, string(filepath.Separator), "-")
+}
+
+// ResolveBackendURL returns the Pulumi state backend URL from the component `backend` section.
+// A relative `file://` path is resolved against the component directory. Without `backend.url`,
+// the state is kept in a local file backend in the component directory.
+func ResolveBackendURL(backendSection schema.AtmosSectionMapType, componentPath string) (string, error) {
+	defer perf.Track(nil, "pulumi.ResolveBackendURL")()
+
+	url := ""
+	if backendSection != nil {
+		if value, ok := backendSection["url"]; ok && value != nil {
+			s, isString := value.(string)
+			if !isString {
+				return "", fmt.Errorf("%w: backend.url must be a string", errUtils.ErrInvalidPulumiBackend)
+			}
+			url = s
+		}
+	}
+
+	if url == "" {
+		return fileBackendScheme + filepath.ToSlash(componentPath), nil
+	}
+
+	if !strings.HasPrefix(url, fileBackendScheme) {
+		return url, nil
+	}
+
+	path := strings.TrimPrefix(url, fileBackendScheme)
+	if path == "" || path == "." {
+		return fileBackendScheme + filepath.ToSlash(componentPath), nil
+	}
+	if filepath.IsAbs(path) || strings.HasPrefix(path, "/") || strings.HasPrefix(path, "~") {
+		return url, nil
+	}
+
+	return fileBackendScheme + filepath.ToSlash(filepath.Join(componentPath, path)), nil
+}
+
+// ResolveProjectName returns the Pulumi project name used to namespace stack config keys.
+// `settings.pulumi.project` takes precedence over the `name` in the project file of the component.
+func ResolveProjectName(componentPath string, settingsSection *schema.AtmosSectionMapType) (string, error) {
+	defer perf.Track(nil, "pulumi.ResolveProjectName")()
+
+	if project := getPulumiSetting(settingsSection, "project"); project != "" {
+		return project, nil
+	}
+
+	for _, name := range projectFileNames {
+		content, err := os.ReadFile(filepath.Join(componentPath, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return "", errors.Join(errUtils.ErrFileOperation, fmt.Errorf("failed to read %s: %w", name, err))
+		}
+
+		var project struct {
+			Name string `yaml:"name"`
+		}
+		if err := yaml.Unmarshal(content, &project); err != nil {
+			return "", errors.Join(errUtils.ErrFileOperation, fmt.Errorf("failed to parse %s: %w", name, err))
+		}
+		if project.Name != "" {
+			return project.Name, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: set `name` in Pulumi.yaml in '%s' or `settings.pulumi.project`",
+		errUtils.ErrPulumiProjectMissing,
+		componentPath,
+	)
+}
+
+// StackConfigFileName returns the name of the Pulumi stack config file.
+func StackConfigFileName(stackName string) string {
+	defer perf.Track(nil, "pulumi.StackConfigFileName")()
+
+	return fmt.Sprintf("Pulumi.%s.yaml", stackName)
+}
+
+// WriteStackConfig merges the component variables into the `config` section of the Pulumi stack config file.
+// Keys without a namespace are prefixed with the project name (`<project>:<key>`); keys that already
+// contain a namespace (e.g. `aws:region`) are written as is. Other keys in the file are preserved.
+func WriteStackConfig(
+	componentPath string,
+	stackName string,
+	vars schema.AtmosSectionMapType,
+	settingsSection *schema.AtmosSectionMapType,
+) error {
+	defer perf.Track(nil, "pulumi.WriteStackConfig")()
+
+	filePath := filepath.Join(componentPath, StackConfigFileName(stackName))
+
+	stackConfig := map[string]any{}
+	content, err := os.ReadFile(filePath)
+	switch {
+	case err == nil:
+		if err := yaml.Unmarshal(content, &stackConfig); err != nil {
+			return errors.Join(errUtils.ErrPulumiStackConfig, fmt.Errorf("failed to parse %s: %w", filePath, err))
+		}
+		if stackConfig == nil {
+			stackConfig = map[string]any{}
+		}
+	case !os.IsNotExist(err):
+		return errors.Join(errUtils.ErrPulumiStackConfig, err)
+	}
+
+	if len(vars) == 0 {
+		return nil
+	}
+
+	config, ok := stackConfig["config"].(map[string]any)
+	if !ok {
+		config = map[string]any{}
+	}
+
+	var project string
+	for key, value := range vars {
+		if !strings.Contains(key, ":") {
+			if project == "" {
+				project, err = ResolveProjectName(componentPath, settingsSection)
+				if err != nil {
+					return err
+				}
+			}
+			key = project + ":" + key
+		}
+		config[key] = value
+	}
+	stackConfig["config"] = config
+
+	log.Debug("Writing the Pulumi stack config", "file", filePath)
+
+	if err := u.WriteToFileAsYAML(filePath, stackConfig, 0o644); err != nil {
+		return errors.Join(errUtils.ErrPulumiStackConfig, fmt.Errorf("%s: %w", filePath, err))
+	}
+
+	return nil
+}
+
+// getGenerateSectionFromComponent extracts the generate section from a component configuration.
+// Returns nil if the component has no generate section defined.
+func getGenerateSectionFromComponent(componentSection map[string]any) map[string]any {
+	if componentSection == nil {
+		return nil
+	}
+
+	generateSection, ok := componentSection["generate"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	return generateSection
+}
+
+// maybeAutoGenerateFiles conditionally generates files for a component before path validation.
+// It generates files when:
+//   - auto_generate_files is enabled in the Pulumi configuration.
+//   - the component has a generate section.
+//   - not in dry-run mode (to avoid filesystem modifications).
+//
+// Returns nil if generation is skipped or succeeds, error otherwise.
+func maybeAutoGenerateFiles(
+	atmosConfig *schema.AtmosConfiguration,
+	info *schema.ConfigAndStacksInfo,
+	componentPath string,
+) error {
+	defer perf.Track(atmosConfig, "pulumi.maybeAutoGenerateFiles")()
+
+	// Skip if auto-generation is disabled or in dry-run mode.
+	if !atmosConfig.Components.Pulumi.AutoGenerateFiles || info.DryRun {
+		return nil
+	}
+
+	// Skip if component has no generate section.
+	generateSection := getGenerateSectionFromComponent(info.ComponentSection)
+	if generateSection == nil {
+		return nil
+	}
+
+	// Ensure component directory exists for file generation.
+	if mkdirErr := os.MkdirAll(componentPath, 0o755); mkdirErr != nil { //nolint:revive
+		return errors.Join(errUtils.ErrCreateDirectory, fmt.Errorf("auto-generation: %w", mkdirErr))
+	}
+
+	// Generate files before path validation.
+	if genErr := e.GenerateFilesForComponent(atmosConfig, info, componentPath); genErr != nil {
+		return errors.Join(errUtils.ErrFileOperation, genErr)
+	}
+
+	return nil
+}
+
+// resolveComponentPath resolves the component path, handling JIT source provisioning if needed.
+// It returns the resolved component path or an error if the component cannot be found.
+func resolveComponentPath(
+	atmosConfig *schema.AtmosConfiguration,
+	info *schema.ConfigAndStacksInfo,
+	initialPath string,
+) (string, error) {
+	defer perf.Track(atmosConfig, "pulumi.resolveComponentPath")()
+
+	componentPath := initialPath
+	componentPathExists, err := u.IsDirectory(componentPath)
+
+	// If path exists, return it directly.
+	if err == nil && componentPathExists {
+		return componentPath, nil
+	}
+
+	// Check if component has source configured for JIT provisioning.
+	if provSource.HasSource(info.ComponentSection) {
+		// Run JIT source provisioning before path validation.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer cancel()
+
+		if provErr := provSource.AutoProvisionSource(ctx, atmosConfig, cfg.PulumiComponentType, info.ComponentSection, info.AuthContext); provErr != nil {
+			return "", errors.Join(errUtils.ErrProvisionerFailed, fmt.Errorf("auto-provision source: %w", provErr))
+		}
+
+		// Check if source provisioner set a workdir path (source + workdir case).
+		if workdirPath, ok := info.ComponentSection[provWorkdir.WorkdirPathKey].(string); ok {
+			return workdirPath, nil
+		}
+
+		// Re-check if component path now exists after provisioning (source only case).
+		componentPathExists, err = u.IsDirectory(componentPath)
+		if err == nil && componentPathExists {
+			return componentPath, nil
+		}
+	}
+
+	// If still doesn't exist, return the error.
+	basePath, basePathErr := u.GetComponentBasePath(atmosConfig, "pulumi")
+	if basePathErr != nil {
+		return "", fmt.Errorf("%w: '%s' points to the Pulumi component '%s', but failed to resolve base path: %v",
+			errUtils.ErrInvalidComponent,
+			info.ComponentFromArg,
+			info.FinalComponent,
+			basePathErr,
+		)
+	}
+	return "", fmt.Errorf("%w: '%s' points to the Pulumi component '%s', but it does not exist in '%s'",
+		errUtils.ErrInvalidComponent,
+		info.ComponentFromArg,
+		info.FinalComponent,
+		basePath,
+	)
+}
+
+// isMutatingCommand reports whether a Pulumi subcommand changes infrastructure or state.
+func isMutatingCommand(subCommand string) bool {
+	switch subCommand {
+	case "up", "destroy", "refresh":
+		return true
+	default:
+		return false
+	}
+}
+
+// validateComponentMetadata checks if the component can be provisioned based on metadata.
+// Returns an error if the component is abstract or locked and the subcommand changes infrastructure.
+func validateComponentMetadata(info *schema.ConfigAndStacksInfo) error {
+	if !isMutatingCommand(info.SubCommand) {
+		return nil
+	}
+
+	// Check if the component is allowed to be provisioned (`metadata.type` attribute).
+	if info.ComponentIsAbstract {
+		return fmt.Errorf("%w: the component '%s' cannot be provisioned because it's marked as abstract (metadata.type: abstract)",
+			errUtils.ErrAbstractComponentCantBeProvisioned,
+			filepath.Join(info.ComponentFolderPrefix, info.Component))
+	}
+
+	// Check if the component is locked (`metadata.locked` is set to true).
+	if info.ComponentIsLocked {
+		return fmt.Errorf("%w: component '%s' cannot be modified (metadata.locked: true)",
+			errUtils.ErrLockedComponentCantBeProvisioned,
+			filepath.Join(info.ComponentFolderPrefix, info.Component))
+	}
+
+	return nil
+}
+
+// ensureDependencies resolves and installs component dependencies, returning the updated environment list.
+// If dependencies are found, it installs them and adds the toolchain PATH to the environment.
+func ensureDependencies(
+	atmosConfig *schema.AtmosConfiguration,
+	info *schema.ConfigAndStacksInfo,
+) ([]string, error) {
+	defer perf.Track(atmosConfig, "pulumi.ensureDependencies")()
+
+	resolver := dependencies.NewResolver(atmosConfig)
+	deps, err := resolver.ResolveComponentDependencies("pulumi", info.StackSection, info.ComponentSection)
+	if err != nil {
+		return nil, errors.Join(errUtils.ErrDependencyResolution, err)
+	}
+
+	envList := info.ComponentEnvList
+
+	if len(deps) > 0 {
+		log.Debug("Installing component dependencies", "component", info.ComponentFromArg, "stack", info.Stack, "tools", deps)
+		installer := dependencies.NewInstaller(atmosConfig)
+		if err := installer.EnsureTools(deps); err != nil {
+			return nil, errors.Join(errUtils.ErrDependencyResolution, fmt.Errorf("install dependencies: %w", err))
+		}
+
+		// Build PATH with toolchain binaries and add to component environment.
+		// This does NOT modify the global process environment - only the subprocess environment.
+		toolchainPATH, err := dependencies.BuildToolchainPATH(atmosConfig, deps)
+		if err != nil {
+			return nil, errors.Join(errUtils.ErrPathResolution, fmt.Errorf("toolchain PATH: %w", err))
+		}
+
+		// Propagate toolchain PATH into environment for subprocess.
+		envList = append(envList, fmt.Sprintf("PATH=%s", toolchainPATH))
+	}
+
+	return envList, nil
+}
+
+// CommandArgs holds the command and arguments for execution.
+type CommandArgs struct {
+	Command string
+	Args    []string
+}
+
+// buildSelectArgs builds the `pulumi stack select --create` command that selects the Pulumi stack.
+// The secrets provider from `settings.pulumi.secrets_provider` is used when the stack is created.
+func buildSelectArgs(info *schema.ConfigAndStacksInfo, stackName string) *CommandArgs {
+	defer perf.Track(nil, "pulumi.buildSelectArgs")()
+
+	args := []string{"stack", "select", "--create", stackName, "--non-interactive"}
+	if secretsProvider := getPulumiSetting(&info.ComponentSettingsSection, "secrets_provider"); secretsProvider != "" {
+		args = append(args, "--secrets-provider", secretsProvider)
+	}
+
+	return &CommandArgs{Command: info.Command, Args: args}
+}
+
+// buildCommandArgs builds the Pulumi command for the subcommand, targeting the selected stack.
+func buildCommandArgs(info *schema.ConfigAndStacksInfo, stackName string) *CommandArgs {
+	defer perf.Track(nil, "pulumi.buildCommandArgs")()
+
+	args := []string{info.SubCommand, "--stack", stackName}
+	args = append(args, info.AdditionalArgsAndFlags...)
+
+	return &CommandArgs{Command: info.Command, Args: args}
+}
+
+// prepareEnvVars prepares the environment variables for command execution.
+// `PULUMI_BACKEND_URL` is only set when the component `env` section does not already define it.
+func prepareEnvVars(atmosConfig *schema.AtmosConfiguration, envList []string, backendURL string) ([]string, error) {
+	defer perf.Track(atmosConfig, "pulumi.prepareEnvVars")()
+
+	envVars := append(envList, fmt.Sprintf("ATMOS_CLI_CONFIG_PATH=%s", atmosConfig.CliConfigPath))
+
+	basePath, err := filepath.Abs(atmosConfig.BasePath)
+	if err != nil {
+		return nil, errors.Join(errUtils.ErrPathResolution, fmt.Errorf("failed to resolve base path: %w", err))
+	}
+
+	envVars = append(envVars, fmt.Sprintf("ATMOS_BASE_PATH=%s", basePath))
+
+	hasBackendURL := false
+	for _, v := range envList {
+		if strings.HasPrefix(v, BackendURLEnvVar+"=") {
+			hasBackendURL = true
+			break
+		}
+	}
+	if !hasBackendURL {
+		envVars = append(envVars, fmt.Sprintf("%s=%s", BackendURLEnvVar, backendURL))
+	}
+
+	return envVars, nil
+}
@@ -0,0 +1,265 @@
+package pulumi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.yaml.in/yaml/v3"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestCheckConfig(t *testing.T) {
+	err := checkConfig(&schema.AtmosConfiguration{})
+	assert.ErrorIs(t, err, errUtils.ErrMissingPulumiBasePath)
+
+	err = checkConfig(&schema.AtmosConfiguration{
+		Components: schema.Components{Pulumi: schema.Pulumi{BasePath: "components/pulumi"}},
+	})
+	assert.NoError(t, err)
+}
+
+func TestConstructStackName(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     schema.ConfigAndStacksInfo
+		expected string
+	}{
+		{
+			name:     "stack name",
+			info:     schema.ConfigAndStacksInfo{Stack: "plat-ue2-dev", Component: "website", FinalComponent: "website"},
+			expected: "plat-ue2-dev",
+		},
+		{
+			name:     "path separators are replaced",
+			info:     schema.ConfigAndStacksInfo{Stack: "orgs/acme/dev", Component: "website", FinalComponent: "website"},
+			expected: "orgs-acme-dev",
+		},
+		{
+			name:     "derived component is appended",
+			info:     schema.ConfigAndStacksInfo{Stack: "dev", Component: "website/blue", FinalComponent: "website"},
+			expected: "dev-website-blue",
+		},
+		{
+			name: "settings.pulumi.stack overrides",
+			info: schema.ConfigAndStacksInfo{
+				Stack:                    "dev",
+				Component:                "website/blue",
+				FinalComponent:           "website",
+				ComponentSettingsSection: schema.AtmosSectionMapType{"pulumi": map[string]any{"stack": "acme/website/dev"}},
+			},
+			expected: "acme/website/dev",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ConstructStackName(&tt.info))
+		})
+	}
+}
+
+func TestResolveBackendURL(t *testing.T) {
+	componentPath := filepath.Join(t.TempDir(), "website")
+
+	tests := []struct {
+		name     string
+		backend  schema.AtmosSectionMapType
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "default local backend",
+			backend:  nil,
+			expected: "file://" + filepath.ToSlash(componentPath),
+		},
+		{
+			name:     "relative file backend",
+			backend:  schema.AtmosSectionMapType{"url": "file://state"},
+			expected: "file://" + filepath.ToSlash(filepath.Join(componentPath, "state")),
+		},
+		{
+			name:     "home file backend",
+			backend:  schema.AtmosSectionMapType{"url": "file://~"},
+			expected: "file://~",
+		},
+		{
+			name:     "absolute file backend",
+			backend:  schema.AtmosSectionMapType{"url": "file:///var/pulumi"},
+			expected: "file:///var/pulumi",
+		},
+		{
+			name:     "cloud backend",
+			backend:  schema.AtmosSectionMapType{"url": "s3://acme-pulumi-state"},
+			expected: "s3://acme-pulumi-state",
+		},
+		{
+			name:    "invalid url",
+			backend: schema.AtmosSectionMapType{"url": 42},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, err := ResolveBackendURL(tt.backend, componentPath)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errUtils.ErrInvalidPulumiBackend)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, url)
+		})
+	}
+}
+
+func TestResolveProjectName(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := ResolveProjectName(dir, nil)
+	assert.ErrorIs(t, err, errUtils.ErrPulumiProjectMissing)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Pulumi.yaml"), []byte("name: website\nruntime: go\n"), 0o644))
+	project, err := ResolveProjectName(dir, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "website", project)
+
+	settings := schema.AtmosSectionMapType{"pulumi": map[string]any{"project": "override"}}
+	project, err = ResolveProjectName(dir, &settings)
+	require.NoError(t, err)
+	assert.Equal(t, "override", project)
+}
+
+func TestWriteStackConfig(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "Pulumi.yaml"), []byte("name: website\n"), 0o644))
+
+	// Existing keys written by Pulumi must survive.
+	existing := "encryptionsalt: v1:abc\nconfig:\n  website:stale: keep\n  website:name: old\n"
+	filePath := filepath.Join(dir, StackConfigFileName("dev"))
+	require.NoError(t, os.WriteFile(filePath, []byte(existing), 0o644))
+
+	vars := schema.AtmosSectionMapType{
+		"name":       "new",
+		"aws:region": "us-east-2",
+		"tags":       map[string]any{"team": "web"},
+	}
+	require.NoError(t, WriteStackConfig(dir, "dev", vars, nil))
+
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+
+	var stackConfig map[string]any
+	require.NoError(t, yaml.Unmarshal(content, &stackConfig))
+
+	assert.Equal(t, "v1:abc", stackConfig["encryptionsalt"])
+	assert.Equal(t, map[string]any{
+		"website:stale": "keep",
+		"website:name":  "new",
+		"aws:region":    "us-east-2",
+		"website:tags":  map[string]any{"team": "web"},
+	}, stackConfig["config"])
+}
+
+func TestWriteStackConfig_MissingProject(t *testing.T) {
+	dir := t.TempDir()
+
+	// Namespaced keys don't need a project name.
+	require.NoError(t, WriteStackConfig(dir, "dev", schema.AtmosSectionMapType{"aws:region": "us-east-2"}, nil))
+
+	err := WriteStackConfig(dir, "dev", schema.AtmosSectionMapType{"name": "website"}, nil)
+	assert.ErrorIs(t, err, errUtils.ErrPulumiProjectMissing)
+}
+
+func TestWriteStackConfig_NoVars(t *testing.T) {
+	dir := t.TempDir()
+
+	require.NoError(t, WriteStackConfig(dir, "dev", nil, nil))
+	assert.NoFileExists(t, filepath.Join(dir, StackConfigFileName("dev")))
+}
+
+func TestValidateComponentMetadata(t *testing.T) {
+	tests := []struct {
+		name    string
+		info    schema.ConfigAndStacksInfo
+		wantErr error
+	}{
+		{
+			name: "abstract component can be previewed",
+			info: schema.ConfigAndStacksInfo{SubCommand: "preview", ComponentIsAbstract: true},
+		},
+		{
+			name:    "abstract component can't be deployed",
+			info:    schema.ConfigAndStacksInfo{SubCommand: "up", ComponentIsAbstract: true},
+			wantErr: errUtils.ErrAbstractComponentCantBeProvisioned,
+		},
+		{
+			name:    "locked component can't be destroyed",
+			info:    schema.ConfigAndStacksInfo{SubCommand: "destroy", ComponentIsLocked: true},
+			wantErr: errUtils.ErrLockedComponentCantBeProvisioned,
+		},
+		{
+			name:    "locked component can't be refreshed",
+			info:    schema.ConfigAndStacksInfo{SubCommand: "refresh", ComponentIsLocked: true},
+			wantErr: errUtils.ErrLockedComponentCantBeProvisioned,
+		},
+		{
+			name: "regular component",
+			info: schema.ConfigAndStacksInfo{SubCommand: "up"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateComponentMetadata(&tt.info)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestBuildSelectArgs(t *testing.T) {
+	info := &schema.ConfigAndStacksInfo{Command: "pulumi"}
+	args := buildSelectArgs(info, "dev")
+	assert.Equal(t, "pulumi", args.Command)
+	assert.Equal(t, []string{"stack", "select", "--create", "dev", "--non-interactive"}, args.Args)
+
+	info.ComponentSettingsSection = schema.AtmosSectionMapType{"pulumi": map[string]any{"secrets_provider": "passphrase"}}
+	args = buildSelectArgs(info, "dev")
+	assert.Equal(t, []string{"stack", "select", "--create", "dev", "--non-interactive", "--secrets-provider", "passphrase"}, args.Args)
+}
+
+func TestBuildCommandArgs(t *testing.T) {
+	info := &schema.ConfigAndStacksInfo{
+		Command:                "pulumi",
+		SubCommand:             "up",
+		AdditionalArgsAndFlags: []string{"--yes", "--diff"},
+	}
+
+	args := buildCommandArgs(info, "plat-ue2-dev")
+	assert.Equal(t, "pulumi", args.Command)
+	assert.Equal(t, []string{"up", "--stack", "plat-ue2-dev", "--yes", "--diff"}, args.Args)
+}
+
+func TestPrepareEnvVars(t *testing.T) {
+	atmosConfig := &schema.AtmosConfiguration{BasePath: ".", CliConfigPath: "/etc/atmos"}
+
+	envVars, err := prepareEnvVars(atmosConfig, []string{"FOO=bar"}, "file:///tmp/state")
+	require.NoError(t, err)
+	assert.Contains(t, envVars, "FOO=bar")
+	assert.Contains(t, envVars, "ATMOS_CLI_CONFIG_PATH=/etc/atmos")
+	assert.Contains(t, envVars, "PULUMI_BACKEND_URL=file:///tmp/state")
+
+	// A backend URL in the component env section takes precedence.
+	envVars, err = prepareEnvVars(atmosConfig, []string{"PULUMI_BACKEND_URL=s3://bucket"}, "file:///tmp/state")
+	require.NoError(t, err)
+	assert.Contains(t, envVars, "PULUMI_BACKEND_URL=s3://bucket")
+	assert.NotContains(t, envVars, "PULUMI_BACKEND_URL=file:///tmp/state")
+}
@@ -0,0 +1,150 @@
+package pulumi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	e "github.com/cloudposse/atmos/internal/exec"
+	"github.com/cloudposse/atmos/pkg/component"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// PulumiComponentProvider implements ComponentProvider for Pulumi components.
+// It maps Atmos stack configuration onto Pulumi stacks: `vars` become Pulumi stack config,
+// `backend` selects the Pulumi state backend, and the Atmos stack name selects the Pulumi stack.
+type PulumiComponentProvider struct{}
+
+func init() {
+	defer perf.Track(nil, "pulumi.init")()
+
+	// Self-register with the component registry.
+	if err := component.Register(&PulumiComponentProvider{}); err != nil {
+		panic(fmt.Sprintf("failed to register pulumi component provider: %v", err))
+	}
+}
+
+// GetType returns the component type identifier.
+func (p *PulumiComponentProvider) GetType() string {
+	defer perf.Track(nil, "pulumi.GetType")()
+
+	return "pulumi"
+}
+
+// GetGroup returns the component group for categorization.
+func (p *PulumiComponentProvider) GetGroup() string {
+	defer perf.Track(nil, "pulumi.GetGroup")()
+
+	return "Infrastructure as Code"
+}
+
+// GetBasePath returns the base directory path for this component type.
+func (p *PulumiComponentProvider) GetBasePath(atmosConfig *schema.AtmosConfiguration) string {
+	defer perf.Track(atmosConfig, "pulumi.GetBasePath")()
+
+	if atmosConfig == nil {
+		return DefaultConfig().BasePath
+	}
+
+	// Use the built-in Pulumi configuration from schema.
+	if atmosConfig.Components.Pulumi.BasePath != "" {
+		return atmosConfig.Components.Pulumi.BasePath
+	}
+
+	return DefaultConfig().BasePath
+}
+
+// ListComponents discovers all pulumi components in a stack.
+func (p *PulumiComponentProvider) ListComponents(ctx context.Context, stack string, stackConfig map[string]any) ([]string, error) {
+	defer perf.Track(nil, "pulumi.ListComponents")()
+
+	componentsSection, ok := stackConfig["components"].(map[string]any)
+	if !ok {
+		return []string{}, nil
+	}
+
+	pulumiComponents, ok := componentsSection["pulumi"].(map[string]any)
+	if !ok {
+		return []string{}, nil
+	}
+
+	componentNames := make([]string, 0, len(pulumiComponents))
+	for name := range pulumiComponents {
+		componentNames = append(componentNames, name)
+	}
+
+	sort.Strings(componentNames)
+	return componentNames, nil
+}
+
+// ValidateComponent validates pulumi component configuration.
+func (p *PulumiComponentProvider) ValidateComponent(config map[string]any) error {
+	defer perf.Track(nil, "pulumi.ValidateComponent")()
+
+	if config == nil {
+		return nil
+	}
+
+	// Validate metadata section if present.
+	if metadata, ok := config["metadata"].(map[string]any); ok {
+		// Abstract components are valid but cannot be executed.
+		if componentType, ok := metadata["type"].(string); ok && componentType == "abstract" {
+			return nil
+		}
+	}
+
+	// Validate backend.url if present.
+	if backend, ok := config["backend"].(map[string]any); ok {
+		if url, ok := backend["url"]; ok {
+			if _, isString := url.(string); !isString && url != nil {
+				return fmt.Errorf("%w: backend.url must be a string", errUtils.ErrComponentValidationFailed)
+			}
+		}
+	}
+
+	// Validate settings.pulumi section if present.
+	if settings, ok := config["settings"].(map[string]any); ok {
+		if pulumi, ok := settings["pulumi"].(map[string]any); ok {
+			for _, key := range []string{"project", "stack", "secrets_provider"} {
+				if value, ok := pulumi[key]; ok {
+					if _, isString := value.(string); !isString && value != nil {
+						return fmt.Errorf("%w: settings.pulumi.%s must be a string", errUtils.ErrComponentValidationFailed, key)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Execute runs a command for pulumi components.
+// Delegates to the appropriate executor function based on the subcommand.
+func (p *PulumiComponentProvider) Execute(ctx *component.ExecutionContext) error {
+	defer perf.Track(ctx.AtmosConfig, "pulumi.Execute")()
+
+	if ctx.SubCommand == "version" {
+		return ExecuteVersion(&ctx.ConfigAndStacksInfo)
+	}
+
+	return ExecuteCommand(&ctx.ConfigAndStacksInfo, e.WithOutputCapture(ctx.OutputCapture))
+}
+
+// GenerateArtifacts creates necessary files for pulumi component execution.
+// For Pulumi, this is the `Pulumi.<stack>.yaml` stack config file.
+func (p *PulumiComponentProvider) GenerateArtifacts(ctx *component.ExecutionContext) error {
+	defer perf.Track(ctx.AtmosConfig, "pulumi.GenerateArtifacts")()
+
+	// The stack config file is written within ExecuteCommand after the Pulumi stack is selected.
+	// This method exists to satisfy the ComponentProvider interface.
+	return nil
+}
+
+// GetAvailableCommands returns list of commands this component type supports.
+func (p *PulumiComponentProvider) GetAvailableCommands() []string {
+	defer perf.Track(nil, "pulumi.GetAvailableCommands")()
+
+	return []string{"preview", "up", "destroy", "refresh", "version"}
+}
@@ -508,6 +508,22 @@ func AtmosConfigAbsolutePaths(atmosConfig *schema.AtmosConfiguration) error {
 	}
 	atmosConfig.AnsibleDirAbsolutePath = ansibleDirAbsPath
 
+	// Convert Pulumi dir to an absolute path.
+	pulumiBasePath := u.JoinPath(atmosBasePathAbs, atmosConfig.Components.Pulumi.BasePath)
+	pulumiDirAbsPath, err := filepath.Abs(pulumiBasePath)
+	if err != nil {
+		return err
+	}
+	atmosConfig.PulumiDirAbsolutePath = pulumiDirAbsPath
+
+	// Convert Kustomize dir to an absolute path.
+	kustomizeBasePath := u.JoinPath(atmosBasePathAbs, atmosConfig.Components.Kustomize.BasePath)
+	kustomizeDirAbsPath, err := filepath.Abs(kustomizeBasePath)
+	if err != nil {
+		return err
+	}
+	atmosConfig.KustomizeDirAbsolutePath = kustomizeDirAbsPath
+
 	return nil
 }
 
@@ -76,6 +76,8 @@ const (
 	HelmfileComponentType  = "helmfile"
 	PackerComponentType    = "packer"
 	AnsibleComponentType   = "ansible"
+	PulumiComponentType    = "pulumi"
+	KustomizeComponentType = "kustomize"
 
 	ComponentVendorConfigFileName = "component.yaml"
 	AtmosVendorConfigFileName     = "vendor"
@@ -106,6 +108,8 @@ const (
 	AnsibleSectionName                = "ansible"
 	AnsiblePlaybookSectionName        = "playbook"
 	AnsibleInventorySectionName       = "inventory"
+	PulumiSectionName                 = "pulumi"
+	KustomizeSectionName              = "kustomize"
 	WorkspaceSectionName              = "workspace"
 	AuthSectionName                   = "auth"
 	GenerateSectionName               = "generate"
@@ -126,6 +130,11 @@ const (
 	BackendTypeAzurerm                = "azurerm"
 	BackendTypeGCS                    = "gcs"
 	BackendTypeCloud                  = "cloud"
+	BackendTypeRemote                 = "remote"
+	BackendTypeHTTP                   = "http"
+	BackendTypePg                     = "pg"
+	BackendTypeConsul                 = "consul"
+	BackendTypeKubernetes             = "kubernetes"
 	ComponentPathSectionName          = "component_path"
 	InheritsSectionName               = "inherits"
 	AbstractSectionName               = "abstract"
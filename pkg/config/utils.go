@@ -362,6 +362,30 @@ func processEnvVars(atmosConfig *schema.AtmosConfiguration) error {
 		atmosConfig.Components.Packer.BasePath = componentsPackerBasePath
 	}
 
+	componentsPulumiCommand := os.Getenv("ATMOS_COMPONENTS_PULUMI_COMMAND")
+	if len(componentsPulumiCommand) > 0 {
+		log.Debug(foundEnvVarMessage, "ATMOS_COMPONENTS_PULUMI_COMMAND", componentsPulumiCommand)
+		atmosConfig.Components.Pulumi.Command = componentsPulumiCommand
+	}
+
+	componentsPulumiBasePath := os.Getenv("ATMOS_COMPONENTS_PULUMI_BASE_PATH")
+	if len(componentsPulumiBasePath) > 0 {
+		log.Debug(foundEnvVarMessage, "ATMOS_COMPONENTS_PULUMI_BASE_PATH", componentsPulumiBasePath)
+		atmosConfig.Components.Pulumi.BasePath = componentsPulumiBasePath
+	}
+
+	componentsKustomizeCommand := os.Getenv("ATMOS_COMPONENTS_KUSTOMIZE_COMMAND")
+	if len(componentsKustomizeCommand) > 0 {
+		log.Debug(foundEnvVarMessage, "ATMOS_COMPONENTS_KUSTOMIZE_COMMAND", componentsKustomizeCommand)
+		atmosConfig.Components.Kustomize.Command = componentsKustomizeCommand
+	}
+
+	componentsKustomizeBasePath := os.Getenv("ATMOS_COMPONENTS_KUSTOMIZE_BASE_PATH")
+	if len(componentsKustomizeBasePath) > 0 {
+		log.Debug(foundEnvVarMessage, "ATMOS_COMPONENTS_KUSTOMIZE_BASE_PATH", componentsKustomizeBasePath)
+		atmosConfig.Components.Kustomize.BasePath = componentsKustomizeBasePath
+	}
+
 	workflowsBasePath := os.Getenv("ATMOS_WORKFLOWS_BASE_PATH")
 	if len(workflowsBasePath) > 0 {
 		log.Debug(foundEnvVarMessage, "ATMOS_WORKFLOWS_BASE_PATH", workflowsBasePath)
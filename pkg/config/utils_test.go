@@ -238,6 +238,38 @@ func Test_processEnvVars(t *testing.T) {
 			},
 			expectError: false,
 		},
+		{
+			name: "test pulumi config env vars",
+			envVars: map[string]string{
+				"ATMOS_COMPONENTS_PULUMI_COMMAND":   "/path/to/pulumi",
+				"ATMOS_COMPONENTS_PULUMI_BASE_PATH": "/pulumi/base/path",
+			},
+			expectedConfig: schema.AtmosConfiguration{
+				Components: schema.Components{
+					Pulumi: schema.Pulumi{
+						Command:  "/path/to/pulumi",
+						BasePath: "/pulumi/base/path",
+					},
+				},
+			},
+			expectError: false,
+		},
+		{
+			name: "test kustomize config env vars",
+			envVars: map[string]string{
+				"ATMOS_COMPONENTS_KUSTOMIZE_COMMAND":   "/path/to/kubectl",
+				"ATMOS_COMPONENTS_KUSTOMIZE_BASE_PATH": "/kustomize/base/path",
+			},
+			expectedConfig: schema.AtmosConfiguration{
+				Components: schema.Components{
+					Kustomize: schema.Kustomize{
+						Command:  "/path/to/kubectl",
+						BasePath: "/kustomize/base/path",
+					},
+				},
+			},
+			expectError: false,
+		},
 		{
 			name: "test workflows config env var",
 			envVars: map[string]string{
@@ -3,7 +3,9 @@ package downloader
 import (
 	"net/url"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 
 	log "github.com/cloudposse/atmos/pkg/logger"
 	"github.com/cloudposse/atmos/pkg/schema"
@@ -16,6 +18,8 @@ type CustomGitGetter struct {
 	getter.GitGetter
 	// RetryConfig specifies retry behavior for git operations.
 	RetryConfig *schema.RetryConfig
+	// OnCommit is called with the commit SHA checked out in the cloned repository, if set.
+	OnCommit func(commit string)
 }
 
 // Get implements the custom getter logic removing symlinks.
@@ -24,10 +28,26 @@ func (c *CustomGitGetter) Get(dst string, url *url.URL) error {
 	if err := c.GetCustom(dst, url); err != nil {
 		return err
 	}
+	// Read the commit before go-getter copies a subdirectory out of the clone.
+	if c.OnCommit != nil {
+		c.OnCommit(c.headCommit(dst))
+	}
 	// Remove symlinks
 	return removeSymlinks(dst)
 }
 
+// headCommit returns the commit SHA checked out in the repository at dir, or "" if it can't be read.
+func (c *CustomGitGetter) headCommit(dir string) string {
+	cmd := exec.CommandContext(c.Context(), gitCommand, "rev-parse", "HEAD")
+	cmd.Dir = dir
+	out, err := cmd.Output()
+	if err != nil {
+		log.Warn("Failed to read the Git commit of the cloned repository", "error", err)
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
+
 // removeSymlinks walks the directory and removes any symlinks it encounters.
 func removeSymlinks(root string) error {
 	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
@@ -0,0 +1,93 @@
+package downloader
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/hashicorp/go-getter"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// ListGitTags returns the tag names of the Git repository that a go-getter source points to.
+// Returns nil for sources that are not Git repositories.
+func ListGitTags(ctx context.Context, atmosConfig *schema.AtmosConfiguration, src string) ([]string, error) {
+	defer perf.Track(atmosConfig, "downloader.ListGitTags")()
+
+	repoURL, _, ok, err := detectGitSource(atmosConfig, src)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	// #nosec G204 -- The URL is parsed by go-getter and we use the "--" separator to prevent option injection.
+	cmd := exec.CommandContext(ctx, gitCommand, "ls-remote", "--tags", "--refs", gitArgSeparator, repoURL.String())
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	out, err := cmd.Output()
+	if err != nil {
+		masked, _ := maskBasicAuth(repoURL.String())
+		return nil, errUtils.Build(errUtils.ErrListGitTags).
+			WithCause(err).
+			WithExplanationf("Failed to list tags of '%s'", masked).
+			Err()
+	}
+	return parseLsRemoteTags(string(out)), nil
+}
+
+// parseLsRemoteTags returns the tag names from `git ls-remote --tags --refs` output.
+func parseLsRemoteTags(output string) []string {
+	var tags []string
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.HasPrefix(fields[1], "refs/tags/") {
+			tags = append(tags, strings.TrimPrefix(fields[1], "refs/tags/"))
+		}
+	}
+	return tags
+}
+
+// detectGitSource runs go-getter detection on src and returns the repository URL (without subdirectory
+// and go-getter query parameters) and the `ref`. ok is false when the source is not a Git repository.
+func detectGitSource(atmosConfig *schema.AtmosConfiguration, src string) (*url.URL, string, bool, error) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		return nil, "", false, err
+	}
+
+	detectors := append([]getter.Detector{NewCustomGitDetector(atmosConfig, src)}, getter.Detectors...)
+	detected, err := getter.Detect(src, pwd, detectors)
+	if err != nil {
+		return nil, "", false, err
+	}
+	if !strings.HasPrefix(detected, GitPrefix) {
+		return nil, "", false, nil
+	}
+
+	repo, _ := getter.SourceDirSubdir(strings.TrimPrefix(detected, GitPrefix))
+	repoURL, err := url.Parse(repo)
+	if err != nil {
+		return nil, "", false, fmt.Errorf("%w: %w", errUtils.ErrParseURL, err)
+	}
+
+	q := repoURL.Query()
+	ref := q.Get("ref")
+	if q.Get("sshkey") != "" {
+		// Inline SSH keys are only written to disk during the clone.
+		log.Debug("Skipping Git ref listing for a source with an inline SSH key")
+		return nil, "", false, nil
+	}
+	for _, param := range []string{"ref", "depth", "sshkey"} {
+		q.Del(param)
+	}
+	repoURL.RawQuery = q.Encode()
+
+	return repoURL, ref, true, nil
+}
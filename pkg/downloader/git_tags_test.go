@@ -0,0 +1,102 @@
+package downloader
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestParseLsRemoteTags(t *testing.T) {
+	output := strings.Join([]string{
+		"3333333333333333333333333333333333333333\trefs/tags/v1.0.0",
+		"5555555555555555555555555555555555555555\trefs/tags/v2.0.0",
+		"2222222222222222222222222222222222222222\trefs/heads/main",
+		"",
+	}, "\n")
+
+	assert.Equal(t, []string{"v1.0.0", "v2.0.0"}, parseLsRemoteTags(output))
+	assert.Empty(t, parseLsRemoteTags(""))
+}
+
+// newTestGitRepo creates a Git repository with a tagged commit and a second commit,
+// and returns its path and the two commit SHAs.
+func newTestGitRepo(t *testing.T) (string, string, string) {
+	t.Helper()
+
+	if _, err := exec.LookPath(gitCommand); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repo := t.TempDir()
+	git := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command(gitCommand, append([]string{"-C", repo}, args...)...)
+		cmd.Env = append(cmd.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	git("init", "--quiet")
+	require.NoError(t, os.MkdirAll(filepath.Join(repo, "modules", "vpc"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repo, "modules", "vpc", "main.tf"), []byte("# vpc\n"), 0o644))
+	git("add", ".")
+	git("commit", "--quiet", "-m", "first")
+	git("tag", "-a", "v1.0.0", "-m", "v1.0.0")
+	first := git("rev-parse", "HEAD")
+	git("commit", "--quiet", "--allow-empty", "-m", "second")
+	git("tag", "v1.1.0")
+	second := git("rev-parse", "HEAD")
+
+	return repo, first, second
+}
+
+func TestListGitTags(t *testing.T) {
+	repo, _, _ := newTestGitRepo(t)
+	src := "git::file://" + filepath.ToSlash(repo)
+
+	tags, err := ListGitTags(context.Background(), &schema.AtmosConfiguration{}, src+"//modules/vpc?ref=v1.0.0")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"v1.0.0", "v1.1.0"}, tags)
+}
+
+func TestListGitTags_NotGit(t *testing.T) {
+	tags, err := ListGitTags(context.Background(), &schema.AtmosConfiguration{}, "https://example.com/archive.tar.gz")
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func TestWithGitCommitHandler(t *testing.T) {
+	repo, first, second := newTestGitRepo(t)
+	src := "git::file://" + filepath.ToSlash(repo)
+
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{name: "annotated tag with subdirectory", src: src + "//modules/vpc?ref=v1.0.0", want: first},
+		{name: "default branch", src: src + "?depth=1", want: second},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var commit string
+			dst := filepath.Join(t.TempDir(), "dst")
+			d := NewGoGetterDownloader(&schema.AtmosConfiguration{}, WithGitCommitHandler(func(c string) { commit = c }))
+
+			require.NoError(t, d.Fetch(tt.src, dst, ClientModeAny, time.Minute))
+			assert.Equal(t, tt.want, commit)
+		})
+	}
+}
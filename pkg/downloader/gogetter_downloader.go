@@ -27,6 +27,7 @@ type goGetterClientFactory struct {
 	atmosConfig *schema.AtmosConfiguration
 	retryConfig *schema.RetryConfig
 	httpClient  *http.Client // Optional custom HTTP client for testing.
+	onGitCommit func(commit string)
 }
 
 // NewClient creates a new `go-getter` client.
@@ -56,7 +57,7 @@ func (f *goGetterClientFactory) NewClient(ctx context.Context, src, dest string,
 		DisableSymlinks: false,
 		Getters: map[string]getter.Getter{
 			// Overriding 'git'.
-			"git":   &CustomGitGetter{RetryConfig: f.retryConfig},
+			"git":   &CustomGitGetter{RetryConfig: f.retryConfig, OnCommit: f.onGitCommit},
 			"file":  &getter.FileGetter{},
 			"hg":    &getter.HgGetter{},
 			"http":  httpGetter,
@@ -101,6 +102,13 @@ func WithHTTPClient(client *http.Client) GoGetterOption {
 	}
 }
 
+// WithGitCommitHandler sets a function that is called with the commit SHA of each cloned Git repository.
+func WithGitCommitHandler(fn func(commit string)) GoGetterOption {
+	return func(f *goGetterClientFactory) {
+		f.onGitCommit = fn
+	}
+}
+
 // NewGoGetterDownloader creates a new go-getter based downloader.
 func NewGoGetterDownloader(atmosConfig *schema.AtmosConfiguration, opts ...GoGetterOption) FileDownloader {
 	defer perf.Track(atmosConfig, "pkg.downloader.NewGoGetterDownloader")()
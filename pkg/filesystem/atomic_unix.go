@@ -5,12 +5,12 @@ package filesystem
 import (
 	"os"
 
-	"github.com/google/renameio/v2"
+	"github.com/cloudposse/atmos/pkg/filesystem/atomicfile"
 )
 
 // WriteFileAtomicUnix uses renameio for atomic file writing on Unix systems.
 // This ensures atomic visibility (readers never see truncated files) via
 // temp file + rename. Note: durability depends on fsync behavior.
 func WriteFileAtomicUnix(filename string, data []byte, perm os.FileMode) error {
-	return renameio.WriteFile(filename, data, perm)
+	return atomicfile.WriteFile(filename, data, perm)
 }
@@ -0,0 +1,16 @@
+//go:build !windows
+
+package atomicfile
+
+import (
+	"os"
+
+	"github.com/google/renameio/v2"
+)
+
+// WriteFile uses renameio for atomic file writing on Unix systems.
+// This ensures atomic visibility (readers never see truncated files) via
+// temp file + rename. Note: durability depends on fsync behavior.
+func WriteFile(filename string, data []byte, perm os.FileMode) error {
+	return renameio.WriteFile(filename, data, perm)
+}
@@ -0,0 +1,8 @@
+// Package atomicfile writes files atomically (POSIX rename, and a Windows-compatible
+// remove-before-rename variant).
+//
+// It has no Atmos dependencies so that low-level packages which cannot import
+// [github.com/cloudposse/atmos/pkg/filesystem] without an import cycle (for example
+// pkg/store, which pkg/schema depends on) share the same implementation.
+// Other callers should use [github.com/cloudposse/atmos/pkg/filesystem.OSFileSystem.WriteFileAtomic].
+package atomicfile
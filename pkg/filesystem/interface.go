@@ -40,6 +40,9 @@ type FileSystem interface {
 	// ReadFile reads a file.
 	ReadFile(name string) ([]byte, error)
 
+	// ReadDir reads a directory and returns its entries sorted by file name.
+	ReadDir(name string) ([]os.DirEntry, error)
+
 	// Remove removes a file or empty directory.
 	Remove(name string) error
 
@@ -145,6 +145,21 @@ func (mr *MockFileSystemMockRecorder) Open(name any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Open", reflect.TypeOf((*MockFileSystem)(nil).Open), name)
 }
 
+// ReadDir mocks base method.
+func (m *MockFileSystem) ReadDir(name string) ([]os.DirEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDir", name)
+	ret0, _ := ret[0].([]os.DirEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDir indicates an expected call of ReadDir.
+func (mr *MockFileSystemMockRecorder) ReadDir(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDir", reflect.TypeOf((*MockFileSystem)(nil).ReadDir), name)
+}
+
 // ReadFile mocks base method.
 func (m *MockFileSystem) ReadFile(name string) ([]byte, error) {
 	m.ctrl.T.Helper()
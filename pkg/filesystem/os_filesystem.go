@@ -59,6 +59,11 @@ func (o *OSFileSystem) ReadFile(name string) ([]byte, error) {
 	return os.ReadFile(name)
 }
 
+// ReadDir reads a directory and returns its entries sorted by file name.
+func (o *OSFileSystem) ReadDir(name string) ([]os.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
 // Remove removes a file or empty directory.
 func (o *OSFileSystem) Remove(name string) error {
 	return os.Remove(name)
@@ -5,13 +5,48 @@ import "strings"
 type HookEvent string
 
 const (
-	BeforeTerraformInit   HookEvent = "before.terraform.init"
-	AfterTerraformApply   HookEvent = "after.terraform.apply"
-	BeforeTerraformApply  HookEvent = "before.terraform.apply"
-	AfterTerraformPlan    HookEvent = "after.terraform.plan"
-	BeforeTerraformPlan   HookEvent = "before.terraform.plan"
-	BeforeTerraformDeploy HookEvent = "before.terraform.deploy"
-	AfterTerraformDeploy  HookEvent = "after.terraform.deploy"
+	BeforeTerraformInit    HookEvent = "before.terraform.init"
+	AfterTerraformInit     HookEvent = "after.terraform.init"
+	AfterTerraformApply    HookEvent = "after.terraform.apply"
+	BeforeTerraformApply   HookEvent = "before.terraform.apply"
+	AfterTerraformPlan     HookEvent = "after.terraform.plan"
+	BeforeTerraformPlan    HookEvent = "before.terraform.plan"
+	BeforeTerraformDeploy  HookEvent = "before.terraform.deploy"
+	AfterTerraformDeploy   HookEvent = "after.terraform.deploy"
+	BeforeTerraformDestroy HookEvent = "before.terraform.destroy"
+	AfterTerraformDestroy  HookEvent = "after.terraform.destroy"
+
+	// OnTerraformFailure fires when a terraform command fails. Hooks receive the exit code and the error.
+	OnTerraformFailure HookEvent = "on.terraform.failure"
+
+	BeforeHelmfileSync  HookEvent = "before.helmfile.sync"
+	AfterHelmfileSync   HookEvent = "after.helmfile.sync"
+	BeforeHelmfileApply HookEvent = "before.helmfile.apply"
+	AfterHelmfileApply  HookEvent = "after.helmfile.apply"
+	BeforeHelmfileDiff  HookEvent = "before.helmfile.diff"
+	AfterHelmfileDiff   HookEvent = "after.helmfile.diff"
+
+	BeforePackerBuild HookEvent = "before.packer.build"
+	AfterPackerBuild  HookEvent = "after.packer.build"
+
+	BeforeAnsiblePlaybook HookEvent = "before.ansible.playbook"
+	AfterAnsiblePlaybook  HookEvent = "after.ansible.playbook"
+
+	BeforePulumiPreview HookEvent = "before.pulumi.preview"
+	AfterPulumiPreview  HookEvent = "after.pulumi.preview"
+	BeforePulumiUp      HookEvent = "before.pulumi.up"
+	AfterPulumiUp       HookEvent = "after.pulumi.up"
+	BeforePulumiDestroy HookEvent = "before.pulumi.destroy"
+	AfterPulumiDestroy  HookEvent = "after.pulumi.destroy"
+	BeforePulumiRefresh HookEvent = "before.pulumi.refresh"
+	AfterPulumiRefresh  HookEvent = "after.pulumi.refresh"
+
+	BeforeKustomizeBuild HookEvent = "before.kustomize.build"
+	AfterKustomizeBuild  HookEvent = "after.kustomize.build"
+	BeforeKustomizeDiff  HookEvent = "before.kustomize.diff"
+	AfterKustomizeDiff   HookEvent = "after.kustomize.diff"
+	BeforeKustomizeApply HookEvent = "before.kustomize.apply"
+	AfterKustomizeApply  HookEvent = "after.kustomize.apply"
 )
 
 // Normalize returns the canonical form of a HookEvent, collapsing deploy aliases
@@ -1,6 +1,20 @@
 package hooks
 
-import "strings"
+import (
+	"strings"
+	"time"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// Hook command types.
+const (
+	CommandStore    = "store"
+	CommandShell    = "shell"
+	CommandHTTP     = "http"
+	CommandWorkflow = "workflow"
+)
 
 // Hook is the structure for a hook and is using in the stack config to define
 // a command that should be run when a specific event occurs.
@@ -13,10 +27,31 @@ type Hook struct {
 	// store command.
 	Name    string            `yaml:"name,omitempty"`    // for store command
 	Outputs map[string]string `yaml:"outputs,omitempty"` // for store command
+	Action  string            `yaml:"action,omitempty"`  // for store command, `set` (default) or `delete`
+
+	// shell command.
+	Run string            `yaml:"run,omitempty"` // for shell command
+	Env map[string]string `yaml:"env,omitempty"` // for shell command
+
+	// http command.
+	URL     string              `yaml:"url,omitempty"`     // for http command
+	Method  string              `yaml:"method,omitempty"`  // for http command, defaults to POST
+	Headers map[string]string   `yaml:"headers,omitempty"` // for http command
+	Timeout time.Duration       `yaml:"timeout,omitempty"` // for http command
+	Retry   *schema.RetryConfig `yaml:"retry,omitempty"`   // for http command
+
+	// workflow command.
+	Workflow string `yaml:"workflow,omitempty"` // for workflow command
+	File     string `yaml:"file,omitempty"`     // for workflow command
 
 	// Note: CI commands (ci.upload, ci.download, ci.summary) are deprecated.
 	// Use RunCIHooks which automatically triggers CI actions based on
 	// component provider bindings. See pkg/ci/ for the modern implementation.
+
+	// key is the name of the hook in the `hooks` section.
+	key string
+	// cmdErr is the error of the failed command for failure events.
+	cmdErr error
 }
 
 // MatchesEvent reports whether this hook should run for the given event.
@@ -38,3 +73,57 @@ func (h Hook) MatchesEvent(event HookEvent) bool {
 	}
 	return false
 }
+
+// Validate checks that the hook uses a known command and sets the properties that command requires.
+func (h Hook) Validate(name string) error {
+	var missing string
+
+	switch h.Command {
+	case CommandStore:
+		// Store hooks without outputs are skipped at runtime.
+		return h.validateStoreAction(name)
+	case "ci.check", "ci.output", "ci.summary", "ci.upload", "ci.download":
+		// Deprecated CI commands are accepted and ignored.
+		return nil
+	case CommandShell:
+		if h.Run == "" {
+			missing = "run"
+		}
+	case CommandHTTP:
+		if h.URL == "" {
+			missing = "url"
+		}
+	case CommandWorkflow:
+		if h.Workflow == "" {
+			missing = "workflow"
+		}
+	default:
+		return errUtils.Build(errUtils.ErrInvalidHook).
+			WithExplanationf("Hook `%s` uses unknown command `%s`", name, h.Command).
+			WithHintf("Supported commands are `%s`, `%s`, `%s` and `%s`", CommandStore, CommandShell, CommandHTTP, CommandWorkflow).
+			WithContext("hook", name).
+			Err()
+	}
+
+	if missing != "" {
+		return errUtils.Build(errUtils.ErrInvalidHook).
+			WithExplanationf("Hook `%s` with command `%s` requires the `%s` property", name, h.Command, missing).
+			WithContext("hook", name).
+			Err()
+	}
+	return nil
+}
+
+// validateStoreAction checks the action of a store hook.
+func (h Hook) validateStoreAction(name string) error {
+	switch h.Action {
+	case "", StoreActionSet, StoreActionDelete:
+		return nil
+	default:
+		return errUtils.Build(errUtils.ErrInvalidHook).
+			WithExplanationf("Hook `%s` uses unknown store action `%s`", name, h.Action).
+			WithHintf("Supported actions are `%s` and `%s`", StoreActionSet, StoreActionDelete).
+			WithContext("hook", name).
+			Err()
+	}
+}
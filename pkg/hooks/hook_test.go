@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	errUtils "github.com/cloudposse/atmos/errors"
 )
 
 func TestHook_MatchesEvent(t *testing.T) {
@@ -49,6 +51,18 @@ func TestHook_MatchesEvent(t *testing.T) {
 			event:    AfterTerraformApply,
 			expected: true,
 		},
+		{
+			name:     "matches failure event",
+			hook:     Hook{Events: []string{"on-terraform-failure"}},
+			event:    OnTerraformFailure,
+			expected: true,
+		},
+		{
+			name:     "matches helmfile event",
+			hook:     Hook{Events: []string{"after-helmfile-sync"}},
+			event:    AfterHelmfileSync,
+			expected: true,
+		},
 		{
 			name:     "does not match when none of multiple events match",
 			hook:     Hook{Events: []string{"before-terraform-plan", "before-terraform-apply"}},
@@ -100,3 +114,36 @@ func TestHook_MatchesEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestHook_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		hook    Hook
+		wantErr bool
+	}{
+		{name: "store", hook: Hook{Command: "store"}},
+		{name: "store set", hook: Hook{Command: "store", Action: "set"}},
+		{name: "store delete", hook: Hook{Command: "store", Action: "delete"}},
+		{name: "store unknown action", hook: Hook{Command: "store", Action: "purge"}, wantErr: true},
+		{name: "deprecated ci command", hook: Hook{Command: "ci.upload"}},
+		{name: "shell", hook: Hook{Command: "shell", Run: "echo hi"}},
+		{name: "shell without run", hook: Hook{Command: "shell"}, wantErr: true},
+		{name: "http", hook: Hook{Command: "http", URL: "https://example.com"}},
+		{name: "http without url", hook: Hook{Command: "http"}, wantErr: true},
+		{name: "workflow", hook: Hook{Command: "workflow", Workflow: "deploy"}},
+		{name: "workflow without name", hook: Hook{Command: "workflow"}, wantErr: true},
+		{name: "unknown command", hook: Hook{Command: "slack"}, wantErr: true},
+		{name: "missing command", hook: Hook{}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.hook.Validate("my-hook")
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errUtils.ErrInvalidHook)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
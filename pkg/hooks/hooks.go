@@ -1,18 +1,30 @@
 package hooks
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"io"
 
 	log "github.com/cloudposse/atmos/pkg/logger"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	"gopkg.in/yaml.v2"
 
 	errUtils "github.com/cloudposse/atmos/errors"
 	e "github.com/cloudposse/atmos/internal/exec"
+	"github.com/cloudposse/atmos/pkg/ansi"
 	"github.com/cloudposse/atmos/pkg/ci"
-	_ "github.com/cloudposse/atmos/pkg/ci/plugins/terraform" // Register terraform CI plugin.
-	_ "github.com/cloudposse/atmos/pkg/ci/providers/generic" // Register generic CI provider.
-	_ "github.com/cloudposse/atmos/pkg/ci/providers/github"  // Register GitHub Actions CI provider.
+	_ "github.com/cloudposse/atmos/pkg/ci/plugins/ansible"       // Register ansible CI plugin.
+	_ "github.com/cloudposse/atmos/pkg/ci/plugins/helmfile"      // Register helmfile CI plugin.
+	_ "github.com/cloudposse/atmos/pkg/ci/plugins/packer"        // Register packer CI plugin.
+	_ "github.com/cloudposse/atmos/pkg/ci/plugins/terraform"     // Register terraform CI plugin.
+	_ "github.com/cloudposse/atmos/pkg/ci/providers/azuredevops" // Register Azure DevOps Pipelines CI provider.
+	_ "github.com/cloudposse/atmos/pkg/ci/providers/bitbucket"   // Register Bitbucket Pipelines CI provider.
+	_ "github.com/cloudposse/atmos/pkg/ci/providers/generic"     // Register generic CI provider.
+	_ "github.com/cloudposse/atmos/pkg/ci/providers/github"      // Register GitHub Actions CI provider.
+	_ "github.com/cloudposse/atmos/pkg/ci/providers/gitlab"      // Register GitLab CI provider.
+	cfg "github.com/cloudposse/atmos/pkg/config"
 	"github.com/cloudposse/atmos/pkg/perf"
 	"github.com/cloudposse/atmos/pkg/schema"
 	"github.com/cloudposse/atmos/pkg/ui"
@@ -22,6 +34,8 @@ type Hooks struct {
 	config *schema.AtmosConfiguration
 	info   *schema.ConfigAndStacksInfo
 	items  map[string]Hook
+	// env is the component `env` section, passed to shell hooks.
+	env map[string]any
 }
 
 func (h Hooks) HasHooks() bool {
@@ -75,16 +89,33 @@ func GetHooks(atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStac
 		return nil, fmt.Errorf("failed to unmarshal to Hooks: %w", err)
 	}
 
+	for name, hook := range items {
+		if err := hook.Validate(name); err != nil {
+			return nil, err
+		}
+	}
+
+	env, _ := sections["env"].(map[string]any)
+
 	hooks := Hooks{
 		config: atmosConfig,
 		info:   info,
 		items:  items,
+		env:    env,
 	}
 
 	return &hooks, nil
 }
 
 func (h Hooks) RunAll(event HookEvent, atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStacksInfo, cmd *cobra.Command, args []string) error {
+	return h.RunAllWithError(event, atmosConfig, info, cmd, args, nil)
+}
+
+// RunAllWithError runs the hooks matching the event and passes the error of the failed command to them.
+// Used for failure events such as `on.terraform.failure`.
+func (h Hooks) RunAllWithError(event HookEvent, atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStacksInfo, cmd *cobra.Command, args []string, cmdErr error) error {
+	defer perf.Track(atmosConfig, "hooks.RunAllWithError")()
+
 	log.Debug("Running hooks", "count", len(h.items))
 
 	for name, hook := range h.items {
@@ -93,20 +124,29 @@ func (h Hooks) RunAll(event HookEvent, atmosConfig *schema.AtmosConfiguration, i
 			continue
 		}
 
+		if err := hook.Validate(name); err != nil {
+			return err
+		}
+		hook.key = name
+		hook.cmdErr = cmdErr
+
 		var hookCmd Command
 		var err error
 
 		switch hook.Command {
-		case "store":
+		case CommandStore:
 			hookCmd, err = NewStoreCommand(atmosConfig, info)
+		case CommandShell:
+			hookCmd, err = NewShellCommand(atmosConfig, info, h.env)
+		case CommandHTTP:
+			hookCmd, err = NewHTTPCommand(atmosConfig, info)
+		case CommandWorkflow:
+			hookCmd, err = NewWorkflowCommand(atmosConfig, info)
 		// CI commands are deprecated - use RunCIHooks instead which automatically
 		// triggers CI actions based on component provider bindings.
 		case "ci.check", "ci.output", "ci.summary", "ci.upload", "ci.download":
 			log.Debug("CI hook command deprecated, use RunCIHooks instead", "command", hook.Command)
 			continue
-		default:
-			log.Debug("Unknown hook command", "command", hook.Command)
-			continue
 		}
 
 		if err != nil {
@@ -120,6 +160,88 @@ func (h Hooks) RunAll(event HookEvent, atmosConfig *schema.AtmosConfiguration, i
 	return nil
 }
 
+// RunEvent loads the hooks of the component in info and runs the ones matching the event.
+// It is used by the helmfile, packer and ansible commands, which resolve the component before running hooks.
+func RunEvent(event HookEvent, info *schema.ConfigAndStacksInfo) error {
+	defer perf.Track(nil, "hooks.RunEvent")()
+
+	if info.ComponentFromArg == "" || info.Stack == "" {
+		return nil
+	}
+
+	atmosConfig, err := cfg.InitCliConfig(*info, true)
+	if err != nil {
+		return errors.Join(errUtils.ErrInitializeCLIConfig, err)
+	}
+
+	hooks, err := GetHooks(&atmosConfig, info)
+	if err != nil {
+		return err
+	}
+	if !hooks.HasHooks() {
+		return nil
+	}
+
+	log.Info("Running hooks", "event", event)
+	return hooks.RunAll(event, &atmosConfig, info, nil, nil)
+}
+
+// RunWithEvents runs the hooks of the before event, then the command, then the hooks of the after event.
+// The after event hooks only run when the command succeeds.
+// When CI integration is active, the CI hooks of both events run as well. The command output is captured
+// through the writer passed to run, and the CI hooks of the after event also run when the command fails,
+// so check runs and summaries report the failure. The writer is nil when CI integration is not active.
+func RunWithEvents(before, after HookEvent, info *schema.ConfigAndStacksInfo, run func(capture io.Writer) error) error {
+	defer perf.Track(nil, "hooks.RunWithEvents")()
+
+	if err := RunEvent(before, info); err != nil {
+		return err
+	}
+
+	atmosConfig, forceCIMode, ok := resolveCIMode(info)
+	if !ok {
+		if err := run(nil); err != nil {
+			return err
+		}
+		return RunEvent(after, info)
+	}
+
+	// CI hook errors never fail the command.
+	if err := RunCIHooks(before, atmosConfig, info, "", forceCIMode, nil); err != nil {
+		log.Warn("CI hook execution failed", "error", err)
+	}
+
+	var captured bytes.Buffer
+	cmdErr := run(&captured)
+
+	if err := RunCIHooks(after, atmosConfig, info, ansi.Strip(captured.String()), forceCIMode, cmdErr); err != nil {
+		log.Warn("CI hook execution failed", "error", err)
+	}
+	if cmdErr != nil {
+		return cmdErr
+	}
+	return RunEvent(after, info)
+}
+
+// resolveCIMode reports whether CI hooks should run for a command that fires hook events.
+// CI mode is on when forced with ATMOS_CI/CI or when a CI provider is detected, and `ci.enabled` is set.
+func resolveCIMode(info *schema.ConfigAndStacksInfo) (*schema.AtmosConfiguration, bool, bool) {
+	forceCIMode := viper.GetBool("ci")
+	if !forceCIMode && !ci.IsCI() {
+		return nil, false, false
+	}
+
+	atmosConfig, err := cfg.InitCliConfig(*info, true)
+	if err != nil {
+		log.Debug("CI hooks skipped", "error", err)
+		return nil, false, false
+	}
+	if !atmosConfig.CI.Enabled {
+		return nil, false, false
+	}
+	return &atmosConfig, forceCIMode, true
+}
+
 // RunCIHooks executes CI actions based on provider bindings.
 // This is called automatically during command execution if CI is enabled.
 // The output parameter is the command output to process (e.g., terraform plan output).
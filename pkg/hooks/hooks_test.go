@@ -2,6 +2,7 @@ package hooks
 
 import (
 	"errors"
+	"io"
 	"path/filepath"
 	"testing"
 
@@ -309,6 +310,27 @@ func TestRunAll(t *testing.T) {
 	}
 }
 
+func TestRunEvent_WithoutComponent(t *testing.T) {
+	// Commands without a component and stack have no hooks to run.
+	assert.NoError(t, RunEvent(AfterHelmfileSync, &schema.ConfigAndStacksInfo{}))
+	assert.NoError(t, RunWithEvents(BeforePackerBuild, AfterPackerBuild, &schema.ConfigAndStacksInfo{}, func(io.Writer) error { return nil }))
+
+	runErr := errors.New("build failed")
+	err := RunWithEvents(BeforePackerBuild, AfterPackerBuild, &schema.ConfigAndStacksInfo{}, func(io.Writer) error { return runErr })
+	assert.ErrorIs(t, err, runErr)
+}
+
+func TestRunAll_UnknownCommand(t *testing.T) {
+	hooks := Hooks{
+		config: &schema.AtmosConfiguration{},
+		info:   &schema.ConfigAndStacksInfo{},
+		items:  map[string]Hook{"notify": {Command: "slack"}},
+	}
+
+	err := hooks.RunAll(AfterTerraformApply, hooks.config, hooks.info, nil, nil)
+	assert.ErrorIs(t, err, errUtils.ErrInvalidHook)
+}
+
 // TestRunAll_EventFiltering verifies that RunAll only executes hooks whose Events list
 // includes the current event. This is the guard that prevents after-terraform-apply hooks
 // from firing during before-terraform-apply (and vice-versa).
@@ -0,0 +1,168 @@
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	atmoshttp "github.com/cloudposse/atmos/pkg/http"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/retry"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	// defaultHTTPHookTimeout is the timeout of a single HTTP hook request when the hook does not set one.
+	defaultHTTPHookTimeout = 30 * time.Second
+
+	// defaultHTTPHookMaxAttempts is the number of attempts when the hook does not configure retries.
+	defaultHTTPHookMaxAttempts = 3
+
+	// defaultHTTPHookInitialDelay is the delay before the first retry when the hook does not configure retries.
+	defaultHTTPHookInitialDelay = time.Second
+
+	// maxHTTPHookErrorBodySize limits how much of an error response body is included in the error.
+	maxHTTPHookErrorBodySize = 1024
+)
+
+// Assert that HTTPCommand implements Command interface.
+var _ Command = &HTTPCommand{}
+
+// HTTPPayload is the JSON document sent by HTTP hooks.
+type HTTPPayload struct {
+	Hook      string    `json:"hook"`
+	Event     string    `json:"event"`
+	Component string    `json:"component"`
+	Stack     string    `json:"stack"`
+	Timestamp time.Time `json:"timestamp"`
+	// ExitCode and Error are set for failure events.
+	ExitCode int    `json:"exit_code,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// HTTPCommand sends a JSON payload describing the hook event to a URL.
+type HTTPCommand struct {
+	Name        string
+	atmosConfig *schema.AtmosConfiguration
+	info        *schema.ConfigAndStacksInfo
+	// client overrides the HTTP client built from the hook timeout. Used in tests.
+	client atmoshttp.Client
+}
+
+// NewHTTPCommand creates an HTTP hook command.
+func NewHTTPCommand(atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStacksInfo) (*HTTPCommand, error) {
+	defer perf.Track(atmosConfig, "hooks.NewHTTPCommand")()
+
+	return &HTTPCommand{
+		Name:        CommandHTTP,
+		atmosConfig: atmosConfig,
+		info:        info,
+	}, nil
+}
+
+func (c *HTTPCommand) GetName() string {
+	return c.Name
+}
+
+// RunE sends the hook payload. Network errors, 5xx and 429 responses are retried according to the hook `retry` config.
+func (c *HTTPCommand) RunE(hook *Hook, event HookEvent, cmd *cobra.Command, args []string) error {
+	defer perf.Track(c.atmosConfig, "hooks.HTTPCommand.RunE")()
+
+	payload := HTTPPayload{
+		Hook:      hook.key,
+		Event:     string(event),
+		Component: c.info.ComponentFromArg,
+		Stack:     c.info.Stack,
+		Timestamp: time.Now().UTC(),
+	}
+	if hook.cmdErr != nil {
+		payload.ExitCode = errUtils.GetExitCode(hook.cmdErr)
+		payload.Error = hook.cmdErr.Error()
+	}
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	client := c.client
+	if client == nil {
+		timeout := hook.Timeout
+		if timeout <= 0 {
+			timeout = defaultHTTPHookTimeout
+		}
+		client = atmoshttp.NewDefaultClient(atmoshttp.WithTimeout(timeout))
+	}
+
+	retryConfig := hook.Retry
+	if retryConfig == nil {
+		retryConfig = defaultHTTPHookRetryConfig()
+	}
+
+	log.Debug("Executing http hook", "hook", hook.key, "event", event, "url", hook.URL)
+
+	// retryable records whether the last attempt failed with a transient error.
+	var retryable bool
+	err = retry.WithPredicate(context.Background(), retryConfig, func() error {
+		var sendErr error
+		retryable, sendErr = sendHookRequest(client, hook, body)
+		return sendErr
+	}, func(error) bool {
+		return retryable
+	})
+	if err != nil {
+		return fmt.Errorf("%w: hook %q (event %q): %w", errUtils.ErrHookFailed, hook.key, event, err)
+	}
+	return nil
+}
+
+// sendHookRequest sends a single request and reports whether a failure is worth retrying.
+func sendHookRequest(client atmoshttp.Client, hook *Hook, body []byte) (bool, error) {
+	method := hook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), method, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("%w: %w", errUtils.ErrHTTPRequestFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range hook.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("%w: %s %s: %w", errUtils.ErrHTTPRequestFailed, method, req.URL.Redacted(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return false, nil
+	}
+
+	respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxHTTPHookErrorBodySize))
+	retryable := resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests
+	return retryable, fmt.Errorf("%w: %s %s returned status %d: %s",
+		errUtils.ErrHTTPRequestFailed, method, req.URL.Redacted(), resp.StatusCode, bytes.TrimSpace(respBody))
+}
+
+// defaultHTTPHookRetryConfig retries a few times with exponential backoff.
+func defaultHTTPHookRetryConfig() *schema.RetryConfig {
+	maxAttempts := defaultHTTPHookMaxAttempts
+	initialDelay := defaultHTTPHookInitialDelay
+	return &schema.RetryConfig{
+		MaxAttempts:     &maxAttempts,
+		BackoffStrategy: schema.BackoffExponential,
+		InitialDelay:    &initialDelay,
+	}
+}
@@ -0,0 +1,117 @@
+package hooks
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func testRetryConfig(attempts int) *schema.RetryConfig {
+	delay := time.Millisecond
+	return &schema.RetryConfig{MaxAttempts: &attempts, InitialDelay: &delay}
+}
+
+func TestHTTPCommand_RunE(t *testing.T) {
+	var payload HTTPPayload
+	var headers http.Header
+	var method string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		headers = r.Header.Clone()
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	atmosConfig := &schema.AtmosConfiguration{}
+	info := &schema.ConfigAndStacksInfo{ComponentFromArg: "vpc", Stack: "dev"}
+	hooks := Hooks{
+		config: atmosConfig,
+		info:   info,
+		items: map[string]Hook{
+			"webhook": {
+				Events:  []string{"after-terraform-apply"},
+				Command: "http",
+				URL:     server.URL,
+				Headers: map[string]string{"Authorization": "Bearer token"},
+			},
+		},
+	}
+
+	require.NoError(t, hooks.RunAll(AfterTerraformApply, atmosConfig, info, nil, nil))
+
+	assert.Equal(t, http.MethodPost, method)
+	assert.Equal(t, "application/json", headers.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", headers.Get("Authorization"))
+	assert.Equal(t, "webhook", payload.Hook)
+	assert.Equal(t, string(AfterTerraformApply), payload.Event)
+	assert.Equal(t, "vpc", payload.Component)
+	assert.Equal(t, "dev", payload.Stack)
+	assert.False(t, payload.Timestamp.IsZero())
+}
+
+func TestHTTPCommand_RunE_FailureEvent(t *testing.T) {
+	var payload HTTPPayload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer server.Close()
+
+	cmd, err := NewHTTPCommand(&schema.AtmosConfiguration{}, &schema.ConfigAndStacksInfo{ComponentFromArg: "vpc", Stack: "dev"})
+	require.NoError(t, err)
+
+	cmdErr := errUtils.ExitCodeError{Code: 3}
+	hook := &Hook{Command: "http", URL: server.URL, key: "announce", cmdErr: cmdErr}
+	require.NoError(t, cmd.RunE(hook, OnTerraformFailure, nil, nil))
+
+	assert.Equal(t, string(OnTerraformFailure), payload.Event)
+	assert.Equal(t, 3, payload.ExitCode)
+	assert.Equal(t, cmdErr.Error(), payload.Error)
+}
+
+func TestHTTPCommand_RunE_Retry(t *testing.T) {
+	tests := []struct {
+		name         string
+		statuses     []int
+		attempts     int
+		wantRequests int32
+		wantErr      bool
+	}{
+		{name: "retries server errors", statuses: []int{http.StatusBadGateway, http.StatusTooManyRequests, http.StatusOK}, attempts: 3, wantRequests: 3},
+		{name: "gives up after max attempts", statuses: []int{http.StatusInternalServerError}, attempts: 2, wantRequests: 2, wantErr: true},
+		{name: "does not retry client errors", statuses: []int{http.StatusBadRequest}, attempts: 3, wantRequests: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := int(requests.Add(1))
+				w.WriteHeader(tt.statuses[min(n, len(tt.statuses))-1])
+			}))
+			defer server.Close()
+
+			cmd, err := NewHTTPCommand(&schema.AtmosConfiguration{}, &schema.ConfigAndStacksInfo{})
+			require.NoError(t, err)
+
+			hook := &Hook{Command: "http", URL: server.URL, Method: http.MethodPut, Retry: testRetryConfig(tt.attempts), key: "webhook"}
+			err = cmd.RunE(hook, AfterTerraformApply, nil, nil)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errUtils.ErrHookFailed)
+				assert.ErrorIs(t, err, errUtils.ErrHTTPRequestFailed)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantRequests, requests.Load())
+		})
+	}
+}
@@ -3,6 +3,8 @@ package hooks
 import (
 	"fmt"
 	"sync"
+
+	"github.com/cloudposse/atmos/pkg/store"
 )
 
 // MockStore is a test implementation of the store.Store interface.
@@ -34,6 +36,19 @@ func (m *MockStore) Set(stack string, component string, key string, value any) e
 	return nil
 }
 
+// Delete removes a value from the mock store.
+func (m *MockStore) Delete(stack string, component string, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	storeKey := fmt.Sprintf("%s/%s/%s", stack, component, key)
+	if _, ok := m.data[storeKey]; !ok {
+		return fmt.Errorf("%w: %s", store.ErrResourceNotFound, storeKey)
+	}
+	delete(m.data, storeKey)
+	return nil
+}
+
 // Get retrieves a value from the mock store.
 func (m *MockStore) Get(stack string, component string, key string) (any, error) {
 	m.mu.Lock()
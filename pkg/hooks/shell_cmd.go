@@ -0,0 +1,111 @@
+package hooks
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	e "github.com/cloudposse/atmos/internal/exec"
+	envpkg "github.com/cloudposse/atmos/pkg/env"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	u "github.com/cloudposse/atmos/pkg/utils"
+)
+
+// Assert that ShellCommand implements Command interface.
+var _ Command = &ShellCommand{}
+
+// ShellCommand runs a shell script with the component environment.
+type ShellCommand struct {
+	Name         string
+	atmosConfig  *schema.AtmosConfiguration
+	info         *schema.ConfigAndStacksInfo
+	componentEnv map[string]any
+}
+
+// NewShellCommand creates a shell hook command. componentEnv is the component `env` section.
+func NewShellCommand(atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStacksInfo, componentEnv map[string]any) (*ShellCommand, error) {
+	defer perf.Track(atmosConfig, "hooks.NewShellCommand")()
+
+	return &ShellCommand{
+		Name:         CommandShell,
+		atmosConfig:  atmosConfig,
+		info:         info,
+		componentEnv: componentEnv,
+	}, nil
+}
+
+func (c *ShellCommand) GetName() string {
+	return c.Name
+}
+
+// RunE runs the hook script. The environment is built from the global `env` in `atmos.yaml`, the component `env`
+// section, the hook context variables and the hook `env`, in increasing order of precedence.
+func (c *ShellCommand) RunE(hook *Hook, event HookEvent, cmd *cobra.Command, args []string) error {
+	defer perf.Track(c.atmosConfig, "hooks.ShellCommand.RunE")()
+
+	componentEnv, err := c.resolveComponentEnv()
+	if err != nil {
+		return fmt.Errorf("%w: hook %q (event %q): %w", errUtils.ErrHookFailed, hook.key, event, err)
+	}
+
+	env := envpkg.MergeGlobalEnv(nil, c.atmosConfig.Env)
+	env = append(env, envpkg.ConvertEnvVars(componentEnv)...)
+	env = append(env, hookContextEnv(hook, event, c.info)...)
+	env = append(env, envpkg.ConvertMapToSlice(hook.Env)...)
+
+	log.Debug("Executing shell hook", "hook", hook.key, "event", event)
+	if err := e.ExecuteShell(hook.Run, hook.key, "", env, false); err != nil {
+		return fmt.Errorf("%w: hook %q (event %q): %w", errUtils.ErrHookFailed, hook.key, event, err)
+	}
+	return nil
+}
+
+// resolveComponentEnv returns the component `env` section with YAML functions such as `!store` and `!env` evaluated.
+// Hooks are loaded before authentication, so the section is only described again with YAML functions
+// processed when it actually uses them.
+func (c *ShellCommand) resolveComponentEnv() (map[string]any, error) {
+	if !hasYamlFunctions(c.componentEnv) {
+		return c.componentEnv, nil
+	}
+	log.Debug("Resolving YAML functions in the component env for shell hook", "component", c.info.ComponentFromArg, "stack", c.info.Stack)
+	return e.DescribeComponentEnv(c.atmosConfig, c.info)
+}
+
+// hasYamlFunctions reports whether any value in the env section is an unevaluated Atmos YAML function.
+func hasYamlFunctions(env map[string]any) bool {
+	for _, value := range env {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		for _, tag := range u.AtmosYamlTags {
+			if str == tag || strings.HasPrefix(str, tag+" ") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hookContextEnv returns the environment variables describing the hook invocation.
+// Failure events also receive the exit code and the error of the failed command.
+func hookContextEnv(hook *Hook, event HookEvent, info *schema.ConfigAndStacksInfo) []string {
+	env := []string{
+		"ATMOS_HOOK_NAME=" + hook.key,
+		"ATMOS_HOOK_EVENT=" + string(event),
+		"ATMOS_COMPONENT=" + info.ComponentFromArg,
+		"ATMOS_STACK=" + info.Stack,
+	}
+	if hook.cmdErr != nil {
+		env = append(env,
+			"ATMOS_HOOK_EXIT_CODE="+strconv.Itoa(errUtils.GetExitCode(hook.cmdErr)),
+			"ATMOS_HOOK_ERROR="+hook.cmdErr.Error(),
+		)
+	}
+	return env
+}
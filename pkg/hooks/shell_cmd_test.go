@@ -0,0 +1,98 @@
+package hooks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestShellCommand_RunE(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "env.txt")
+	atmosConfig := &schema.AtmosConfiguration{Env: map[string]string{"GLOBAL": "global", "REGION": "global"}}
+	info := &schema.ConfigAndStacksInfo{ComponentFromArg: "vpc", Stack: "dev"}
+	componentEnv := map[string]any{"REGION": "us-east-2", "OWNER": "component", "UNSET": nil}
+
+	hooks := Hooks{
+		config: atmosConfig,
+		info:   info,
+		env:    componentEnv,
+		items: map[string]Hook{
+			"notify": {
+				Events:  []string{"after-terraform-apply"},
+				Command: "shell",
+				Run:     `echo "$ATMOS_HOOK_NAME $ATMOS_HOOK_EVENT $ATMOS_COMPONENT $ATMOS_STACK $GLOBAL $REGION $OWNER" > "$OUT"`,
+				Env:     map[string]string{"OWNER": "hook", "OUT": out},
+			},
+		},
+	}
+
+	require.NoError(t, hooks.RunAll(AfterTerraformApply, atmosConfig, info, nil, nil))
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "notify after.terraform.apply vpc dev global us-east-2 hook\n", string(content))
+}
+
+func TestShellCommand_RunE_FailureEvent(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "failure.txt")
+	atmosConfig := &schema.AtmosConfiguration{}
+	info := &schema.ConfigAndStacksInfo{ComponentFromArg: "vpc", Stack: "dev"}
+	hooks := Hooks{
+		config: atmosConfig,
+		info:   info,
+		items: map[string]Hook{
+			"announce": {
+				Events:  []string{"on-terraform-failure"},
+				Command: "shell",
+				Run:     `echo "$ATMOS_HOOK_EXIT_CODE $ATMOS_HOOK_ERROR" > "$OUT"`,
+				Env:     map[string]string{"OUT": out},
+			},
+		},
+	}
+
+	// Hooks for other events don't run.
+	require.NoError(t, hooks.RunAllWithError(AfterTerraformApply, atmosConfig, info, nil, nil, nil))
+	assert.NoFileExists(t, out)
+
+	cmdErr := errUtils.ExitCodeError{Code: 2}
+	require.NoError(t, hooks.RunAllWithError(OnTerraformFailure, atmosConfig, info, nil, nil, cmdErr))
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "2 "+cmdErr.Error()+"\n", string(content))
+}
+
+func TestShellCommand_RunE_Failure(t *testing.T) {
+	cmd, err := NewShellCommand(&schema.AtmosConfiguration{}, &schema.ConfigAndStacksInfo{}, nil)
+	require.NoError(t, err)
+
+	err = cmd.RunE(&Hook{Command: "shell", Run: "exit 4", key: "fail"}, AfterTerraformApply, nil, nil)
+	assert.ErrorIs(t, err, errUtils.ErrHookFailed)
+	assert.Equal(t, 4, errUtils.GetExitCode(err))
+}
+
+func TestHasYamlFunctions(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]any
+		want bool
+	}{
+		{name: "nil", env: nil, want: false},
+		{name: "plain values", env: map[string]any{"REGION": "us-east-2", "COUNT": 3}, want: false},
+		{name: "store function", env: map[string]any{"VPC_ID": "!store ssm vpc vpc_id"}, want: true},
+		{name: "env function", env: map[string]any{"TOKEN": "!env GITHUB_TOKEN"}, want: true},
+		{name: "exclamation in a plain value", env: map[string]any{"GREETING": "!hello"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, hasYamlFunctions(tt.env))
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package hooks
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -9,6 +11,7 @@ import (
 	errUtils "github.com/cloudposse/atmos/errors"
 	log "github.com/cloudposse/atmos/pkg/logger"
 	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/store"
 	tfoutput "github.com/cloudposse/atmos/pkg/terraform/output"
 )
 
@@ -28,6 +31,12 @@ type TerraformOutputGetter func(
 	authManager any,
 ) (any, bool, error)
 
+// Store hook actions.
+const (
+	StoreActionSet    = "set"
+	StoreActionDelete = "delete"
+)
+
 // Assert that StoreCommand implements Command interface.
 var _ Command = &StoreCommand{}
 
@@ -59,7 +68,11 @@ func (c *StoreCommand) processStoreCommand(hook *Hook, event HookEvent) error {
 		return nil
 	}
 
-	log.Debug("Executing store hook", "hook", hook.Name, "command", hook.Command)
+	log.Debug("Executing store hook", "hook", hook.Name, "command", hook.Command, "action", hook.Action)
+	if hook.Action == StoreActionDelete {
+		return c.deleteOutputs(hook)
+	}
+
 	for key, value := range hook.Outputs {
 		outputKey, outputValue, err := c.getOutputValue(hook.Name, event, value)
 		if err != nil {
@@ -110,15 +123,49 @@ func (c *StoreCommand) getOutputValue(hookName string, event HookEvent, value st
 // storeOutput puts the value of the output in the store
 func (c *StoreCommand) storeOutput(hook *Hook, key string, outputKey string, outputValue any) error {
 	log.Debug("checking if the store exists", "store", hook.Name)
-	store := c.atmosConfig.Stores[hook.Name]
+	s := c.atmosConfig.Stores[hook.Name]
 
-	if store == nil {
-		return fmt.Errorf("store %q not found in configuration", hook.Name)
+	if s == nil {
+		return fmt.Errorf("%w: %q", errUtils.ErrStoreNotFound, hook.Name)
 	}
 
 	log.Debug("storing terraform output", "outputKey", outputKey, "store", hook.Name, "key", key, "value", outputValue)
 
-	return store.Set(c.info.Stack, c.info.ComponentFromArg, key, outputValue)
+	return s.Set(c.info.Stack, c.info.ComponentFromArg, key, outputValue)
+}
+
+// deleteOutputs removes the keys of the hook outputs from the store, e.g. after the component is destroyed.
+// The outputs are not read, so the hook works when the terraform state no longer exists.
+// Keys that are already absent from the store are skipped.
+func (c *StoreCommand) deleteOutputs(hook *Hook) error {
+	s := c.atmosConfig.Stores[hook.Name]
+	if s == nil {
+		return fmt.Errorf("%w: %q", errUtils.ErrStoreNotFound, hook.Name)
+	}
+
+	deletable, ok := s.(store.DeletableStore)
+	if !ok {
+		return fmt.Errorf("%w: store %q does not support %s", errUtils.ErrStoreUnsupportedOp, hook.Name, StoreActionDelete)
+	}
+
+	keys := make([]string, 0, len(hook.Outputs))
+	for key := range hook.Outputs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		log.Debug("deleting key from store", "store", hook.Name, "key", key)
+		err := deletable.Delete(c.info.Stack, c.info.ComponentFromArg, key)
+		if errors.Is(err, store.ErrResourceNotFound) {
+			log.Debug("Key not found in store, skipping", "store", hook.Name, "key", key)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // RunE is the entrypoint for the store command.
@@ -445,3 +445,45 @@ func TestStoreCommand_GetOutputValue_OutputNotFound(t *testing.T) {
 	require.Error(t, err)
 	assert.ErrorIs(t, err, errUtils.ErrTerraformOutputNotFound)
 }
+
+// setOnlyStore exposes only the base store.Store methods of the wrapped store, so it can't delete keys.
+type setOnlyStore struct {
+	store.Store
+}
+
+func TestStoreCommand_Delete(t *testing.T) {
+	mockStore := NewMockStore()
+	require.NoError(t, mockStore.Set("test-stack", "test-component", "vpc_id", "vpc-12345"))
+	require.NoError(t, mockStore.Set("test-stack", "test-component", "other", "keep"))
+
+	atmosConfig := &schema.AtmosConfiguration{
+		Stores: store.StoreRegistry{"test-store": mockStore, "plain-store": setOnlyStore{NewMockStore()}},
+	}
+	cmd := &StoreCommand{
+		atmosConfig: atmosConfig,
+		info: &schema.ConfigAndStacksInfo{
+			ComponentFromArg: "test-component",
+			Stack:            "test-stack",
+		},
+		outputGetter: func(*schema.AtmosConfiguration, string, string, string, bool, *schema.AuthContext, any) (any, bool, error) {
+			t.Fatal("outputs must not be read when deleting")
+			return nil, false, nil
+		},
+	}
+
+	hook := &Hook{
+		Name:    "test-store",
+		Action:  StoreActionDelete,
+		Outputs: map[string]string{"vpc_id": ".vpc_id", "missing": ".missing"},
+	}
+	require.NoError(t, cmd.processStoreCommand(hook, AfterTerraformDestroy))
+	assert.Equal(t, map[string]any{"test-stack/test-component/other": "keep"}, mockStore.GetData())
+
+	hook.Name = "plain-store"
+	err := cmd.processStoreCommand(hook, AfterTerraformDestroy)
+	assert.ErrorIs(t, err, errUtils.ErrStoreUnsupportedOp)
+
+	hook.Name = "nonexistent-store"
+	err = cmd.processStoreCommand(hook, AfterTerraformDestroy)
+	assert.ErrorIs(t, err, errUtils.ErrStoreNotFound)
+}
@@ -0,0 +1,49 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	e "github.com/cloudposse/atmos/internal/exec"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// Assert that WorkflowCommand implements Command interface.
+var _ Command = &WorkflowCommand{}
+
+// WorkflowCommand runs a named Atmos workflow in the stack of the component.
+type WorkflowCommand struct {
+	Name        string
+	atmosConfig *schema.AtmosConfiguration
+	info        *schema.ConfigAndStacksInfo
+}
+
+// NewWorkflowCommand creates a workflow hook command.
+func NewWorkflowCommand(atmosConfig *schema.AtmosConfiguration, info *schema.ConfigAndStacksInfo) (*WorkflowCommand, error) {
+	defer perf.Track(atmosConfig, "hooks.NewWorkflowCommand")()
+
+	return &WorkflowCommand{
+		Name:        CommandWorkflow,
+		atmosConfig: atmosConfig,
+		info:        info,
+	}, nil
+}
+
+func (c *WorkflowCommand) GetName() string {
+	return c.Name
+}
+
+// RunE runs the workflow. When the hook does not set `file`, the workflow is discovered across all workflow manifests.
+func (c *WorkflowCommand) RunE(hook *Hook, event HookEvent, cmd *cobra.Command, args []string) error {
+	defer perf.Track(c.atmosConfig, "hooks.WorkflowCommand.RunE")()
+
+	log.Debug("Executing workflow hook", "hook", hook.key, "event", event, "workflow", hook.Workflow)
+	if err := e.ExecuteWorkflowByName(c.atmosConfig, hook.Workflow, hook.File, c.info.Stack); err != nil {
+		return fmt.Errorf("%w: hook %q (event %q): %w", errUtils.ErrHookFailed, hook.key, event, err)
+	}
+	return nil
+}
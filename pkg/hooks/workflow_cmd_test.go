@@ -0,0 +1,45 @@
+package hooks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestWorkflowCommand_RunE(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("ATMOS_XDG_STATE_HOME", filepath.Join(dir, "state"))
+	out := filepath.Join(dir, "stack.txt")
+
+	workflowsDir := filepath.Join(dir, "workflows")
+	require.NoError(t, os.MkdirAll(workflowsDir, 0o755))
+	manifest := `
+workflows:
+  notify:
+    steps:
+      - type: shell
+        command: echo "$ATMOS_STACK" > ` + out + `
+`
+	require.NoError(t, os.WriteFile(filepath.Join(workflowsDir, "hooks.yaml"), []byte(manifest), 0o600))
+
+	atmosConfig := &schema.AtmosConfiguration{BasePath: dir, Workflows: schema.Workflows{BasePath: "workflows"}}
+	info := &schema.ConfigAndStacksInfo{ComponentFromArg: "vpc", Stack: "dev"}
+	cmd, err := NewWorkflowCommand(atmosConfig, info)
+	require.NoError(t, err)
+
+	// The workflow is discovered when the hook does not set the file.
+	require.NoError(t, cmd.RunE(&Hook{Command: "workflow", Workflow: "notify", key: "notify"}, AfterTerraformApply, nil, nil))
+	assert.FileExists(t, out)
+
+	require.NoError(t, cmd.RunE(&Hook{Command: "workflow", Workflow: "notify", File: "hooks", key: "notify"}, AfterTerraformApply, nil, nil))
+
+	err = cmd.RunE(&Hook{Command: "workflow", Workflow: "missing", key: "notify"}, AfterTerraformApply, nil, nil)
+	assert.ErrorIs(t, err, errUtils.ErrHookFailed)
+	assert.ErrorIs(t, err, errUtils.ErrWorkflowNoWorkflow)
+}
@@ -102,7 +102,7 @@ func findVarsFromComponents(stackMap map[string]any) map[string]any {
 }
 
 // getComponentTypes returns all component types to check.
-// Includes built-in types (terraform, helmfile, packer, ansible) plus any
+// Includes built-in types (terraform, helmfile, packer, ansible, pulumi, kustomize) plus any
 // additional types registered in the component registry.
 func getComponentTypes() []string {
 	defer perf.Track(nil, "extract.getComponentTypes")()
@@ -114,6 +114,8 @@ func getComponentTypes() []string {
 		config.HelmfileComponentType:  {},
 		config.PackerComponentType:    {},
 		config.AnsibleComponentType:   {},
+		config.PulumiComponentType:    {},
+		config.KustomizeComponentType: {},
 	}
 
 	// Add any additional types from the component registry.
@@ -41,6 +41,16 @@ func getStackComponents(stackData any) ([]string, error) {
 		allComponents = append(allComponents, lo.Keys(ansibleComponents)...)
 	}
 
+	// Extract pulumi components.
+	if pulumiComponents, ok := componentsMap["pulumi"].(map[string]any); ok {
+		allComponents = append(allComponents, lo.Keys(pulumiComponents)...)
+	}
+
+	// Extract kustomize components.
+	if kustomizeComponents, ok := componentsMap["kustomize"].(map[string]any); ok {
+		allComponents = append(allComponents, lo.Keys(kustomizeComponents)...)
+	}
+
 	// If no components found, return an error.
 	if len(allComponents) == 0 {
 		return nil, errUtils.ErrNoComponentsFound
@@ -0,0 +1,399 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/viper"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	backendTypeAzurerm = "azurerm"
+
+	// azurermSoftDeleteDays is the retention period for deleted blobs and containers.
+	azurermSoftDeleteDays = 7
+)
+
+// azurermSubscriptionEnvVars are the environment variables checked for the Azure subscription, in order.
+// These are the same variables honored by the Terraform azurerm provider and the Azure SDKs.
+var azurermSubscriptionEnvVars = []string{"ARM_SUBSCRIPTION_ID", "AZURE_SUBSCRIPTION_ID"}
+
+// AzureStorageAccountRef identifies an Azure storage account.
+type AzureStorageAccountRef struct {
+	SubscriptionID string
+	ResourceGroup  string
+	Name           string
+}
+
+// AzureStorageAccount describes a storage account to create.
+type AzureStorageAccount struct {
+	Location string
+	Tags     map[string]string
+}
+
+// AzureBlobServiceProperties are the blob service settings applied to a storage account.
+type AzureBlobServiceProperties struct {
+	VersioningEnabled            bool
+	DeleteRetentionDays          int
+	ContainerDeleteRetentionDays int
+}
+
+// AzureStorageAPI defines the interface for Azure storage provisioning operations.
+// This interface allows for mocking in tests.
+type AzureStorageAPI interface {
+	// ResourceGroupLocation returns the location of the resource group, or "" if it does not exist.
+	ResourceGroupLocation(ctx context.Context, subscriptionID, resourceGroup string) (string, error)
+	CreateResourceGroup(ctx context.Context, subscriptionID, resourceGroup, location string, tags map[string]string) error
+	StorageAccountExists(ctx context.Context, account AzureStorageAccountRef) (bool, error)
+	// CreateStorageAccount creates the account and waits for the operation to complete.
+	CreateStorageAccount(ctx context.Context, account AzureStorageAccountRef, spec AzureStorageAccount) error
+	SetBlobServiceProperties(ctx context.Context, account AzureStorageAccountRef, props AzureBlobServiceProperties) error
+	ContainerExists(ctx context.Context, account AzureStorageAccountRef, container string) (bool, error)
+	CreateContainer(ctx context.Context, account AzureStorageAccountRef, container string) error
+	DeleteContainer(ctx context.Context, account AzureStorageAccountRef, container string) error
+	// ListBlobs returns the names of all blobs in the container, including previous versions.
+	ListBlobs(ctx context.Context, account AzureStorageAccountRef, container string) ([]string, error)
+}
+
+// azurermTestMu protects test-only variables for concurrent test execution.
+var azurermTestMu sync.RWMutex
+
+// azureStorageClientFactory creates Azure storage clients from an auth context.
+// Override in tests to inject fake Azure clients.
+// Protected by azurermTestMu for thread-safe concurrent test execution.
+var azureStorageClientFactory = newAzureStorageClient
+
+// getAzureStorageClientFactory returns the current Azure storage client factory with thread-safe read access.
+func getAzureStorageClientFactory() func(*schema.AuthContext) (AzureStorageAPI, error) {
+	azurermTestMu.RLock()
+	defer azurermTestMu.RUnlock()
+	return azureStorageClientFactory
+}
+
+// SetAzureStorageClientFactory sets a custom Azure storage client factory for testing.
+func SetAzureStorageClientFactory(f func(*schema.AuthContext) (AzureStorageAPI, error)) {
+	defer perf.Track(nil, "backend.SetAzureStorageClientFactory")()
+
+	azurermTestMu.Lock()
+	defer azurermTestMu.Unlock()
+	azureStorageClientFactory = f
+}
+
+// ResetAzureStorageClientFactory resets the Azure storage client factory to default.
+func ResetAzureStorageClientFactory() {
+	defer perf.Track(nil, "backend.ResetAzureStorageClientFactory")()
+
+	azurermTestMu.Lock()
+	defer azurermTestMu.Unlock()
+	azureStorageClientFactory = newAzureStorageClient
+}
+
+// azurermConfig holds azurerm backend configuration.
+type azurermConfig struct {
+	account   AzureStorageAccountRef
+	container string
+}
+
+func init() {
+	// Register azurerm backend create function.
+	RegisterBackendCreate(backendTypeAzurerm, CreateAzurermBackend)
+	// Register azurerm backend delete function.
+	RegisterBackendDelete(backendTypeAzurerm, DeleteAzurermBackend)
+	// Register azurerm backend exists function.
+	RegisterBackendExists(backendTypeAzurerm, AzurermBackendExists)
+	// Register azurerm backend name function.
+	RegisterBackendName(backendTypeAzurerm, AzurermBackendName)
+}
+
+// AzurermBackendName returns the storage account and container from azurerm backend config.
+func AzurermBackendName(backendConfig map[string]any) string {
+	defer perf.Track(nil, "backend.AzurermBackendName")()
+
+	account, _ := backendConfig["storage_account_name"].(string)
+	container, _ := backendConfig["container_name"].(string)
+	if account == "" || container == "" {
+		return ""
+	}
+	return account + "/" + container
+}
+
+// CreateAzurermBackend creates an azurerm backend with opinionated, hardcoded defaults.
+//
+// Hardcoded features:
+// - Resource group: created when missing (tagged managed-by=atmos)
+// - Storage account: StorageV2, Standard_LRS, TLS 1.2, HTTPS only, no public blob access
+// - Blob versioning: ENABLED (always)
+// - Soft delete: ENABLED for blobs and containers, 7-day retention (always)
+// - Container: private access (always)
+//
+// The subscription comes from backend.subscription_id, the Atmos auth identity, or
+// ARM_SUBSCRIPTION_ID / AZURE_SUBSCRIPTION_ID. The location comes from the Atmos auth
+// identity or the existing resource group.
+// For production use, migrate to a dedicated Terraform module for the state storage account.
+func CreateAzurermBackend(
+	ctx context.Context,
+	atmosConfig *schema.AtmosConfiguration,
+	backendConfig map[string]any,
+	authContext *schema.AuthContext,
+) (*ProvisionResult, error) {
+	defer perf.Track(atmosConfig, "backend.CreateAzurermBackend")()
+
+	config, err := extractAzurermConfig(backendConfig, authContext)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := createAzureStorageClient(config, authContext)
+	if err != nil {
+		return nil, err
+	}
+
+	accountAlreadyExisted, err := ensureStorageAccount(ctx, client, config, authContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the storage account already existed, warnings are returned (not printed directly) to
+	// avoid concurrent output issues when running inside a spinner.
+	warnings, err := applyAzurermDefaults(ctx, client, config, accountAlreadyExisted)
+	if err != nil {
+		return nil, fmt.Errorf(errFormat, errUtils.ErrApplyBucketDefaults, err)
+	}
+
+	if err := ensureContainer(ctx, client, config); err != nil {
+		return nil, err
+	}
+
+	return &ProvisionResult{Warnings: warnings}, nil
+}
+
+// AzurermBackendExists checks if an azurerm backend container exists.
+// This function is registered in the backend registry and called during auto-provisioning
+// to check if the backend already exists before attempting to create it.
+func AzurermBackendExists(
+	ctx context.Context,
+	atmosConfig *schema.AtmosConfiguration,
+	backendConfig map[string]any,
+	authContext *schema.AuthContext,
+) (bool, error) {
+	defer perf.Track(atmosConfig, "backend.AzurermBackendExists")()
+
+	config, err := extractAzurermConfig(backendConfig, authContext)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := createAzureStorageClient(config, authContext)
+	if err != nil {
+		return false, err
+	}
+
+	exists, err := client.StorageAccountExists(ctx, config.account)
+	if err != nil || !exists {
+		return false, wrapAzurermCheckError(err, config)
+	}
+
+	exists, err = client.ContainerExists(ctx, config.account, config.container)
+	if err != nil {
+		return false, wrapAzurermCheckError(err, config)
+	}
+	return exists, nil
+}
+
+// extractAzurermConfig extracts and validates required azurerm configuration.
+func extractAzurermConfig(backendConfig map[string]any, authContext *schema.AuthContext) (*azurermConfig, error) {
+	account, ok := backendConfig["storage_account_name"].(string)
+	if !ok || account == "" {
+		return nil, fmt.Errorf("%w", errUtils.ErrStorageAccountRequired)
+	}
+
+	container, ok := backendConfig["container_name"].(string)
+	if !ok || container == "" {
+		return nil, fmt.Errorf("%w", errUtils.ErrAzureContainerRequired)
+	}
+
+	resourceGroup, ok := backendConfig["resource_group_name"].(string)
+	if !ok || resourceGroup == "" {
+		return nil, errUtils.Build(errUtils.ErrResourceGroupRequired).
+			WithExplanation("Provisioning an azurerm backend requires the resource group of the storage account").
+			WithHint("Set backend.resource_group_name in the component's backend configuration").
+			WithContext("storage_account_name", account).
+			Err()
+	}
+
+	subscriptionID := resolveAzureSubscription(backendConfig, authContext)
+	if subscriptionID == "" {
+		return nil, errUtils.Build(errUtils.ErrSubscriptionIDRequired).
+			WithExplanationf("Cannot provision storage account '%s' without an Azure subscription", account).
+			WithHint("Set backend.subscription_id, use an Atmos auth identity with a subscription, or set ARM_SUBSCRIPTION_ID").
+			WithContext("storage_account_name", account).
+			Err()
+	}
+
+	return &azurermConfig{
+		account: AzureStorageAccountRef{
+			SubscriptionID: subscriptionID,
+			ResourceGroup:  resourceGroup,
+			Name:           account,
+		},
+		container: container,
+	}, nil
+}
+
+// resolveAzureSubscription returns the subscription of the storage account.
+// Precedence: backend.subscription_id, then the Atmos auth identity, then the environment.
+func resolveAzureSubscription(backendConfig map[string]any, authContext *schema.AuthContext) string {
+	if subscriptionID, ok := backendConfig["subscription_id"].(string); ok && subscriptionID != "" {
+		return subscriptionID
+	}
+	if authContext != nil && authContext.Azure != nil && authContext.Azure.SubscriptionID != "" {
+		return authContext.Azure.SubscriptionID
+	}
+	v := viper.New()
+	// Best-effort bind; an unset subscription is reported by the caller.
+	_ = v.BindEnv(append([]string{"subscription"}, azurermSubscriptionEnvVars...)...)
+	return v.GetString("subscription")
+}
+
+// createAzureStorageClient creates an Azure storage client using the client factory (allows test injection).
+func createAzureStorageClient(config *azurermConfig, authContext *schema.AuthContext) (AzureStorageAPI, error) {
+	client, err := getAzureStorageClientFactory()(authContext)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCreateAzureClient).
+			WithCause(err).
+			WithHint("Check Azure credentials are configured correctly").
+			WithHint("If using --identity flag, ensure the identity is authenticated").
+			WithContext("storage_account_name", config.account.Name).
+			Err()
+	}
+	return client, nil
+}
+
+// wrapAzurermCheckError wraps an error from an existence check. Returns nil for a nil error.
+func wrapAzurermCheckError(err error, config *azurermConfig) error {
+	if err == nil {
+		return nil
+	}
+	return errUtils.Build(errUtils.ErrCheckBucketExist).
+		WithCause(err).
+		WithHint("Check that your Azure identity has the Reader role on the resource group").
+		WithContext("storage_account_name", config.account.Name).
+		WithContext("container_name", config.container).
+		WithContext("resource_group_name", config.account.ResourceGroup).
+		Err()
+}
+
+// ensureStorageAccount checks if the storage account exists and creates it (and its resource group) if needed.
+// Returns (true, nil) if the account already existed, (false, nil) if it was created, (_, error) on failure.
+func ensureStorageAccount(ctx context.Context, client AzureStorageAPI, config *azurermConfig, authContext *schema.AuthContext) (bool, error) {
+	exists, err := client.StorageAccountExists(ctx, config.account)
+	if err != nil {
+		return false, wrapAzurermCheckError(err, config)
+	}
+	if exists {
+		return true, nil
+	}
+
+	location, err := ensureResourceGroup(ctx, client, config, authContext)
+	if err != nil {
+		return false, err
+	}
+
+	spec := AzureStorageAccount{Location: location, Tags: map[string]string{"managed-by": "atmos"}}
+	if err := client.CreateStorageAccount(ctx, config.account, spec); err != nil {
+		return false, errUtils.Build(errUtils.ErrCreateStorageAccount).
+			WithCause(err).
+			WithHint("Storage account names must be globally unique, 3-24 characters, lowercase letters and numbers only").
+			WithHint("Check that your Azure identity has the Contributor role on the resource group").
+			WithContext("storage_account_name", config.account.Name).
+			WithContext("location", location).
+			Err()
+	}
+	return false, nil
+}
+
+// ensureResourceGroup returns the location for a new storage account, creating the resource group if needed.
+func ensureResourceGroup(ctx context.Context, client AzureStorageAPI, config *azurermConfig, authContext *schema.AuthContext) (string, error) {
+	groupLocation, err := client.ResourceGroupLocation(ctx, config.account.SubscriptionID, config.account.ResourceGroup)
+	if err != nil {
+		return "", wrapAzurermCheckError(err, config)
+	}
+
+	location := groupLocation
+	if authContext != nil && authContext.Azure != nil && authContext.Azure.Location != "" {
+		location = authContext.Azure.Location
+	}
+	if location == "" {
+		return "", errUtils.Build(errUtils.ErrAzureLocationRequired).
+			WithExplanationf("Resource group '%s' does not exist and no location is configured", config.account.ResourceGroup).
+			WithHint("Create the resource group first, or use an Atmos auth identity with a location").
+			WithContext("resource_group_name", config.account.ResourceGroup).
+			Err()
+	}
+
+	if groupLocation != "" {
+		return location, nil
+	}
+
+	tags := map[string]string{"managed-by": "atmos"}
+	if err := client.CreateResourceGroup(ctx, config.account.SubscriptionID, config.account.ResourceGroup, location, tags); err != nil {
+		return "", errUtils.Build(errUtils.ErrCreateResourceGroup).
+			WithCause(err).
+			WithHint("Check that your Azure identity has the Contributor role on the subscription").
+			WithContext("resource_group_name", config.account.ResourceGroup).
+			WithContext("location", location).
+			Err()
+	}
+	return location, nil
+}
+
+// applyAzurermDefaults enables blob versioning and soft delete on the storage account.
+//
+// Settings are always applied, even for existing accounts. If the account already existed,
+// warnings are returned to inform the user that existing settings are being modified.
+func applyAzurermDefaults(ctx context.Context, client AzureStorageAPI, config *azurermConfig, alreadyExisted bool) ([]string, error) {
+	var warnings []string
+	if alreadyExisted {
+		warnings = append(warnings, fmt.Sprintf("Applying Atmos defaults to existing storage account `%s`\n\n"+
+			"- Blob versioning will be ENABLED\n"+
+			"- Soft delete for blobs will be ENABLED with %d-day retention\n"+
+			"- Soft delete for containers will be ENABLED with %d-day retention",
+			config.account.Name, azurermSoftDeleteDays, azurermSoftDeleteDays))
+	}
+
+	props := AzureBlobServiceProperties{
+		VersioningEnabled:            true,
+		DeleteRetentionDays:          azurermSoftDeleteDays,
+		ContainerDeleteRetentionDays: azurermSoftDeleteDays,
+	}
+	if err := client.SetBlobServiceProperties(ctx, config.account, props); err != nil {
+		return nil, fmt.Errorf(errFormat, errUtils.ErrEnableSoftDelete, err)
+	}
+
+	return warnings, nil
+}
+
+// ensureContainer creates the blob container if it does not exist.
+func ensureContainer(ctx context.Context, client AzureStorageAPI, config *azurermConfig) error {
+	exists, err := client.ContainerExists(ctx, config.account, config.container)
+	if err != nil {
+		return wrapAzurermCheckError(err, config)
+	}
+	if exists {
+		return nil
+	}
+
+	if err := client.CreateContainer(ctx, config.account, config.container); err != nil {
+		return errUtils.Build(errUtils.ErrCreateContainer).
+			WithCause(err).
+			WithContext("storage_account_name", config.account.Name).
+			WithContext("container_name", config.container).
+			Err()
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	azureCloud "github.com/cloudposse/atmos/pkg/auth/cloud/azure"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// The Azure Resource Manager API versions used by the provisioner.
+const (
+	azureResourcesAPIVersion = "2021-04-01"
+	azureStorageAPIVersion   = "2023-05-01"
+)
+
+// azureCloudConfigs maps Atmos cloud environment names to Azure SDK cloud configurations.
+var azureCloudConfigs = map[string]cloud.Configuration{
+	"public":       cloud.AzurePublic,
+	"usgovernment": cloud.AzureGovernment,
+	"china":        cloud.AzureChina,
+}
+
+// azureStorageClient implements AzureStorageAPI using the Azure Resource Manager REST API
+// for management operations and the Blob service for listing blobs.
+type azureStorageClient struct {
+	arm        *arm.Client
+	credential azcore.TokenCredential
+	blobSuffix string
+}
+
+// newAzureStorageClient creates an Azure storage client for the cloud environment of the auth context.
+func newAzureStorageClient(authContext *schema.AuthContext) (AzureStorageAPI, error) {
+	environment := ""
+	if authContext != nil && authContext.Azure != nil {
+		environment = authContext.Azure.CloudEnvironment
+	}
+	cloudEnv := azureCloud.GetCloudEnvironment(environment)
+	clientOptions := policy.ClientOptions{Cloud: azureCloudConfigs[cloudEnv.Name]}
+
+	credential, err := newAzureCredential(authContext, clientOptions)
+	if err != nil {
+		return nil, fmt.Errorf(errFormat, errUtils.ErrCreateAzureCredential, err)
+	}
+
+	// Telemetry is disabled because the provisioner is not a versioned Azure SDK module.
+	clientOptions.Telemetry.Disabled = true
+	armClient, err := arm.NewClient("atmos/backend", "", credential, &arm.ClientOptions{ClientOptions: clientOptions})
+	if err != nil {
+		return nil, err
+	}
+
+	return &azureStorageClient{arm: armClient, credential: credential, blobSuffix: cloudEnv.BlobStorageSuffix}, nil
+}
+
+// newAzureCredential returns the credential for the Atmos auth identity, or the default Azure credential chain.
+func newAzureCredential(authContext *schema.AuthContext, clientOptions policy.ClientOptions) (azcore.TokenCredential, error) {
+	if authContext == nil || authContext.Azure == nil {
+		return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{ClientOptions: clientOptions})
+	}
+
+	azureCtx := authContext.Azure
+	if azureCtx.UseOIDC && azureCtx.ClientID != "" && azureCtx.TenantID != "" {
+		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientOptions: clientOptions,
+			ClientID:      azureCtx.ClientID,
+			TenantID:      azureCtx.TenantID,
+			TokenFilePath: azureCtx.TokenFilePath,
+		})
+	}
+
+	return azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+		ClientOptions: clientOptions,
+		TenantID:      azureCtx.TenantID,
+	})
+}
+
+// resourceGroupURL returns the ARM URL of a resource group.
+func (c *azureStorageClient) resourceGroupURL(subscriptionID, resourceGroup string) string {
+	return fmt.Sprintf("%s/subscriptions/%s/resourcegroups/%s?api-version=%s",
+		c.arm.Endpoint(), url.PathEscape(subscriptionID), url.PathEscape(resourceGroup), azureResourcesAPIVersion)
+}
+
+// storageAccountURL returns the ARM URL of a storage account, or of a sub-resource when suffix is set.
+func (c *azureStorageClient) storageAccountURL(account AzureStorageAccountRef, suffix string) string {
+	return fmt.Sprintf("%s/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s%s?api-version=%s",
+		c.arm.Endpoint(), url.PathEscape(account.SubscriptionID), url.PathEscape(account.ResourceGroup),
+		url.PathEscape(account.Name), suffix, azureStorageAPIVersion)
+}
+
+// containerURL returns the ARM URL of a blob container.
+func (c *azureStorageClient) containerURL(account AzureStorageAccountRef, name string) string {
+	return c.storageAccountURL(account, "/blobServices/default/containers/"+url.PathEscape(name))
+}
+
+// do sends an ARM request and returns the response if its status code is one of the expected codes.
+func (c *azureStorageClient) do(ctx context.Context, method, endpoint string, body any, statusCodes ...int) (*http.Response, error) {
+	req, err := runtime.NewRequest(ctx, method, endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		if err := runtime.MarshalAsJSON(req, body); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := c.arm.Pipeline().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if !runtime.HasStatusCode(resp, statusCodes...) {
+		return nil, runtime.NewResponseError(resp)
+	}
+	return resp, nil
+}
+
+// exists sends a GET request and reports whether the resource exists.
+func (c *azureStorageClient) exists(ctx context.Context, endpoint string) (*http.Response, bool, error) {
+	resp, err := c.do(ctx, http.MethodGet, endpoint, nil, http.StatusOK, http.StatusNotFound)
+	if err != nil {
+		return nil, false, err
+	}
+	return resp, resp.StatusCode == http.StatusOK, nil
+}
+
+func (c *azureStorageClient) ResourceGroupLocation(ctx context.Context, subscriptionID, resourceGroup string) (string, error) {
+	defer perf.Track(nil, "backend.azureStorageClient.ResourceGroupLocation")()
+
+	resp, found, err := c.exists(ctx, c.resourceGroupURL(subscriptionID, resourceGroup))
+	if err != nil || !found {
+		return "", err
+	}
+	var group struct {
+		Location string `json:"location"`
+	}
+	if err := runtime.UnmarshalAsJSON(resp, &group); err != nil {
+		return "", err
+	}
+	return group.Location, nil
+}
+
+func (c *azureStorageClient) CreateResourceGroup(ctx context.Context, subscriptionID, resourceGroup, location string, tags map[string]string) error {
+	defer perf.Track(nil, "backend.azureStorageClient.CreateResourceGroup")()
+
+	body := map[string]any{"location": location, "tags": tags}
+	_, err := c.do(ctx, http.MethodPut, c.resourceGroupURL(subscriptionID, resourceGroup), body, http.StatusOK, http.StatusCreated)
+	return err
+}
+
+func (c *azureStorageClient) StorageAccountExists(ctx context.Context, account AzureStorageAccountRef) (bool, error) {
+	defer perf.Track(nil, "backend.azureStorageClient.StorageAccountExists")()
+
+	_, found, err := c.exists(ctx, c.storageAccountURL(account, ""))
+	return found, err
+}
+
+func (c *azureStorageClient) CreateStorageAccount(ctx context.Context, account AzureStorageAccountRef, spec AzureStorageAccount) error {
+	defer perf.Track(nil, "backend.azureStorageClient.CreateStorageAccount")()
+
+	body := map[string]any{
+		"location": spec.Location,
+		"kind":     "StorageV2",
+		"sku":      map[string]any{"name": "Standard_LRS"},
+		"tags":     spec.Tags,
+		"properties": map[string]any{
+			"minimumTlsVersion":        "TLS1_2",
+			"allowBlobPublicAccess":    false,
+			"supportsHttpsTrafficOnly": true,
+		},
+	}
+	resp, err := c.do(ctx, http.MethodPut, c.storageAccountURL(account, ""), body, http.StatusOK, http.StatusAccepted)
+	if err != nil {
+		return err
+	}
+
+	// Storage account creation is a long-running operation.
+	poller, err := runtime.NewPoller[map[string]any](resp, c.arm.Pipeline(), nil)
+	if err != nil {
+		return err
+	}
+	_, err = poller.PollUntilDone(ctx, nil)
+	return err
+}
+
+func (c *azureStorageClient) SetBlobServiceProperties(ctx context.Context, account AzureStorageAccountRef, props AzureBlobServiceProperties) error {
+	defer perf.Track(nil, "backend.azureStorageClient.SetBlobServiceProperties")()
+
+	body := map[string]any{
+		"properties": map[string]any{
+			"isVersioningEnabled": props.VersioningEnabled,
+			"deleteRetentionPolicy": map[string]any{
+				"enabled": props.DeleteRetentionDays > 0,
+				"days":    props.DeleteRetentionDays,
+			},
+			"containerDeleteRetentionPolicy": map[string]any{
+				"enabled": props.ContainerDeleteRetentionDays > 0,
+				"days":    props.ContainerDeleteRetentionDays,
+			},
+		},
+	}
+	_, err := c.do(ctx, http.MethodPut, c.storageAccountURL(account, "/blobServices/default"), body, http.StatusOK)
+	return err
+}
+
+func (c *azureStorageClient) ContainerExists(ctx context.Context, account AzureStorageAccountRef, name string) (bool, error) {
+	defer perf.Track(nil, "backend.azureStorageClient.ContainerExists")()
+
+	_, found, err := c.exists(ctx, c.containerURL(account, name))
+	return found, err
+}
+
+func (c *azureStorageClient) CreateContainer(ctx context.Context, account AzureStorageAccountRef, name string) error {
+	defer perf.Track(nil, "backend.azureStorageClient.CreateContainer")()
+
+	body := map[string]any{"properties": map[string]any{"publicAccess": "None"}}
+	_, err := c.do(ctx, http.MethodPut, c.containerURL(account, name), body, http.StatusOK, http.StatusCreated)
+	return err
+}
+
+func (c *azureStorageClient) DeleteContainer(ctx context.Context, account AzureStorageAccountRef, name string) error {
+	defer perf.Track(nil, "backend.azureStorageClient.DeleteContainer")()
+
+	_, err := c.do(ctx, http.MethodDelete, c.containerURL(account, name), nil, http.StatusOK, http.StatusNoContent)
+	return err
+}
+
+func (c *azureStorageClient) ListBlobs(ctx context.Context, account AzureStorageAccountRef, name string) ([]string, error) {
+	defer perf.Track(nil, "backend.azureStorageClient.ListBlobs")()
+
+	client, err := container.NewClient(fmt.Sprintf("https://%s.%s/%s", account.Name, c.blobSuffix, name), c.credential, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var blobs []string
+	pager := client.NewListBlobsFlatPager(&container.ListBlobsFlatOptions{
+		Include: container.ListBlobsInclude{Versions: true},
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range page.Segment.BlobItems {
+			if item.Name != nil {
+				blobs = append(blobs, *item.Name)
+			}
+		}
+	}
+	return blobs, nil
+}
@@ -0,0 +1,106 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/ui"
+)
+
+// DeleteAzurermBackend deletes an azurerm backend container and all its blobs.
+//
+// Safety mechanisms match DeleteS3Backend: force=true is required, all blobs and versions
+// are listed first, .tfstate files are counted, and the user is warned about data loss
+// before the container is deleted.
+//
+// Only the container is deleted. The storage account and resource group may hold other
+// containers and are left in place. With container soft delete enabled (the Atmos default),
+// the container can be restored for 7 days.
+func DeleteAzurermBackend(
+	ctx context.Context,
+	atmosConfig *schema.AtmosConfiguration,
+	backendConfig map[string]any,
+	authContext *schema.AuthContext,
+	force bool,
+) error {
+	defer perf.Track(atmosConfig, "backend.DeleteAzurermBackend")()
+
+	if !force {
+		return errForceRequired()
+	}
+
+	config, err := extractAzurermConfig(backendConfig, authContext)
+	if err != nil {
+		return err
+	}
+
+	ui.Info(fmt.Sprintf("Deleting azurerm backend: storage_account=%s container=%s", config.account.Name, config.container))
+
+	client, err := createAzureStorageClient(config, authContext)
+	if err != nil {
+		return err
+	}
+
+	if err := validateContainerExistsForDeletion(ctx, client, config); err != nil {
+		return err
+	}
+
+	blobs, err := client.ListBlobs(ctx, config.account, config.container)
+	if err != nil {
+		return errUtils.Build(errUtils.ErrListObjects).
+			WithCause(err).
+			WithExplanation("Failed to list blobs in container").
+			WithContext("storage_account_name", config.account.Name).
+			WithContext("container_name", config.container).
+			WithHint("Check that your Azure identity has the Storage Blob Data Reader role on the storage account").
+			Err()
+	}
+
+	if len(blobs) > 0 {
+		stateFileCount := 0
+		for _, name := range blobs {
+			if strings.HasSuffix(name, ".tfstate") {
+				stateFileCount++
+			}
+		}
+		showDeletionWarning(config.container, len(blobs), stateFileCount)
+	}
+
+	if err := client.DeleteContainer(ctx, config.account, config.container); err != nil {
+		return errUtils.Build(errUtils.ErrDeleteContainer).
+			WithCause(err).
+			WithExplanation("Failed to delete blob container").
+			WithContext("storage_account_name", config.account.Name).
+			WithContext("container_name", config.container).
+			WithHint("Check that your Azure identity has the Contributor role on the storage account").
+			Err()
+	}
+
+	ui.Success(fmt.Sprintf("✓ Backend deleted: container '%s' and all contents removed from storage account '%s'",
+		config.container, config.account.Name))
+	return nil
+}
+
+// validateContainerExistsForDeletion checks if the storage account and container exist before deletion.
+func validateContainerExistsForDeletion(ctx context.Context, client AzureStorageAPI, config *azurermConfig) error {
+	exists, err := client.StorageAccountExists(ctx, config.account)
+	if err == nil && exists {
+		exists, err = client.ContainerExists(ctx, config.account, config.container)
+	}
+	if err != nil {
+		return wrapAzurermCheckError(err, config)
+	}
+	if !exists {
+		return errUtils.Build(errUtils.ErrBackendNotFound).
+			WithExplanation("Cannot delete backend - container does not exist").
+			WithContext("storage_account_name", config.account.Name).
+			WithContext("container_name", config.container).
+			WithHint("Verify the storage account and container names in your backend configuration").
+			Err()
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// fakeAzureStorageClient is an in-memory AzureStorageAPI implementation.
+type fakeAzureStorageClient struct {
+	resourceGroups map[string]string
+	accounts       map[string]AzureStorageAccount
+	containers     map[string]bool
+	blobs          []string
+	blobProps      *AzureBlobServiceProperties
+	checkErr       error
+	createErr      error
+	propsErr       error
+	listErr        error
+}
+
+func newFakeAzureStorageClient() *fakeAzureStorageClient {
+	return &fakeAzureStorageClient{
+		resourceGroups: map[string]string{},
+		accounts:       map[string]AzureStorageAccount{},
+		containers:     map[string]bool{},
+	}
+}
+
+func (f *fakeAzureStorageClient) ResourceGroupLocation(_ context.Context, _, resourceGroup string) (string, error) {
+	return f.resourceGroups[resourceGroup], f.checkErr
+}
+
+func (f *fakeAzureStorageClient) CreateResourceGroup(_ context.Context, _, resourceGroup, location string, _ map[string]string) error {
+	f.resourceGroups[resourceGroup] = location
+	return nil
+}
+
+func (f *fakeAzureStorageClient) StorageAccountExists(_ context.Context, account AzureStorageAccountRef) (bool, error) {
+	_, ok := f.accounts[account.Name]
+	return ok, f.checkErr
+}
+
+func (f *fakeAzureStorageClient) CreateStorageAccount(_ context.Context, account AzureStorageAccountRef, spec AzureStorageAccount) error {
+	if f.createErr != nil {
+		return f.createErr
+	}
+	f.accounts[account.Name] = spec
+	return nil
+}
+
+func (f *fakeAzureStorageClient) SetBlobServiceProperties(_ context.Context, _ AzureStorageAccountRef, props AzureBlobServiceProperties) error {
+	if f.propsErr != nil {
+		return f.propsErr
+	}
+	f.blobProps = &props
+	return nil
+}
+
+func (f *fakeAzureStorageClient) ContainerExists(_ context.Context, _ AzureStorageAccountRef, container string) (bool, error) {
+	return f.containers[container], f.checkErr
+}
+
+func (f *fakeAzureStorageClient) CreateContainer(_ context.Context, _ AzureStorageAccountRef, container string) error {
+	f.containers[container] = true
+	return nil
+}
+
+func (f *fakeAzureStorageClient) DeleteContainer(_ context.Context, _ AzureStorageAccountRef, container string) error {
+	delete(f.containers, container)
+	return nil
+}
+
+func (f *fakeAzureStorageClient) ListBlobs(context.Context, AzureStorageAccountRef, string) ([]string, error) {
+	return f.blobs, f.listErr
+}
+
+// useFakeAzureStorageClient injects the fake client for the duration of the test.
+func useFakeAzureStorageClient(t *testing.T, client *fakeAzureStorageClient) {
+	t.Helper()
+	SetAzureStorageClientFactory(func(*schema.AuthContext) (AzureStorageAPI, error) {
+		return client, nil
+	})
+	t.Cleanup(ResetAzureStorageClientFactory)
+}
+
+func azurermBackendConfig() map[string]any {
+	return map[string]any{
+		"storage_account_name": "atmosstate",
+		"container_name":       "tfstate",
+		"resource_group_name":  "rg-state",
+		"subscription_id":      "00000000-0000-0000-0000-000000000000",
+	}
+}
+
+func TestAzurermProvisionerRegistration(t *testing.T) {
+	// Other tests may have cleared the registry, so we can't rely on init().
+	ResetRegistryForTesting()
+	RegisterBackendCreate(backendTypeAzurerm, CreateAzurermBackend)
+	RegisterBackendDelete(backendTypeAzurerm, DeleteAzurermBackend)
+	RegisterBackendExists(backendTypeAzurerm, AzurermBackendExists)
+	RegisterBackendName(backendTypeAzurerm, AzurermBackendName)
+
+	assert.NotNil(t, GetBackendCreate(backendTypeAzurerm))
+	assert.NotNil(t, GetBackendDelete(backendTypeAzurerm))
+	assert.NotNil(t, GetBackendExists(backendTypeAzurerm))
+	assert.Equal(t, "atmosstate/tfstate", GetBackendName(backendTypeAzurerm)(azurermBackendConfig()))
+}
+
+func TestExtractAzurermConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		remove    string
+		expectErr error
+	}{
+		{name: "storage account required", remove: "storage_account_name", expectErr: errUtils.ErrStorageAccountRequired},
+		{name: "container required", remove: "container_name", expectErr: errUtils.ErrAzureContainerRequired},
+		{name: "resource group required", remove: "resource_group_name", expectErr: errUtils.ErrResourceGroupRequired},
+		{name: "subscription required", remove: "subscription_id", expectErr: errUtils.ErrSubscriptionIDRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range azurermSubscriptionEnvVars {
+				t.Setenv(name, "")
+			}
+			backendConfig := azurermBackendConfig()
+			delete(backendConfig, tt.remove)
+
+			_, err := extractAzurermConfig(backendConfig, nil)
+			assert.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
+
+func TestResolveAzureSubscription(t *testing.T) {
+	t.Setenv("ARM_SUBSCRIPTION_ID", "")
+	t.Setenv("AZURE_SUBSCRIPTION_ID", "from-env")
+	assert.Equal(t, "from-env", resolveAzureSubscription(map[string]any{}, nil))
+
+	authContext := &schema.AuthContext{Azure: &schema.AzureAuthContext{SubscriptionID: "from-identity"}}
+	assert.Equal(t, "from-identity", resolveAzureSubscription(map[string]any{}, authContext))
+	assert.Equal(t, "from-backend", resolveAzureSubscription(map[string]any{"subscription_id": "from-backend"}, authContext))
+}
+
+func TestCreateAzurermBackend_NewAccount(t *testing.T) {
+	client := newFakeAzureStorageClient()
+	useFakeAzureStorageClient(t, client)
+
+	authContext := &schema.AuthContext{Azure: &schema.AzureAuthContext{Location: "eastus2"}}
+	result, err := CreateAzurermBackend(context.Background(), nil, azurermBackendConfig(), authContext)
+	require.NoError(t, err)
+	assert.Empty(t, result.Warnings)
+
+	assert.Equal(t, "eastus2", client.resourceGroups["rg-state"])
+	require.Contains(t, client.accounts, "atmosstate")
+	assert.Equal(t, "eastus2", client.accounts["atmosstate"].Location)
+	assert.Equal(t, "atmos", client.accounts["atmosstate"].Tags["managed-by"])
+	require.NotNil(t, client.blobProps)
+	assert.True(t, client.blobProps.VersioningEnabled)
+	assert.Equal(t, azurermSoftDeleteDays, client.blobProps.DeleteRetentionDays)
+	assert.Equal(t, azurermSoftDeleteDays, client.blobProps.ContainerDeleteRetentionDays)
+	assert.True(t, client.containers["tfstate"])
+}
+
+func TestCreateAzurermBackend_LocationFromResourceGroup(t *testing.T) {
+	client := newFakeAzureStorageClient()
+	client.resourceGroups["rg-state"] = "westeurope"
+	useFakeAzureStorageClient(t, client)
+
+	_, err := CreateAzurermBackend(context.Background(), nil, azurermBackendConfig(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "westeurope", client.accounts["atmosstate"].Location)
+}
+
+func TestCreateAzurermBackend_LocationRequired(t *testing.T) {
+	client := newFakeAzureStorageClient()
+	useFakeAzureStorageClient(t, client)
+
+	_, err := CreateAzurermBackend(context.Background(), nil, azurermBackendConfig(), nil)
+	assert.ErrorIs(t, err, errUtils.ErrAzureLocationRequired)
+	assert.Empty(t, client.resourceGroups)
+}
+
+func TestCreateAzurermBackend_ExistingAccount(t *testing.T) {
+	client := newFakeAzureStorageClient()
+	client.accounts["atmosstate"] = AzureStorageAccount{}
+	useFakeAzureStorageClient(t, client)
+
+	result, err := CreateAzurermBackend(context.Background(), nil, azurermBackendConfig(), nil)
+	require.NoError(t, err)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "Applying Atmos defaults to existing storage account `atmosstate`")
+	require.NotNil(t, client.blobProps)
+	assert.True(t, client.containers["tfstate"])
+}
+
+func TestCreateAzurermBackend_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		setup     func(*fakeAzureStorageClient)
+		expectErr error
+	}{
+		{
+			name:      "check fails",
+			setup:     func(f *fakeAzureStorageClient) { f.checkErr = errors.New("forbidden") },
+			expectErr: errUtils.ErrCheckBucketExist,
+		},
+		{
+			name: "create fails",
+			setup: func(f *fakeAzureStorageClient) {
+				f.resourceGroups["rg-state"] = "eastus"
+				f.createErr = errors.New("name taken")
+			},
+			expectErr: errUtils.ErrCreateStorageAccount,
+		},
+		{
+			name: "soft delete fails",
+			setup: func(f *fakeAzureStorageClient) {
+				f.accounts["atmosstate"] = AzureStorageAccount{}
+				f.propsErr = errors.New("forbidden")
+			},
+			expectErr: errUtils.ErrEnableSoftDelete,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeAzureStorageClient()
+			tt.setup(client)
+			useFakeAzureStorageClient(t, client)
+
+			_, err := CreateAzurermBackend(context.Background(), nil, azurermBackendConfig(), nil)
+			assert.ErrorIs(t, err, tt.expectErr)
+		})
+	}
+}
+
+func TestAzurermBackendExists(t *testing.T) {
+	client := newFakeAzureStorageClient()
+	useFakeAzureStorageClient(t, client)
+
+	exists, err := AzurermBackendExists(context.Background(), nil, azurermBackendConfig(), nil)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	client.accounts["atmosstate"] = AzureStorageAccount{}
+	exists, err = AzurermBackendExists(context.Background(), nil, azurermBackendConfig(), nil)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	client.containers["tfstate"] = true
+	exists, err = AzurermBackendExists(context.Background(), nil, azurermBackendConfig(), nil)
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestDeleteAzurermBackend_ForceRequired(t *testing.T) {
+	err := DeleteAzurermBackend(context.Background(), nil, azurermBackendConfig(), nil, false)
+	assert.ErrorIs(t, err, errUtils.ErrForceRequired)
+}
+
+func TestDeleteAzurermBackend_NotFound(t *testing.T) {
+	client := newFakeAzureStorageClient()
+	client.accounts["atmosstate"] = AzureStorageAccount{}
+	useFakeAzureStorageClient(t, client)
+
+	err := DeleteAzurermBackend(context.Background(), nil, azurermBackendConfig(), nil, true)
+	assert.ErrorIs(t, err, errUtils.ErrBackendNotFound)
+}
+
+func TestDeleteAzurermBackend_DeletesContainer(t *testing.T) {
+	client := newFakeAzureStorageClient()
+	client.accounts["atmosstate"] = AzureStorageAccount{}
+	client.containers["tfstate"] = true
+	client.blobs = []string{"dev.terraform.tfstate", "dev.terraform.tfstate", "prod.terraform.tfstate"}
+	useFakeAzureStorageClient(t, client)
+
+	err := DeleteAzurermBackend(context.Background(), nil, azurermBackendConfig(), nil, true)
+	require.NoError(t, err)
+	assert.NotContains(t, client.containers, "tfstate")
+	assert.Contains(t, client.accounts, "atmosstate")
+}
+
+func TestDeleteAzurermBackend_ListFails(t *testing.T) {
+	client := newFakeAzureStorageClient()
+	client.accounts["atmosstate"] = AzureStorageAccount{}
+	client.containers["tfstate"] = true
+	client.listErr = errors.New("missing data plane role")
+	useFakeAzureStorageClient(t, client)
+
+	err := DeleteAzurermBackend(context.Background(), nil, azurermBackendConfig(), nil, true)
+	assert.ErrorIs(t, err, errUtils.ErrListObjects)
+	assert.True(t, client.containers["tfstate"])
+}
@@ -0,0 +1,410 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"cloud.google.com/go/storage"
+	"github.com/spf13/viper"
+	"golang.org/x/oauth2"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/internal/gcp"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+const (
+	backendTypeGCS = "gcs"
+
+	// defaultGCSLocation is the bucket location used when neither the auth context nor the environment specify one.
+	defaultGCSLocation = "US"
+)
+
+// gcsProjectEnvVars are the environment variables checked for the GCP project, in order.
+// These are the same variables honored by the Terraform Google provider and gcloud.
+var gcsProjectEnvVars = []string{"GOOGLE_CLOUD_PROJECT", "GOOGLE_PROJECT", "CLOUDSDK_CORE_PROJECT"}
+
+// GCSObjectVersion identifies a single generation of a GCS object.
+type GCSObjectVersion struct {
+	Name       string
+	Generation int64
+}
+
+// GCSClientAPI defines the interface for GCS bucket operations.
+// This interface allows for mocking in tests.
+type GCSClientAPI interface {
+	// BucketAttrs returns the bucket attributes, or storage.ErrBucketNotExist if the bucket does not exist.
+	BucketAttrs(ctx context.Context, bucket string) (*storage.BucketAttrs, error)
+	CreateBucket(ctx context.Context, bucket, projectID string, attrs *storage.BucketAttrs) error
+	UpdateBucket(ctx context.Context, bucket string, attrs *storage.BucketAttrsToUpdate) error
+	// ListObjectVersions returns all generations of all objects in the bucket.
+	ListObjectVersions(ctx context.Context, bucket string) ([]GCSObjectVersion, error)
+	DeleteObjectVersion(ctx context.Context, bucket string, object GCSObjectVersion) error
+	DeleteBucket(ctx context.Context, bucket string) error
+}
+
+// gcsTestMu protects test-only variables for concurrent test execution.
+var gcsTestMu sync.RWMutex
+
+// gcsClientFactory creates GCS clients from client options.
+// Override in tests to inject fake GCS clients.
+// Protected by gcsTestMu for thread-safe concurrent test execution.
+var gcsClientFactory = newGCSClient
+
+// getGCSClientFactory returns the current GCS client factory with thread-safe read access.
+func getGCSClientFactory() func(context.Context, ...option.ClientOption) (GCSClientAPI, error) {
+	gcsTestMu.RLock()
+	defer gcsTestMu.RUnlock()
+	return gcsClientFactory
+}
+
+// SetGCSClientFactory sets a custom GCS client factory for testing.
+func SetGCSClientFactory(f func(context.Context, ...option.ClientOption) (GCSClientAPI, error)) {
+	defer perf.Track(nil, "backend.SetGCSClientFactory")()
+
+	gcsTestMu.Lock()
+	defer gcsTestMu.Unlock()
+	gcsClientFactory = f
+}
+
+// ResetGCSClientFactory resets the GCS client factory to default.
+func ResetGCSClientFactory() {
+	defer perf.Track(nil, "backend.ResetGCSClientFactory")()
+
+	gcsTestMu.Lock()
+	defer gcsTestMu.Unlock()
+	gcsClientFactory = newGCSClient
+}
+
+// gcsConfig holds GCS backend configuration.
+type gcsConfig struct {
+	bucket           string
+	credentials      string
+	kmsEncryptionKey string
+}
+
+func init() {
+	// Register GCS backend create function.
+	RegisterBackendCreate(backendTypeGCS, CreateGCSBackend)
+	// Register GCS backend delete function.
+	RegisterBackendDelete(backendTypeGCS, DeleteGCSBackend)
+	// Register GCS backend exists function.
+	RegisterBackendExists(backendTypeGCS, GCSBackendExists)
+	// Register GCS backend name function.
+	RegisterBackendName(backendTypeGCS, GCSBackendName)
+}
+
+// GCSBackendName returns the bucket name from GCS backend config.
+func GCSBackendName(backendConfig map[string]any) string {
+	defer perf.Track(nil, "backend.GCSBackendName")()
+
+	if bucket, ok := backendConfig["bucket"].(string); ok && bucket != "" {
+		return bucket
+	}
+	return ""
+}
+
+// CreateGCSBackend creates a GCS backend with opinionated, hardcoded defaults.
+//
+// Hardcoded features:
+// - Versioning: ENABLED (always)
+// - Uniform bucket-level access: ENABLED (always)
+// - Public access prevention: ENFORCED (always)
+// - Encryption: Google-managed keys, or CMEK when backend.kms_encryption_key is set
+// - Labels: Standard label (managed-by=atmos, always)
+//
+// The bucket is created in the project of the Atmos auth identity, or the project from
+// GOOGLE_CLOUD_PROJECT, GOOGLE_PROJECT or CLOUDSDK_CORE_PROJECT. The location comes from the
+// auth identity, defaulting to the US multi-region.
+// For production use, migrate to the terraform-google-modules cloud-storage module.
+func CreateGCSBackend(
+	ctx context.Context,
+	atmosConfig *schema.AtmosConfiguration,
+	backendConfig map[string]any,
+	authContext *schema.AuthContext,
+) (*ProvisionResult, error) {
+	defer perf.Track(atmosConfig, "backend.CreateGCSBackend")()
+
+	config, err := extractGCSConfig(backendConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := createGCSClient(ctx, config, authContext)
+	if err != nil {
+		return nil, err
+	}
+
+	bucketAlreadyExisted, err := ensureGCSBucket(ctx, client, config, authContext)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the bucket already existed, warnings are returned (not printed directly) to avoid
+	// concurrent output issues when running inside a spinner.
+	warnings, err := applyGCSBucketDefaults(ctx, client, config, bucketAlreadyExisted)
+	if err != nil {
+		return nil, fmt.Errorf(errFormat, errUtils.ErrApplyBucketDefaults, err)
+	}
+
+	return &ProvisionResult{Warnings: warnings}, nil
+}
+
+// GCSBackendExists checks if a GCS backend bucket exists.
+// This function is registered in the backend registry and called during auto-provisioning
+// to check if the backend already exists before attempting to create it.
+func GCSBackendExists(
+	ctx context.Context,
+	atmosConfig *schema.AtmosConfiguration,
+	backendConfig map[string]any,
+	authContext *schema.AuthContext,
+) (bool, error) {
+	defer perf.Track(atmosConfig, "backend.GCSBackendExists")()
+
+	config, err := extractGCSConfig(backendConfig)
+	if err != nil {
+		return false, err
+	}
+
+	client, err := createGCSClient(ctx, config, authContext)
+	if err != nil {
+		return false, err
+	}
+
+	return gcsBucketExists(ctx, client, config.bucket)
+}
+
+// extractGCSConfig extracts and validates required GCS configuration.
+func extractGCSConfig(backendConfig map[string]any) (*gcsConfig, error) {
+	bucket, ok := backendConfig["bucket"].(string)
+	if !ok || bucket == "" {
+		return nil, fmt.Errorf("%w", errUtils.ErrBucketRequired)
+	}
+
+	kmsEncryptionKey, _ := backendConfig["kms_encryption_key"].(string)
+
+	return &gcsConfig{
+		bucket:           bucket,
+		credentials:      gcp.GetCredentialsFromBackend(backendConfig),
+		kmsEncryptionKey: kmsEncryptionKey,
+	}, nil
+}
+
+// gcsClientOptions returns the GCS client options.
+// Precedence: Atmos auth identity, then backend.credentials, then Application Default Credentials.
+func gcsClientOptions(config *gcsConfig, authContext *schema.AuthContext) []option.ClientOption {
+	if authContext != nil && authContext.GCP != nil {
+		if authContext.GCP.CredentialsFile != "" {
+			return gcp.GetClientOptions(gcp.AuthOptions{Credentials: authContext.GCP.CredentialsFile})
+		}
+		if authContext.GCP.AccessToken != "" {
+			return []option.ClientOption{option.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{
+				AccessToken: authContext.GCP.AccessToken,
+				Expiry:      authContext.GCP.TokenExpiry,
+			}))}
+		}
+	}
+	return gcp.GetClientOptions(gcp.AuthOptions{Credentials: config.credentials})
+}
+
+// createGCSClient creates a GCS client using the client factory (allows test injection).
+func createGCSClient(ctx context.Context, config *gcsConfig, authContext *schema.AuthContext) (GCSClientAPI, error) {
+	client, err := getGCSClientFactory()(ctx, gcsClientOptions(config, authContext)...)
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrCreateGCSClient).
+			WithCause(err).
+			WithHint("Check GCP credentials are configured correctly").
+			WithHint("If using --identity flag, ensure the identity is authenticated").
+			WithContext("bucket", config.bucket).
+			Err()
+	}
+	return client, nil
+}
+
+// resolveGCSProject returns the project to create the bucket in.
+func resolveGCSProject(authContext *schema.AuthContext) string {
+	if authContext != nil && authContext.GCP != nil && authContext.GCP.ProjectID != "" {
+		return authContext.GCP.ProjectID
+	}
+	v := viper.New()
+	// Best-effort bind; an unset project is reported by the caller.
+	_ = v.BindEnv(append([]string{"project"}, gcsProjectEnvVars...)...)
+	return v.GetString("project")
+}
+
+// resolveGCSLocation returns the location to create the bucket in.
+func resolveGCSLocation(authContext *schema.AuthContext) string {
+	if authContext != nil && authContext.GCP != nil {
+		if authContext.GCP.Location != "" {
+			return authContext.GCP.Location
+		}
+		if authContext.GCP.Region != "" {
+			return authContext.GCP.Region
+		}
+	}
+	return defaultGCSLocation
+}
+
+// gcsBucketExists checks if a GCS bucket exists.
+// Returns (false, nil) if the bucket doesn't exist.
+// Returns (false, error) for permission denied, network issues, or other errors.
+func gcsBucketExists(ctx context.Context, client GCSClientAPI, bucket string) (bool, error) {
+	_, err := client.BucketAttrs(ctx, bucket)
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, storage.ErrBucketNotExist) {
+		return false, nil
+	}
+	return false, errUtils.Build(errUtils.ErrCheckBucketExist).
+		WithCause(err).
+		WithHint("Check GCP IAM permissions for storage.buckets.get").
+		WithHintf("Verify that your credentials have access to bucket '%s'", bucket).
+		WithContext("bucket", bucket).
+		Err()
+}
+
+// ensureGCSBucket checks if the bucket exists and creates it if needed.
+// Returns (true, nil) if the bucket already existed, (false, nil) if it was created, (_, error) on failure.
+func ensureGCSBucket(ctx context.Context, client GCSClientAPI, config *gcsConfig, authContext *schema.AuthContext) (bool, error) {
+	exists, err := gcsBucketExists(ctx, client, config.bucket)
+	if err != nil {
+		return false, err
+	}
+	if exists {
+		return true, nil
+	}
+
+	project := resolveGCSProject(authContext)
+	if project == "" {
+		return false, errUtils.Build(errUtils.ErrGCPProjectRequired).
+			WithExplanationf("Cannot create GCS bucket '%s' without a GCP project", config.bucket).
+			WithHint("Use an Atmos auth identity with a GCP project, or set GOOGLE_CLOUD_PROJECT").
+			WithContext("bucket", config.bucket).
+			Err()
+	}
+
+	if err := client.CreateBucket(ctx, config.bucket, project, newGCSBucketAttrs(config, resolveGCSLocation(authContext))); err != nil {
+		return false, fmt.Errorf(errFormat, errUtils.ErrCreateBucket, err)
+	}
+	return false, nil
+}
+
+// newGCSBucketAttrs returns the attributes of a new bucket with the hardcoded defaults.
+func newGCSBucketAttrs(config *gcsConfig, location string) *storage.BucketAttrs {
+	attrs := &storage.BucketAttrs{
+		Location:                 location,
+		VersioningEnabled:        true,
+		UniformBucketLevelAccess: storage.UniformBucketLevelAccess{Enabled: true},
+		PublicAccessPrevention:   storage.PublicAccessPreventionEnforced,
+		Labels:                   map[string]string{"managed-by": "atmos"},
+	}
+	if config.kmsEncryptionKey != "" {
+		attrs.Encryption = &storage.BucketEncryption{DefaultKMSKeyName: config.kmsEncryptionKey}
+	}
+	return attrs
+}
+
+// applyGCSBucketDefaults applies hardcoded defaults to an existing GCS bucket.
+//
+// New buckets are created with the defaults, so only pre-existing buckets are updated.
+// If the bucket already existed, warnings are returned to inform the user that existing
+// settings are being modified.
+func applyGCSBucketDefaults(ctx context.Context, client GCSClientAPI, config *gcsConfig, alreadyExisted bool) ([]string, error) {
+	if !alreadyExisted {
+		return nil, nil
+	}
+
+	msg := fmt.Sprintf("Applying Atmos defaults to existing bucket `%s`\n\n"+
+		"- Versioning will be ENABLED\n"+
+		"- Uniform bucket-level access will be ENABLED\n"+
+		"- Public access prevention will be ENFORCED\n"+
+		"- Label managed-by=atmos will be added", config.bucket)
+	if config.kmsEncryptionKey != "" {
+		msg += "\n- Default encryption will use the KMS key from backend.kms_encryption_key"
+	}
+	warnings := []string{msg}
+
+	update := &storage.BucketAttrsToUpdate{
+		VersioningEnabled:        true,
+		UniformBucketLevelAccess: &storage.UniformBucketLevelAccess{Enabled: true},
+		PublicAccessPrevention:   storage.PublicAccessPreventionEnforced,
+	}
+	update.SetLabel("managed-by", "atmos")
+	if config.kmsEncryptionKey != "" {
+		update.Encryption = &storage.BucketEncryption{DefaultKMSKeyName: config.kmsEncryptionKey}
+	}
+
+	if err := client.UpdateBucket(ctx, config.bucket, update); err != nil {
+		return nil, fmt.Errorf(errFormat, errUtils.ErrEnableVersioning, err)
+	}
+
+	return warnings, nil
+}
+
+// gcsClient implements GCSClientAPI using the Google Cloud Storage SDK.
+type gcsClient struct {
+	client *storage.Client
+}
+
+// newGCSClient creates a GCS client from client options.
+func newGCSClient(ctx context.Context, opts ...option.ClientOption) (GCSClientAPI, error) {
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &gcsClient{client: client}, nil
+}
+
+func (c *gcsClient) BucketAttrs(ctx context.Context, bucket string) (*storage.BucketAttrs, error) {
+	defer perf.Track(nil, "backend.gcsClient.BucketAttrs")()
+
+	return c.client.Bucket(bucket).Attrs(ctx)
+}
+
+func (c *gcsClient) CreateBucket(ctx context.Context, bucket, projectID string, attrs *storage.BucketAttrs) error {
+	defer perf.Track(nil, "backend.gcsClient.CreateBucket")()
+
+	return c.client.Bucket(bucket).Create(ctx, projectID, attrs)
+}
+
+func (c *gcsClient) UpdateBucket(ctx context.Context, bucket string, attrs *storage.BucketAttrsToUpdate) error {
+	defer perf.Track(nil, "backend.gcsClient.UpdateBucket")()
+
+	_, err := c.client.Bucket(bucket).Update(ctx, *attrs)
+	return err
+}
+
+func (c *gcsClient) ListObjectVersions(ctx context.Context, bucket string) ([]GCSObjectVersion, error) {
+	defer perf.Track(nil, "backend.gcsClient.ListObjectVersions")()
+
+	var objects []GCSObjectVersion
+	it := c.client.Bucket(bucket).Objects(ctx, &storage.Query{Versions: true})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, GCSObjectVersion{Name: attrs.Name, Generation: attrs.Generation})
+	}
+}
+
+func (c *gcsClient) DeleteObjectVersion(ctx context.Context, bucket string, object GCSObjectVersion) error {
+	defer perf.Track(nil, "backend.gcsClient.DeleteObjectVersion")()
+
+	return c.client.Bucket(bucket).Object(object.Name).Generation(object.Generation).Delete(ctx)
+}
+
+func (c *gcsClient) DeleteBucket(ctx context.Context, bucket string) error {
+	defer perf.Track(nil, "backend.gcsClient.DeleteBucket")()
+
+	return c.client.Bucket(bucket).Delete(ctx)
+}
@@ -0,0 +1,119 @@
+package backend
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/ui"
+)
+
+// DeleteGCSBackend deletes a GCS backend and all its contents.
+//
+// Safety mechanisms match DeleteS3Backend: force=true is required, all objects and
+// noncurrent generations are listed first, .tfstate files are counted, and the user is
+// warned about data loss before every generation and then the bucket are deleted.
+//
+// This operation is irreversible. State files will be permanently lost.
+func DeleteGCSBackend(
+	ctx context.Context,
+	atmosConfig *schema.AtmosConfiguration,
+	backendConfig map[string]any,
+	authContext *schema.AuthContext,
+	force bool,
+) error {
+	defer perf.Track(atmosConfig, "backend.DeleteGCSBackend")()
+
+	if !force {
+		return errForceRequired()
+	}
+
+	config, err := extractGCSConfig(backendConfig)
+	if err != nil {
+		return err
+	}
+
+	ui.Info(fmt.Sprintf("Deleting GCS backend: bucket=%s", config.bucket))
+
+	client, err := createGCSClient(ctx, config, authContext)
+	if err != nil {
+		return err
+	}
+
+	if err := validateGCSBucketExistsForDeletion(ctx, client, config.bucket); err != nil {
+		return err
+	}
+
+	if err := deleteGCSBucketAndContents(ctx, client, config.bucket); err != nil {
+		return err
+	}
+
+	ui.Success(fmt.Sprintf("✓ Backend deleted: bucket '%s' and all contents removed", config.bucket))
+	return nil
+}
+
+// validateGCSBucketExistsForDeletion checks if the bucket exists before deletion.
+func validateGCSBucketExistsForDeletion(ctx context.Context, client GCSClientAPI, bucket string) error {
+	exists, err := gcsBucketExists(ctx, client, bucket)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errUtils.Build(errUtils.ErrBackendNotFound).
+			WithExplanation("Cannot delete backend - bucket does not exist").
+			WithContext("bucket", bucket).
+			WithHint("Verify the bucket name in your backend configuration").
+			Err()
+	}
+	return nil
+}
+
+// deleteGCSBucketAndContents lists, warns, deletes all object generations, and deletes the bucket.
+func deleteGCSBucketAndContents(ctx context.Context, client GCSClientAPI, bucket string) error {
+	objects, err := client.ListObjectVersions(ctx, bucket)
+	if err != nil {
+		return errUtils.Build(errUtils.ErrListObjects).
+			WithCause(err).
+			WithExplanation("Failed to list objects in bucket").
+			WithContext("bucket", bucket).
+			WithHint("Check GCP IAM permissions for storage.objects.list").
+			Err()
+	}
+
+	if len(objects) > 0 {
+		stateFileCount := 0
+		for _, object := range objects {
+			if strings.HasSuffix(object.Name, ".tfstate") {
+				stateFileCount++
+			}
+		}
+		showDeletionWarning(bucket, len(objects), stateFileCount)
+
+		for _, object := range objects {
+			if err := client.DeleteObjectVersion(ctx, bucket, object); err != nil {
+				return errUtils.Build(errUtils.ErrDeleteObjects).
+					WithCause(err).
+					WithExplanation("Failed to delete objects from bucket").
+					WithContext("bucket", bucket).
+					WithContext("object", object.Name).
+					WithContext("generation", object.Generation).
+					WithHint("Check GCP IAM permissions for storage.objects.delete and any retention policy on the bucket").
+					Err()
+			}
+		}
+		ui.Success(fmt.Sprintf("Deleted %d object(s) from bucket '%s'", len(objects), bucket))
+	}
+
+	if err := client.DeleteBucket(ctx, bucket); err != nil {
+		return errUtils.Build(errUtils.ErrDeleteBucket).
+			WithCause(err).
+			WithExplanation("Failed to delete GCS bucket").
+			WithContext("bucket", bucket).
+			WithHint("Check GCP IAM permissions for storage.buckets.delete and ensure bucket is empty").
+			Err()
+	}
+	return nil
+}
@@ -0,0 +1,266 @@
+package backend
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// fakeGCSClient is an in-memory GCSClientAPI implementation.
+type fakeGCSClient struct {
+	buckets   map[string]*storage.BucketAttrs
+	objects   map[string][]GCSObjectVersion
+	projects  map[string]string
+	updates   []*storage.BucketAttrsToUpdate
+	deleted   []GCSObjectVersion
+	attrsErr  error
+	createErr error
+	updateErr error
+	deleteErr error
+}
+
+func newFakeGCSClient() *fakeGCSClient {
+	return &fakeGCSClient{
+		buckets:  map[string]*storage.BucketAttrs{},
+		objects:  map[string][]GCSObjectVersion{},
+		projects: map[string]string{},
+	}
+}
+
+func (f *fakeGCSClient) BucketAttrs(_ context.Context, bucket string) (*storage.BucketAttrs, error) {
+	if f.attrsErr != nil {
+		return nil, f.attrsErr
+	}
+	attrs, ok := f.buckets[bucket]
+	if !ok {
+		return nil, storage.ErrBucketNotExist
+	}
+	return attrs, nil
+}
+
+func (f *fakeGCSClient) CreateBucket(_ context.Context, bucket, projectID string, attrs *storage.BucketAttrs) error {
+	if f.createErr != nil {
+		return f.createErr
+	}
+	f.buckets[bucket] = attrs
+	f.projects[bucket] = projectID
+	return nil
+}
+
+func (f *fakeGCSClient) UpdateBucket(_ context.Context, _ string, attrs *storage.BucketAttrsToUpdate) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	f.updates = append(f.updates, attrs)
+	return nil
+}
+
+func (f *fakeGCSClient) ListObjectVersions(_ context.Context, bucket string) ([]GCSObjectVersion, error) {
+	return f.objects[bucket], nil
+}
+
+func (f *fakeGCSClient) DeleteObjectVersion(_ context.Context, _ string, object GCSObjectVersion) error {
+	if f.deleteErr != nil {
+		return f.deleteErr
+	}
+	f.deleted = append(f.deleted, object)
+	return nil
+}
+
+func (f *fakeGCSClient) DeleteBucket(_ context.Context, bucket string) error {
+	delete(f.buckets, bucket)
+	return nil
+}
+
+// useFakeGCSClient injects the fake client for the duration of the test.
+func useFakeGCSClient(t *testing.T, client *fakeGCSClient) {
+	t.Helper()
+	SetGCSClientFactory(func(context.Context, ...option.ClientOption) (GCSClientAPI, error) {
+		return client, nil
+	})
+	t.Cleanup(ResetGCSClientFactory)
+}
+
+func TestGCSProvisionerRegistration(t *testing.T) {
+	// Other tests may have cleared the registry, so we can't rely on init().
+	ResetRegistryForTesting()
+	RegisterBackendCreate(backendTypeGCS, CreateGCSBackend)
+	RegisterBackendDelete(backendTypeGCS, DeleteGCSBackend)
+	RegisterBackendExists(backendTypeGCS, GCSBackendExists)
+	RegisterBackendName(backendTypeGCS, GCSBackendName)
+
+	assert.NotNil(t, GetBackendCreate(backendTypeGCS))
+	assert.NotNil(t, GetBackendDelete(backendTypeGCS))
+	assert.NotNil(t, GetBackendExists(backendTypeGCS))
+	assert.Equal(t, "state", GetBackendName(backendTypeGCS)(map[string]any{"bucket": "state"}))
+}
+
+func TestExtractGCSConfig(t *testing.T) {
+	config, err := extractGCSConfig(map[string]any{
+		"bucket":             "state",
+		"kms_encryption_key": "projects/p/locations/us/keyRings/r/cryptoKeys/k",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "state", config.bucket)
+	assert.Equal(t, "projects/p/locations/us/keyRings/r/cryptoKeys/k", config.kmsEncryptionKey)
+
+	_, err = extractGCSConfig(map[string]any{})
+	assert.ErrorIs(t, err, errUtils.ErrBucketRequired)
+}
+
+func TestCreateGCSBackend_NewBucket(t *testing.T) {
+	client := newFakeGCSClient()
+	useFakeGCSClient(t, client)
+
+	authContext := &schema.AuthContext{GCP: &schema.GCPAuthContext{ProjectID: "my-project", Region: "us-central1"}}
+	result, err := CreateGCSBackend(context.Background(), nil, map[string]any{
+		"bucket":             "state",
+		"kms_encryption_key": "projects/p/locations/us/keyRings/r/cryptoKeys/k",
+	}, authContext)
+	require.NoError(t, err)
+	assert.Empty(t, result.Warnings)
+
+	attrs := client.buckets["state"]
+	require.NotNil(t, attrs)
+	assert.Equal(t, "my-project", client.projects["state"])
+	assert.Equal(t, "us-central1", attrs.Location)
+	assert.True(t, attrs.VersioningEnabled)
+	assert.True(t, attrs.UniformBucketLevelAccess.Enabled)
+	assert.Equal(t, storage.PublicAccessPreventionEnforced, attrs.PublicAccessPrevention)
+	assert.Equal(t, "atmos", attrs.Labels["managed-by"])
+	require.NotNil(t, attrs.Encryption)
+	assert.Equal(t, "projects/p/locations/us/keyRings/r/cryptoKeys/k", attrs.Encryption.DefaultKMSKeyName)
+	assert.Empty(t, client.updates)
+}
+
+func TestCreateGCSBackend_ProjectFromEnv(t *testing.T) {
+	client := newFakeGCSClient()
+	useFakeGCSClient(t, client)
+	t.Setenv("GOOGLE_CLOUD_PROJECT", "")
+	t.Setenv("GOOGLE_PROJECT", "env-project")
+
+	_, err := CreateGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "env-project", client.projects["state"])
+	assert.Equal(t, defaultGCSLocation, client.buckets["state"].Location)
+	assert.Nil(t, client.buckets["state"].Encryption)
+}
+
+func TestCreateGCSBackend_ProjectRequired(t *testing.T) {
+	useFakeGCSClient(t, newFakeGCSClient())
+	for _, name := range gcsProjectEnvVars {
+		t.Setenv(name, "")
+	}
+
+	_, err := CreateGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, nil)
+	assert.ErrorIs(t, err, errUtils.ErrGCPProjectRequired)
+}
+
+func TestCreateGCSBackend_ExistingBucket(t *testing.T) {
+	client := newFakeGCSClient()
+	client.buckets["state"] = &storage.BucketAttrs{}
+	useFakeGCSClient(t, client)
+
+	result, err := CreateGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, nil)
+	require.NoError(t, err)
+	require.Len(t, result.Warnings, 1)
+	assert.Contains(t, result.Warnings[0], "Applying Atmos defaults to existing bucket `state`")
+
+	require.Len(t, client.updates, 1)
+	update := client.updates[0]
+	assert.Equal(t, true, update.VersioningEnabled)
+	assert.True(t, update.UniformBucketLevelAccess.Enabled)
+	assert.Equal(t, storage.PublicAccessPreventionEnforced, update.PublicAccessPrevention)
+	assert.Nil(t, update.Encryption)
+}
+
+func TestCreateGCSBackend_UpdateFails(t *testing.T) {
+	client := newFakeGCSClient()
+	client.buckets["state"] = &storage.BucketAttrs{}
+	client.updateErr = errors.New("forbidden")
+	useFakeGCSClient(t, client)
+
+	_, err := CreateGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, nil)
+	assert.ErrorIs(t, err, errUtils.ErrApplyBucketDefaults)
+}
+
+func TestCreateGCSBackend_CreateFails(t *testing.T) {
+	client := newFakeGCSClient()
+	client.createErr = errors.New("conflict")
+	useFakeGCSClient(t, client)
+
+	authContext := &schema.AuthContext{GCP: &schema.GCPAuthContext{ProjectID: "my-project"}}
+	_, err := CreateGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, authContext)
+	assert.ErrorIs(t, err, errUtils.ErrCreateBucket)
+}
+
+func TestGCSBackendExists(t *testing.T) {
+	client := newFakeGCSClient()
+	useFakeGCSClient(t, client)
+
+	exists, err := GCSBackendExists(context.Background(), nil, map[string]any{"bucket": "state"}, nil)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	client.buckets["state"] = &storage.BucketAttrs{}
+	exists, err = GCSBackendExists(context.Background(), nil, map[string]any{"bucket": "state"}, nil)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	client.attrsErr = errors.New("permission denied")
+	_, err = GCSBackendExists(context.Background(), nil, map[string]any{"bucket": "state"}, nil)
+	assert.ErrorIs(t, err, errUtils.ErrCheckBucketExist)
+}
+
+func TestDeleteGCSBackend_ForceRequired(t *testing.T) {
+	err := DeleteGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, nil, false)
+	assert.ErrorIs(t, err, errUtils.ErrForceRequired)
+}
+
+func TestDeleteGCSBackend_BucketNotFound(t *testing.T) {
+	useFakeGCSClient(t, newFakeGCSClient())
+
+	err := DeleteGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, nil, true)
+	assert.ErrorIs(t, err, errUtils.ErrBackendNotFound)
+}
+
+func TestDeleteGCSBackend_DeletesAllGenerations(t *testing.T) {
+	client := newFakeGCSClient()
+	client.buckets["state"] = &storage.BucketAttrs{}
+	client.objects["state"] = []GCSObjectVersion{
+		{Name: "dev/terraform.tfstate", Generation: 1},
+		{Name: "dev/terraform.tfstate", Generation: 2},
+		{Name: "dev/.terraform.lock.hcl", Generation: 1},
+	}
+	useFakeGCSClient(t, client)
+
+	err := DeleteGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, client.objects["state"], client.deleted)
+	assert.NotContains(t, client.buckets, "state")
+}
+
+func TestDeleteGCSBackend_DeleteObjectFails(t *testing.T) {
+	client := newFakeGCSClient()
+	client.buckets["state"] = &storage.BucketAttrs{}
+	client.objects["state"] = []GCSObjectVersion{{Name: "terraform.tfstate", Generation: 1}}
+	client.deleteErr = errors.New("retention policy")
+	useFakeGCSClient(t, client)
+
+	err := DeleteGCSBackend(context.Background(), nil, map[string]any{"bucket": "state"}, nil, true)
+	assert.ErrorIs(t, err, errUtils.ErrDeleteObjects)
+	assert.Contains(t, client.buckets, "state")
+}
+
+func TestGCSClientOptions_AccessToken(t *testing.T) {
+	authContext := &schema.AuthContext{GCP: &schema.GCPAuthContext{AccessToken: "token"}}
+	assert.Len(t, gcsClientOptions(&gcsConfig{bucket: "state"}, authContext), 1)
+}
@@ -83,6 +83,8 @@ type AtmosConfiguration struct {
 	HelmfileDirAbsolutePath       string             `yaml:"helmfileDirAbsolutePath,omitempty" json:"helmfileDirAbsolutePath,omitempty" mapstructure:"helmfileDirAbsolutePath"`
 	PackerDirAbsolutePath         string             `yaml:"packerDirAbsolutePath,omitempty" json:"packerDirAbsolutePath,omitempty" mapstructure:"packerDirAbsolutePath"`
 	AnsibleDirAbsolutePath        string             `yaml:"ansibleDirAbsolutePath,omitempty" json:"ansibleDirAbsolutePath,omitempty" mapstructure:"ansibleDirAbsolutePath"`
+	PulumiDirAbsolutePath         string             `yaml:"pulumiDirAbsolutePath,omitempty" json:"pulumiDirAbsolutePath,omitempty" mapstructure:"pulumiDirAbsolutePath"`
+	KustomizeDirAbsolutePath      string             `yaml:"kustomizeDirAbsolutePath,omitempty" json:"kustomizeDirAbsolutePath,omitempty" mapstructure:"kustomizeDirAbsolutePath"`
 	StackConfigFilesRelativePaths []string           `yaml:"stackConfigFilesRelativePaths,omitempty" json:"stackConfigFilesRelativePaths,omitempty" mapstructure:"stackConfigFilesRelativePaths"`
 	StackConfigFilesAbsolutePaths []string           `yaml:"stackConfigFilesAbsolutePaths,omitempty" json:"stackConfigFilesAbsolutePaths,omitempty" mapstructure:"stackConfigFilesAbsolutePaths"`
 	StackType                     string             `yaml:"stackType,omitempty" json:"StackType,omitempty" mapstructure:"stackType"`
@@ -626,6 +628,18 @@ type CITemplatesConfig struct {
 	// Helmfile contains template overrides for helmfile commands.
 	// Keys are command names (e.g., "diff", "apply"), values are template file paths.
 	Helmfile map[string]string `yaml:"helmfile,omitempty" json:"helmfile,omitempty" mapstructure:"helmfile"`
+
+	// Packer contains template overrides for packer commands.
+	// Keys are command names (e.g., "build"), values are template file paths.
+	Packer map[string]string `yaml:"packer,omitempty" json:"packer,omitempty" mapstructure:"packer"`
+
+	// Ansible contains template overrides for ansible commands.
+	// Keys are command names (e.g., "playbook"), values are template file paths.
+	Ansible map[string]string `yaml:"ansible,omitempty" json:"ansible,omitempty" mapstructure:"ansible"`
+
+	// Pulumi contains template overrides for pulumi commands.
+	// Keys are command names (e.g., "preview", "up"), values are template file paths.
+	Pulumi map[string]string `yaml:"pulumi,omitempty" json:"pulumi,omitempty" mapstructure:"pulumi"`
 }
 
 type Helmfile struct {
@@ -666,19 +680,42 @@ type Ansible struct {
 	AutoGenerateFiles bool `yaml:"auto_generate_files" json:"auto_generate_files" mapstructure:"auto_generate_files"`
 }
 
+// Pulumi defines configuration for Pulumi components.
+type Pulumi struct {
+	BasePath string `yaml:"base_path" json:"base_path" mapstructure:"base_path"`
+	Command  string `yaml:"command" json:"command" mapstructure:"command"`
+	// AutoGenerateFiles enables automatic generation of auxiliary configuration files
+	// during Pulumi operations when set to true.
+	// Generated files are defined in the component's generate section.
+	AutoGenerateFiles bool `yaml:"auto_generate_files" json:"auto_generate_files" mapstructure:"auto_generate_files"`
+}
+
+// Kustomize defines configuration for Kustomize components.
+type Kustomize struct {
+	BasePath string `yaml:"base_path" json:"base_path" mapstructure:"base_path"`
+	// Command is the kubectl executable used to build, diff and apply the kustomizations.
+	Command string `yaml:"command" json:"command" mapstructure:"command"`
+	// AutoGenerateFiles enables automatic generation of auxiliary configuration files
+	// during Kustomize operations when set to true.
+	// Generated files are defined in the component's generate section.
+	AutoGenerateFiles bool `yaml:"auto_generate_files" json:"auto_generate_files" mapstructure:"auto_generate_files"`
+}
+
 type Components struct {
 	// Built-in component types (legacy - will migrate to plugin model in future phases).
 	Terraform Terraform `yaml:"terraform" json:"terraform" mapstructure:"terraform"`
 	Helmfile  Helmfile  `yaml:"helmfile" json:"helmfile" mapstructure:"helmfile"`
 	Packer    Packer    `yaml:"packer" json:"packer" mapstructure:"packer"`
 	Ansible   Ansible   `yaml:"ansible" json:"ansible" mapstructure:"ansible"`
+	Pulumi    Pulumi    `yaml:"pulumi" json:"pulumi" mapstructure:"pulumi"`
+	Kustomize Kustomize `yaml:"kustomize" json:"kustomize" mapstructure:"kustomize"`
 
 	// List configuration for component listing.
 	List ListConfig `yaml:"list,omitempty" json:"list,omitempty" mapstructure:"list"`
 
 	// Dynamic plugin component types.
 	// Uses mapstructure:",remain" to capture all unmapped fields from the YAML/JSON.
-	// This allows new component types (like mock, pulumi, cdk) to be added without schema changes.
+	// This allows new component types (like mock, cdk) to be added without schema changes.
 	Plugins map[string]any `yaml:",inline" json:",inline" mapstructure:",remain"`
 }
 
@@ -697,6 +734,10 @@ func (c *Components) GetComponentConfig(componentType string) (any, bool) {
 		return c.Packer, true
 	case "ansible":
 		return c.Ansible, true
+	case "pulumi":
+		return c.Pulumi, true
+	case "kustomize":
+		return c.Kustomize, true
 	default:
 		// Check plugin types.
 		if config, ok := c.Plugins[componentType]; ok {
@@ -1069,6 +1110,8 @@ type ConfigAndStacksInfo struct {
 	CliArgs                   []string
 	Affected                  bool
 	All                       bool
+	Parallelism               int
+	ContinueOnError           bool
 	Components                []string
 	Identity                  string
 	ClusterName               string // EKS cluster name from --cluster-name flag.
@@ -23,7 +23,7 @@ type AuthConfig struct {
 
 // KeyringConfig defines keyring backend configuration for credential storage.
 type KeyringConfig struct {
-	Type string                 `yaml:"type,omitempty" json:"type,omitempty" mapstructure:"type"` // "system", "file", or "memory"
+	Type string                 `yaml:"type,omitempty" json:"type,omitempty" mapstructure:"type"` // "system", "file", "age", "pass", or "memory"
 	Spec map[string]interface{} `yaml:"spec,omitempty" json:"spec,omitempty" mapstructure:"spec"` // Type-specific configuration
 }
 
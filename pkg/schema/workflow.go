@@ -13,6 +13,14 @@ type WorkflowStep struct {
 	Retry            *RetryConfig      `yaml:"retry,omitempty" json:"retry,omitempty" mapstructure:"retry"`
 	Identity         string            `yaml:"identity,omitempty" json:"identity,omitempty" mapstructure:"identity"`
 	Env              map[string]string `yaml:"env,omitempty" json:"env,omitempty" mapstructure:"env"`
+	// Needs lists the names of the steps that must succeed before this step runs.
+	Needs []string `yaml:"needs,omitempty" json:"needs,omitempty" mapstructure:"needs"`
+	// Parallel groups consecutive steps so they run at the same time.
+	Parallel bool `yaml:"parallel,omitempty" json:"parallel,omitempty" mapstructure:"parallel"`
+	// If is a Go template expression; the step is skipped unless it evaluates to true.
+	If string `yaml:"if,omitempty" json:"if,omitempty" mapstructure:"if"`
+	// Outputs maps output names to Go templates evaluated against the captured stdout of the step.
+	Outputs map[string]string `yaml:"outputs,omitempty" json:"outputs,omitempty" mapstructure:"outputs"`
 }
 
 type WorkflowDefinition struct {
@@ -23,6 +31,8 @@ type WorkflowDefinition struct {
 	Steps        []WorkflowStep    `yaml:"steps" json:"steps" mapstructure:"steps"`
 	Stack        string            `yaml:"stack,omitempty" json:"stack,omitempty" mapstructure:"stack"`
 	Env          map[string]string `yaml:"env,omitempty" json:"env,omitempty" mapstructure:"env"`
+	// MaxParallel limits how many steps run at the same time when steps use `needs` or `parallel`.
+	MaxParallel int `yaml:"max_parallel,omitempty" json:"max_parallel,omitempty" mapstructure:"max_parallel"`
 }
 
 type WorkflowConfig map[string]WorkflowDefinition
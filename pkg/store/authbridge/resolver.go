@@ -108,3 +108,30 @@ func (r *Resolver) ResolveGCPAuthContext(ctx context.Context, identityName strin
 		ProjectID:       gcpCtx.ProjectID,
 	}, nil
 }
+
+// ResolveOIDCToken authenticates the root provider of the named identity and returns its OIDC token.
+// This is used by stores that log in with a JWT (e.g., Vault JWT auth with a GitHub OIDC provider).
+func (r *Resolver) ResolveOIDCToken(ctx context.Context, identityName string) (string, error) {
+	defer perf.Track(nil, "authbridge.ResolveOIDCToken")()
+
+	log.Debug("Resolving OIDC token for store identity", "identity", identityName)
+
+	providerName := r.authManager.GetProviderForIdentity(identityName)
+	if providerName == "" {
+		// The name may refer to a provider directly.
+		providerName = identityName
+	}
+
+	whoami, err := r.authManager.AuthenticateProvider(ctx, providerName)
+	if err != nil {
+		return "", fmt.Errorf("failed to authenticate identity %q for store: %w", identityName, err)
+	}
+
+	if whoami != nil {
+		if creds, ok := whoami.Credentials.(*types.OIDCCredentials); ok && creds.Token != "" {
+			return creds.Token, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: OIDC token not available after authenticating identity %q", store.ErrAuthContextNotAvailable, identityName)
+}
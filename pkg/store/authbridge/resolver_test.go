@@ -326,3 +326,72 @@ func TestResolver_NilStackInfo(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "GCP auth context not available")
 }
+
+func TestResolveOIDCToken_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := types.NewMockAuthManager(ctrl)
+	mockManager.EXPECT().GetProviderForIdentity("vault-ci").Return("github-oidc")
+	mockManager.EXPECT().
+		AuthenticateProvider(gomock.Any(), "github-oidc").
+		Return(&types.WhoamiInfo{Credentials: &types.OIDCCredentials{Token: "jwt-token", Provider: "github"}}, nil)
+
+	resolver := NewResolver(mockManager, &schema.ConfigAndStacksInfo{})
+	token, err := resolver.ResolveOIDCToken(context.Background(), "vault-ci")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token", token)
+}
+
+func TestResolveOIDCToken_ProviderName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := types.NewMockAuthManager(ctrl)
+	mockManager.EXPECT().GetProviderForIdentity("github-oidc").Return("")
+	mockManager.EXPECT().
+		AuthenticateProvider(gomock.Any(), "github-oidc").
+		Return(&types.WhoamiInfo{Credentials: &types.OIDCCredentials{Token: "jwt-token"}}, nil)
+
+	resolver := NewResolver(mockManager, &schema.ConfigAndStacksInfo{})
+	token, err := resolver.ResolveOIDCToken(context.Background(), "github-oidc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "jwt-token", token)
+}
+
+func TestResolveOIDCToken_NotOIDC(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := types.NewMockAuthManager(ctrl)
+	mockManager.EXPECT().GetProviderForIdentity("prod-admin").Return("aws-sso")
+	mockManager.EXPECT().
+		AuthenticateProvider(gomock.Any(), "aws-sso").
+		Return(&types.WhoamiInfo{}, nil)
+
+	resolver := NewResolver(mockManager, &schema.ConfigAndStacksInfo{})
+	token, err := resolver.ResolveOIDCToken(context.Background(), "prod-admin")
+
+	assert.Error(t, err)
+	assert.Empty(t, token)
+	assert.Contains(t, err.Error(), "OIDC token not available")
+}
+
+func TestResolveOIDCToken_AuthFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockManager := types.NewMockAuthManager(ctrl)
+	mockManager.EXPECT().GetProviderForIdentity("bad-identity").Return("github-oidc")
+	mockManager.EXPECT().
+		AuthenticateProvider(gomock.Any(), "github-oidc").
+		Return(nil, errors.New("authentication failed"))
+
+	resolver := NewResolver(mockManager, &schema.ConfigAndStacksInfo{})
+	_, err := resolver.ResolveOIDCToken(context.Background(), "bad-identity")
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to authenticate identity")
+}
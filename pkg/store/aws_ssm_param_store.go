@@ -3,9 +3,12 @@ package store
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
@@ -15,6 +18,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 )
 
+const ssmOperationTimeout = 30 * time.Second
+
 // SSMStore is an implementation of the Store interface for AWS SSM Parameter Store.
 type SSMStore struct {
 	client         SSMClient
@@ -42,16 +47,21 @@ type SSMStoreOptions struct {
 	WriteRoleArn   *string `mapstructure:"write_role_arn"`
 }
 
-// Ensure SSMStore implements the store.Store and IdentityAwareStore interfaces.
+// Ensure SSMStore implements the store.Store, IdentityAwareStore and optional operation interfaces.
 var (
 	_ Store              = (*SSMStore)(nil)
 	_ IdentityAwareStore = (*SSMStore)(nil)
+	_ DeletableStore     = (*SSMStore)(nil)
+	_ ListableStore      = (*SSMStore)(nil)
+	_ VersionedStore     = (*SSMStore)(nil)
 )
 
 // SSMClient interface allows us to mock the AWS SSM client.
 type SSMClient interface {
 	PutParameter(ctx context.Context, params *ssm.PutParameterInput, optFns ...func(*ssm.Options)) (*ssm.PutParameterOutput, error)
 	GetParameter(ctx context.Context, params *ssm.GetParameterInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterOutput, error)
+	DeleteParameter(ctx context.Context, params *ssm.DeleteParameterInput, optFns ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error)
+	GetParametersByPath(ctx context.Context, params *ssm.GetParametersByPathInput, optFns ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error)
 }
 
 // STSClient interface allows us to mock the AWS STS client.
@@ -255,7 +265,8 @@ func (s *SSMStore) Set(stack string, component string, key string, value any) er
 		return err
 	}
 
-	ctx := context.TODO()
+	ctx, cancel := context.WithTimeout(context.Background(), ssmOperationTimeout)
+	defer cancel()
 
 	// Convert value to JSON string
 	jsonValue, err := json.Marshal(value)
@@ -317,7 +328,8 @@ func (s *SSMStore) Get(stack string, component string, key string) (any, error)
 		return nil, err
 	}
 
-	ctx := context.TODO()
+	ctx, cancel := context.WithTimeout(context.Background(), ssmOperationTimeout)
+	defer cancel()
 
 	// Construct the full parameter name using getKey
 	paramName, err := s.getKey(stack, component, key)
@@ -371,7 +383,8 @@ func (s *SSMStore) GetKey(key string) (any, error) {
 		return nil, err
 	}
 
-	ctx := context.TODO()
+	ctx, cancel := context.WithTimeout(context.Background(), ssmOperationTimeout)
+	defer cancel()
 
 	// Use the key directly as the parameter name
 	paramName := key
@@ -421,3 +434,151 @@ func (s *SSMStore) GetKey(key string) (any, error) {
 
 	return result, nil
 }
+
+// clientForRole returns an SSM client that uses the given role, or the default client if no role is set.
+func (s *SSMStore) clientForRole(ctx context.Context, roleArn *string) (SSMClient, error) {
+	if roleArn == nil {
+		return s.client, nil
+	}
+
+	cfg, err := s.assumeRole(ctx, roleArn)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.newSSMClient != nil {
+		return s.newSSMClient(*cfg), nil
+	}
+	return ssm.NewFromConfig(*cfg), nil
+}
+
+// decodeParameterValue decodes a JSON parameter value, falling back to the raw string.
+func decodeParameterValue(value string) any {
+	var result any
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		return value
+	}
+	return result
+}
+
+// Delete removes a parameter from AWS SSM Parameter Store.
+func (s *SSMStore) Delete(stack string, component string, key string) error {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return err
+	}
+
+	if err := s.ensureClient(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ssmOperationTimeout)
+	defer cancel()
+
+	paramName, err := s.getKey(stack, component, key)
+	if err != nil {
+		return fmt.Errorf(errWrapFormat, ErrGetKey, err)
+	}
+
+	client, err := s.clientForRole(ctx, s.writeRoleArn)
+	if err != nil {
+		return fmt.Errorf("failed to assume write role: %w", err)
+	}
+
+	_, err = client.DeleteParameter(ctx, &ssm.DeleteParameterInput{
+		Name: aws.String(paramName),
+	})
+	if err != nil {
+		var notFound *types.ParameterNotFound
+		if errors.As(err, &notFound) {
+			return fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, paramName, err)
+		}
+		return fmt.Errorf(errWrapFormatWithID, ErrDeleteKey, paramName, err)
+	}
+
+	return nil
+}
+
+// List returns the keys stored in AWS SSM Parameter Store for the stack and component.
+func (s *SSMStore) List(stack string, component string) ([]string, error) {
+	if err := validateComponentArgs(stack, component); err != nil {
+		return nil, err
+	}
+
+	if err := s.ensureClient(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ssmOperationTimeout)
+	defer cancel()
+
+	basePath, err := s.getKey(stack, component, "")
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, ErrGetKey, err)
+	}
+	basePath = strings.TrimSuffix(basePath, "/")
+
+	client, err := s.clientForRole(ctx, s.readRoleArn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assume read role: %w", err)
+	}
+
+	keys := []string{}
+	input := &ssm.GetParametersByPathInput{
+		Path:      aws.String(basePath),
+		Recursive: aws.Bool(true),
+	}
+	for {
+		output, err := client.GetParametersByPath(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf(errWrapFormatWithID, ErrListKeys, basePath, err)
+		}
+		for _, parameter := range output.Parameters {
+			keys = append(keys, strings.TrimPrefix(aws.ToString(parameter.Name), basePath+"/"))
+		}
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// GetVersion retrieves a specific version of a parameter from AWS SSM Parameter Store.
+// The version is the parameter version number or a parameter label.
+func (s *SSMStore) GetVersion(stack string, component string, key string, version string) (any, error) {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return nil, err
+	}
+	if version == "" {
+		return nil, fmt.Errorf("%w: version cannot be empty", ErrInvalidVersion)
+	}
+
+	if err := s.ensureClient(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), ssmOperationTimeout)
+	defer cancel()
+
+	paramName, err := s.getKey(stack, component, key)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, ErrGetKey, err)
+	}
+
+	client, err := s.clientForRole(ctx, s.readRoleArn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assume read role: %w", err)
+	}
+
+	selector := paramName + ":" + version
+	output, err := client.GetParameter(ctx, &ssm.GetParameterInput{
+		Name: aws.String(selector),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormatWithID, ErrGetParameter, selector, err)
+	}
+
+	return decodeParameterValue(aws.ToString(output.Parameter.Value)), nil
+}
@@ -39,6 +39,22 @@ func (m *MockSSMClient) GetParameter(ctx context.Context, params *ssm.GetParamet
 	return args.Get(0).(*ssm.GetParameterOutput), args.Error(1)
 }
 
+func (m *MockSSMClient) DeleteParameter(ctx context.Context, params *ssm.DeleteParameterInput, optFns ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ssm.DeleteParameterOutput), args.Error(1)
+}
+
+func (m *MockSSMClient) GetParametersByPath(ctx context.Context, params *ssm.GetParametersByPathInput, optFns ...func(*ssm.Options)) (*ssm.GetParametersByPathOutput, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*ssm.GetParametersByPathOutput), args.Error(1)
+}
+
 // MockSTSClient is a mock implementation of the STSClient interface.
 type MockSTSClient struct {
 	mock.Mock
@@ -984,3 +1000,87 @@ func TestSSMStore_GetKey(t *testing.T) {
 		})
 	}
 }
+
+func newTestSSMStore(client SSMClient) *SSMStore {
+	stackDelimiter := "/"
+	return &SSMStore{
+		client:         client,
+		prefix:         "/test-prefix",
+		stackDelimiter: &stackDelimiter,
+		awsConfig:      &aws.Config{Region: "us-west-2"},
+	}
+}
+
+func TestSSMStore_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr error
+	}{
+		{name: "deleted"},
+		{name: "not found", mockErr: &types.ParameterNotFound{}, wantErr: ErrResourceNotFound},
+		{name: "api error", mockErr: errors.New("throttled"), wantErr: ErrDeleteKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockSSM := new(MockSSMClient)
+			input := &ssm.DeleteParameterInput{Name: aws.String("/test-prefix/dev/vpc/vpc_id")}
+			if tt.mockErr != nil {
+				mockSSM.On("DeleteParameter", mock.Anything, input).Return(nil, tt.mockErr)
+			} else {
+				mockSSM.On("DeleteParameter", mock.Anything, input).Return(&ssm.DeleteParameterOutput{}, nil)
+			}
+
+			err := newTestSSMStore(mockSSM).Delete("dev", "vpc", "vpc_id")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockSSM.AssertExpectations(t)
+		})
+	}
+}
+
+func TestSSMStore_List(t *testing.T) {
+	mockSSM := new(MockSSMClient)
+	mockSSM.On("GetParametersByPath", mock.Anything, &ssm.GetParametersByPathInput{
+		Path:      aws.String("/test-prefix/dev/vpc"),
+		Recursive: aws.Bool(true),
+	}).Return(&ssm.GetParametersByPathOutput{
+		Parameters: []types.Parameter{{Name: aws.String("/test-prefix/dev/vpc/vpc_id")}},
+		NextToken:  aws.String("next"),
+	}, nil).Once()
+	mockSSM.On("GetParametersByPath", mock.Anything, mock.MatchedBy(func(in *ssm.GetParametersByPathInput) bool {
+		return aws.ToString(in.NextToken) == "next"
+	})).Return(&ssm.GetParametersByPathOutput{
+		Parameters: []types.Parameter{{Name: aws.String("/test-prefix/dev/vpc/cidr")}},
+	}, nil).Once()
+
+	keys, err := newTestSSMStore(mockSSM).List("dev", "vpc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cidr", "vpc_id"}, keys)
+	mockSSM.AssertExpectations(t)
+}
+
+func TestSSMStore_GetVersion(t *testing.T) {
+	mockSSM := new(MockSSMClient)
+	mockSSM.On("GetParameter", mock.Anything, &ssm.GetParameterInput{
+		Name: aws.String("/test-prefix/dev/vpc/vpc_id:3"),
+	}).Return(&ssm.GetParameterOutput{
+		Parameter: &types.Parameter{Value: aws.String(`"vpc-123"`)},
+	}, nil)
+
+	s := newTestSSMStore(mockSSM)
+	value, err := s.GetVersion("dev", "vpc", "vpc_id", "3")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "vpc-123", value)
+	mockSSM.AssertExpectations(t)
+
+	_, err = s.GetVersion("dev", "vpc", "vpc_id", "")
+	assert.ErrorIs(t, err, ErrInvalidVersion)
+}
@@ -28,6 +28,7 @@ const (
 type AzureKeyVaultClient interface {
 	SetSecret(ctx context.Context, name string, parameters azsecrets.SetSecretParameters, options *azsecrets.SetSecretOptions) (azsecrets.SetSecretResponse, error)
 	GetSecret(ctx context.Context, name string, version string, options *azsecrets.GetSecretOptions) (azsecrets.GetSecretResponse, error)
+	DeleteSecret(ctx context.Context, name string, options *azsecrets.DeleteSecretOptions) (azsecrets.DeleteSecretResponse, error)
 }
 
 // AzureKeyVaultStore is an implementation of the Store interface for Azure Key Vault.
@@ -54,6 +55,8 @@ type AzureKeyVaultStoreOptions struct {
 var (
 	_ Store              = (*AzureKeyVaultStore)(nil)
 	_ IdentityAwareStore = (*AzureKeyVaultStore)(nil)
+	_ DeletableStore     = (*AzureKeyVaultStore)(nil)
+	_ VersionedStore     = (*AzureKeyVaultStore)(nil)
 )
 
 // NewAzureKeyVaultStore creates a new Azure Key Vault store.
@@ -263,31 +266,7 @@ func (s *AzureKeyVaultStore) Get(stack string, component string, key string) (in
 		return nil, fmt.Errorf(errWrapFormat, ErrGetKey, err)
 	}
 
-	resp, err := s.client.GetSecret(context.Background(), secretName, "", nil)
-	if err != nil {
-		var respErr *azcore.ResponseError
-		if errors.As(err, &respErr) {
-			switch respErr.StatusCode {
-			case statusCodeNotFound:
-				return nil, fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, secretName, err)
-			case statusCodeForbidden:
-				return nil, fmt.Errorf(errWrapFormatWithID, ErrPermissionDenied, fmt.Sprintf("secret %s", secretName), err)
-			}
-		}
-		return nil, fmt.Errorf(errWrapFormat, ErrAccessSecret, err)
-	}
-
-	if resp.Value == nil {
-		return "", nil
-	}
-
-	// Try to unmarshal as JSON first, fallback to string if it fails.
-	var result interface{}
-	if jsonErr := json.Unmarshal([]byte(*resp.Value), &result); jsonErr != nil {
-		// If JSON unmarshaling fails, return as string.
-		return *resp.Value, nil
-	}
-	return result, nil
+	return s.getSecret(secretName, "")
 }
 
 func (s *AzureKeyVaultStore) GetKey(key string) (interface{}, error) {
@@ -302,18 +281,57 @@ func (s *AzureKeyVaultStore) GetKey(key string) (interface{}, error) {
 	// Normalize the key to comply with Azure Key Vault naming restrictions.
 	secretName := s.normalizeSecretName(key)
 
-	resp, err := s.client.GetSecret(context.Background(), secretName, "", nil)
+	return s.getSecret(secretName, "")
+}
+
+// GetVersion retrieves a specific version of a secret from Azure Key Vault.
+func (s *AzureKeyVaultStore) GetVersion(stack string, component string, key string, version string) (any, error) {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return nil, err
+	}
+	if version == "" {
+		return nil, fmt.Errorf("%w: version cannot be empty", ErrInvalidVersion)
+	}
+
+	if err := s.ensureClient(); err != nil {
+		return nil, err
+	}
+
+	secretName, err := s.getKey(stack, component, key)
 	if err != nil {
-		var respErr *azcore.ResponseError
-		if errors.As(err, &respErr) {
-			switch respErr.StatusCode {
-			case statusCodeNotFound:
-				return nil, fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, secretName, err)
-			case statusCodeForbidden:
-				return nil, fmt.Errorf(errWrapFormatWithID, ErrPermissionDenied, fmt.Sprintf("secret %s", secretName), err)
-			}
-		}
-		return nil, fmt.Errorf(errWrapFormat, ErrAccessSecret, err)
+		return nil, fmt.Errorf(errWrapFormat, ErrGetKey, err)
+	}
+
+	return s.getSecret(secretName, version)
+}
+
+// Delete removes a secret from Azure Key Vault.
+// Vaults with soft-delete enabled keep the deleted secret until it is purged.
+func (s *AzureKeyVaultStore) Delete(stack string, component string, key string) error {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return err
+	}
+
+	if err := s.ensureClient(); err != nil {
+		return err
+	}
+
+	secretName, err := s.getKey(stack, component, key)
+	if err != nil {
+		return fmt.Errorf(errWrapFormat, ErrGetKey, err)
+	}
+
+	if _, err := s.client.DeleteSecret(context.Background(), secretName, nil); err != nil {
+		return s.wrapSecretError(secretName, err, ErrDeleteKey)
+	}
+	return nil
+}
+
+// getSecret reads a secret version (the latest if version is empty) and decodes its JSON value.
+func (s *AzureKeyVaultStore) getSecret(secretName string, version string) (any, error) {
+	resp, err := s.client.GetSecret(context.Background(), secretName, version, nil)
+	if err != nil {
+		return nil, s.wrapSecretError(secretName, err, ErrAccessSecret)
 	}
 
 	if resp.Value == nil {
@@ -328,3 +346,17 @@ func (s *AzureKeyVaultStore) GetKey(key string) (interface{}, error) {
 	}
 	return result, nil
 }
+
+// wrapSecretError maps Azure response errors to store errors.
+func (s *AzureKeyVaultStore) wrapSecretError(secretName string, err error, fallback error) error {
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		switch respErr.StatusCode {
+		case statusCodeNotFound:
+			return fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, secretName, err)
+		case statusCodeForbidden:
+			return fmt.Errorf(errWrapFormatWithID, ErrPermissionDenied, fmt.Sprintf("secret %s", secretName), err)
+		}
+	}
+	return fmt.Errorf(errWrapFormat, fallback, err)
+}
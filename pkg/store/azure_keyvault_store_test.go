@@ -10,8 +10,9 @@ import (
 )
 
 type mockClient struct {
-	getSecretFunc func(ctx context.Context, name string, version string, options *azsecrets.GetSecretOptions) (azsecrets.GetSecretResponse, error)
-	setSecretFunc func(ctx context.Context, name string, parameters azsecrets.SetSecretParameters, options *azsecrets.SetSecretOptions) (azsecrets.SetSecretResponse, error)
+	getSecretFunc    func(ctx context.Context, name string, version string, options *azsecrets.GetSecretOptions) (azsecrets.GetSecretResponse, error)
+	setSecretFunc    func(ctx context.Context, name string, parameters azsecrets.SetSecretParameters, options *azsecrets.SetSecretOptions) (azsecrets.SetSecretResponse, error)
+	deleteSecretFunc func(ctx context.Context, name string, options *azsecrets.DeleteSecretOptions) (azsecrets.DeleteSecretResponse, error)
 }
 
 func (m *mockClient) GetSecret(ctx context.Context, name string, version string, options *azsecrets.GetSecretOptions) (azsecrets.GetSecretResponse, error) {
@@ -22,6 +23,10 @@ func (m *mockClient) SetSecret(ctx context.Context, name string, parameters azse
 	return m.setSecretFunc(ctx, name, parameters, options)
 }
 
+func (m *mockClient) DeleteSecret(ctx context.Context, name string, options *azsecrets.DeleteSecretOptions) (azsecrets.DeleteSecretResponse, error) {
+	return m.deleteSecretFunc(ctx, name, options)
+}
+
 func TestAzureKeyVaultStore_Set(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -390,3 +395,64 @@ func TestAzureKeyVaultStore_GetKey(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureKeyVaultStore_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr error
+	}{
+		{name: "deleted"},
+		{name: "not found", mockErr: &azcore.ResponseError{StatusCode: statusCodeNotFound}, wantErr: ErrResourceNotFound},
+		{name: "forbidden", mockErr: &azcore.ResponseError{StatusCode: statusCodeForbidden}, wantErr: ErrPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted string
+			delimiter := "-"
+			store := &AzureKeyVaultStore{
+				client: &mockClient{
+					deleteSecretFunc: func(ctx context.Context, name string, options *azsecrets.DeleteSecretOptions) (azsecrets.DeleteSecretResponse, error) {
+						deleted = name
+						return azsecrets.DeleteSecretResponse{}, tt.mockErr
+					},
+				},
+				stackDelimiter: &delimiter,
+			}
+
+			err := store.Delete("dev", "app", "secret")
+
+			assert.Equal(t, "dev-app-secret", deleted)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAzureKeyVaultStore_GetVersion(t *testing.T) {
+	delimiter := "-"
+	value := `{"id":1}`
+	var requestedVersion string
+	store := &AzureKeyVaultStore{
+		client: &mockClient{
+			getSecretFunc: func(ctx context.Context, name string, version string, options *azsecrets.GetSecretOptions) (azsecrets.GetSecretResponse, error) {
+				requestedVersion = version
+				return azsecrets.GetSecretResponse{Secret: azsecrets.Secret{Value: &value}}, nil
+			},
+		},
+		stackDelimiter: &delimiter,
+	}
+
+	result, err := store.GetVersion("dev", "app", "secret", "abc123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", requestedVersion)
+	assert.Equal(t, map[string]interface{}{"id": float64(1)}, result)
+
+	_, err = store.GetVersion("dev", "app", "secret", "")
+	assert.ErrorIs(t, err, ErrInvalidVersion)
+}
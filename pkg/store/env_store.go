@@ -0,0 +1,144 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+const defaultEnvStorePrefix = "ATMOS_STORE"
+
+// EnvStore is a read-only store backed by environment variables.
+// A value is read from `<PREFIX>_<STACK>_<COMPONENT>_<KEY>`, upper-cased with every
+// non-alphanumeric character replaced by `_`. Values that are valid JSON are decoded.
+type EnvStore struct {
+	prefix         string
+	stackDelimiter string
+	lookupEnv      func(string) (string, bool)
+	environ        func() []string
+}
+
+type EnvStoreOptions struct {
+	Prefix         *string `mapstructure:"prefix"`
+	StackDelimiter *string `mapstructure:"stack_delimiter"`
+}
+
+// Ensure EnvStore implements the store.Store and ListableStore interfaces.
+var (
+	_ Store         = (*EnvStore)(nil)
+	_ ListableStore = (*EnvStore)(nil)
+)
+
+func NewEnvStore(options EnvStoreOptions) (Store, error) {
+	prefix := defaultEnvStorePrefix
+	if options.Prefix != nil {
+		prefix = *options.Prefix
+	}
+
+	stackDelimiter := "-"
+	if options.StackDelimiter != nil {
+		stackDelimiter = *options.StackDelimiter
+	}
+
+	return &EnvStore{
+		prefix:         prefix,
+		stackDelimiter: stackDelimiter,
+		lookupEnv:      os.LookupEnv,
+		environ:        os.Environ,
+	}, nil
+}
+
+// envVarName builds the environment variable name from the name parts.
+func (s *EnvStore) envVarName(parts ...string) string {
+	var segments []string
+	if s.prefix != "" {
+		segments = append(segments, s.prefix)
+	}
+	for _, part := range parts {
+		if part != "" {
+			segments = append(segments, part)
+		}
+	}
+
+	name := strings.Join(segments, "_")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+func (s *EnvStore) Get(stack string, component string, key string) (any, error) {
+	if stack == "" {
+		return nil, ErrEmptyStack
+	}
+
+	if component == "" {
+		return nil, ErrEmptyComponent
+	}
+
+	if key == "" {
+		return nil, ErrEmptyKey
+	}
+
+	parts := strings.Split(stack, s.stackDelimiter)
+	parts = append(parts, component, key)
+	return s.lookup(s.envVarName(parts...))
+}
+
+// Set is not supported because the env store is read-only.
+func (s *EnvStore) Set(stack string, component string, key string, value any) error {
+	return fmt.Errorf(errWrapFormat, ErrReadOnlyStore, "env")
+}
+
+// List returns the keys of the environment variables that belong to the stack and component.
+// Environment variable names are upper-cased, so the keys are returned in lower case.
+func (s *EnvStore) List(stack string, component string) ([]string, error) {
+	if err := validateComponentArgs(stack, component); err != nil {
+		return nil, err
+	}
+
+	parts := strings.Split(stack, s.stackDelimiter)
+	parts = append(parts, component)
+	prefix := s.envVarName(parts...) + "_"
+
+	keys := []string{}
+	for _, entry := range s.environ() {
+		name, _, _ := strings.Cut(entry, "=")
+		if key, ok := strings.CutPrefix(name, prefix); ok && key != "" {
+			keys = append(keys, strings.ToLower(key))
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// GetKey reads the environment variable `<PREFIX>_<KEY>`.
+func (s *EnvStore) GetKey(key string) (any, error) {
+	if key == "" {
+		return nil, ErrEmptyKey
+	}
+
+	return s.lookup(s.envVarName(key))
+}
+
+func (s *EnvStore) lookup(name string) (any, error) {
+	value, ok := s.lookupEnv(name)
+	if !ok {
+		return nil, fmt.Errorf(errWrapFormat, ErrResourceNotFound, name)
+	}
+
+	var result any
+	if err := json.Unmarshal([]byte(value), &result); err != nil {
+		// Not JSON, return the raw string.
+		return value, nil
+	}
+	return result, nil
+}
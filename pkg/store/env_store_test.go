@@ -0,0 +1,86 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvStore_Get(t *testing.T) {
+	t.Setenv("ATMOS_STORE_PLAT_UE2_DEV_VPC_VPC_ID", "vpc-123")
+	t.Setenv("ATMOS_STORE_PLAT_UE2_DEV_VPC_SUBNETS", `["a","b"]`)
+	t.Setenv("ATMOS_STORE_PLAT_UE2_DEV_VPC_COUNT", "3")
+
+	s, err := NewEnvStore(EnvStoreOptions{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		key     string
+		want    any
+		wantErr error
+	}{
+		{name: "string value", key: "vpc_id", want: "vpc-123"},
+		{name: "json list", key: "subnets", want: []any{"a", "b"}},
+		{name: "json number", key: "count", want: float64(3)},
+		{name: "missing", key: "missing", wantErr: ErrResourceNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := s.Get("plat-ue2-dev", "vpc", tt.key)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, value)
+		})
+	}
+}
+
+func TestEnvStore_GetKey(t *testing.T) {
+	t.Setenv("CI_SHARED_DB_HOST", "db.internal")
+
+	prefix := "ci"
+	s, err := NewEnvStore(EnvStoreOptions{Prefix: &prefix})
+	require.NoError(t, err)
+
+	value, err := s.GetKey("shared/db-host")
+	require.NoError(t, err)
+	assert.Equal(t, "db.internal", value)
+
+	_, err = s.GetKey("")
+	assert.ErrorIs(t, err, ErrEmptyKey)
+}
+
+func TestEnvStore_Validation(t *testing.T) {
+	s, err := NewEnvStore(EnvStoreOptions{})
+	require.NoError(t, err)
+
+	_, err = s.Get("", "vpc", "k")
+	assert.ErrorIs(t, err, ErrEmptyStack)
+	_, err = s.Get("dev", "", "k")
+	assert.ErrorIs(t, err, ErrEmptyComponent)
+	_, err = s.Get("dev", "vpc", "")
+	assert.ErrorIs(t, err, ErrEmptyKey)
+
+	err = s.Set("dev", "vpc", "k", "v")
+	assert.ErrorIs(t, err, ErrReadOnlyStore)
+}
+
+func TestEnvStore_List(t *testing.T) {
+	t.Setenv("ATMOS_STORE_PLAT_UE2_DEV_VPC_VPC_ID", "vpc-123")
+	t.Setenv("ATMOS_STORE_PLAT_UE2_DEV_VPC_CIDR", "10.0.0.0/16")
+	t.Setenv("ATMOS_STORE_PLAT_UE2_DEV_EKS_NAME", "eks")
+
+	s, err := NewEnvStore(EnvStoreOptions{})
+	require.NoError(t, err)
+
+	keys, err := s.(ListableStore).List("plat-ue2-dev", "vpc")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cidr", "vpc_id"}, keys)
+
+	assert.ErrorIs(t, s.Set("plat-ue2-dev", "vpc", "vpc_id", "x"), ErrReadOnlyStore)
+}
@@ -53,10 +53,32 @@ var (
 	ErrCreateSecret      = errors.New("failed to create secret")
 	ErrAddSecretVersion  = errors.New("failed to add secret version")
 
+	// File store specific errors.
+	ErrFileStorePathRequired  = errors.New("path is required in file store configuration")
+	ErrInvalidFileStoreFormat = errors.New("invalid file store format, must be one of: json, yaml")
+	ErrInvalidStoreKey        = errors.New("invalid store key")
+	ErrFileStoreLock          = errors.New("failed to lock file store document")
+	ErrWriteFileStore         = errors.New("failed to write file store document")
+
+	// Env store specific errors.
+	ErrReadOnlyStore = errors.New("store is read-only")
+
+	// Vault specific errors.
+	ErrMissingVaultAddress    = errors.New("either address must be set in options or VAULT_ADDR environment variable must be set")
+	ErrInvalidVaultKVVersion  = errors.New("invalid vault kv_version, must be 1 or 2")
+	ErrInvalidVaultAuthMethod = errors.New("invalid vault auth method, must be one of: token, approle, jwt")
+	ErrVaultAuthConfig        = errors.New("invalid vault auth configuration")
+	ErrVaultLogin             = errors.New("failed to log in to vault")
+	ErrReadSecret             = errors.New("failed to read secret")
+	ErrWriteSecret            = errors.New("failed to write secret")
+
 	// Registry specific errors.
 	ErrParseArtifactoryOptions = errors.New("failed to parse Artifactory store options")
 	ErrParseSSMOptions         = errors.New("failed to parse SSM store options")
 	ErrParseRedisOptions       = errors.New("failed to parse Redis store options")
+	ErrParseFileOptions        = errors.New("failed to parse file store options")
+	ErrParseEnvOptions         = errors.New("failed to parse env store options")
+	ErrParseVaultOptions       = errors.New("failed to parse Vault store options")
 	ErrStoreTypeNotFound       = errors.New("store type not found")
 
 	// Identity errors.
@@ -67,4 +89,10 @@ var (
 	ErrSerializeJSON = errors.New("failed to serialize value to JSON")
 	ErrMarshalValue  = errors.New("failed to marshal value")
 	ErrNilValue      = errors.New("cannot store nil value")
+
+	// Optional operation errors.
+	ErrOperationNotSupported = errors.New("operation not supported by store")
+	ErrInvalidVersion        = errors.New("invalid version")
+	ErrDeleteKey             = errors.New("failed to delete key")
+	ErrListKeys              = errors.New("failed to list keys")
 )
@@ -0,0 +1,328 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gofrs/flock"
+	"gopkg.in/yaml.v3"
+
+	"github.com/cloudposse/atmos/pkg/filesystem/atomicfile"
+)
+
+const (
+	fileStoreFormatJSON = "json"
+	fileStoreFormatYAML = "yaml"
+
+	fileStoreDirPerm  = 0o755
+	fileStoreFilePerm = 0o644
+
+	// File locking timeouts.
+	fileStoreLockTimeout = 10 * time.Second
+	fileStoreLockRetry   = 50 * time.Millisecond
+)
+
+// FileStore keeps values in a directory of JSON or YAML documents.
+// Each stack and component has one document that maps keys to values:
+// `<path>/<prefix>/<stack parts>/<component parts>.<format>`.
+type FileStore struct {
+	path           string
+	prefix         string
+	format         string
+	stackDelimiter string
+}
+
+type FileStoreOptions struct {
+	Path           *string `mapstructure:"path"`
+	Prefix         *string `mapstructure:"prefix"`
+	Format         *string `mapstructure:"format"`
+	StackDelimiter *string `mapstructure:"stack_delimiter"`
+}
+
+// Ensure FileStore implements the store.Store, DeletableStore and ListableStore interfaces.
+var (
+	_ Store          = (*FileStore)(nil)
+	_ DeletableStore = (*FileStore)(nil)
+	_ ListableStore  = (*FileStore)(nil)
+)
+
+func NewFileStore(options FileStoreOptions) (Store, error) {
+	if options.Path == nil || *options.Path == "" {
+		return nil, ErrFileStorePathRequired
+	}
+
+	format := fileStoreFormatJSON
+	if options.Format != nil && *options.Format != "" {
+		format = strings.ToLower(*options.Format)
+	}
+	if format == "yml" {
+		format = fileStoreFormatYAML
+	}
+	if format != fileStoreFormatJSON && format != fileStoreFormatYAML {
+		return nil, fmt.Errorf(errWrapFormat, ErrInvalidFileStoreFormat, format)
+	}
+
+	prefix := ""
+	if options.Prefix != nil {
+		prefix = *options.Prefix
+	}
+
+	stackDelimiter := "/"
+	if options.StackDelimiter != nil {
+		stackDelimiter = *options.StackDelimiter
+	}
+
+	return &FileStore{
+		path:           *options.Path,
+		prefix:         prefix,
+		format:         format,
+		stackDelimiter: stackDelimiter,
+	}, nil
+}
+
+// documentPath returns the path of the document that holds the keys of the stack and component.
+func (s *FileStore) documentPath(stack string, component string) (string, error) {
+	parts := strings.Split(stack, s.stackDelimiter)
+	parts = append(parts, strings.Split(component, "/")...)
+	return s.resolve(parts)
+}
+
+// resolve joins the path segments under the store directory and rejects segments that would escape it.
+func (s *FileStore) resolve(segments []string) (string, error) {
+	parts := []string{s.path}
+	if s.prefix != "" {
+		parts = append(parts, s.prefix)
+	}
+	for _, segment := range segments {
+		if segment == "" {
+			continue
+		}
+		if segment == "." || segment == ".." || strings.ContainsAny(segment, `/\`) {
+			return "", fmt.Errorf(errWrapFormat, ErrInvalidStoreKey, strings.Join(segments, "/"))
+		}
+		parts = append(parts, segment)
+	}
+	return filepath.Join(parts...) + "." + s.format, nil
+}
+
+func (s *FileStore) Get(stack string, component string, key string) (any, error) {
+	if stack == "" {
+		return nil, ErrEmptyStack
+	}
+
+	if component == "" {
+		return nil, ErrEmptyComponent
+	}
+
+	if key == "" {
+		return nil, ErrEmptyKey
+	}
+
+	path, err := s.documentPath(stack, component)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.readKey(path, key)
+}
+
+func (s *FileStore) Set(stack string, component string, key string, value any) error {
+	if stack == "" {
+		return ErrEmptyStack
+	}
+
+	if component == "" {
+		return ErrEmptyComponent
+	}
+
+	if key == "" {
+		return ErrEmptyKey
+	}
+
+	if value == nil {
+		return fmt.Errorf("%w for key %s in stack %s component %s", ErrNilValue, key, stack, component)
+	}
+
+	path, err := s.documentPath(stack, component)
+	if err != nil {
+		return err
+	}
+
+	return s.update(path, func(document map[string]any) {
+		document[key] = value
+	})
+}
+
+// Delete removes a key from the document of the stack and component.
+// The document is removed when its last key is deleted.
+func (s *FileStore) Delete(stack string, component string, key string) error {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return err
+	}
+
+	path, err := s.documentPath(stack, component)
+	if err != nil {
+		return err
+	}
+
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, key, path)
+	}
+
+	var found bool
+	err = s.update(path, func(document map[string]any) {
+		_, found = document[key]
+		delete(document, key)
+	})
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, key, path)
+	}
+	return nil
+}
+
+// List returns the keys in the document of the stack and component.
+func (s *FileStore) List(stack string, component string) ([]string, error) {
+	if err := validateComponentArgs(stack, component); err != nil {
+		return nil, err
+	}
+
+	path, err := s.documentPath(stack, component)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := s.readDocument(path)
+	if errors.Is(err, ErrResourceNotFound) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(document))
+	for key := range document {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// update applies a change to the document at path while holding its lock.
+// Writers lock a dedicated file so concurrent updates of the same document don't lose keys.
+// The document itself is replaced atomically, so readers never observe a partial write.
+func (s *FileStore) update(path string, change func(document map[string]any)) error {
+	if err := os.MkdirAll(filepath.Dir(path), fileStoreDirPerm); err != nil {
+		return fmt.Errorf(errFormat, ErrWriteFileStore, err)
+	}
+
+	lock := flock.New(path + ".lock")
+	ctx, cancel := context.WithTimeout(context.Background(), fileStoreLockTimeout)
+	defer cancel()
+	locked, err := lock.TryLockContext(ctx, fileStoreLockRetry)
+	if err != nil || !locked {
+		return fmt.Errorf(errWrapFormatWithID, ErrFileStoreLock, path, err)
+	}
+	defer func() { _ = lock.Unlock() }()
+
+	document, err := s.readDocument(path)
+	if err != nil && !errors.Is(err, ErrResourceNotFound) {
+		return err
+	}
+	if document == nil {
+		document = map[string]any{}
+	}
+	change(document)
+
+	if len(document) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf(errFormat, ErrWriteFileStore, err)
+		}
+		return nil
+	}
+
+	data, err := s.marshal(document)
+	if err != nil {
+		return fmt.Errorf(errFormat, ErrMarshalValue, err)
+	}
+
+	if err := atomicfile.WriteFile(path, data, fileStoreFilePerm); err != nil {
+		return fmt.Errorf(errFormat, ErrWriteFileStore, err)
+	}
+	return nil
+}
+
+// GetKey reads a value by its path in the store, e.g. `plat/ue2/dev/vpc/vpc_id`.
+// The last segment is the key and the preceding segments name the document.
+func (s *FileStore) GetKey(key string) (any, error) {
+	if key == "" {
+		return nil, ErrEmptyKey
+	}
+
+	segments := strings.Split(strings.Trim(key, "/"), "/")
+	if len(segments) < 2 {
+		return nil, fmt.Errorf(errWrapFormat, ErrInvalidStoreKey, key)
+	}
+
+	path, err := s.resolve(segments[:len(segments)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	return s.readKey(path, segments[len(segments)-1])
+}
+
+func (s *FileStore) readKey(path string, key string) (any, error) {
+	document, err := s.readDocument(path)
+	if err != nil {
+		return nil, err
+	}
+
+	value, ok := document[key]
+	if !ok {
+		return nil, fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, key, path)
+	}
+	return value, nil
+}
+
+func (s *FileStore) readDocument(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, path, err)
+		}
+		return nil, fmt.Errorf(errFormat, ErrReadFile, err)
+	}
+
+	document := map[string]any{}
+	if s.format == fileStoreFormatYAML {
+		err = yaml.Unmarshal(data, &document)
+	} else if len(data) > 0 {
+		err = json.Unmarshal(data, &document)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormatWithID, ErrUnmarshalFile, path, err)
+	}
+	return document, nil
+}
+
+func (s *FileStore) marshal(document map[string]any) ([]byte, error) {
+	if s.format == fileStoreFormatYAML {
+		return yaml.Marshal(document)
+	}
+
+	data, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
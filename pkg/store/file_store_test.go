@@ -0,0 +1,189 @@
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestFileStore(t *testing.T, options FileStoreOptions) (*FileStore, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	options.Path = &dir
+	s, err := NewFileStore(options)
+	require.NoError(t, err)
+	return s.(*FileStore), dir
+}
+
+func TestNewFileStore_Validation(t *testing.T) {
+	empty := ""
+	_, err := NewFileStore(FileStoreOptions{Path: &empty})
+	assert.ErrorIs(t, err, ErrFileStorePathRequired)
+
+	dir := t.TempDir()
+	format := "toml"
+	_, err = NewFileStore(FileStoreOptions{Path: &dir, Format: &format})
+	assert.ErrorIs(t, err, ErrInvalidFileStoreFormat)
+
+	format = "YML"
+	s, err := NewFileStore(FileStoreOptions{Path: &dir, Format: &format})
+	require.NoError(t, err)
+	assert.Equal(t, fileStoreFormatYAML, s.(*FileStore).format)
+}
+
+func TestFileStore_SetGet(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		ext    string
+	}{
+		{name: "json", format: "json", ext: ".json"},
+		{name: "yaml", format: "yaml", ext: ".yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefix := "atmos"
+			s, dir := newTestFileStore(t, FileStoreOptions{Format: &tt.format, Prefix: &prefix})
+
+			require.NoError(t, s.Set("plat/ue2/dev", "vpc", "vpc_id", "vpc-123"))
+			require.NoError(t, s.Set("plat/ue2/dev", "vpc", "subnets", []any{"a", "b"}))
+			require.NoError(t, s.Set("plat/ue2/dev", "vpc", "tags", map[string]any{"env": "dev"}))
+
+			assert.FileExists(t, filepath.Join(dir, "atmos", "plat", "ue2", "dev", "vpc"+tt.ext))
+
+			value, err := s.Get("plat/ue2/dev", "vpc", "vpc_id")
+			require.NoError(t, err)
+			assert.Equal(t, "vpc-123", value)
+
+			value, err = s.Get("plat/ue2/dev", "vpc", "subnets")
+			require.NoError(t, err)
+			assert.Equal(t, []any{"a", "b"}, value)
+
+			value, err = s.Get("plat/ue2/dev", "vpc", "tags")
+			require.NoError(t, err)
+			assert.Equal(t, map[string]any{"env": "dev"}, value)
+
+			value, err = s.GetKey("plat/ue2/dev/vpc/vpc_id")
+			require.NoError(t, err)
+			assert.Equal(t, "vpc-123", value)
+		})
+	}
+}
+
+func TestFileStore_NotFound(t *testing.T) {
+	s, _ := newTestFileStore(t, FileStoreOptions{})
+
+	_, err := s.Get("dev", "vpc", "vpc_id")
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+
+	require.NoError(t, s.Set("dev", "vpc", "cidr", "10.0.0.0/16"))
+	_, err = s.Get("dev", "vpc", "vpc_id")
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+}
+
+func TestFileStore_Validation(t *testing.T) {
+	s, _ := newTestFileStore(t, FileStoreOptions{})
+
+	tests := []struct {
+		name      string
+		stack     string
+		component string
+		key       string
+		value     any
+		wantErr   error
+	}{
+		{name: "empty stack", component: "vpc", key: "k", value: "v", wantErr: ErrEmptyStack},
+		{name: "empty component", stack: "dev", key: "k", value: "v", wantErr: ErrEmptyComponent},
+		{name: "empty key", stack: "dev", component: "vpc", value: "v", wantErr: ErrEmptyKey},
+		{name: "nil value", stack: "dev", component: "vpc", key: "k", wantErr: ErrNilValue},
+		{name: "path traversal", stack: "../dev", component: "vpc", key: "k", value: "v", wantErr: ErrInvalidStoreKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.Set(tt.stack, tt.component, tt.key, tt.value)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	_, err := s.GetKey("../../etc/passwd")
+	assert.ErrorIs(t, err, ErrInvalidStoreKey)
+
+	_, err = s.GetKey("vpc_id")
+	assert.ErrorIs(t, err, ErrInvalidStoreKey)
+}
+
+func TestFileStore_UnmarshalError(t *testing.T) {
+	s, dir := newTestFileStore(t, FileStoreOptions{})
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dev.json"), []byte("{not json"), 0o644))
+
+	_, err := s.Get("dev", "vpc", "vpc_id")
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "dev"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "dev", "vpc.json"), []byte("{not json"), 0o644))
+
+	_, err = s.Get("dev", "vpc", "vpc_id")
+	assert.ErrorIs(t, err, ErrUnmarshalFile)
+
+	err = s.Set("dev", "vpc", "vpc_id", "vpc-123")
+	assert.ErrorIs(t, err, ErrUnmarshalFile)
+}
+
+func TestFileStore_ConcurrentSet(t *testing.T) {
+	s, _ := newTestFileStore(t, FileStoreOptions{})
+
+	const writers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, s.Set("dev", "vpc", fmt.Sprintf("key_%d", i), i))
+		}(i)
+	}
+	wg.Wait()
+
+	// Every writer's key must survive the concurrent read-modify-write cycles.
+	for i := 0; i < writers; i++ {
+		value, err := s.Get("dev", "vpc", fmt.Sprintf("key_%d", i))
+		require.NoError(t, err)
+		assert.EqualValues(t, i, value)
+	}
+}
+
+func TestFileStore_DeleteList(t *testing.T) {
+	s, _ := newTestFileStore(t, FileStoreOptions{})
+
+	keys, err := s.List("plat/ue2/dev", "vpc")
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	require.NoError(t, s.Set("plat/ue2/dev", "vpc", "vpc_id", "vpc-123"))
+	require.NoError(t, s.Set("plat/ue2/dev", "vpc", "cidr", "10.0.0.0/16"))
+
+	keys, err = s.List("plat/ue2/dev", "vpc")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cidr", "vpc_id"}, keys)
+
+	require.NoError(t, s.Delete("plat/ue2/dev", "vpc", "vpc_id"))
+	assert.ErrorIs(t, s.Delete("plat/ue2/dev", "vpc", "vpc_id"), ErrResourceNotFound)
+
+	require.NoError(t, s.Delete("plat/ue2/dev", "vpc", "cidr"))
+	path, err := s.documentPath("plat/ue2/dev", "vpc")
+	require.NoError(t, err)
+	assert.NoFileExists(t, path)
+
+	assert.ErrorIs(t, s.Delete("plat/ue2/dev", "vpc", "cidr"), ErrResourceNotFound)
+	assert.ErrorIs(t, s.Delete("", "vpc", "cidr"), ErrEmptyStack)
+	_, err = s.List("plat/ue2/dev", "")
+	assert.ErrorIs(t, err, ErrEmptyComponent)
+}
@@ -28,6 +28,7 @@ type GSMClient interface {
 	CreateSecret(ctx context.Context, req *secretmanagerpb.CreateSecretRequest, opts ...gax.CallOption) (*secretmanagerpb.Secret, error)
 	AddSecretVersion(ctx context.Context, req *secretmanagerpb.AddSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.SecretVersion, error)
 	AccessSecretVersion(ctx context.Context, req *secretmanagerpb.AccessSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.AccessSecretVersionResponse, error)
+	DeleteSecret(ctx context.Context, req *secretmanagerpb.DeleteSecretRequest, opts ...gax.CallOption) error
 	Close() error
 }
 
@@ -60,6 +61,8 @@ type GSMStoreOptions struct {
 var (
 	_ Store              = (*GSMStore)(nil)
 	_ IdentityAwareStore = (*GSMStore)(nil)
+	_ DeletableStore     = (*GSMStore)(nil)
+	_ VersionedStore     = (*GSMStore)(nil)
 )
 
 // NewGSMStore initializes a new Google Secret Manager Store.
@@ -353,8 +356,71 @@ func (s *GSMStore) Get(stack string, component string, key string) (any, error)
 		return nil, fmt.Errorf(errWrapFormat, ErrGetKey, err)
 	}
 
-	// Build the resource name for the latest version
-	name := fmt.Sprintf("projects/%s/secrets/%s/versions/latest", s.projectID, secretID)
+	return s.accessSecretVersion(ctx, secretID, "latest")
+}
+
+// GetVersion retrieves a specific version of a secret from Google Secret Manager.
+func (s *GSMStore) GetVersion(stack string, component string, key string, version string) (any, error) {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return nil, err
+	}
+	if version == "" {
+		return nil, fmt.Errorf("%w: version cannot be empty", ErrInvalidVersion)
+	}
+
+	if err := s.ensureClient(); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gsmOperationTimeout)
+	defer cancel()
+
+	secretID, err := s.getKey(stack, component, key)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, ErrGetKey, err)
+	}
+
+	return s.accessSecretVersion(ctx, secretID, version)
+}
+
+// Delete removes a secret and all of its versions from Google Secret Manager.
+func (s *GSMStore) Delete(stack string, component string, key string) error {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return err
+	}
+
+	if err := s.ensureClient(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), gsmOperationTimeout)
+	defer cancel()
+
+	secretID, err := s.getKey(stack, component, key)
+	if err != nil {
+		return fmt.Errorf(errWrapFormat, ErrGetKey, err)
+	}
+
+	err = s.client.DeleteSecret(ctx, &secretmanagerpb.DeleteSecretRequest{
+		Name: fmt.Sprintf("projects/%s/secrets/%s", s.projectID, secretID),
+	})
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			switch st.Code() {
+			case codes.NotFound:
+				return fmt.Errorf(errWrapFormatWithID, ErrResourceNotFound, secretID, err)
+			case codes.PermissionDenied:
+				return fmt.Errorf(errWrapFormatWithID, ErrPermissionDenied, fmt.Sprintf("secret %s", secretID), err)
+			}
+		}
+		return fmt.Errorf(errWrapFormat, ErrDeleteKey, err)
+	}
+	return nil
+}
+
+// accessSecretVersion reads a secret version and decodes its JSON payload.
+func (s *GSMStore) accessSecretVersion(ctx context.Context, secretID string, version string) (any, error) {
+	name := fmt.Sprintf("projects/%s/secrets/%s/versions/%s", s.projectID, secretID, version)
 
 	// Access the secret version
 	result, err := s.client.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{
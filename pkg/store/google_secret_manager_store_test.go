@@ -53,6 +53,12 @@ func (m *MockGSMClient) AccessSecretVersion(ctx context.Context, req *secretmana
 	return args.Get(0).(*secretmanagerpb.AccessSecretVersionResponse), args.Error(1)
 }
 
+// DeleteSecret mocks the GSM DeleteSecret API call.
+func (m *MockGSMClient) DeleteSecret(ctx context.Context, req *secretmanagerpb.DeleteSecretRequest, opts ...gax.CallOption) error {
+	args := m.Called(mock.Anything, req)
+	return args.Error(0)
+}
+
 // Close mocks the GSM client Close method.
 func (m *MockGSMClient) Close() error {
 	args := m.Called()
@@ -741,3 +747,53 @@ func TestGSMStore_GetKeyDirect(t *testing.T) {
 		})
 	}
 }
+
+func TestGSMStore_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		mockErr error
+		wantErr error
+	}{
+		{name: "deleted"},
+		{name: "not found", mockErr: status.Error(codes.NotFound, "not found"), wantErr: ErrResourceNotFound},
+		{name: "permission denied", mockErr: status.Error(codes.PermissionDenied, "denied"), wantErr: ErrPermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(MockGSMClient)
+			store := newGSMStoreWithClient(mockClient, GSMStoreOptions{ProjectID: "test-project"})
+			mockClient.On("DeleteSecret", mock.Anything, &secretmanagerpb.DeleteSecretRequest{
+				Name: "projects/test-project/secrets/dev_app_secret",
+			}).Return(tt.mockErr)
+
+			err := store.Delete("dev", "app", "secret")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGSMStore_GetVersion(t *testing.T) {
+	mockClient := new(MockGSMClient)
+	store := newGSMStoreWithClient(mockClient, GSMStoreOptions{ProjectID: "test-project"})
+	mockClient.On("AccessSecretVersion", mock.Anything, &secretmanagerpb.AccessSecretVersionRequest{
+		Name: "projects/test-project/secrets/dev_app_secret/versions/2",
+	}).Return(&secretmanagerpb.AccessSecretVersionResponse{
+		Payload: &secretmanagerpb.SecretPayload{Data: []byte(`"previous"`)},
+	}, nil)
+
+	value, err := store.GetVersion("dev", "app", "secret", "2")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "previous", value)
+	mockClient.AssertExpectations(t)
+
+	_, err = store.GetVersion("dev", "app", "secret", "")
+	assert.ErrorIs(t, err, ErrInvalidVersion)
+}
@@ -43,6 +43,9 @@ type AuthContextResolver interface {
 
 	// ResolveGCPAuthContext authenticates the named identity and returns GCP credentials.
 	ResolveGCPAuthContext(ctx context.Context, identityName string) (*GCPAuthConfig, error)
+
+	// ResolveOIDCToken authenticates the named identity and returns the OIDC token (JWT) of its provider.
+	ResolveOIDCToken(ctx context.Context, identityName string) (string, error)
 }
 
 // IdentityAwareStore is implemented by stores that support identity-based authentication.
@@ -85,6 +85,21 @@ func (mr *MockAuthContextResolverMockRecorder) ResolveGCPAuthContext(ctx, identi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveGCPAuthContext", reflect.TypeOf((*MockAuthContextResolver)(nil).ResolveGCPAuthContext), ctx, identityName)
 }
 
+// ResolveOIDCToken mocks base method.
+func (m *MockAuthContextResolver) ResolveOIDCToken(ctx context.Context, identityName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveOIDCToken", ctx, identityName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveOIDCToken indicates an expected call of ResolveOIDCToken.
+func (mr *MockAuthContextResolverMockRecorder) ResolveOIDCToken(ctx, identityName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveOIDCToken", reflect.TypeOf((*MockAuthContextResolver)(nil).ResolveOIDCToken), ctx, identityName)
+}
+
 // MockIdentityAwareStore is a mock of IdentityAwareStore interface.
 type MockIdentityAwareStore struct {
 	ctrl     *gomock.Controller
@@ -82,3 +82,251 @@ func (mr *MockStoreMockRecorder) Set(stack, component, key, value any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockStore)(nil).Set), stack, component, key, value)
 }
+
+// MockDeletableStore is a mock of DeletableStore interface.
+type MockDeletableStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeletableStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockDeletableStoreMockRecorder is the mock recorder for MockDeletableStore.
+type MockDeletableStoreMockRecorder struct {
+	mock *MockDeletableStore
+}
+
+// NewMockDeletableStore creates a new mock instance.
+func NewMockDeletableStore(ctrl *gomock.Controller) *MockDeletableStore {
+	mock := &MockDeletableStore{ctrl: ctrl}
+	mock.recorder = &MockDeletableStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeletableStore) EXPECT() *MockDeletableStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockDeletableStore) Delete(stack, component, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", stack, component, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockDeletableStoreMockRecorder) Delete(stack, component, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockDeletableStore)(nil).Delete), stack, component, key)
+}
+
+// Get mocks base method.
+func (m *MockDeletableStore) Get(stack, component, key string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", stack, component, key)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockDeletableStoreMockRecorder) Get(stack, component, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockDeletableStore)(nil).Get), stack, component, key)
+}
+
+// GetKey mocks base method.
+func (m *MockDeletableStore) GetKey(key string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKey", key)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKey indicates an expected call of GetKey.
+func (mr *MockDeletableStoreMockRecorder) GetKey(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockDeletableStore)(nil).GetKey), key)
+}
+
+// Set mocks base method.
+func (m *MockDeletableStore) Set(stack, component, key string, value any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", stack, component, key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockDeletableStoreMockRecorder) Set(stack, component, key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockDeletableStore)(nil).Set), stack, component, key, value)
+}
+
+// MockListableStore is a mock of ListableStore interface.
+type MockListableStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockListableStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockListableStoreMockRecorder is the mock recorder for MockListableStore.
+type MockListableStoreMockRecorder struct {
+	mock *MockListableStore
+}
+
+// NewMockListableStore creates a new mock instance.
+func NewMockListableStore(ctrl *gomock.Controller) *MockListableStore {
+	mock := &MockListableStore{ctrl: ctrl}
+	mock.recorder = &MockListableStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListableStore) EXPECT() *MockListableStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockListableStore) Get(stack, component, key string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", stack, component, key)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockListableStoreMockRecorder) Get(stack, component, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockListableStore)(nil).Get), stack, component, key)
+}
+
+// GetKey mocks base method.
+func (m *MockListableStore) GetKey(key string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKey", key)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKey indicates an expected call of GetKey.
+func (mr *MockListableStoreMockRecorder) GetKey(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockListableStore)(nil).GetKey), key)
+}
+
+// List mocks base method.
+func (m *MockListableStore) List(stack, component string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", stack, component)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockListableStoreMockRecorder) List(stack, component any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockListableStore)(nil).List), stack, component)
+}
+
+// Set mocks base method.
+func (m *MockListableStore) Set(stack, component, key string, value any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", stack, component, key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockListableStoreMockRecorder) Set(stack, component, key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockListableStore)(nil).Set), stack, component, key, value)
+}
+
+// MockVersionedStore is a mock of VersionedStore interface.
+type MockVersionedStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockVersionedStoreMockRecorder
+	isgomock struct{}
+}
+
+// MockVersionedStoreMockRecorder is the mock recorder for MockVersionedStore.
+type MockVersionedStoreMockRecorder struct {
+	mock *MockVersionedStore
+}
+
+// NewMockVersionedStore creates a new mock instance.
+func NewMockVersionedStore(ctrl *gomock.Controller) *MockVersionedStore {
+	mock := &MockVersionedStore{ctrl: ctrl}
+	mock.recorder = &MockVersionedStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVersionedStore) EXPECT() *MockVersionedStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockVersionedStore) Get(stack, component, key string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", stack, component, key)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockVersionedStoreMockRecorder) Get(stack, component, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVersionedStore)(nil).Get), stack, component, key)
+}
+
+// GetKey mocks base method.
+func (m *MockVersionedStore) GetKey(key string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKey", key)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKey indicates an expected call of GetKey.
+func (mr *MockVersionedStoreMockRecorder) GetKey(key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKey", reflect.TypeOf((*MockVersionedStore)(nil).GetKey), key)
+}
+
+// GetVersion mocks base method.
+func (m *MockVersionedStore) GetVersion(stack, component, key, version string) (any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVersion", stack, component, key, version)
+	ret0, _ := ret[0].(any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVersion indicates an expected call of GetVersion.
+func (mr *MockVersionedStoreMockRecorder) GetVersion(stack, component, key, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockVersionedStore)(nil).GetVersion), stack, component, key, version)
+}
+
+// Set mocks base method.
+func (m *MockVersionedStore) Set(stack, component, key string, value any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", stack, component, key, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockVersionedStoreMockRecorder) Set(stack, component, key, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockVersionedStore)(nil).Set), stack, component, key, value)
+}
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,10 +30,19 @@ type RedisStoreOptions struct {
 type RedisClient interface {
 	Get(ctx context.Context, key string) *redis.StringCmd
 	Set(ctx context.Context, key string, value interface{}, expiration time.Duration) *redis.StatusCmd
+	Del(ctx context.Context, keys ...string) *redis.IntCmd
+	Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd
 }
 
-// Ensure RedisStore implements the store.Store interface.
-var _ Store = (*RedisStore)(nil)
+// redisScanCount is the number of keys requested per SCAN iteration.
+const redisScanCount = 100
+
+// Ensure RedisStore implements the store.Store, DeletableStore and ListableStore interfaces.
+var (
+	_ Store          = (*RedisStore)(nil)
+	_ DeletableStore = (*RedisStore)(nil)
+	_ ListableStore  = (*RedisStore)(nil)
+)
 
 func getRedisOptions(options *RedisStoreOptions) (*redis.Options, error) {
 	if options.URL != nil {
@@ -153,6 +164,61 @@ func (s *RedisStore) Set(stack string, component string, key string, value inter
 	return err
 }
 
+// Delete removes a key from Redis.
+func (s *RedisStore) Delete(stack string, component string, key string) error {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return err
+	}
+
+	redisKey, err := s.getKey(stack, component, key)
+	if err != nil {
+		return fmt.Errorf(errFormat, ErrGetKey, err)
+	}
+
+	deleted, err := s.redisClient.Del(context.Background(), redisKey).Result()
+	if err != nil {
+		return fmt.Errorf(errWrapFormatWithID, ErrDeleteKey, redisKey, err)
+	}
+	if deleted == 0 {
+		return fmt.Errorf(errWrapFormat, ErrResourceNotFound, redisKey)
+	}
+	return nil
+}
+
+// List returns the keys stored in Redis for the stack and component.
+func (s *RedisStore) List(stack string, component string) ([]string, error) {
+	if err := validateComponentArgs(stack, component); err != nil {
+		return nil, err
+	}
+
+	base, err := s.getKey(stack, component, "")
+	if err != nil {
+		return nil, fmt.Errorf(errFormat, ErrGetKey, err)
+	}
+
+	ctx := context.Background()
+	keys := []string{}
+	var cursor uint64
+	for {
+		page, next, err := s.redisClient.Scan(ctx, cursor, base+"*", redisScanCount).Result()
+		if err != nil {
+			return nil, fmt.Errorf(errWrapFormatWithID, ErrListKeys, base, err)
+		}
+		for _, redisKey := range page {
+			if key := strings.TrimPrefix(redisKey, base); key != "" {
+				keys = append(keys, key)
+			}
+		}
+		if next == 0 {
+			break
+		}
+		cursor = next
+	}
+
+	sort.Strings(keys)
+	return slices.Compact(keys), nil
+}
+
 func (s *RedisStore) GetKey(key string) (interface{}, error) {
 	if key == "" {
 		return nil, ErrEmptyKey
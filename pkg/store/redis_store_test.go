@@ -29,6 +29,16 @@ func (m *MockRedisClient) Set(ctx context.Context, key string, value interface{}
 	return cmd
 }
 
+func (m *MockRedisClient) Del(ctx context.Context, keys ...string) *redis.IntCmd {
+	args := m.Called(ctx, keys)
+	return redis.NewIntResult(int64(args.Int(0)), args.Error(1))
+}
+
+func (m *MockRedisClient) Scan(ctx context.Context, cursor uint64, match string, count int64) *redis.ScanCmd {
+	args := m.Called(ctx, cursor, match, count)
+	return redis.NewScanCmdResult(args.Get(0).([]string), args.Get(1).(uint64), args.Error(2))
+}
+
 func ptr(s string) *string {
 	return &s
 }
@@ -446,3 +456,65 @@ func TestRedisStore_GetKey(t *testing.T) {
 		})
 	}
 }
+
+func newTestRedisStore(t *testing.T) (*RedisStore, *MockRedisClient) {
+	t.Helper()
+
+	mockClient := new(MockRedisClient)
+	s, err := NewRedisStore(RedisStoreOptions{
+		Prefix:         ptr("myapp"),
+		StackDelimiter: ptr("/"),
+		URL:            ptr("redis://localhost:6379"),
+	})
+	assert.NoError(t, err)
+
+	redisStore := s.(*RedisStore)
+	redisStore.redisClient = mockClient
+	return redisStore, mockClient
+}
+
+func TestRedisStore_Delete(t *testing.T) {
+	tests := []struct {
+		name    string
+		deleted int
+		mockErr error
+		wantErr error
+	}{
+		{name: "deleted", deleted: 1},
+		{name: "not found", deleted: 0, wantErr: ErrResourceNotFound},
+		{name: "redis error", mockErr: fmt.Errorf("connection refused"), wantErr: ErrDeleteKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redisStore, mockClient := newTestRedisStore(t)
+			mockClient.On("Del", mock.Anything, []string{"myapp/dev/vpc/vpc_id"}).Return(tt.deleted, tt.mockErr)
+
+			err := redisStore.Delete("dev", "vpc", "vpc_id")
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRedisStore_List(t *testing.T) {
+	redisStore, mockClient := newTestRedisStore(t)
+	mockClient.On("Scan", mock.Anything, uint64(0), "myapp/dev/vpc/*", int64(redisScanCount)).
+		Return([]string{"myapp/dev/vpc/vpc_id", "myapp/dev/vpc/cidr"}, uint64(7), nil)
+	mockClient.On("Scan", mock.Anything, uint64(7), "myapp/dev/vpc/*", int64(redisScanCount)).
+		Return([]string{"myapp/dev/vpc/cidr", "myapp/dev/vpc/subnets"}, uint64(0), nil)
+
+	keys, err := redisStore.List("dev", "vpc")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cidr", "subnets", "vpc_id"}, keys)
+	mockClient.AssertExpectations(t)
+
+	_, err = redisStore.List("dev", "")
+	assert.ErrorIs(t, err, ErrEmptyComponent)
+}
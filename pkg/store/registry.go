@@ -82,6 +82,52 @@ func NewStoreRegistry(config *StoresConfig) (StoreRegistry, error) {
 			}
 			registry[key] = store
 
+		case "vault", "openbao":
+			var opts VaultStoreOptions
+			if err := parseOptions(storeConfig.Options, &opts); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrParseVaultOptions, err)
+			}
+
+			store, err := NewVaultStore(opts, storeConfig.Identity)
+			if err != nil {
+				return nil, err
+			}
+			registry[key] = store
+
+		case "file":
+			var opts FileStoreOptions
+			if err := parseOptions(storeConfig.Options, &opts); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrParseFileOptions, err)
+			}
+
+			if storeConfig.Identity != "" {
+				log.Warn("Identity-based authentication is not supported for file stores, identity will be ignored",
+					"store", key, "identity", storeConfig.Identity)
+			}
+
+			store, err := NewFileStore(opts)
+			if err != nil {
+				return nil, err
+			}
+			registry[key] = store
+
+		case "env":
+			var opts EnvStoreOptions
+			if err := parseOptions(storeConfig.Options, &opts); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrParseEnvOptions, err)
+			}
+
+			if storeConfig.Identity != "" {
+				log.Warn("Identity-based authentication is not supported for env stores, identity will be ignored",
+					"store", key, "identity", storeConfig.Identity)
+			}
+
+			store, err := NewEnvStore(opts)
+			if err != nil {
+				return nil, err
+			}
+			registry[key] = store
+
 		default:
 			return nil, fmt.Errorf("%w: %s", ErrStoreTypeNotFound, storeConfig.Type)
 		}
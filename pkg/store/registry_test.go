@@ -156,3 +156,68 @@ func TestNewStoreRegistry_MixedIdentityStores(t *testing.T) {
 		_ = ias
 	}
 }
+
+func TestNewStoreRegistry_FileAndEnvStores(t *testing.T) {
+	config := &StoresConfig{
+		"local": StoreConfig{
+			Type:     "file",
+			Identity: "prod-admin",
+			Options:  map[string]interface{}{"path": t.TempDir(), "format": "yaml"},
+		},
+		"env": StoreConfig{
+			Type:    "env",
+			Options: map[string]interface{}{"prefix": "MY_STORE"},
+		},
+	}
+
+	// File stores log a warning for identity (unsupported) but still create successfully.
+	registry, err := NewStoreRegistry(config)
+	assert.NoError(t, err)
+	assert.Len(t, registry, 2)
+
+	fileStore, ok := registry["local"].(*FileStore)
+	assert.True(t, ok)
+	assert.Equal(t, "yaml", fileStore.format)
+
+	envStore, ok := registry["env"].(*EnvStore)
+	assert.True(t, ok)
+	assert.Equal(t, "MY_STORE", envStore.prefix)
+}
+
+func TestNewStoreRegistry_FileStoreMissingPath(t *testing.T) {
+	config := &StoresConfig{
+		"local": StoreConfig{Type: "file"},
+	}
+
+	_, err := NewStoreRegistry(config)
+	assert.ErrorIs(t, err, ErrFileStorePathRequired)
+}
+
+func TestNewStoreRegistry_VaultWithIdentity(t *testing.T) {
+	config := &StoresConfig{
+		"vault": StoreConfig{
+			Type:     "vault",
+			Identity: "github-oidc",
+			Options: map[string]interface{}{
+				"address":    "https://vault.example.com",
+				"namespace":  "platform",
+				"kv_version": 1,
+				"auth":       map[string]interface{}{"role": "atmos-ci"},
+			},
+		},
+	}
+
+	registry, err := NewStoreRegistry(config)
+	assert.NoError(t, err)
+	assert.Len(t, registry, 1)
+
+	// Verify Vault store was created with identity, JWT auth and deferred client init.
+	vaultStore, ok := registry["vault"].(*VaultStore)
+	assert.True(t, ok)
+	assert.Equal(t, "github-oidc", vaultStore.identityName)
+	assert.Equal(t, "platform", vaultStore.namespace)
+	assert.Equal(t, 1, vaultStore.kvVersion)
+	assert.Equal(t, "jwt", vaultStore.auth.Method)
+	assert.Equal(t, "atmos-ci", vaultStore.auth.Role)
+	assert.Nil(t, vaultStore.client)
+}
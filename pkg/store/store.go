@@ -14,9 +14,55 @@ type Store interface {
 	GetKey(key string) (any, error)
 }
 
+// DeletableStore is implemented by stores that can remove keys.
+type DeletableStore interface {
+	Store
+	// Delete removes the value for a specific stack, component, and key combination.
+	Delete(stack string, component string, key string) error
+}
+
+// ListableStore is implemented by stores that can enumerate the keys of a component in a stack.
+type ListableStore interface {
+	Store
+	// List returns the keys stored for a specific stack and component, sorted alphabetically.
+	List(stack string, component string) ([]string, error)
+}
+
+// VersionedStore is implemented by stores that keep previous versions of values.
+type VersionedStore interface {
+	Store
+	// GetVersion retrieves a specific version of the value for a stack, component, and key combination.
+	GetVersion(stack string, component string, key string, version string) (any, error)
+}
+
 // StoreFactory is a function type to initialize a new store.
 type StoreFactory func(options map[string]any) (Store, error)
 
+// validateKeyArgs checks that the stack, component and key are set.
+func validateKeyArgs(stack string, component string, key string) error {
+	if stack == "" {
+		return ErrEmptyStack
+	}
+	if component == "" {
+		return ErrEmptyComponent
+	}
+	if key == "" {
+		return ErrEmptyKey
+	}
+	return nil
+}
+
+// validateComponentArgs checks that the stack and component are set.
+func validateComponentArgs(stack string, component string) error {
+	if stack == "" {
+		return ErrEmptyStack
+	}
+	if component == "" {
+		return ErrEmptyComponent
+	}
+	return nil
+}
+
 // nolint
 // getKey generates a key for the store. First it splits the stack by the stack delimiter (from atmos.yaml),
 // then it splits the component if it contains a "/",
@@ -0,0 +1,566 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+)
+
+const (
+	vaultOperationTimeout = 30 * time.Second
+	vaultDefaultMount     = "secret"
+	vaultDefaultKVVersion = 2
+	vaultValueField       = "value"
+
+	// vaultTokenRefreshWindow is how long before the login token expires the store logs in again.
+	vaultTokenRefreshWindow = time.Minute
+
+	vaultAuthToken   = "token"
+	vaultAuthAppRole = "approle"
+	vaultAuthJWT     = "jwt"
+)
+
+// VaultStore is an implementation of the Store interface for HashiCorp Vault and OpenBao KV secret engines.
+// Each value is stored as its own secret under `<mount>/<prefix>/<stack>/<component>/<key>` in a `value` field.
+type VaultStore struct {
+	client         *vault.Client
+	address        string
+	namespace      string
+	mount          string
+	kvVersion      int
+	prefix         string
+	stackDelimiter *string
+	auth           VaultAuthOptions
+
+	// Identity-based authentication fields.
+	identityName string
+	authResolver AuthContextResolver
+
+	// mu guards the client and the expiry of its login token.
+	mu             sync.Mutex
+	tokenExpiresAt time.Time
+}
+
+// VaultStoreOptions defines the configuration options for the Vault store.
+type VaultStoreOptions struct {
+	Address        *string           `mapstructure:"address"`
+	Namespace      *string           `mapstructure:"namespace"`
+	Mount          *string           `mapstructure:"mount"`
+	KVVersion      *int              `mapstructure:"kv_version"`
+	Prefix         *string           `mapstructure:"prefix"`
+	StackDelimiter *string           `mapstructure:"stack_delimiter"`
+	Auth           *VaultAuthOptions `mapstructure:"auth"`
+}
+
+// VaultAuthOptions defines how the Vault store logs in.
+type VaultAuthOptions struct {
+	// Method is one of `token` (default), `approle` or `jwt`.
+	Method string `mapstructure:"method"`
+	// Mount is the auth method mount path. Defaults to the method name.
+	Mount string `mapstructure:"mount"`
+	// Token is used by the `token` method. Defaults to the VAULT_TOKEN environment variable.
+	Token string `mapstructure:"token"`
+	// RoleID and SecretID are used by the `approle` method.
+	RoleID   string `mapstructure:"role_id"`
+	SecretID string `mapstructure:"secret_id"`
+	// Role, JWT and JWTFile are used by the `jwt` method. With an identity, the JWT comes from the identity instead.
+	Role    string `mapstructure:"role"`
+	JWT     string `mapstructure:"jwt"`
+	JWTFile string `mapstructure:"jwt_file"`
+}
+
+// Verify that VaultStore implements the Store and IdentityAwareStore interfaces.
+var (
+	_ Store              = (*VaultStore)(nil)
+	_ IdentityAwareStore = (*VaultStore)(nil)
+	_ DeletableStore     = (*VaultStore)(nil)
+	_ ListableStore      = (*VaultStore)(nil)
+	_ VersionedStore     = (*VaultStore)(nil)
+)
+
+// NewVaultStore initializes a new Vault store.
+// Login is deferred to first use so the JWT of an identity can be resolved after authentication.
+func NewVaultStore(options VaultStoreOptions, identityName string) (Store, error) {
+	address := os.Getenv("VAULT_ADDR")
+	if options.Address != nil && *options.Address != "" {
+		address = *options.Address
+	}
+	if address == "" {
+		return nil, ErrMissingVaultAddress
+	}
+
+	store := &VaultStore{
+		address:      address,
+		mount:        vaultDefaultMount,
+		kvVersion:    vaultDefaultKVVersion,
+		identityName: identityName,
+	}
+
+	if options.Namespace != nil {
+		store.namespace = *options.Namespace
+	}
+
+	if options.Mount != nil && *options.Mount != "" {
+		store.mount = strings.Trim(*options.Mount, "/")
+	}
+
+	if options.KVVersion != nil {
+		store.kvVersion = *options.KVVersion
+	}
+	if store.kvVersion != 1 && store.kvVersion != 2 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidVaultKVVersion, store.kvVersion)
+	}
+
+	if options.Prefix != nil {
+		store.prefix = *options.Prefix
+	}
+
+	if options.StackDelimiter != nil {
+		store.stackDelimiter = options.StackDelimiter
+	} else {
+		defaultDelimiter := "/"
+		store.stackDelimiter = &defaultDelimiter
+	}
+
+	if options.Auth != nil {
+		store.auth = *options.Auth
+	}
+	if store.auth.Method == "" {
+		store.auth.Method = vaultAuthToken
+		// An identity can only provide a JWT.
+		if identityName != "" {
+			store.auth.Method = vaultAuthJWT
+		}
+	}
+	if err := store.validateAuth(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// validateAuth checks that the selected auth method has the settings it needs.
+func (s *VaultStore) validateAuth() error {
+	switch s.auth.Method {
+	case vaultAuthToken:
+		return nil
+	case vaultAuthAppRole:
+		if s.auth.RoleID == "" || s.auth.SecretID == "" {
+			return fmt.Errorf("%w: approle auth requires role_id and secret_id", ErrVaultAuthConfig)
+		}
+	case vaultAuthJWT:
+		if s.auth.Role == "" {
+			return fmt.Errorf("%w: jwt auth requires role", ErrVaultAuthConfig)
+		}
+		if s.identityName == "" && s.auth.JWT == "" && s.auth.JWTFile == "" {
+			return fmt.Errorf("%w: jwt auth requires an identity, jwt or jwt_file", ErrVaultAuthConfig)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidVaultAuthMethod, s.auth.Method)
+	}
+	return nil
+}
+
+// SetAuthContext implements IdentityAwareStore.
+// If identityName is non-empty, it overrides the store's identity. Otherwise, the existing identity is preserved.
+func (s *VaultStore) SetAuthContext(resolver AuthContextResolver, identityName string) {
+	s.authResolver = resolver
+	if identityName != "" {
+		s.identityName = identityName
+	}
+}
+
+// withClient runs op on the secret at path with a logged-in client, and maps its errors to store errors.
+// If Vault denies the request, the store logs in again and retries once, since the login token may have been revoked.
+func (s *VaultStore) withClient(path string, fallback error, op func(ctx context.Context, client *vault.Client) error) error {
+	client, err := s.ensureClient(false)
+	if err != nil {
+		return err
+	}
+
+	err = runVaultOperation(client, op)
+	// A static token can't be renewed by logging in again.
+	if isVaultPermissionDenied(err) && s.auth.Method != vaultAuthToken {
+		if client, loginErr := s.ensureClient(true); loginErr == nil {
+			err = runVaultOperation(client, op)
+		}
+	}
+	if err != nil {
+		return s.wrapError(path, err, fallback)
+	}
+	return nil
+}
+
+func runVaultOperation(client *vault.Client, op func(ctx context.Context, client *vault.Client) error) error {
+	ctx, cancel := context.WithTimeout(context.Background(), vaultOperationTimeout)
+	defer cancel()
+
+	return op(ctx, client)
+}
+
+// ensureClient lazily creates the Vault client and logs in on first use.
+// The store logs in again when forced, or when the login token is about to expire.
+// A failed login is not cached, so the next operation tries again.
+func (s *VaultStore) ensureClient(forceLogin bool) (*vault.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil && !forceLogin && !s.tokenExpiring() {
+		return s.client, nil
+	}
+
+	client := s.client
+	if client == nil {
+		var err error
+		if client, err = s.newClient(); err != nil {
+			return nil, err
+		}
+	}
+
+	expiresAt, err := s.login(client)
+	if err != nil {
+		// The client is only kept after a successful login.
+		s.client = nil
+		return nil, err
+	}
+	s.client = client
+	s.tokenExpiresAt = expiresAt
+	return client, nil
+}
+
+// tokenExpiring reports whether the login token expires within the refresh window.
+// Tokens without a TTL never expire.
+func (s *VaultStore) tokenExpiring() bool {
+	return !s.tokenExpiresAt.IsZero() && time.Until(s.tokenExpiresAt) < vaultTokenRefreshWindow
+}
+
+func (s *VaultStore) newClient() (*vault.Client, error) {
+	config := vault.DefaultConfig()
+	if config.Error != nil {
+		return nil, fmt.Errorf(errWrapFormat, ErrCreateClient, config.Error)
+	}
+	config.Address = s.address
+	config.Timeout = vaultOperationTimeout
+
+	client, err := vault.NewClient(config)
+	if err != nil {
+		return nil, fmt.Errorf(errWrapFormat, ErrCreateClient, err)
+	}
+
+	if s.namespace != "" {
+		client.SetNamespace(s.namespace)
+	}
+	return client, nil
+}
+
+// login authenticates the client with the configured auth method, and returns when the login token expires.
+// The returned time is zero if the token doesn't expire or its TTL is unknown.
+func (s *VaultStore) login(client *vault.Client) (time.Time, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), vaultOperationTimeout)
+	defer cancel()
+
+	var data map[string]any
+	switch s.auth.Method {
+	case vaultAuthToken:
+		// The client already reads VAULT_TOKEN from the environment.
+		if s.auth.Token != "" {
+			client.SetToken(s.auth.Token)
+		}
+		if client.Token() == "" {
+			return time.Time{}, fmt.Errorf("%w: token auth requires token or the VAULT_TOKEN environment variable", ErrVaultAuthConfig)
+		}
+		return time.Time{}, nil
+
+	case vaultAuthAppRole:
+		data = map[string]any{
+			"role_id":   s.auth.RoleID,
+			"secret_id": s.auth.SecretID,
+		}
+
+	case vaultAuthJWT:
+		jwt, err := s.resolveJWT(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		data = map[string]any{
+			"role": s.auth.Role,
+			"jwt":  jwt,
+		}
+	}
+
+	mount := s.auth.Mount
+	if mount == "" {
+		mount = s.auth.Method
+	}
+
+	// Don't send an expired or revoked token with the login request.
+	client.ClearToken()
+	secret, err := client.Logical().WriteWithContext(ctx, "auth/"+strings.Trim(mount, "/")+"/login", data)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %s auth: %w", ErrVaultLogin, s.auth.Method, err)
+	}
+	if secret == nil || secret.Auth == nil || secret.Auth.ClientToken == "" {
+		return time.Time{}, fmt.Errorf("%w: %s auth returned no client token", ErrVaultLogin, s.auth.Method)
+	}
+
+	client.SetToken(secret.Auth.ClientToken)
+	if secret.Auth.LeaseDuration <= 0 {
+		return time.Time{}, nil
+	}
+	return time.Now().Add(time.Duration(secret.Auth.LeaseDuration) * time.Second), nil
+}
+
+// resolveJWT returns the JWT for the `jwt` auth method from the identity, the `jwt` option or the `jwt_file` option.
+func (s *VaultStore) resolveJWT(ctx context.Context) (string, error) {
+	if s.identityName != "" {
+		if s.authResolver == nil {
+			return "", fmt.Errorf("%w: store requires identity %q but no auth resolver was injected", ErrIdentityNotConfigured, s.identityName)
+		}
+		token, err := s.authResolver.ResolveOIDCToken(ctx, s.identityName)
+		if err != nil {
+			return "", fmt.Errorf("%w: failed to resolve OIDC token for identity %q: %w", ErrAuthContextNotAvailable, s.identityName, err)
+		}
+		return token, nil
+	}
+
+	if s.auth.JWT != "" {
+		return s.auth.JWT, nil
+	}
+
+	data, err := os.ReadFile(s.auth.JWTFile)
+	if err != nil {
+		return "", fmt.Errorf(errFormat, ErrReadFile, err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// getKey generates the secret path for the stack, component and key.
+func (s *VaultStore) getKey(stack string, component string, key string) (string, error) {
+	if s.stackDelimiter == nil {
+		return "", ErrStackDelimiterNotSet
+	}
+
+	path, err := getKey(s.prefix, *s.stackDelimiter, stack, component, key, "/")
+	if err != nil {
+		return "", fmt.Errorf(errWrapFormat, ErrGetKey, err)
+	}
+
+	return strings.Trim(path, "/"), nil
+}
+
+// dataPath returns the API path of a secret, which includes `data/` for KV v2.
+func (s *VaultStore) dataPath(path string) string {
+	if s.kvVersion == 2 {
+		return s.mount + "/data/" + path
+	}
+	return s.mount + "/" + path
+}
+
+// metadataPath returns the API path of the metadata of a secret, which includes `metadata/` for KV v2.
+func (s *VaultStore) metadataPath(path string) string {
+	if s.kvVersion == 2 {
+		return s.mount + "/metadata/" + path
+	}
+	return s.mount + "/" + path
+}
+
+// Set stores a value in Vault.
+func (s *VaultStore) Set(stack string, component string, key string, value any) error {
+	if stack == "" {
+		return ErrEmptyStack
+	}
+	if component == "" {
+		return ErrEmptyComponent
+	}
+	if key == "" {
+		return ErrEmptyKey
+	}
+	if value == nil {
+		return fmt.Errorf("%w for key %s in stack %s component %s", ErrNilValue, key, stack, component)
+	}
+
+	path, err := s.getKey(stack, component, key)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]any{vaultValueField: value}
+	if s.kvVersion == 2 {
+		data = map[string]any{"data": data}
+	}
+
+	return s.withClient(path, ErrWriteSecret, func(ctx context.Context, client *vault.Client) error {
+		_, err := client.Logical().WriteWithContext(ctx, s.dataPath(path), data)
+		return err
+	})
+}
+
+// Get retrieves a value from Vault.
+func (s *VaultStore) Get(stack string, component string, key string) (any, error) {
+	if stack == "" {
+		return nil, ErrEmptyStack
+	}
+	if component == "" {
+		return nil, ErrEmptyComponent
+	}
+	if key == "" {
+		return nil, ErrEmptyKey
+	}
+
+	path, err := s.getKey(stack, component, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.read(path)
+}
+
+// GetKey retrieves the secret at the given path, relative to the prefix.
+// Secrets written by Atmos return their `value` field. Any other secret returns all of its fields.
+func (s *VaultStore) GetKey(key string) (any, error) {
+	if key == "" {
+		return nil, ErrEmptyKey
+	}
+
+	path := strings.Trim(key, "/")
+	if s.prefix != "" {
+		path = strings.Trim(s.prefix, "/") + "/" + path
+	}
+
+	return s.read(path)
+}
+
+// Delete removes a secret from Vault. For KV v2, all versions and the metadata of the secret are removed.
+func (s *VaultStore) Delete(stack string, component string, key string) error {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return err
+	}
+
+	path, err := s.getKey(stack, component, key)
+	if err != nil {
+		return err
+	}
+
+	return s.withClient(path, ErrDeleteKey, func(ctx context.Context, client *vault.Client) error {
+		_, err := client.Logical().DeleteWithContext(ctx, s.metadataPath(path))
+		return err
+	})
+}
+
+// List returns the keys stored in Vault for the stack and component.
+func (s *VaultStore) List(stack string, component string) ([]string, error) {
+	if err := validateComponentArgs(stack, component); err != nil {
+		return nil, err
+	}
+
+	path, err := s.getKey(stack, component, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var secret *vault.Secret
+	err = s.withClient(path, ErrListKeys, func(ctx context.Context, client *vault.Client) error {
+		var err error
+		secret, err = client.Logical().ListWithContext(ctx, s.metadataPath(path))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := []string{}
+	if secret == nil {
+		return keys, nil
+	}
+	entries, _ := secret.Data["keys"].([]any)
+	for _, entry := range entries {
+		// Entries ending with `/` are folders, not secrets.
+		if key, ok := entry.(string); ok && !strings.HasSuffix(key, "/") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// GetVersion retrieves a specific version of a secret. Versions are only available with KV v2.
+func (s *VaultStore) GetVersion(stack string, component string, key string, version string) (any, error) {
+	if err := validateKeyArgs(stack, component, key); err != nil {
+		return nil, err
+	}
+	if s.kvVersion != 2 {
+		return nil, fmt.Errorf("%w: versions require kv_version 2", ErrOperationNotSupported)
+	}
+	if _, err := strconv.Atoi(version); err != nil {
+		return nil, fmt.Errorf("%w: %q is not a KV v2 version number", ErrInvalidVersion, version)
+	}
+
+	path, err := s.getKey(stack, component, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.readVersion(path, version)
+}
+
+func (s *VaultStore) read(path string) (any, error) {
+	return s.readVersion(path, "")
+}
+
+// readVersion reads a secret version (the latest if version is empty) and returns its value.
+func (s *VaultStore) readVersion(path string, version string) (any, error) {
+	var params map[string][]string
+	if version != "" {
+		params = map[string][]string{"version": {version}}
+	}
+
+	var secret *vault.Secret
+	err := s.withClient(path, ErrReadSecret, func(ctx context.Context, client *vault.Client) error {
+		var err error
+		secret, err = client.Logical().ReadWithDataWithContext(ctx, s.dataPath(path), params)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var data map[string]any
+	if secret != nil {
+		data = secret.Data
+		if s.kvVersion == 2 {
+			// KV v2 nests the fields under `data`, which is null for deleted versions.
+			data, _ = secret.Data["data"].(map[string]any)
+		}
+	}
+	if data == nil {
+		return nil, fmt.Errorf(errWrapFormat, ErrResourceNotFound, path)
+	}
+
+	if value, ok := data[vaultValueField]; ok && len(data) == 1 {
+		return value, nil
+	}
+	return data, nil
+}
+
+// wrapError maps Vault response errors to store errors.
+func (s *VaultStore) wrapError(path string, err error, fallback error) error {
+	if isVaultPermissionDenied(err) {
+		return fmt.Errorf(errWrapFormatWithID, ErrPermissionDenied, path, err)
+	}
+	return fmt.Errorf(errWrapFormatWithID, fallback, path, err)
+}
+
+// isVaultPermissionDenied reports whether Vault denied the request, which happens when the token expired or was revoked.
+func isVaultPermissionDenied(err error) bool {
+	var respErr *vault.ResponseError
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusForbidden
+}
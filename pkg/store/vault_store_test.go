@@ -0,0 +1,413 @@
+package store
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// fakeVault is a minimal Vault HTTP API with KV v1/v2 engines and token, AppRole and JWT login.
+type fakeVault struct {
+	mu        sync.Mutex
+	secrets   map[string]map[string]any
+	versions  map[string][]map[string]any
+	logins    map[string]map[string]any
+	namespace string
+	token     string
+
+	// loginCount is the number of logins, failLogins makes the next logins fail,
+	// leaseDuration is the TTL in seconds of the login tokens, and revoked tokens are denied.
+	loginCount    int
+	failLogins    int
+	leaseDuration int
+	revoked       map[string]bool
+}
+
+func newFakeVault(t *testing.T) (*fakeVault, *httptest.Server) {
+	t.Helper()
+
+	fv := &fakeVault{
+		secrets:  map[string]map[string]any{},
+		versions: map[string][]map[string]any{},
+		logins:   map[string]map[string]any{},
+		revoked:  map[string]bool{},
+	}
+	server := httptest.NewServer(http.HandlerFunc(fv.handle))
+	t.Cleanup(server.Close)
+	return fv, server
+}
+
+func (f *fakeVault) handle(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/")
+	f.namespace = r.Header.Get("X-Vault-Namespace")
+
+	var body map[string]any
+	if r.Body != nil {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	if strings.HasPrefix(path, "auth/") && strings.HasSuffix(path, "/login") {
+		if f.failLogins > 0 {
+			f.failLogins--
+			w.WriteHeader(http.StatusBadRequest)
+			writeJSON(w, map[string]any{"errors": []string{"invalid role or secret ID"}})
+			return
+		}
+		f.loginCount++
+		f.logins[path] = body
+		token := "login-token"
+		if f.loginCount > 1 {
+			token += "-" + strconv.Itoa(f.loginCount)
+		}
+		writeJSON(w, map[string]any{"auth": map[string]any{"client_token": token, "lease_duration": f.leaseDuration}})
+		return
+	}
+
+	f.token = r.Header.Get("X-Vault-Token")
+	if f.token == "denied" || f.revoked[f.token] {
+		w.WriteHeader(http.StatusForbidden)
+		writeJSON(w, map[string]any{"errors": []string{"permission denied"}})
+		return
+	}
+
+	// KV v2 metadata paths address the same secrets as the data paths.
+	dataPath := strings.Replace(path, "/metadata/", "/data/", 1)
+
+	switch {
+	case r.Method == http.MethodPut || r.Method == http.MethodPost:
+		f.secrets[path] = body
+		f.versions[path] = append(f.versions[path], body)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		delete(f.secrets, dataPath)
+		w.WriteHeader(http.StatusNoContent)
+	case r.URL.Query().Get("list") == "true":
+		var keys []string
+		for secretPath := range f.secrets {
+			if key, ok := strings.CutPrefix(secretPath, strings.TrimSuffix(dataPath, "/")+"/"); ok {
+				keys = append(keys, key)
+			}
+		}
+		if len(keys) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"errors": []string{}})
+			return
+		}
+		writeJSON(w, map[string]any{"data": map[string]any{"keys": keys}})
+	case r.URL.Query().Get("version") != "":
+		version, _ := strconv.Atoi(r.URL.Query().Get("version"))
+		writeJSON(w, map[string]any{"data": f.versions[path][version-1]})
+	default:
+		secret, ok := f.secrets[path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			writeJSON(w, map[string]any{"errors": []string{}})
+			return
+		}
+		writeJSON(w, map[string]any{"data": secret})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func newTestVaultStore(t *testing.T, address string, options VaultStoreOptions, identity string) *VaultStore {
+	t.Helper()
+
+	options.Address = &address
+	s, err := NewVaultStore(options, identity)
+	require.NoError(t, err)
+	return s.(*VaultStore)
+}
+
+func TestNewVaultStore_Validation(t *testing.T) {
+	t.Setenv("VAULT_ADDR", "")
+	address := "http://127.0.0.1:8200"
+	kvVersion := 3
+
+	tests := []struct {
+		name     string
+		options  VaultStoreOptions
+		identity string
+		wantErr  error
+	}{
+		{name: "missing address", options: VaultStoreOptions{}, wantErr: ErrMissingVaultAddress},
+		{name: "invalid kv version", options: VaultStoreOptions{Address: &address, KVVersion: &kvVersion}, wantErr: ErrInvalidVaultKVVersion},
+		{name: "invalid auth method", options: VaultStoreOptions{Address: &address, Auth: &VaultAuthOptions{Method: "ldap"}}, wantErr: ErrInvalidVaultAuthMethod},
+		{name: "approle without secret id", options: VaultStoreOptions{Address: &address, Auth: &VaultAuthOptions{Method: "approle", RoleID: "r"}}, wantErr: ErrVaultAuthConfig},
+		{name: "jwt without role", options: VaultStoreOptions{Address: &address, Auth: &VaultAuthOptions{Method: "jwt", JWT: "x"}}, wantErr: ErrVaultAuthConfig},
+		{name: "jwt without source", options: VaultStoreOptions{Address: &address, Auth: &VaultAuthOptions{Method: "jwt", Role: "ci"}}, wantErr: ErrVaultAuthConfig},
+		{name: "jwt with identity", options: VaultStoreOptions{Address: &address, Auth: &VaultAuthOptions{Role: "ci"}}, identity: "github"},
+		{name: "token default", options: VaultStoreOptions{Address: &address}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewVaultStore(tt.options, tt.identity)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestVaultStore_SetGet(t *testing.T) {
+	tests := []struct {
+		name       string
+		kvVersion  int
+		secretPath string
+	}{
+		{name: "kv v2", kvVersion: 2, secretPath: "secret/data/atmos/plat/ue2/dev/vpc/vpc_id"},
+		{name: "kv v1", kvVersion: 1, secretPath: "secret/atmos/plat/ue2/dev/vpc/vpc_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fv, server := newFakeVault(t)
+			prefix := "atmos"
+			namespace := "team-a"
+			s := newTestVaultStore(t, server.URL, VaultStoreOptions{
+				KVVersion: &tt.kvVersion,
+				Prefix:    &prefix,
+				Namespace: &namespace,
+				Auth:      &VaultAuthOptions{Token: "root"},
+			}, "")
+
+			require.NoError(t, s.Set("plat/ue2/dev", "vpc", "vpc_id", "vpc-123"))
+			require.NoError(t, s.Set("plat/ue2/dev", "vpc", "subnets", []any{"a", "b"}))
+			assert.Contains(t, fv.secrets, tt.secretPath)
+			assert.Equal(t, "root", fv.token)
+			assert.Equal(t, "team-a", fv.namespace)
+
+			value, err := s.Get("plat/ue2/dev", "vpc", "vpc_id")
+			require.NoError(t, err)
+			assert.Equal(t, "vpc-123", value)
+
+			value, err = s.Get("plat/ue2/dev", "vpc", "subnets")
+			require.NoError(t, err)
+			assert.Equal(t, []any{"a", "b"}, value)
+
+			value, err = s.GetKey("plat/ue2/dev/vpc/vpc_id")
+			require.NoError(t, err)
+			assert.Equal(t, "vpc-123", value)
+
+			_, err = s.Get("plat/ue2/dev", "vpc", "missing")
+			assert.ErrorIs(t, err, ErrResourceNotFound)
+		})
+	}
+}
+
+func TestVaultStore_GetKey_MultipleFields(t *testing.T) {
+	fv, server := newFakeVault(t)
+	fv.secrets["secret/data/app/db"] = map[string]any{"data": map[string]any{"username": "app", "password": "s3cr3t"}}
+
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{Auth: &VaultAuthOptions{Token: "root"}}, "")
+
+	value, err := s.GetKey("app/db")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"username": "app", "password": "s3cr3t"}, value)
+}
+
+func TestVaultStore_PermissionDenied(t *testing.T) {
+	_, server := newFakeVault(t)
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{Auth: &VaultAuthOptions{Token: "denied"}}, "")
+
+	_, err := s.Get("dev", "vpc", "vpc_id")
+	assert.ErrorIs(t, err, ErrPermissionDenied)
+}
+
+func TestVaultStore_TokenFromEnvironment(t *testing.T) {
+	fv, server := newFakeVault(t)
+	t.Setenv("VAULT_TOKEN", "env-token")
+	t.Setenv("VAULT_ADDR", server.URL)
+
+	s, err := NewVaultStore(VaultStoreOptions{}, "")
+	require.NoError(t, err)
+
+	require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-123"))
+	assert.Equal(t, "env-token", fv.token)
+}
+
+func TestVaultStore_MissingToken(t *testing.T) {
+	_, server := newFakeVault(t)
+	t.Setenv("VAULT_TOKEN", "")
+
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{}, "")
+
+	err := s.Set("dev", "vpc", "vpc_id", "vpc-123")
+	assert.ErrorIs(t, err, ErrVaultAuthConfig)
+}
+
+func TestVaultStore_AppRoleLogin(t *testing.T) {
+	fv, server := newFakeVault(t)
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{
+		Auth: &VaultAuthOptions{Method: "approle", Mount: "ci-approle", RoleID: "role", SecretID: "secret"},
+	}, "")
+
+	require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-123"))
+	assert.Equal(t, map[string]any{"role_id": "role", "secret_id": "secret"}, fv.logins["auth/ci-approle/login"])
+	assert.Equal(t, "login-token", fv.token)
+}
+
+func TestVaultStore_RetriesFailedLogin(t *testing.T) {
+	fv, server := newFakeVault(t)
+	fv.failLogins = 1
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{
+		Auth: &VaultAuthOptions{Method: "approle", RoleID: "role", SecretID: "secret"},
+	}, "")
+
+	err := s.Set("dev", "vpc", "vpc_id", "vpc-123")
+	assert.ErrorIs(t, err, ErrVaultLogin)
+
+	// The failed login is not cached.
+	require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-123"))
+	assert.Equal(t, 1, fv.loginCount)
+}
+
+func TestVaultStore_LogsInAgainWhenTokenIsRevoked(t *testing.T) {
+	fv, server := newFakeVault(t)
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{
+		Auth: &VaultAuthOptions{Method: "approle", RoleID: "role", SecretID: "secret"},
+	}, "")
+
+	require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-123"))
+	fv.revoked["login-token"] = true
+
+	value, err := s.Get("dev", "vpc", "vpc_id")
+	require.NoError(t, err)
+	assert.Equal(t, "vpc-123", value)
+	assert.Equal(t, 2, fv.loginCount)
+	assert.Equal(t, "login-token-2", fv.token)
+}
+
+func TestVaultStore_LogsInAgainBeforeTokenExpires(t *testing.T) {
+	fv, server := newFakeVault(t)
+	fv.leaseDuration = 3600
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{
+		Auth: &VaultAuthOptions{Method: "approle", RoleID: "role", SecretID: "secret"},
+	}, "")
+
+	require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-123"))
+	require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-456"))
+	assert.Equal(t, 1, fv.loginCount, "the token is reused until it is about to expire")
+
+	s.tokenExpiresAt = time.Now().Add(vaultTokenRefreshWindow / 2)
+	require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-789"))
+	assert.Equal(t, 2, fv.loginCount)
+	assert.Equal(t, "login-token-2", fv.token)
+}
+
+func TestVaultStore_JWTLogin(t *testing.T) {
+	fv, server := newFakeVault(t)
+	jwtFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(jwtFile, []byte("file-jwt\n"), 0o600))
+
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{
+		Auth: &VaultAuthOptions{Method: "jwt", Role: "ci", JWTFile: jwtFile},
+	}, "")
+
+	require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-123"))
+	assert.Equal(t, map[string]any{"role": "ci", "jwt": "file-jwt"}, fv.logins["auth/jwt/login"])
+	assert.Equal(t, "login-token", fv.token)
+}
+
+func TestVaultStore_JWTLoginWithIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fv, server := newFakeVault(t)
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{Auth: &VaultAuthOptions{Role: "ci"}}, "github-oidc")
+
+	resolver := NewMockAuthContextResolver(ctrl)
+	resolver.EXPECT().ResolveOIDCToken(gomock.Any(), "github-oidc").Return("identity-jwt", nil)
+	s.SetAuthContext(resolver, "")
+
+	_, err := s.Get("dev", "vpc", "vpc_id")
+	assert.ErrorIs(t, err, ErrResourceNotFound)
+	assert.Equal(t, map[string]any{"role": "ci", "jwt": "identity-jwt"}, fv.logins["auth/jwt/login"])
+}
+
+func TestVaultStore_JWTLoginWithoutResolver(t *testing.T) {
+	_, server := newFakeVault(t)
+	s := newTestVaultStore(t, server.URL, VaultStoreOptions{Auth: &VaultAuthOptions{Role: "ci"}}, "github-oidc")
+
+	_, err := s.Get("dev", "vpc", "vpc_id")
+	assert.ErrorIs(t, err, ErrIdentityNotConfigured)
+}
+
+func TestVaultStore_Validation(t *testing.T) {
+	s := newTestVaultStore(t, "http://127.0.0.1:1", VaultStoreOptions{}, "")
+
+	assert.ErrorIs(t, s.Set("", "vpc", "k", "v"), ErrEmptyStack)
+	assert.ErrorIs(t, s.Set("dev", "", "k", "v"), ErrEmptyComponent)
+	assert.ErrorIs(t, s.Set("dev", "vpc", "", "v"), ErrEmptyKey)
+	assert.ErrorIs(t, s.Set("dev", "vpc", "k", nil), ErrNilValue)
+
+	_, err := s.Get("", "vpc", "k")
+	assert.ErrorIs(t, err, ErrEmptyStack)
+	_, err = s.GetKey("")
+	assert.ErrorIs(t, err, ErrEmptyKey)
+}
+
+func TestVaultStore_DeleteListVersions(t *testing.T) {
+	tests := []struct {
+		name      string
+		kvVersion int
+	}{
+		{name: "kv v2", kvVersion: 2},
+		{name: "kv v1", kvVersion: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, server := newFakeVault(t)
+			s := newTestVaultStore(t, server.URL, VaultStoreOptions{KVVersion: &tt.kvVersion, Auth: &VaultAuthOptions{Token: "root"}}, "")
+
+			keys, err := s.List("dev", "vpc")
+			require.NoError(t, err)
+			assert.Empty(t, keys)
+
+			require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-1"))
+			require.NoError(t, s.Set("dev", "vpc", "vpc_id", "vpc-2"))
+			require.NoError(t, s.Set("dev", "vpc", "cidr", "10.0.0.0/16"))
+
+			keys, err = s.List("dev", "vpc")
+			require.NoError(t, err)
+			assert.Equal(t, []string{"cidr", "vpc_id"}, keys)
+
+			if tt.kvVersion == 2 {
+				value, err := s.GetVersion("dev", "vpc", "vpc_id", "1")
+				require.NoError(t, err)
+				assert.Equal(t, "vpc-1", value)
+
+				_, err = s.GetVersion("dev", "vpc", "vpc_id", "latest")
+				assert.ErrorIs(t, err, ErrInvalidVersion)
+			} else {
+				_, err := s.GetVersion("dev", "vpc", "vpc_id", "1")
+				assert.ErrorIs(t, err, ErrOperationNotSupported)
+			}
+
+			require.NoError(t, s.Delete("dev", "vpc", "vpc_id"))
+			_, err = s.Get("dev", "vpc", "vpc_id")
+			assert.ErrorIs(t, err, ErrResourceNotFound)
+		})
+	}
+}
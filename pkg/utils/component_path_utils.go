@@ -226,6 +226,14 @@ func getBasePathForComponentType(atmosConfig *schema.AtmosConfiguration, compone
 		envVarName = "ATMOS_COMPONENTS_ANSIBLE_BASE_PATH"
 		resolvedPath = atmosConfig.AnsibleDirAbsolutePath
 		configBasePath = atmosConfig.Components.Ansible.BasePath
+	case "pulumi":
+		envVarName = "ATMOS_COMPONENTS_PULUMI_BASE_PATH"
+		resolvedPath = atmosConfig.PulumiDirAbsolutePath
+		configBasePath = atmosConfig.Components.Pulumi.BasePath
+	case "kustomize":
+		envVarName = "ATMOS_COMPONENTS_KUSTOMIZE_BASE_PATH"
+		resolvedPath = atmosConfig.KustomizeDirAbsolutePath
+		configBasePath = atmosConfig.Components.Kustomize.BasePath
 	default:
 		return "", "", fmt.Errorf("%w: %s", ErrUnknownComponentType, componentType)
 	}
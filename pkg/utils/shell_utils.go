@@ -65,6 +65,13 @@ func ExecuteShellAndReturnOutput(
 func ShellRunner(command string, name string, dir string, env []string, out io.Writer) error {
 	defer perf.Track(nil, "utils.ShellRunner")()
 
+	return ShellRunnerWithStderr(command, name, dir, env, out, os.Stderr)
+}
+
+// ShellRunnerWithStderr runs a shell script like ShellRunner, and also diverts its stderr.
+func ShellRunnerWithStderr(command string, name string, dir string, env []string, out io.Writer, errOut io.Writer) error {
+	defer perf.Track(nil, "utils.ShellRunnerWithStderr")()
+
 	parser, err := syntax.NewParser().Parse(strings.NewReader(command), name)
 	if err != nil {
 		return err
@@ -81,7 +88,7 @@ func ShellRunner(command string, name string, dir string, env []string, out io.W
 	runner, err := interp.New(
 		interp.Dir(dir),
 		interp.Env(listEnviron),
-		interp.StdIO(os.Stdin, out, os.Stderr),
+		interp.StdIO(os.Stdin, out, errOut),
 	)
 	if err != nil {
 		return err
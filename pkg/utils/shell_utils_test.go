@@ -105,6 +105,19 @@ func TestShellRunner_Environment(t *testing.T) {
 	assert.Contains(t, buf.String(), "test_value")
 }
 
+func TestShellRunnerWithStderr(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skipf("Skipping test on Windows: uses Unix shell commands")
+	}
+
+	var stdout, stderr bytes.Buffer
+	err := ShellRunnerWithStderr("echo out; echo err >&2", "test", ".", []string{}, &stdout, &stderr)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "out\n", stdout.String())
+	assert.Equal(t, "err\n", stderr.String())
+}
+
 func TestShellRunner_WorkingDirectory(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skipf("Skipping test on Windows: uses Unix shell commands and paths")
@@ -0,0 +1,233 @@
+package workflow
+
+import (
+	"errors"
+	"slices"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/dependency"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	u "github.com/cloudposse/atmos/pkg/utils"
+)
+
+// DefaultMaxParallel is the number of steps that run at the same time when the workflow does not set `max_parallel`.
+const DefaultMaxParallel = 4
+
+// StepRunFunc runs a single workflow step. stepIdx is the position of the step in the workflow definition.
+type StepRunFunc func(stepIdx int, step *schema.WorkflowStep) error
+
+// IsDAG returns true if any step of the workflow uses `needs` or `parallel`.
+// Workflows without these attributes keep the strictly sequential execution.
+func IsDAG(workflowDefinition *schema.WorkflowDefinition) bool {
+	if workflowDefinition == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(workflowDefinition.Steps, func(step schema.WorkflowStep) bool {
+		return step.Parallel || len(step.Needs) > 0
+	})
+}
+
+// BuildStepGraph builds the dependency graph of the workflow steps.
+// Step names must be set (see CheckAndGenerateWorkflowStepNames).
+//
+// A step with `needs` depends only on the listed steps. A step without `needs` depends on the step before it,
+// or on all steps of the preceding `parallel` group. Consecutive steps with `parallel: true` form a group
+// whose steps share the dependencies of the group and run at the same time.
+func BuildStepGraph(workflowDefinition *schema.WorkflowDefinition) (*dependency.Graph, error) {
+	defer perf.Track(nil, "workflow.BuildStepGraph")()
+
+	steps := workflowDefinition.Steps
+	builder := dependency.NewBuilder()
+	stepNames := make([]string, 0, len(steps))
+
+	for i := range steps {
+		if err := builder.AddNode(&dependency.Node{ID: steps[i].Name}); err != nil {
+			return nil, errUtils.Build(errUtils.ErrInvalidWorkflowStepNeeds).
+				WithCause(err).
+				WithExplanationf("Step name `%s` is used more than once. Step names must be unique when steps use `needs` or `parallel`.", steps[i].Name).
+				Err()
+		}
+		stepNames = append(stepNames, steps[i].Name)
+	}
+
+	// frontier holds the steps that a following step without `needs` depends on.
+	// groupDeps holds the dependencies shared by the steps of the current parallel group.
+	var frontier, groupDeps []string
+	inGroup := false
+
+	for i := range steps {
+		step := &steps[i]
+
+		if step.Parallel && !inGroup {
+			groupDeps = frontier
+			frontier = nil
+		}
+
+		deps := step.Needs
+		if len(deps) == 0 {
+			deps = frontier
+			if step.Parallel {
+				deps = groupDeps
+			}
+		}
+
+		for _, dep := range deps {
+			if !slices.Contains(stepNames, dep) {
+				return nil, errUtils.Build(errUtils.ErrInvalidWorkflowStepNeeds).
+					WithExplanationf("Step `%s` needs step `%s`, which does not exist.\n\n### Available steps:\n\n%s", step.Name, dep, u.FormatList(stepNames)).
+					Err()
+			}
+			if err := builder.AddDependency(step.Name, dep); err != nil {
+				return nil, errUtils.Build(errUtils.ErrInvalidWorkflowStepNeeds).
+					WithCause(err).
+					WithExplanationf("Step `%s` cannot depend on step `%s`.", step.Name, dep).
+					Err()
+			}
+		}
+
+		if step.Parallel {
+			frontier = append(frontier, step.Name)
+		} else {
+			frontier = []string{step.Name}
+		}
+		inGroup = step.Parallel
+	}
+
+	graph, err := builder.Build()
+	if err != nil {
+		return nil, errUtils.Build(errUtils.ErrInvalidWorkflowStepNeeds).
+			WithCause(err).
+			WithExplanation("The `needs` attributes of the workflow steps form a cycle.").
+			Err()
+	}
+
+	return graph, nil
+}
+
+// SelectStepsFrom returns the names of the steps to run when resuming a workflow from fromStep:
+// the step itself, every step that (transitively) needs it, and every step listed after it.
+// Steps that are not selected are treated as already completed.
+func SelectStepsFrom(graph *dependency.Graph, steps []schema.WorkflowStep, fromStep string) map[string]bool {
+	defer perf.Track(nil, "workflow.SelectStepsFrom")()
+
+	selected := make(map[string]bool, len(steps))
+	fromIdx := slices.IndexFunc(steps, func(step schema.WorkflowStep) bool { return step.Name == fromStep })
+	if fromIdx < 0 {
+		return selected
+	}
+
+	for i := fromIdx; i < len(steps); i++ {
+		selected[steps[i].Name] = true
+	}
+
+	queue := []string{fromStep}
+	for len(queue) > 0 {
+		node, ok := graph.GetNode(queue[0])
+		queue = queue[1:]
+		if !ok {
+			continue
+		}
+		for _, dependent := range node.Dependents {
+			if !selected[dependent] {
+				selected[dependent] = true
+				queue = append(queue, dependent)
+			}
+		}
+	}
+
+	return selected
+}
+
+// stepDone reports the completion of a step started by RunStepGraph.
+type stepDone struct {
+	name string
+	err  error
+}
+
+// RunStepGraph runs the workflow steps in dependency order.
+// Steps whose dependencies have completed run at the same time, bounded by maxParallel (DefaultMaxParallel if < 1).
+// Ready steps start in the order they are listed in the workflow. If selected is not nil, only the selected
+// steps run and the others are treated as completed. After the first failure no new steps are started;
+// the steps already running are waited for and all failures are returned.
+func RunStepGraph(graph *dependency.Graph, steps []schema.WorkflowStep, selected map[string]bool, maxParallel int, run StepRunFunc) error {
+	defer perf.Track(nil, "workflow.RunStepGraph")()
+
+	if maxParallel < 1 {
+		maxParallel = DefaultMaxParallel
+	}
+
+	index := make(map[string]int, len(steps))
+	remaining := make(map[string]int, len(steps))
+	var ready []string
+	for i := range steps {
+		name := steps[i].Name
+		index[name] = i
+		if node, ok := graph.GetNode(name); ok {
+			remaining[name] = len(node.Dependencies)
+		}
+		if remaining[name] == 0 {
+			ready = append(ready, name)
+		}
+	}
+
+	byIndex := func(a, b string) int { return index[a] - index[b] }
+
+	// release marks a step as completed and queues the dependents that became ready.
+	release := func(name string) {
+		node, ok := graph.GetNode(name)
+		if !ok {
+			return
+		}
+		for _, dependent := range node.Dependents {
+			remaining[dependent]--
+			if remaining[dependent] == 0 {
+				ready = append(ready, dependent)
+			}
+		}
+		slices.SortFunc(ready, byIndex)
+	}
+
+	done := make(chan stepDone)
+	running := 0
+	var errs []error
+
+	for {
+		for len(errs) == 0 && running < maxParallel && len(ready) > 0 {
+			name := ready[0]
+			ready = ready[1:]
+
+			if selected != nil && !selected[name] {
+				log.Debug("Skipping workflow step", "step", name)
+				release(name)
+				continue
+			}
+
+			running++
+			go func(name string) {
+				stepIdx := index[name]
+				done <- stepDone{name: name, err: run(stepIdx, &steps[stepIdx])}
+			}(name)
+		}
+
+		if running == 0 {
+			break
+		}
+
+		result := <-done
+		running--
+		if result.err != nil {
+			errs = append(errs, result.err)
+			continue
+		}
+		release(result.name)
+	}
+
+	// Keep a single failure unwrapped so its explanation and hints are preserved.
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,216 @@
+package workflow
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+var errStepFailed = errors.New("step failed")
+
+// dependenciesOf returns the sorted dependencies of a step in the graph.
+func dependenciesOf(t *testing.T, def *schema.WorkflowDefinition, name string) []string {
+	t.Helper()
+
+	graph, err := BuildStepGraph(def)
+	require.NoError(t, err)
+	node, ok := graph.GetNode(name)
+	require.True(t, ok)
+	deps := slices.Clone(node.Dependencies)
+	slices.Sort(deps)
+	return deps
+}
+
+// stepRecorder records the order in which steps complete and the maximum number of concurrent steps.
+type stepRecorder struct {
+	mu       sync.Mutex
+	order    []string
+	fail     map[string]bool
+	running  atomic.Int32
+	maxInUse atomic.Int32
+}
+
+func (r *stepRecorder) run(_ int, step *schema.WorkflowStep) error {
+	inUse := r.running.Add(1)
+	defer r.running.Add(-1)
+	for {
+		current := r.maxInUse.Load()
+		if inUse <= current || r.maxInUse.CompareAndSwap(current, inUse) {
+			break
+		}
+	}
+	time.Sleep(10 * time.Millisecond)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.order = append(r.order, step.Name)
+	if r.fail[step.Name] {
+		return errStepFailed
+	}
+	return nil
+}
+
+func TestIsDAG(t *testing.T) {
+	assert.False(t, IsDAG(nil))
+	assert.False(t, IsDAG(&schema.WorkflowDefinition{Steps: []schema.WorkflowStep{{Name: "a"}, {Name: "b"}}}))
+	assert.True(t, IsDAG(&schema.WorkflowDefinition{Steps: []schema.WorkflowStep{{Name: "a"}, {Name: "b", Needs: []string{"a"}}}}))
+	assert.True(t, IsDAG(&schema.WorkflowDefinition{Steps: []schema.WorkflowStep{{Name: "a", Parallel: true}}}))
+}
+
+func TestBuildStepGraph_ImplicitDependencies(t *testing.T) {
+	def := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "init"},
+			{Name: "vpc", Parallel: true},
+			{Name: "dns", Parallel: true},
+			{Name: "eks"},
+			{Name: "app", Needs: []string{"vpc"}},
+			{Name: "verify"},
+		},
+	}
+
+	assert.Empty(t, dependenciesOf(t, def, "init"))
+	assert.Equal(t, []string{"init"}, dependenciesOf(t, def, "vpc"))
+	assert.Equal(t, []string{"init"}, dependenciesOf(t, def, "dns"))
+	assert.Equal(t, []string{"dns", "vpc"}, dependenciesOf(t, def, "eks"))
+	assert.Equal(t, []string{"vpc"}, dependenciesOf(t, def, "app"))
+	assert.Equal(t, []string{"app"}, dependenciesOf(t, def, "verify"))
+}
+
+func TestBuildStepGraph_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		steps []schema.WorkflowStep
+	}{
+		{
+			name:  "unknown step",
+			steps: []schema.WorkflowStep{{Name: "a"}, {Name: "b", Needs: []string{"missing"}}},
+		},
+		{
+			name:  "self dependency",
+			steps: []schema.WorkflowStep{{Name: "a", Needs: []string{"a"}}},
+		},
+		{
+			name:  "cycle",
+			steps: []schema.WorkflowStep{{Name: "a", Needs: []string{"b"}}, {Name: "b", Needs: []string{"a"}}},
+		},
+		{
+			name:  "duplicate step name",
+			steps: []schema.WorkflowStep{{Name: "a", Parallel: true}, {Name: "a", Parallel: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildStepGraph(&schema.WorkflowDefinition{Steps: tt.steps})
+			require.Error(t, err)
+			assert.ErrorIs(t, err, errUtils.ErrInvalidWorkflowStepNeeds)
+		})
+	}
+}
+
+func TestSelectStepsFrom(t *testing.T) {
+	def := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "a", Parallel: true},
+			{Name: "b", Parallel: true},
+			{Name: "c", Needs: []string{"a"}},
+			{Name: "d", Needs: []string{"b"}},
+		},
+	}
+	graph, err := BuildStepGraph(def)
+	require.NoError(t, err)
+
+	// a is listed first, so everything after it is selected; b is not.
+	selected := SelectStepsFrom(graph, def.Steps, "c")
+	assert.Equal(t, map[string]bool{"c": true, "d": true}, selected)
+
+	selected = SelectStepsFrom(graph, def.Steps, "a")
+	assert.Equal(t, map[string]bool{"a": true, "b": true, "c": true, "d": true}, selected)
+}
+
+func TestRunStepGraph_RespectsDependencies(t *testing.T) {
+	def := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "vpc"},
+			{Name: "eks", Parallel: true},
+			{Name: "rds", Parallel: true},
+			{Name: "app"},
+		},
+	}
+	graph, err := BuildStepGraph(def)
+	require.NoError(t, err)
+
+	rec := &stepRecorder{}
+	require.NoError(t, RunStepGraph(graph, def.Steps, nil, 0, rec.run))
+
+	require.Len(t, rec.order, 4)
+	assert.Equal(t, "vpc", rec.order[0])
+	assert.Equal(t, "app", rec.order[3])
+	assert.Equal(t, int32(2), rec.maxInUse.Load(), "the parallel group should run concurrently")
+}
+
+func TestRunStepGraph_BoundsConcurrency(t *testing.T) {
+	def := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "a", Parallel: true},
+			{Name: "b", Parallel: true},
+			{Name: "c", Parallel: true},
+			{Name: "d", Parallel: true},
+			{Name: "e", Parallel: true},
+		},
+	}
+	graph, err := BuildStepGraph(def)
+	require.NoError(t, err)
+
+	rec := &stepRecorder{}
+	require.NoError(t, RunStepGraph(graph, def.Steps, nil, 2, rec.run))
+
+	assert.Len(t, rec.order, 5)
+	assert.Equal(t, int32(2), rec.maxInUse.Load())
+}
+
+func TestRunStepGraph_StopsOnFailure(t *testing.T) {
+	def := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "a", Parallel: true},
+			{Name: "b", Parallel: true},
+			{Name: "c", Needs: []string{"a"}},
+			{Name: "d", Needs: []string{"b"}},
+		},
+	}
+	graph, err := BuildStepGraph(def)
+	require.NoError(t, err)
+
+	rec := &stepRecorder{fail: map[string]bool{"a": true}}
+	err = RunStepGraph(graph, def.Steps, nil, 4, rec.run)
+	require.ErrorIs(t, err, errStepFailed)
+
+	// b was already running when a failed; nothing is started afterwards.
+	assert.ElementsMatch(t, []string{"a", "b"}, rec.order)
+}
+
+func TestRunStepGraph_Selected(t *testing.T) {
+	def := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "a"},
+			{Name: "b", Needs: []string{"a"}},
+			{Name: "c", Needs: []string{"b"}},
+		},
+	}
+	graph, err := BuildStepGraph(def)
+	require.NoError(t, err)
+
+	rec := &stepRecorder{}
+	require.NoError(t, RunStepGraph(graph, def.Steps, SelectStepsFrom(graph, def.Steps, "b"), 1, rec.run))
+	assert.Equal(t, []string{"b", "c"}, rec.order)
+}
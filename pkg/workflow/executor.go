@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
 
 	"github.com/samber/lo"
 	"mvdan.cc/sh/v3/shell"
@@ -101,8 +102,12 @@ func (e *Executor) Execute(params *WorkflowParams) (*ExecutionResult, error) {
 
 	log.Debug("Executing workflow", "workflow", params.Workflow, "path", params.WorkflowPath)
 
+	// Steps with `needs` or `parallel` are executed as a dependency graph.
+	isDAG := IsDAG(params.WorkflowDefinition)
+
 	// Handle --from-step flag.
-	steps, err := e.handleFromStep(steps, params.WorkflowDefinition, params.Workflow, params.Opts.FromStep, result)
+	// For a dependency graph, the steps to run are selected from the graph instead.
+	steps, err := e.handleFromStep(steps, params.WorkflowDefinition, params.Workflow, params.Opts.FromStep, isDAG, result)
 	if err != nil {
 		return result, err
 	}
@@ -115,6 +120,10 @@ func (e *Executor) Execute(params *WorkflowParams) (*ExecutionResult, error) {
 		return result, err
 	}
 
+	if isDAG {
+		return e.executeGraph(params, result)
+	}
+
 	// Execute each step.
 	for stepIdx, step := range steps {
 		stepResult := e.executeStep(params, &step, stepIdx)
@@ -137,6 +146,7 @@ func (e *Executor) handleFromStep(
 	workflowDefinition *schema.WorkflowDefinition,
 	workflow string,
 	fromStep string,
+	isDAG bool,
 	result *ExecutionResult,
 ) ([]schema.WorkflowStep, error) {
 	if fromStep == "" {
@@ -158,6 +168,10 @@ func (e *Executor) handleFromStep(
 		return nil, err
 	}
 
+	if isDAG {
+		return steps, nil
+	}
+
 	// Mark skipped steps in result.
 	for _, step := range workflowDefinition.Steps {
 		if step.Name == fromStep {
@@ -174,6 +188,58 @@ func (e *Executor) handleFromStep(
 	return steps, nil
 }
 
+// executeGraph executes the steps of a workflow that uses `needs` or `parallel` as a dependency graph.
+// With --from-step, the named step, the steps that depend on it, and the steps listed after it are executed.
+func (e *Executor) executeGraph(params *WorkflowParams, result *ExecutionResult) (*ExecutionResult, error) {
+	defer perf.Track(params.AtmosConfig, "workflow.Executor.executeGraph")()
+
+	steps := params.WorkflowDefinition.Steps
+
+	graph, err := BuildStepGraph(params.WorkflowDefinition)
+	if err != nil {
+		e.printError(err)
+		result.Success = false
+		result.Error = err
+		return result, err
+	}
+
+	var selected map[string]bool
+	if params.Opts.FromStep != "" {
+		selected = SelectStepsFrom(graph, steps, params.Opts.FromStep)
+		for _, step := range steps {
+			if !selected[step.Name] {
+				result.Steps = append(result.Steps, StepResult{
+					StepName: step.Name,
+					Command:  step.Command,
+					Skipped:  true,
+					Success:  true,
+				})
+			}
+		}
+	}
+
+	// Steps run concurrently, so their results are appended in the order they finish.
+	var mu sync.Mutex
+	err = RunStepGraph(graph, steps, selected, params.WorkflowDefinition.MaxParallel, func(stepIdx int, step *schema.WorkflowStep) error {
+		stepResult := e.executeStep(params, step, stepIdx)
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		result.Steps = append(result.Steps, stepResult.StepResult)
+		if !stepResult.Success && result.ResumeCommand == "" {
+			result.ResumeCommand = e.buildResumeCommand(params.Workflow, params.WorkflowPath, step.Name, stepResult.finalStack, params.AtmosConfig)
+		}
+		return stepResult.Error
+	})
+	if err != nil {
+		result.Success = false
+		result.Error = err
+	}
+
+	return result, err
+}
+
 // stepResultInternal extends StepResult with internal fields.
 type stepResultInternal struct {
 	StepResult
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"path/filepath"
 	"runtime"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -1767,3 +1769,108 @@ func TestExecutor_Execute_AtmosCommandWithEnv(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, result.Success)
 }
+
+// newDependencyGraphWorkflow returns a workflow whose steps `eks` and `rds` run in parallel after `vpc`,
+// and `app` runs after both of them.
+func newDependencyGraphWorkflow() *schema.WorkflowDefinition {
+	return &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "vpc", Command: "echo vpc", Type: "shell"},
+			{Name: "eks", Command: "echo eks", Type: "shell", Parallel: true},
+			{Name: "rds", Command: "echo rds", Type: "shell", Parallel: true},
+			{Name: "app", Command: "echo app", Type: "shell", Needs: []string{"eks", "rds"}},
+		},
+	}
+}
+
+// TestExecutor_Execute_DependencyGraph tests that steps with `needs` and `parallel` run in dependency order.
+func TestExecutor_Execute_DependencyGraph(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRunner := NewMockCommandRunner(ctrl)
+	var mu sync.Mutex
+	var order []string
+	mockRunner.EXPECT().
+		RunShell(gomock.Any(), gomock.Any(), ".", gomock.Any(), false).
+		DoAndReturn(func(command, _, _ string, _ []string, _ bool) error {
+			mu.Lock()
+			defer mu.Unlock()
+			order = append(order, strings.TrimPrefix(command, "echo "))
+			return nil
+		}).
+		Times(4)
+
+	executor := NewExecutor(mockRunner, nil, nil)
+	result, err := executor.Execute(newTestParams(newDependencyGraphWorkflow(), ExecuteOptions{}))
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	assert.Len(t, result.Steps, 4)
+	require.Len(t, order, 4)
+	assert.Equal(t, "vpc", order[0])
+	assert.ElementsMatch(t, []string{"eks", "rds"}, order[1:3])
+	assert.Equal(t, "app", order[3])
+}
+
+// TestExecutor_Execute_DependencyGraphFromStep tests --from-step for a dependency graph workflow.
+func TestExecutor_Execute_DependencyGraphFromStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRunner := NewMockCommandRunner(ctrl)
+	mockRunner.EXPECT().RunShell("echo rds", gomock.Any(), ".", gomock.Any(), false).Return(nil)
+	mockRunner.EXPECT().RunShell("echo app", gomock.Any(), ".", gomock.Any(), false).Return(nil)
+
+	executor := NewExecutor(mockRunner, nil, nil)
+	result, err := executor.Execute(newTestParams(newDependencyGraphWorkflow(), ExecuteOptions{FromStep: "rds"}))
+
+	require.NoError(t, err)
+	assert.True(t, result.Success)
+	skipped := map[string]bool{}
+	for _, step := range result.Steps {
+		skipped[step.StepName] = step.Skipped
+	}
+	assert.Equal(t, map[string]bool{"vpc": true, "eks": true, "rds": false, "app": false}, skipped)
+}
+
+// TestExecutor_Execute_DependencyGraphStepFailure tests that a failing step stops its dependents.
+func TestExecutor_Execute_DependencyGraphStepFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRunner := NewMockCommandRunner(ctrl)
+	mockUI := NewMockUIProvider(ctrl)
+	mockUI.EXPECT().PrintError(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	mockRunner.EXPECT().RunShell("echo vpc", gomock.Any(), ".", gomock.Any(), false).Return(errors.New("vpc failed"))
+
+	executor := NewExecutor(mockRunner, nil, mockUI)
+	result, err := executor.Execute(newTestParams(newDependencyGraphWorkflow(), ExecuteOptions{}))
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, errUtils.ErrWorkflowStepFailed)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.ResumeCommand, "--from-step vpc")
+}
+
+// TestExecutor_Execute_DependencyGraphInvalidNeeds tests that a step needing an unknown step is rejected.
+func TestExecutor_Execute_DependencyGraphInvalidNeeds(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRunner := NewMockCommandRunner(ctrl)
+	mockUI := NewMockUIProvider(ctrl)
+	mockUI.EXPECT().PrintError(gomock.Any(), gomock.Any(), gomock.Any()).Times(1)
+
+	workflowDef := &schema.WorkflowDefinition{
+		Steps: []schema.WorkflowStep{
+			{Name: "app", Command: "echo app", Type: "shell", Needs: []string{"missing"}},
+		},
+	}
+
+	executor := NewExecutor(mockRunner, nil, mockUI)
+	result, err := executor.Execute(newTestParams(workflowDef, ExecuteOptions{}))
+
+	assert.ErrorIs(t, err, errUtils.ErrInvalidWorkflowStepNeeds)
+	assert.False(t, result.Success)
+}
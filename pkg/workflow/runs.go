@@ -0,0 +1,396 @@
+package workflow
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/filesystem"
+	log "github.com/cloudposse/atmos/pkg/logger"
+	"github.com/cloudposse/atmos/pkg/perf"
+	"github.com/cloudposse/atmos/pkg/schema"
+	"github.com/cloudposse/atmos/pkg/xdg"
+)
+
+// Workflow run statuses.
+const (
+	RunStatusRunning   = "running"
+	RunStatusSucceeded = "succeeded"
+	RunStatusFailed    = "failed"
+)
+
+// Step statuses recorded in addition to the statuses exposed to templates.
+const (
+	StepStatusRunning = "running"
+	StepStatusFailed  = "failed"
+)
+
+const (
+	// runsStateSubpath is the directory under the XDG state dir where workflow runs are recorded.
+	runsStateSubpath = "workflow/runs"
+	runsDirPerm      = 0o700
+	runFilePerm      = 0o600
+
+	// OutputTailLimit is the number of trailing bytes of step output kept in a run record.
+	OutputTailLimit = 4096
+
+	// MaxRunRecords is the number of most recent run records kept when a new run starts.
+	MaxRunRecords = 100
+)
+
+// RunRecord is the persisted state of a workflow run.
+type RunRecord struct {
+	ID           string          `json:"id" yaml:"id"`
+	Workflow     string          `json:"workflow" yaml:"workflow"`
+	WorkflowPath string          `json:"workflow_path" yaml:"workflow_path"`
+	Stack        string          `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Identity     string          `json:"identity,omitempty" yaml:"identity,omitempty"`
+	Status       string          `json:"status" yaml:"status"`
+	Error        string          `json:"error,omitempty" yaml:"error,omitempty"`
+	StartedAt    time.Time       `json:"started_at" yaml:"started_at"`
+	FinishedAt   *time.Time      `json:"finished_at,omitempty" yaml:"finished_at,omitempty"`
+	Resumes      int             `json:"resumes,omitempty" yaml:"resumes,omitempty"`
+	Steps        []RunStepRecord `json:"steps" yaml:"steps"`
+}
+
+// RunStepRecord is the persisted state of a workflow step within a run.
+type RunStepRecord struct {
+	Name       string            `json:"name" yaml:"name"`
+	Command    string            `json:"command" yaml:"command"`
+	Type       string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Status     string            `json:"status" yaml:"status"`
+	StartedAt  *time.Time        `json:"started_at,omitempty" yaml:"started_at,omitempty"`
+	FinishedAt *time.Time        `json:"finished_at,omitempty" yaml:"finished_at,omitempty"`
+	ExitCode   int               `json:"exit_code" yaml:"exit_code"`
+	Error      string            `json:"error,omitempty" yaml:"error,omitempty"`
+	OutputTail string            `json:"output_tail,omitempty" yaml:"output_tail,omitempty"`
+	Outputs    map[string]string `json:"outputs,omitempty" yaml:"outputs,omitempty"`
+}
+
+// SyncSteps aligns the step records with the steps of the workflow definition.
+// Records of steps that still exist are kept, so a resumed run skips the steps that already completed,
+// even if the workflow manifest was edited in between. New steps are added as pending.
+func (r *RunRecord) SyncSteps(steps []schema.WorkflowStep) {
+	defer perf.Track(nil, "workflow.RunRecord.SyncSteps")()
+
+	synced := make([]RunStepRecord, 0, len(steps))
+	for i := range steps {
+		record := RunStepRecord{Status: StepStatusPending}
+		if existing := r.Step(steps[i].Name); existing != nil {
+			record = *existing
+		}
+		record.Name = steps[i].Name
+		record.Command = steps[i].Command
+		record.Type = steps[i].Type
+		synced = append(synced, record)
+	}
+	r.Steps = synced
+}
+
+// Step returns the record of the named step.
+func (r *RunRecord) Step(name string) *RunStepRecord {
+	for i := range r.Steps {
+		if r.Steps[i].Name == name {
+			return &r.Steps[i]
+		}
+	}
+	return nil
+}
+
+// CompletedSteps returns the names of the steps that succeeded or were skipped.
+// These steps are not executed again when the run is resumed.
+func (r *RunRecord) CompletedSteps() map[string]bool {
+	completed := make(map[string]bool, len(r.Steps))
+	for i := range r.Steps {
+		if r.Steps[i].Status == StepStatusSuccess || r.Steps[i].Status == StepStatusSkipped {
+			completed[r.Steps[i].Name] = true
+		}
+	}
+	return completed
+}
+
+// Duration returns how long the run took, or has been running.
+func (r *RunRecord) Duration() time.Duration {
+	end := time.Now()
+	if r.FinishedAt != nil {
+		end = *r.FinishedAt
+	}
+	return end.Sub(r.StartedAt).Round(time.Second)
+}
+
+// NewRunID returns a new run ID. IDs sort in the order the runs were started.
+func NewRunID(now time.Time) string {
+	suffix := make([]byte, 3)
+	if _, err := rand.Read(suffix); err != nil {
+		return now.UTC().Format("20060102-150405.000000")
+	}
+	return now.UTC().Format("20060102-150405") + "-" + hex.EncodeToString(suffix)
+}
+
+// RunStore persists workflow run records as JSON files in a directory.
+type RunStore struct {
+	dir string
+	fs  filesystem.FileSystem
+}
+
+// NewRunStore creates a RunStore that keeps the run records in dir.
+func NewRunStore(dir string) *RunStore {
+	return &RunStore{dir: dir, fs: filesystem.NewOSFileSystem()}
+}
+
+// DefaultRunStore creates a RunStore in the XDG state directory (`$XDG_STATE_HOME/atmos/workflow/runs`).
+func DefaultRunStore() (*RunStore, error) {
+	defer perf.Track(nil, "workflow.DefaultRunStore")()
+
+	dir, err := xdg.GetXDGStateDir(runsStateSubpath, runsDirPerm)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrWorkflowRunState, err)
+	}
+	return NewRunStore(dir), nil
+}
+
+// Dir returns the directory of the run records.
+func (s *RunStore) Dir() string {
+	return s.dir
+}
+
+// Save writes the run record atomically.
+func (s *RunStore) Save(record *RunRecord) error {
+	defer perf.Track(nil, "workflow.RunStore.Save")()
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return fmt.Errorf("%w: %w", errUtils.ErrWorkflowRunState, err)
+	}
+	if err := s.fs.MkdirAll(s.dir, runsDirPerm); err != nil {
+		return fmt.Errorf("%w: %w", errUtils.ErrWorkflowRunState, err)
+	}
+	if err := s.fs.WriteFileAtomic(s.path(record.ID), data, runFilePerm); err != nil {
+		return fmt.Errorf("%w: %w", errUtils.ErrWorkflowRunState, err)
+	}
+	return nil
+}
+
+// Load reads the run record with the given ID.
+func (s *RunStore) Load(id string) (*RunRecord, error) {
+	defer perf.Track(nil, "workflow.RunStore.Load")()
+
+	// Run IDs are file names; reject anything that could escape the runs directory.
+	if id == "" || id != filepath.Base(id) || strings.HasPrefix(id, ".") {
+		return nil, fmt.Errorf("%w: %s", errUtils.ErrWorkflowRunNotFound, id)
+	}
+
+	data, err := s.fs.ReadFile(s.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", errUtils.ErrWorkflowRunNotFound, id)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrWorkflowRunState, err)
+	}
+
+	var record RunRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("%w: run %s: %w", errUtils.ErrWorkflowRunState, id, err)
+	}
+	return &record, nil
+}
+
+// List returns all run records, most recent first. Unreadable records are skipped.
+func (s *RunStore) List() ([]*RunRecord, error) {
+	defer perf.Track(nil, "workflow.RunStore.List")()
+
+	entries, err := s.fs.ReadDir(s.dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errUtils.ErrWorkflowRunState, err)
+	}
+
+	records := make([]*RunRecord, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+			continue
+		}
+		record, err := s.Load(strings.TrimSuffix(entry.Name(), ".json"))
+		if err != nil {
+			log.Debug("Skipping unreadable workflow run record", "file", entry.Name(), "error", err)
+			continue
+		}
+		records = append(records, record)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].StartedAt.After(records[j].StartedAt)
+	})
+	return records, nil
+}
+
+// Prune removes the run records beyond the keep most recent ones, and returns the number of removed records.
+func (s *RunStore) Prune(keep int) (int, error) {
+	defer perf.Track(nil, "workflow.RunStore.Prune")()
+
+	records, err := s.List()
+	if err != nil {
+		return 0, err
+	}
+	if len(records) <= keep {
+		return 0, nil
+	}
+
+	removed := 0
+	for _, record := range records[keep:] {
+		if err := s.fs.Remove(s.path(record.ID)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return removed, fmt.Errorf("%w: %w", errUtils.ErrWorkflowRunState, err)
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// path returns the file path of a run record.
+func (s *RunStore) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
+
+// RunRecorder updates a run record as the workflow progresses and saves it after every change.
+// A nil RunRecorder records nothing. It is safe for concurrent use by steps that run in parallel.
+// Failures to save are logged and never fail the workflow.
+type RunRecorder struct {
+	mu     sync.Mutex
+	store  *RunStore
+	record *RunRecord
+}
+
+// NewRunRecorder creates a RunRecorder, marks the run as running and saves it.
+func NewRunRecorder(store *RunStore, record *RunRecord) *RunRecorder {
+	defer perf.Track(nil, "workflow.NewRunRecorder")()
+
+	record.Status = RunStatusRunning
+	record.Error = ""
+	record.FinishedAt = nil
+
+	r := &RunRecorder{store: store, record: record}
+	r.save()
+	return r
+}
+
+// ID returns the run ID.
+func (r *RunRecorder) ID() string {
+	if r == nil {
+		return ""
+	}
+	return r.record.ID
+}
+
+// StepStarted records that a step started.
+func (r *RunRecorder) StepStarted(name string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if step := r.record.Step(name); step != nil {
+		now := time.Now()
+		step.Status = StepStatusRunning
+		step.StartedAt = &now
+		step.FinishedAt = nil
+		step.ExitCode = 0
+		step.Error = ""
+		step.OutputTail = ""
+		step.Outputs = nil
+	}
+	r.save()
+}
+
+// StepFinished records the result of a step.
+func (r *RunRecorder) StepFinished(name, status string, exitCode int, stepErr error, outputTail string, outputs map[string]string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if step := r.record.Step(name); step != nil {
+		now := time.Now()
+		step.Status = status
+		step.FinishedAt = &now
+		step.ExitCode = exitCode
+		step.OutputTail = outputTail
+		step.Outputs = outputs
+		if stepErr != nil {
+			step.Error = stepErr.Error()
+		}
+	}
+	r.save()
+}
+
+// Finish records the end of the run.
+func (r *RunRecorder) Finish(runErr error) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	r.record.FinishedAt = &now
+	r.record.Status = RunStatusSucceeded
+	if runErr != nil {
+		r.record.Status = RunStatusFailed
+		r.record.Error = runErr.Error()
+	}
+	r.save()
+}
+
+// save writes the record. The caller must hold the lock, except during construction.
+func (r *RunRecorder) save() {
+	if err := r.store.Save(r.record); err != nil {
+		log.Warn("Failed to save workflow run state", "run", r.record.ID, "error", err)
+	}
+}
+
+// TailWriter keeps the last bytes written to it. It is safe for concurrent use.
+type TailWriter struct {
+	mu    sync.Mutex
+	limit int
+	buf   []byte
+}
+
+// NewTailWriter creates a TailWriter that keeps at most limit bytes.
+func NewTailWriter(limit int) *TailWriter {
+	return &TailWriter{limit: limit}
+}
+
+// Write appends p, discarding the oldest bytes beyond the limit.
+func (w *TailWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.buf = append(w.buf, p...)
+	if over := len(w.buf) - w.limit; over > 0 {
+		w.buf = append(w.buf[:0], w.buf[over:]...)
+	}
+	return len(p), nil
+}
+
+// String returns the kept bytes.
+func (w *TailWriter) String() string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return string(w.buf)
+}
@@ -0,0 +1,196 @@
+package workflow
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/filesystem"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestNewRunID(t *testing.T) {
+	now := time.Date(2026, 10, 18, 14, 15, 2, 0, time.UTC)
+
+	first := NewRunID(now)
+	second := NewRunID(now)
+	assert.True(t, strings.HasPrefix(first, "20261018-141502-"))
+	assert.NotEqual(t, first, second)
+	assert.Less(t, NewRunID(now), NewRunID(now.Add(time.Second)), "run IDs must sort by start time")
+}
+
+func TestRunStore_SaveLoadList(t *testing.T) {
+	store := NewRunStore(filepath.Join(t.TempDir(), "runs"))
+
+	records, err := store.List()
+	require.NoError(t, err)
+	assert.Empty(t, records, "a missing runs directory has no runs")
+
+	older := &RunRecord{ID: "older", Workflow: "deploy", Status: RunStatusFailed, StartedAt: time.Now().Add(-time.Hour)}
+	newer := &RunRecord{
+		ID:        "newer",
+		Workflow:  "deploy",
+		Status:    RunStatusSucceeded,
+		StartedAt: time.Now(),
+		Steps:     []RunStepRecord{{Name: "step1", Status: StepStatusSuccess, Outputs: map[string]string{"id": "1"}}},
+	}
+	require.NoError(t, store.Save(older))
+	require.NoError(t, store.Save(newer))
+
+	// Unreadable records and other files are ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(store.Dir(), "broken.json"), []byte("{"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(store.Dir(), "notes.txt"), []byte("x"), 0o600))
+
+	loaded, err := store.Load("newer")
+	require.NoError(t, err)
+	assert.Equal(t, "1", loaded.Step("step1").Outputs["id"])
+
+	records, err = store.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "newer", records[0].ID)
+	assert.Equal(t, "older", records[1].ID)
+}
+
+func TestRunStore_LoadErrors(t *testing.T) {
+	store := NewRunStore(t.TempDir())
+
+	for _, id := range []string{"", "missing", "../escape", ".hidden"} {
+		_, err := store.Load(id)
+		assert.ErrorIs(t, err, errUtils.ErrWorkflowRunNotFound, "id %q", id)
+	}
+
+	require.NoError(t, os.WriteFile(filepath.Join(store.Dir(), "broken.json"), []byte("{"), 0o600))
+	_, err := store.Load("broken")
+	assert.ErrorIs(t, err, errUtils.ErrWorkflowRunState)
+}
+
+func TestRunStore_ListReadsThroughFileSystem(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockFS := filesystem.NewMockFileSystem(ctrl)
+	store := &RunStore{dir: "runs", fs: mockFS}
+
+	mockFS.EXPECT().ReadDir("runs").Return(nil, errors.New("permission denied"))
+	_, err := store.List()
+	assert.ErrorIs(t, err, errUtils.ErrWorkflowRunState)
+
+	mockFS.EXPECT().ReadDir("runs").Return(nil, fs.ErrNotExist)
+	records, err := store.List()
+	require.NoError(t, err)
+	assert.Empty(t, records)
+}
+
+func TestRunStore_Prune(t *testing.T) {
+	store := NewRunStore(t.TempDir())
+
+	now := time.Now()
+	for i, id := range []string{"oldest", "older", "newer", "newest"} {
+		require.NoError(t, store.Save(&RunRecord{ID: id, StartedAt: now.Add(time.Duration(i) * time.Minute)}))
+	}
+
+	removed, err := store.Prune(5)
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+
+	removed, err = store.Prune(2)
+	require.NoError(t, err)
+	assert.Equal(t, 2, removed)
+
+	records, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, "newest", records[0].ID)
+	assert.Equal(t, "newer", records[1].ID)
+
+	_, err = store.Load("oldest")
+	assert.ErrorIs(t, err, errUtils.ErrWorkflowRunNotFound)
+}
+
+func TestRunRecord_SyncSteps(t *testing.T) {
+	record := &RunRecord{Steps: []RunStepRecord{
+		{Name: "a", Command: "old", Status: StepStatusSuccess},
+		{Name: "removed", Status: StepStatusFailed},
+	}}
+
+	record.SyncSteps([]schema.WorkflowStep{
+		{Name: "a", Command: "new", Type: "shell"},
+		{Name: "b", Command: "echo b"},
+	})
+
+	require.Len(t, record.Steps, 2)
+	assert.Equal(t, RunStepRecord{Name: "a", Command: "new", Type: "shell", Status: StepStatusSuccess}, record.Steps[0])
+	assert.Equal(t, RunStepRecord{Name: "b", Command: "echo b", Status: StepStatusPending}, record.Steps[1])
+	assert.Equal(t, map[string]bool{"a": true}, record.CompletedSteps())
+}
+
+func TestRunRecorder(t *testing.T) {
+	store := NewRunStore(t.TempDir())
+	record := &RunRecord{ID: "run", Workflow: "deploy", StartedAt: time.Now()}
+	record.SyncSteps([]schema.WorkflowStep{{Name: "a"}, {Name: "b"}, {Name: "c"}})
+
+	recorder := NewRunRecorder(store, record)
+	assert.Equal(t, "run", recorder.ID())
+
+	saved, err := store.Load("run")
+	require.NoError(t, err)
+	assert.Equal(t, RunStatusRunning, saved.Status)
+
+	recorder.StepStarted("a")
+	saved, err = store.Load("run")
+	require.NoError(t, err)
+	assert.Equal(t, StepStatusRunning, saved.Step("a").Status)
+	assert.NotNil(t, saved.Step("a").StartedAt)
+
+	recorder.StepFinished("a", StepStatusSuccess, 0, nil, "done\n", map[string]string{"id": "1"})
+	recorder.StepFinished("b", StepStatusSkipped, 0, nil, "", nil)
+	recorder.StepStarted("c")
+	recorder.StepFinished("c", StepStatusFailed, 2, errors.New("boom"), "error output", nil)
+	recorder.Finish(errors.New("step c failed"))
+
+	saved, err = store.Load("run")
+	require.NoError(t, err)
+	assert.Equal(t, RunStatusFailed, saved.Status)
+	assert.Equal(t, "step c failed", saved.Error)
+	assert.NotNil(t, saved.FinishedAt)
+	assert.Equal(t, "done\n", saved.Step("a").OutputTail)
+	assert.Equal(t, map[string]string{"id": "1"}, saved.Step("a").Outputs)
+	assert.Equal(t, StepStatusSkipped, saved.Step("b").Status)
+	assert.Equal(t, 2, saved.Step("c").ExitCode)
+	assert.Equal(t, "boom", saved.Step("c").Error)
+	assert.Equal(t, map[string]bool{"a": true, "b": true}, saved.CompletedSteps())
+
+	// A nil recorder records nothing.
+	var nilRecorder *RunRecorder
+	assert.Empty(t, nilRecorder.ID())
+	assert.NotPanics(t, func() {
+		nilRecorder.StepStarted("a")
+		nilRecorder.StepFinished("a", StepStatusSuccess, 0, nil, "", nil)
+		nilRecorder.Finish(nil)
+	})
+}
+
+func TestTailWriter(t *testing.T) {
+	w := NewTailWriter(5)
+
+	n, err := w.Write([]byte("abc"))
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, "abc", w.String())
+
+	_, err = w.Write([]byte("defgh"))
+	require.NoError(t, err)
+	assert.Equal(t, "defgh", w.String())
+
+	_, err = w.Write([]byte("0123456789"))
+	require.NoError(t, err)
+	assert.Equal(t, "56789", w.String())
+}
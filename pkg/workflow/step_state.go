@@ -0,0 +1,158 @@
+package workflow
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+// Step statuses exposed to templates as `.steps.<name>.status`.
+const (
+	StepStatusPending = "pending"
+	StepStatusSuccess = "success"
+	StepStatusSkipped = "skipped"
+)
+
+// StepOutcome is the recorded result of a workflow step.
+type StepOutcome struct {
+	Status  string
+	Outputs map[string]string
+}
+
+// StepOutcomes records the outcomes of workflow steps.
+// It is safe for concurrent use by steps that run in parallel.
+type StepOutcomes struct {
+	mu    sync.RWMutex
+	steps map[string]StepOutcome
+}
+
+// NewStepOutcomes creates an empty StepOutcomes.
+func NewStepOutcomes() *StepOutcomes {
+	return &StepOutcomes{steps: make(map[string]StepOutcome)}
+}
+
+// Set records the outcome of a step.
+func (s *StepOutcomes) Set(name string, outcome StepOutcome) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.steps[name] = outcome
+}
+
+// Get returns the outcome of a step.
+func (s *StepOutcomes) Get(name string) (StepOutcome, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	outcome, ok := s.steps[name]
+	return outcome, ok
+}
+
+// TemplateData returns the step outcomes in the form used by templates:
+// `.steps.<name>.status` and `.steps.<name>.outputs.<key>`.
+func (s *StepOutcomes) TemplateData() map[string]any {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	data := make(map[string]any, len(s.steps))
+	for name, outcome := range s.steps {
+		outputs := make(map[string]any, len(outcome.Outputs))
+		for k, v := range outcome.Outputs {
+			outputs[k] = v
+		}
+		data[name] = map[string]any{
+			"status":  outcome.Status,
+			"outputs": outputs,
+		}
+	}
+	return data
+}
+
+// StepTemplateData builds the template context for step conditions, commands and outputs:
+// `.workflow`, `.stack`, `.env` and `.steps`.
+func StepTemplateData(workflow, stack string, env []string, outcomes *StepOutcomes) map[string]any {
+	return map[string]any{
+		"workflow": workflow,
+		"stack":    stack,
+		"env":      envSliceToMap(env),
+		"steps":    outcomes.TemplateData(),
+	}
+}
+
+// OutputTemplateData extends the step template context with the captured stdout of the step:
+// `.stdout` holds the trimmed output and `.json` holds the output parsed as JSON (nil if it is not JSON).
+func OutputTemplateData(data map[string]any, stdout string) map[string]any {
+	outputData := make(map[string]any, len(data)+2)
+	for k, v := range data {
+		outputData[k] = v
+	}
+
+	trimmed := strings.TrimSpace(stdout)
+	outputData["stdout"] = trimmed
+
+	var parsed any
+	if err := json.Unmarshal([]byte(trimmed), &parsed); err != nil {
+		parsed = nil
+	}
+	outputData["json"] = parsed
+
+	return outputData
+}
+
+// ConditionTemplate returns the Go template for a step `if` expression.
+// Bare expressions such as `eq .stack "prod"` are wrapped in template delimiters.
+func ConditionTemplate(condition string) string {
+	condition = strings.TrimSpace(condition)
+	if strings.Contains(condition, "{{") {
+		return condition
+	}
+	return "{{ " + condition + " }}"
+}
+
+// ParseConditionResult converts the rendered `if` expression of a step to a boolean.
+// An empty result is false.
+func ParseConditionResult(stepName, rendered string) (bool, error) {
+	rendered = strings.TrimSpace(rendered)
+	if rendered == "" || rendered == "<no value>" {
+		return false, nil
+	}
+
+	result, err := strconv.ParseBool(rendered)
+	if err != nil {
+		return false, errUtils.Build(errUtils.ErrInvalidWorkflowStepCondition).
+			WithExplanationf("The `if` expression of step `%s` evaluated to `%s`, which is not a boolean.", stepName, rendered).
+			WithHint("The `if` expression must evaluate to `true` or `false`").
+			Err()
+	}
+	return result, nil
+}
+
+// UsesStepOutputs returns true if any step of the workflow declares `outputs`.
+// Step commands are processed as Go templates only in such workflows, so existing commands containing `{{` are unaffected.
+func UsesStepOutputs(workflowDefinition *schema.WorkflowDefinition) bool {
+	if workflowDefinition == nil {
+		return false
+	}
+
+	for i := range workflowDefinition.Steps {
+		if len(workflowDefinition.Steps[i].Outputs) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// envSliceToMap converts `KEY=value` entries to a map. Later entries override earlier ones.
+func envSliceToMap(env []string) map[string]string {
+	result := make(map[string]string, len(env))
+	for _, entry := range env {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			result[key] = value
+		}
+	}
+	return result
+}
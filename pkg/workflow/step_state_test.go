@@ -0,0 +1,85 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	errUtils "github.com/cloudposse/atmos/errors"
+	"github.com/cloudposse/atmos/pkg/schema"
+)
+
+func TestStepOutcomes_TemplateData(t *testing.T) {
+	outcomes := NewStepOutcomes()
+	outcomes.Set("vpc", StepOutcome{Status: StepStatusSuccess, Outputs: map[string]string{"vpc_id": "vpc-123"}})
+	outcomes.Set("dns", StepOutcome{Status: StepStatusSkipped})
+
+	outcome, ok := outcomes.Get("vpc")
+	require.True(t, ok)
+	assert.Equal(t, StepStatusSuccess, outcome.Status)
+
+	data := StepTemplateData("deploy", "dev", []string{"FOO=bar", "FOO=baz", "INVALID"}, outcomes)
+	assert.Equal(t, "deploy", data["workflow"])
+	assert.Equal(t, "dev", data["stack"])
+	assert.Equal(t, map[string]string{"FOO": "baz"}, data["env"])
+	assert.Equal(t, map[string]any{
+		"vpc": map[string]any{"status": StepStatusSuccess, "outputs": map[string]any{"vpc_id": "vpc-123"}},
+		"dns": map[string]any{"status": StepStatusSkipped, "outputs": map[string]any{}},
+	}, data["steps"])
+}
+
+func TestOutputTemplateData(t *testing.T) {
+	base := map[string]any{"stack": "dev"}
+
+	data := OutputTemplateData(base, "  {\"vpc_id\": \"vpc-123\"}\n")
+	assert.Equal(t, "dev", data["stack"])
+	assert.Equal(t, `{"vpc_id": "vpc-123"}`, data["stdout"])
+	assert.Equal(t, map[string]any{"vpc_id": "vpc-123"}, data["json"])
+	assert.NotContains(t, base, "stdout", "the base data must not be modified")
+
+	data = OutputTemplateData(base, "plain text\n")
+	assert.Equal(t, "plain text", data["stdout"])
+	assert.Nil(t, data["json"])
+}
+
+func TestConditionTemplate(t *testing.T) {
+	assert.Equal(t, `{{ eq .stack "prod" }}`, ConditionTemplate(` eq .stack "prod" `))
+	assert.Equal(t, `{{ eq .stack "prod" }}`, ConditionTemplate(`{{ eq .stack "prod" }}`))
+}
+
+func TestParseConditionResult(t *testing.T) {
+	tests := []struct {
+		rendered string
+		want     bool
+		wantErr  bool
+	}{
+		{rendered: "true", want: true},
+		{rendered: " True\n", want: true},
+		{rendered: "false", want: false},
+		{rendered: "", want: false},
+		{rendered: "<no value>", want: false},
+		{rendered: "yes", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.rendered, func(t *testing.T) {
+			got, err := ParseConditionResult("step1", tt.rendered)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, errUtils.ErrInvalidWorkflowStepCondition)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestUsesStepOutputs(t *testing.T) {
+	assert.False(t, UsesStepOutputs(nil))
+	assert.False(t, UsesStepOutputs(&schema.WorkflowDefinition{Steps: []schema.WorkflowStep{{Name: "a"}}}))
+	assert.True(t, UsesStepOutputs(&schema.WorkflowDefinition{Steps: []schema.WorkflowStep{
+		{Name: "a"},
+		{Name: "b", Outputs: map[string]string{"id": "{{ .stdout }}"}},
+	}}))
+}
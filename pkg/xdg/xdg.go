@@ -26,6 +26,7 @@ func init() {
 			xdg.CacheHome = filepath.Join(homeDir, ".cache")
 			xdg.DataHome = filepath.Join(homeDir, ".local", "share")
 			xdg.ConfigHome = filepath.Join(homeDir, ".config")
+			xdg.StateHome = filepath.Join(homeDir, ".local", "state")
 		}
 	}
 }
@@ -51,6 +52,13 @@ func GetXDGConfigDir(subpath string, perm os.FileMode) (string, error) {
 	return getXDGDir("XDG_CONFIG_HOME", "ATMOS_XDG_CONFIG_HOME", xdg.ConfigHome, subpath, perm)
 }
 
+// GetXDGStateDir returns the Atmos state directory following XDG Base Directory Specification.
+// It respects ATMOS_XDG_STATE_HOME and XDG_STATE_HOME environment variables.
+// The directory is created if it doesn't exist.
+func GetXDGStateDir(subpath string, perm os.FileMode) (string, error) {
+	return getXDGDir("XDG_STATE_HOME", "ATMOS_XDG_STATE_HOME", xdg.StateHome, subpath, perm)
+}
+
 // LookupXDGConfigDir resolves the Atmos config directory path without creating it.
 // Use this for read-only checks where directory creation is not desired.
 func LookupXDGConfigDir(subpath string) string {
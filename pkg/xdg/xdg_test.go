@@ -53,6 +53,26 @@ func TestGetXDGConfigDir(t *testing.T) {
 	assert.True(t, info.IsDir())
 }
 
+func TestGetXDGStateDir(t *testing.T) {
+	tempHome := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", filepath.Join(tempHome, ".local", "state"))
+
+	dir, err := GetXDGStateDir("workflow/runs", 0o700)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(tempHome, ".local", "state", "atmos", "workflow", "runs"), dir)
+
+	// Verify directory was created.
+	info, err := os.Stat(dir)
+	require.NoError(t, err)
+	assert.True(t, info.IsDir())
+
+	// ATMOS_XDG_STATE_HOME takes precedence.
+	t.Setenv("ATMOS_XDG_STATE_HOME", filepath.Join(tempHome, "custom-state"))
+	dir, err = GetXDGStateDir("workflow/runs", 0o700)
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(tempHome, "custom-state", "atmos", "workflow", "runs"), dir)
+}
+
 func TestGetXDGCacheDir_AtmosOverride(t *testing.T) {
 	tempHome := t.TempDir()
 	t.Setenv("XDG_CACHE_HOME", filepath.Join(tempHome, ".cache"))
@@ -1112,7 +1112,7 @@ func runCLICommandTest(t *testing.T, tc TestCase) {
 	// Set up XDG directories in temp locations to ensure test isolation.
 	// This prevents tests from:
 	// 1. Reading user's telemetry acknowledgment state (causing inconsistent telemetry notices)
-	// 2. Writing to user's cache/config/data directories
+	// 2. Writing to user's cache/config/data/state directories
 	// 3. Being affected by user's XDG environment settings
 	//
 	// Use the existing tempDir to ensure XDG paths share the same root directory.
@@ -1122,9 +1122,11 @@ func runCLICommandTest(t *testing.T, tc TestCase) {
 		"XDG_CACHE_HOME":        filepath.Join(xdgTempDir, "cache"),
 		"XDG_CONFIG_HOME":       filepath.Join(xdgTempDir, "config"),
 		"XDG_DATA_HOME":         filepath.Join(xdgTempDir, "data"),
+		"XDG_STATE_HOME":        filepath.Join(xdgTempDir, "state"),
 		"ATMOS_XDG_CACHE_HOME":  filepath.Join(xdgTempDir, "cache"),
 		"ATMOS_XDG_CONFIG_HOME": filepath.Join(xdgTempDir, "config"),
 		"ATMOS_XDG_DATA_HOME":   filepath.Join(xdgTempDir, "data"),
+		"ATMOS_XDG_STATE_HOME":  filepath.Join(xdgTempDir, "state"),
 	}
 
 	// Add XDG vars to environment unless test explicitly sets them.